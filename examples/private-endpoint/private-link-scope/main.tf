@@ -43,11 +43,35 @@ resource "azurerm_private_dns_zone" "example" {
   resource_group_name = azurerm_resource_group.example.name
 }
 
+# the Private DNS Zones must be linked to the Virtual Network, otherwise the private records can't be resolved from it
+resource "azurerm_private_dns_zone_virtual_network_link" "example" {
+  for_each = azurerm_private_dns_zone.example
+
+  name                  = "${var.prefix}-link"
+  resource_group_name   = azurerm_resource_group.example.name
+  private_dns_zone_name = each.value.name
+  virtual_network_id    = azurerm_virtual_network.example.id
+}
+
 resource "azurerm_monitor_private_link_scope" "example" {
   name                = "${var.prefix}-ampls"
   resource_group_name = azurerm_resource_group.example.name
 }
 
+resource "azurerm_monitor_data_collection_endpoint" "example" {
+  name                          = "${var.prefix}-dce"
+  resource_group_name           = azurerm_resource_group.example.name
+  location                      = azurerm_resource_group.example.location
+  public_network_access_enabled = false
+}
+
+resource "azurerm_monitor_private_link_scoped_service" "example" {
+  name                = "${var.prefix}-amplsservice"
+  resource_group_name = azurerm_resource_group.example.name
+  scope_name          = azurerm_monitor_private_link_scope.example.name
+  linked_resource_id  = azurerm_monitor_data_collection_endpoint.example.id
+}
+
 resource "azurerm_private_endpoint" "this" {
   name                = "${var.prefix}-ape"
   location            = azurerm_resource_group.example.location
@@ -65,4 +89,8 @@ resource "azurerm_private_endpoint" "this" {
     private_connection_resource_id = azurerm_monitor_private_link_scope.example.id
     subresource_names              = ["azuremonitor"]
   }
+
+  # the Data Collection Endpoint must be within the Private Link Scope before the Private Endpoint is created, so that
+  # the regional records for the Data Collection Endpoint are added to the `privatelink.monitor.azure.com` zone
+  depends_on = [azurerm_monitor_private_link_scoped_service.example]
 }
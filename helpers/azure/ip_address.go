@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// NormalizeIPAddressOrCIDR returns the canonical form of an IPv4 or IPv6 address or CIDR, e.g. `2001:DB8:0::/32`
+// becomes `2001:db8::/32`. The prefix length and host bits are kept as specified, so `10.0.0.1` and `10.0.0.1/32` are
+// distinct values. Values which can't be parsed are returned as-is so that they can be reported by the validation.
+func NormalizeIPAddressOrCIDR(input string) string {
+	value := strings.TrimSpace(input)
+
+	if ip, ipNet, err := net.ParseCIDR(value); err == nil {
+		prefix, _ := ipNet.Mask.Size()
+		return fmt.Sprintf("%s/%d", ip.String(), prefix)
+	}
+
+	if ip := net.ParseIP(value); ip != nil {
+		return ip.String()
+	}
+
+	return input
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+func TestNormalizeIPAddressOrCIDR(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "10.0.0.1",
+			expected: "10.0.0.1",
+		},
+		{
+			input:    " 10.0.0.1 ",
+			expected: "10.0.0.1",
+		},
+		{
+			input:    "10.0.0.1/32",
+			expected: "10.0.0.1/32",
+		},
+		{
+			input:    "10.0.0.5/24",
+			expected: "10.0.0.5/24",
+		},
+		{
+			input:    "0.0.0.0/0",
+			expected: "0.0.0.0/0",
+		},
+		{
+			input:    "2001:DB8:0:0::/32",
+			expected: "2001:db8::/32",
+		},
+		{
+			input:    "2001:0DB8:0000:0000:0000:0000:0000:0001",
+			expected: "2001:db8::1",
+		},
+		{
+			input:    "AzureCloud",
+			expected: "AzureCloud",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		if actual := azure.NormalizeIPAddressOrCIDR(v.input); actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waf
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// The Front Door and Application Gateway Web Application Firewalls use their own SDK types for the operators of a
+// custom rule's match condition, however the values are the same for both
+const (
+	OperatorGeoMatch = "GeoMatch"
+	OperatorIPMatch  = "IPMatch"
+)
+
+var geoCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// MatchValuesSchema returns the schema for the `match_values` of a custom rule's match condition, where the values
+// are compared using the normalised form for the `operator` of the same match condition
+func MatchValuesSchema(required bool, maxItems int, validateFunc pluginsdk.SchemaValidateFunc) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: maxItems,
+		Elem: &pluginsdk.Schema{
+			Type:             pluginsdk.TypeString,
+			ValidateFunc:     validateFunc,
+			DiffSuppressFunc: MatchValueDiffSuppress,
+		},
+	}
+}
+
+// MatchValueDiffSuppress suppresses the difference between a match value and its normalised form, e.g. `us` and `US`
+// for the `GeoMatch` operator, or `2001:DB8:0::/32` and `2001:db8::/32` for the `IPMatch` operator
+func MatchValueDiffSuppress(k, old, new string, d *pluginsdk.ResourceData) bool {
+	index := strings.LastIndex(k, ".match_values.")
+	if index == -1 || old == "" || new == "" {
+		return false
+	}
+
+	operator := d.Get(k[:index] + ".operator").(string)
+	return NormalizeMatchValue(operator, old) == NormalizeMatchValue(operator, new)
+}
+
+// NormalizeMatchValue returns the normalised form of the match value for the operator
+func NormalizeMatchValue(operator string, input string) string {
+	switch operator {
+	case OperatorGeoMatch:
+		return NormalizeGeoCode(input)
+	case OperatorIPMatch:
+		return azure.NormalizeIPAddressOrCIDR(input)
+	}
+
+	return input
+}
+
+// NormalizeMatchValues returns the normalised form of each of the match values for the operator
+func NormalizeMatchValues(operator string, input []interface{}) []string {
+	output := make([]string, 0)
+	for _, v := range input {
+		if s, ok := v.(string); ok {
+			output = append(output, NormalizeMatchValue(operator, s))
+		}
+	}
+
+	return output
+}
+
+// NormalizeGeoCode returns the upper case ISO 3166-1 alpha-2 country code used by the `GeoMatch` operator
+func NormalizeGeoCode(input string) string {
+	return strings.ToUpper(strings.TrimSpace(input))
+}
+
+// ValidateMatchValues validates the match values for the operator, `GeoMatch` values must be a country code and
+// `IPMatch` values must be an IPv4 or IPv6 address or CIDR
+func ValidateMatchValues(operator string, input []interface{}) error {
+	for _, v := range input {
+		value, ok := v.(string)
+		// the values may not be known until apply when they're sourced from another resource
+		if !ok || value == "" {
+			continue
+		}
+
+		switch operator {
+		case OperatorGeoMatch:
+			if !geoCodeRegex.MatchString(NormalizeGeoCode(value)) {
+				return fmt.Errorf("when the `operator` is `%s` the `match_values` must be a 2 letter country code, got %q", OperatorGeoMatch, value)
+			}
+		case OperatorIPMatch:
+			if !isIPAddressOrCIDR(strings.TrimSpace(value)) {
+				return fmt.Errorf("when the `operator` is `%s` the `match_values` must be an IPv4 or IPv6 address or CIDR, got %q", OperatorIPMatch, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateCustomRules validates the match values of each match condition within the custom rules, where
+// conditionsKey is the name of the match conditions block within a custom rule
+func ValidateCustomRules(rules []interface{}, conditionsKey string) error {
+	for i, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		conditions, _ := rule[conditionsKey].([]interface{})
+		for j, c := range conditions {
+			condition, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			operator, _ := condition["operator"].(string)
+			values, _ := condition["match_values"].([]interface{})
+			if err := ValidateMatchValues(operator, values); err != nil {
+				return fmt.Errorf("custom rule %d, `%s` %d: %+v", i, conditionsKey, j, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isIPAddressOrCIDR(input string) bool {
+	if _, _, err := net.ParseCIDR(input); err == nil {
+		return true
+	}
+
+	return net.ParseIP(input) != nil
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waf
+
+import (
+	"testing"
+)
+
+func TestNormalizeMatchValue(t *testing.T) {
+	cases := []struct {
+		Operator string
+		Input    string
+		Expected string
+	}{
+		{
+			Operator: OperatorGeoMatch,
+			Input:    "us",
+			Expected: "US",
+		},
+		{
+			Operator: OperatorGeoMatch,
+			Input:    " Gb ",
+			Expected: "GB",
+		},
+		{
+			Operator: OperatorIPMatch,
+			Input:    "10.0.0.0/24",
+			Expected: "10.0.0.0/24",
+		},
+		{
+			Operator: OperatorIPMatch,
+			Input:    "192.168.1.1",
+			Expected: "192.168.1.1",
+		},
+		{
+			Operator: OperatorIPMatch,
+			Input:    "2001:DB8:0:0::/32",
+			Expected: "2001:db8::/32",
+		},
+		{
+			Operator: OperatorIPMatch,
+			Input:    "2001:0DB8:0000:0000:0000:0000:0000:0001",
+			Expected: "2001:db8::1",
+		},
+		{
+			Operator: OperatorIPMatch,
+			Input:    "not-an-ip",
+			Expected: "not-an-ip",
+		},
+		{
+			Operator: "Contains",
+			Input:    "us",
+			Expected: "us",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q with %q", tc.Input, tc.Operator)
+
+		if actual := NormalizeMatchValue(tc.Operator, tc.Input); actual != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestValidateMatchValues(t *testing.T) {
+	cases := []struct {
+		Operator string
+		Input    []interface{}
+		Valid    bool
+	}{
+		{
+			Operator: OperatorGeoMatch,
+			Input:    []interface{}{"US", "gb", "ZZ"},
+			Valid:    true,
+		},
+		{
+			Operator: OperatorGeoMatch,
+			Input:    []interface{}{"USA"},
+			Valid:    false,
+		},
+		{
+			Operator: OperatorGeoMatch,
+			Input:    []interface{}{"U1"},
+			Valid:    false,
+		},
+		{
+			Operator: OperatorIPMatch,
+			Input:    []interface{}{"10.0.0.0/8", "192.168.1.1", "2001:db8::/32", "::1"},
+			Valid:    true,
+		},
+		{
+			Operator: OperatorIPMatch,
+			Input:    []interface{}{"2001:db8::/129"},
+			Valid:    false,
+		},
+		{
+			Operator: OperatorIPMatch,
+			Input:    []interface{}{"10.0.0.256"},
+			Valid:    false,
+		},
+		{
+			// unknown values are validated during the apply
+			Operator: OperatorIPMatch,
+			Input:    []interface{}{""},
+			Valid:    true,
+		},
+		{
+			Operator: "Contains",
+			Input:    []interface{}{"anything"},
+			Valid:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %v with %q", tc.Input, tc.Operator)
+
+		err := ValidateMatchValues(tc.Operator, tc.Input)
+		if valid := err == nil; valid != tc.Valid {
+			t.Fatalf("expected %t but got %t (%+v)", tc.Valid, valid, err)
+		}
+	}
+}
+
+func TestValidateCustomRules(t *testing.T) {
+	rules := []interface{}{
+		map[string]interface{}{
+			"match_conditions": []interface{}{
+				map[string]interface{}{
+					"operator":     OperatorGeoMatch,
+					"match_values": []interface{}{"US"},
+				},
+				map[string]interface{}{
+					"operator":     OperatorIPMatch,
+					"match_values": []interface{}{"2001:db8::/32"},
+				},
+			},
+		},
+	}
+
+	if err := ValidateCustomRules(rules, "match_conditions"); err != nil {
+		t.Fatalf("expected no error but got %+v", err)
+	}
+
+	rules = append(rules, map[string]interface{}{
+		"match_conditions": []interface{}{
+			map[string]interface{}{
+				"operator":     OperatorIPMatch,
+				"match_values": []interface{}{"2001:db8::zz"},
+			},
+		},
+	})
+
+	if err := ValidateCustomRules(rules, "match_conditions"); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
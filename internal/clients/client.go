@@ -160,6 +160,10 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// TimeoutMultipliers contains the multiplier for the default Create, Update and Delete timeouts of the
+	// resources in each service, keyed by the name of the service
+	TimeoutMultipliers map[string]float64
+
 	AadB2c                            *aadb2c_v2021_04_01_preview.Client
 	Advisor                           *advisor.Client
 	AnalysisServices                  *analysisservices_v2017_08_01.Client
@@ -288,6 +292,16 @@ type Client struct {
 	Workloads                         *workloads_v2024_09_01.Client
 }
 
+// TimeoutMultiplier returns the multiplier for the default Create, Update and Delete timeouts of the resources
+// in the specified service
+func (client *Client) TimeoutMultiplier(service string) float64 {
+	if v, ok := client.TimeoutMultipliers[service]; ok && v > 1 {
+		return v
+	}
+
+	return 1
+}
+
 // NOTE: it should be possible for this method to become Private once the top level Client's removed
 
 func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error {
@@ -150,3 +150,38 @@ func UserPrincipalObjectID(ctx context.Context, authorizer auth.Authorizer, envi
 
 	return model.ID, nil
 }
+
+// DirectoryObjectExists returns whether the Directory Object (e.g. a User, Group or Service Principal) with the specified
+// Object ID can be resolved in Microsoft Graph - which can take some time after the Principal is created
+func DirectoryObjectExists(ctx context.Context, authorizer auth.Authorizer, environment environments.Environment, objectId string) (bool, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+			http.StatusNotFound,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: nil,
+		Path:          fmt.Sprintf("/directoryObjects/%s", objectId),
+	}
+
+	client, err := graphClient(authorizer, environment)
+	if err != nil {
+		return false, err
+	}
+
+	req, err := client.NewRequest(ctx, opts)
+	if err != nil {
+		return false, fmt.Errorf("building new request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		if resp != nil && resp.Response != nil && resp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("executing request: %+v", err)
+	}
+
+	return resp.StatusCode == http.StatusOK, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"golang.org/x/oauth2"
+)
+
+var _ auth.Authorizer = &oidcTokenFileAuthorizer{}
+
+// oidcTokenFileAuthorizer re-reads the OIDC token from a file whenever the file is modified, so that a token which is
+// rotated on disk (for example by AKS Workload Identity) is exchanged for new access tokens during long-running applies,
+// rather than the token which was read when the provider was configured (which may since have expired)
+type oidcTokenFileAuthorizer struct {
+	api  environments.Api
+	path string
+
+	mutex       sync.Mutex
+	credentials auth.Credentials
+	modTime     time.Time
+	authorizer  auth.Authorizer
+}
+
+// newAuthorizer builds an authorizer for the specified API using the credentials from the ClientBuilder
+func newAuthorizer(ctx context.Context, builder ClientBuilder, api environments.Api) (auth.Authorizer, error) {
+	authorizer, err := auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+	if err != nil {
+		return nil, err
+	}
+
+	if builder.OIDCTokenFilePath == "" || !builder.AuthConfig.EnableAuthenticationUsingOIDC {
+		return authorizer, nil
+	}
+
+	info, err := os.Stat(builder.OIDCTokenFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving information about the OIDC Token file %q: %+v", builder.OIDCTokenFilePath, err)
+	}
+
+	return &oidcTokenFileAuthorizer{
+		api:         api,
+		path:        builder.OIDCTokenFilePath,
+		credentials: *builder.AuthConfig,
+		modTime:     info.ModTime(),
+		authorizer:  authorizer,
+	}, nil
+}
+
+func (a *oidcTokenFileAuthorizer) Token(ctx context.Context, request *http.Request) (*oauth2.Token, error) {
+	authorizer, err := a.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorizer.Token(ctx, request)
+}
+
+func (a *oidcTokenFileAuthorizer) AuxiliaryTokens(ctx context.Context, request *http.Request) ([]*oauth2.Token, error) {
+	authorizer, err := a.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorizer.AuxiliaryTokens(ctx, request)
+}
+
+// current returns the authorizer for the OIDC token currently within the file, rebuilding it when the token has changed
+func (a *oidcTokenFileAuthorizer) current(ctx context.Context) (auth.Authorizer, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	info, err := os.Stat(a.path)
+	if err != nil {
+		// the file may be in the middle of being replaced, in which case the existing token continues to be used
+		log.Printf("[DEBUG] retrieving information about the OIDC Token file %q: %+v - using the existing OIDC Token", a.path, err)
+		return a.authorizer, nil
+	}
+
+	if info.ModTime().Equal(a.modTime) {
+		return a.authorizer, nil
+	}
+
+	raw, err := os.ReadFile(a.path)
+	if err != nil {
+		return nil, fmt.Errorf("reading OIDC Token from file %q: %v", a.path, err)
+	}
+
+	token := strings.TrimSpace(string(raw))
+	if token == "" || token == a.credentials.OIDCAssertionToken {
+		a.modTime = info.ModTime()
+		return a.authorizer, nil
+	}
+
+	log.Printf("[DEBUG] the OIDC Token within the file %q has changed - building a new authorizer for the %q API", a.path, a.api.Name())
+	credentials := a.credentials
+	credentials.OIDCAssertionToken = token
+	authorizer, err := auth.NewAuthorizerFromCredentials(ctx, credentials, a.api)
+	if err != nil {
+		return nil, fmt.Errorf("building authorizer for the %q API using the OIDC Token from file %q: %+v", a.api.Name(), a.path, err)
+	}
+
+	a.credentials = credentials
+	a.modTime = info.ModTime()
+	a.authorizer = authorizer
+
+	return a.authorizer, nil
+}
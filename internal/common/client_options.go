@@ -85,6 +85,15 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	c.Authorizer = authorizer
 	c.Sender = sender.BuildSender("AzureRM")
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
+
+	// requests which are throttled or fail with a transient error are retried by go-autorest using these values - these
+	// can't be applied to the go-azure-sdk clients, which retry throttled requests until the deadline of the operation
+	if v := o.Features.Client.LegacyClientRetryMaxAttempts; v > 0 {
+		c.RetryAttempts = v
+	}
+	if v := o.Features.Client.LegacyClientRetryBackoff; v > 0 {
+		c.RetryDuration = v
+	}
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
 		if id == "" {
@@ -3,6 +3,8 @@
 
 package features
 
+import "time"
+
 func Default() UserFeatures {
 	return UserFeatures{
 		// NOTE: ensure all nested objects are fully populated
@@ -86,5 +88,13 @@ func Default() UserFeatures {
 		DatabricksWorkspace: DatabricksWorkspaceFeatures{
 			ForceDelete: false,
 		},
+		RoleAssignment: RoleAssignmentFeatures{
+			WaitForIdentityPropagation: false,
+		},
+		Client: ClientFeatures{
+			LegacyClientRetryMaxAttempts: 3,
+			LegacyClientRetryBackoff:     30 * time.Second,
+			TimeoutMultiplier:            1,
+		},
 	}
 }
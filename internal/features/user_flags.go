@@ -3,6 +3,8 @@
 
 package features
 
+import "time"
+
 type UserFeatures struct {
 	ApiManagement            ApiManagementFeatures
 	AppConfiguration         AppConfigurationFeatures
@@ -23,6 +25,8 @@ type UserFeatures struct {
 	RecoveryService          RecoveryServiceFeatures
 	NetApp                   NetAppFeatures
 	DatabricksWorkspace      DatabricksWorkspaceFeatures
+	RoleAssignment           RoleAssignmentFeatures
+	Client                   ClientFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -66,7 +70,8 @@ type LogAnalyticsWorkspaceFeatures struct {
 }
 
 type ResourceGroupFeatures struct {
-	PreventDeletionIfContainsResources bool
+	PreventDeletionIfContainsResources     bool
+	PreventDeletionIfContainsResourceTypes []string
 }
 
 type ApiManagementFeatures struct {
@@ -121,3 +126,13 @@ type NetAppFeatures struct {
 type DatabricksWorkspaceFeatures struct {
 	ForceDelete bool
 }
+
+type RoleAssignmentFeatures struct {
+	WaitForIdentityPropagation bool
+}
+
+type ClientFeatures struct {
+	LegacyClientRetryMaxAttempts int
+	LegacyClientRetryBackoff     time.Duration
+	TimeoutMultiplier            float64
+}
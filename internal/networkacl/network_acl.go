@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkacl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// The Allow and Deny actions are shared by the Network ACL APIs of every service using this package, with each
+// service's SDK package defining their own type for them.
+const (
+	ActionAllow = "Allow"
+	ActionDeny  = "Deny"
+)
+
+// NetworkACL is a service-agnostic representation of the `network_acl` block, which each service maps onto the
+// types from its own SDK package.
+type NetworkACL struct {
+	DefaultAction string
+	IPRules       []IPRule
+}
+
+// IPRule is an IP address or CIDR range which is an exception to the default action of a NetworkACL.
+type IPRule struct {
+	Address string
+	Action  string
+}
+
+// Schema returns the `network_acl` block, in which `ip_rules` are the exceptions to `default_action` - that is when
+// `default_action` is `Deny` the `ip_rules` are allowed, and when it's `Allow` they're denied.
+func Schema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"default_action": DefaultActionSchema(),
+
+				"ip_rules": IPRulesSchema(),
+			},
+		},
+	}
+}
+
+func DefaultActionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Required: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			ActionAllow,
+			ActionDeny,
+		}, false),
+	}
+}
+
+// IPRulesSchema returns a set of IP addresses and CIDR ranges which is hashed on the normalized address, so that
+// equivalent values such as `2001:DB8::1` and `2001:db8::1` don't cause a diff. It's Computed since services apply
+// their own default rules, which are left untouched unless `ip_rules` is specified.
+func IPRulesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
+		},
+		Set: hashAddress,
+	}
+}
+
+// ValidateRequestTypes validates the `allowed_request_types` and `denied_request_types` of the named block, which
+// like `ip_rules` are exceptions to the default action - so only the request types which are denied can be specified
+// when the default action is `Allow`, and only those which are allowed when it's `Deny`.
+func ValidateRequestTypes(defaultAction string, block string, input map[string]interface{}) error {
+	allowed := 0
+	if v, ok := input["allowed_request_types"].(*pluginsdk.Set); ok {
+		allowed = v.Len()
+	}
+	denied := 0
+	if v, ok := input["denied_request_types"].(*pluginsdk.Set); ok {
+		denied = v.Len()
+	}
+
+	if allowed != 0 && denied != 0 {
+		return fmt.Errorf("`allowed_request_types` and `denied_request_types` cannot be set together for `%s`", block)
+	}
+	if strings.EqualFold(defaultAction, ActionAllow) && allowed != 0 {
+		return fmt.Errorf("when `default_action` is `%s` for `%s`, `allowed_request_types` cannot be specified", ActionAllow, block)
+	}
+	if strings.EqualFold(defaultAction, ActionDeny) && denied != 0 {
+		return fmt.Errorf("when `default_action` is `%s` for `%s`, `denied_request_types` cannot be specified", ActionDeny, block)
+	}
+
+	return nil
+}
+
+// DefaultIPRules returns the rules applied by services when no IP rules are specified, which allow all IPv4 and IPv6
+// addresses through to the default action.
+func DefaultIPRules() []IPRule {
+	return []IPRule{
+		{Address: "0.0.0.0/0", Action: ActionAllow},
+		{Address: "::/0", Action: ActionAllow},
+	}
+}
+
+// OppositeAction returns the action applied to the IP rules for the given default action.
+func OppositeAction(defaultAction string) string {
+	if strings.EqualFold(defaultAction, ActionAllow) {
+		return ActionDeny
+	}
+	return ActionAllow
+}
+
+func Expand(input []interface{}) *NetworkACL {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	defaultAction := raw["default_action"].(string)
+
+	return &NetworkACL{
+		DefaultAction: defaultAction,
+		IPRules:       ExpandIPRules(raw["ip_rules"].(*pluginsdk.Set).List(), defaultAction),
+	}
+}
+
+// ExpandIPRules normalizes the addresses and assigns them the action opposite to the default action.
+func ExpandIPRules(input []interface{}, defaultAction string) []IPRule {
+	output := make([]IPRule, 0)
+	action := OppositeAction(defaultAction)
+	for _, v := range input {
+		address, ok := v.(string)
+		if !ok || address == "" {
+			continue
+		}
+
+		output = append(output, IPRule{
+			Address: azure.NormalizeIPAddressOrCIDR(address),
+			Action:  action,
+		})
+	}
+
+	return output
+}
+
+func Flatten(input *NetworkACL) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"default_action": input.DefaultAction,
+			"ip_rules":       FlattenIPRules(input.IPRules, input.DefaultAction),
+		},
+	}
+}
+
+// FlattenIPRules returns the normalized addresses of the rules which are exceptions to the default action, rules
+// with the same action as the default action have no effect and are omitted.
+func FlattenIPRules(input []IPRule, defaultAction string) []interface{} {
+	output := make([]interface{}, 0)
+	for _, rule := range input {
+		if rule.Address == "" || (rule.Action != "" && strings.EqualFold(rule.Action, defaultAction)) {
+			continue
+		}
+
+		output = append(output, azure.NormalizeIPAddressOrCIDR(rule.Address))
+	}
+
+	return output
+}
+
+func hashAddress(v interface{}) int {
+	return pluginsdk.HashString(azure.NormalizeIPAddressOrCIDR(v.(string)))
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkacl_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/networkacl"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestOppositeAction(t *testing.T) {
+	if actual := networkacl.OppositeAction("Allow"); actual != networkacl.ActionDeny {
+		t.Fatalf("expected %q but got %q", networkacl.ActionDeny, actual)
+	}
+	if actual := networkacl.OppositeAction("Deny"); actual != networkacl.ActionAllow {
+		t.Fatalf("expected %q but got %q", networkacl.ActionAllow, actual)
+	}
+}
+
+func TestExpandIPRules(t *testing.T) {
+	actual := networkacl.ExpandIPRules([]interface{}{"10.0.0.1/32", " 192.168.1.7/24 ", "2001:DB8:0::1", ""}, networkacl.ActionDeny)
+	expected := []networkacl.IPRule{
+		{Address: "10.0.0.1/32", Action: networkacl.ActionAllow},
+		{Address: "192.168.1.7/24", Action: networkacl.ActionAllow},
+		{Address: "2001:db8::1", Action: networkacl.ActionAllow},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestFlattenIPRules(t *testing.T) {
+	input := []networkacl.IPRule{
+		{Address: "10.0.0.1/32", Action: networkacl.ActionDeny},
+		{Address: "10.1.0.0/16", Action: networkacl.ActionAllow},
+		{Address: "10.2.0.0/16"},
+	}
+
+	actual := networkacl.FlattenIPRules(input, networkacl.ActionAllow)
+	expected := []interface{}{"10.0.0.1/32", "10.2.0.0/16"}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestValidateRequestTypes(t *testing.T) {
+	tests := []struct {
+		defaultAction string
+		allowed       []interface{}
+		denied        []interface{}
+		valid         bool
+	}{
+		{defaultAction: networkacl.ActionAllow, denied: []interface{}{"Trace"}, valid: true},
+		{defaultAction: networkacl.ActionAllow, allowed: []interface{}{"Trace"}, valid: false},
+		{defaultAction: networkacl.ActionDeny, allowed: []interface{}{"Trace"}, valid: true},
+		{defaultAction: networkacl.ActionDeny, denied: []interface{}{"Trace"}, valid: false},
+		{defaultAction: networkacl.ActionDeny, allowed: []interface{}{"Trace"}, denied: []interface{}{"RESTAPI"}, valid: false},
+		{defaultAction: networkacl.ActionDeny, valid: true},
+	}
+
+	for _, test := range tests {
+		input := map[string]interface{}{
+			"allowed_request_types": pluginsdk.NewSet(pluginsdk.HashString, test.allowed),
+			"denied_request_types":  pluginsdk.NewSet(pluginsdk.HashString, test.denied),
+		}
+
+		err := networkacl.ValidateRequestTypes(test.defaultAction, "public_network", input)
+		if test.valid && err != nil {
+			t.Fatalf("expected %+v to be valid but got: %+v", test, err)
+		}
+		if !test.valid && err == nil {
+			t.Fatalf("expected %+v to be invalid", test)
+		}
+	}
+}
@@ -5,8 +5,10 @@ package provider
 
 import (
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -270,6 +272,14 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Optional: true,
 						Default:  os.Getenv("TF_ACC") == "",
 					},
+
+					"prevent_deletion_if_contains_resource_types": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
 				},
 			},
 		},
@@ -427,6 +437,55 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"role_assignment": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"wait_for_identity_propagation": {
+						Description: "When enabled, the `azurerm_role_assignment` resource will wait for the Principal to be resolvable in Microsoft Graph before creating the Role Assignment.",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+
+		"client": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"legacy_client_retry_max_attempts": {
+						Description:  "The maximum number of times a request made by an API client using the legacy Azure SDK which is throttled or fails with a transient error is attempted.",
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      3,
+						ValidateFunc: validation.IntBetween(1, 20),
+					},
+
+					"legacy_client_retry_backoff": {
+						Description:  "The number of seconds to wait between attempts of a request made by an API client using the legacy Azure SDK which is throttled or fails with a transient error.",
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      30,
+						ValidateFunc: validation.IntBetween(1, 300),
+					},
+
+					"timeout_multiplier": {
+						Description:  "Scales the default Create, Update and Delete timeouts of all resources.",
+						Type:         pluginsdk.TypeFloat,
+						Optional:     true,
+						Default:      1.0,
+						ValidateFunc: validation.FloatBetween(1, 10),
+					},
+				},
+			},
+		},
 	}
 
 	if !features.FivePointOh() {
@@ -621,6 +680,17 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := resourceGroupRaw["prevent_deletion_if_contains_resources"]; ok {
 				featuresMap.ResourceGroup.PreventDeletionIfContainsResources = v.(bool)
 			}
+			if v, ok := resourceGroupRaw["prevent_deletion_if_contains_resource_types"]; ok {
+				resourceTypes := make([]string, 0)
+				for _, resourceType := range v.([]interface{}) {
+					if s, ok := resourceType.(string); ok && s != "" {
+						resourceTypes = append(resourceTypes, s)
+					}
+				}
+				if len(resourceTypes) > 0 {
+					featuresMap.ResourceGroup.PreventDeletionIfContainsResourceTypes = resourceTypes
+				}
+			}
 		}
 	}
 
@@ -722,5 +792,31 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["role_assignment"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			roleAssignmentRaw := items[0].(map[string]interface{})
+			if v, ok := roleAssignmentRaw["wait_for_identity_propagation"]; ok {
+				featuresMap.RoleAssignment.WaitForIdentityPropagation = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["client"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			clientRaw := items[0].(map[string]interface{})
+			if v, ok := clientRaw["legacy_client_retry_max_attempts"]; ok {
+				featuresMap.Client.LegacyClientRetryMaxAttempts = v.(int)
+			}
+			if v, ok := clientRaw["legacy_client_retry_backoff"]; ok {
+				featuresMap.Client.LegacyClientRetryBackoff = time.Duration(v.(int)) * time.Second
+			}
+			if v, ok := clientRaw["timeout_multiplier"]; ok {
+				featuresMap.Client.TimeoutMultiplier = v.(float64)
+			}
+		}
+	}
+
 	return featuresMap
 }
@@ -6,6 +6,7 @@ package provider
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
@@ -98,6 +99,14 @@ func TestExpandFeatures(t *testing.T) {
 				DatabricksWorkspace: features.DatabricksWorkspaceFeatures{
 					ForceDelete: false,
 				},
+				RoleAssignment: features.RoleAssignmentFeatures{
+					WaitForIdentityPropagation: false,
+				},
+				Client: features.ClientFeatures{
+					LegacyClientRetryMaxAttempts: 3,
+					LegacyClientRetryBackoff:     30 * time.Second,
+					TimeoutMultiplier:            1,
+				},
 			},
 		},
 		{
@@ -219,6 +228,18 @@ func TestExpandFeatures(t *testing.T) {
 							"force_delete": true,
 						},
 					},
+					"role_assignment": []interface{}{
+						map[string]interface{}{
+							"wait_for_identity_propagation": true,
+						},
+					},
+					"client": []interface{}{
+						map[string]interface{}{
+							"legacy_client_retry_max_attempts": 10,
+							"legacy_client_retry_backoff":      60,
+							"timeout_multiplier":               2.5,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -299,6 +320,14 @@ func TestExpandFeatures(t *testing.T) {
 				DatabricksWorkspace: features.DatabricksWorkspaceFeatures{
 					ForceDelete: true,
 				},
+				RoleAssignment: features.RoleAssignmentFeatures{
+					WaitForIdentityPropagation: true,
+				},
+				Client: features.ClientFeatures{
+					LegacyClientRetryMaxAttempts: 10,
+					LegacyClientRetryBackoff:     60 * time.Second,
+					TimeoutMultiplier:            2.5,
+				},
 			},
 		},
 		{
@@ -420,6 +449,18 @@ func TestExpandFeatures(t *testing.T) {
 							"force_delete": false,
 						},
 					},
+					"role_assignment": []interface{}{
+						map[string]interface{}{
+							"wait_for_identity_propagation": false,
+						},
+					},
+					"client": []interface{}{
+						map[string]interface{}{
+							"legacy_client_retry_max_attempts": 3,
+							"legacy_client_retry_backoff":      30,
+							"timeout_multiplier":               1.0,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -500,6 +541,14 @@ func TestExpandFeatures(t *testing.T) {
 				DatabricksWorkspace: features.DatabricksWorkspaceFeatures{
 					ForceDelete: false,
 				},
+				RoleAssignment: features.RoleAssignmentFeatures{
+					WaitForIdentityPropagation: false,
+				},
+				Client: features.ClientFeatures{
+					LegacyClientRetryMaxAttempts: 3,
+					LegacyClientRetryBackoff:     30 * time.Second,
+					TimeoutMultiplier:            1,
+				},
 			},
 		},
 	}
@@ -1341,6 +1390,25 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Prevent Deletion If Contains Resource Types",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_contains_resources":      false,
+							"prevent_deletion_if_contains_resource_types": []interface{}{"Microsoft.Storage/storageAccounts"},
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGroup: features.ResourceGroupFeatures{
+					PreventDeletionIfContainsResources:     false,
+					PreventDeletionIfContainsResourceTypes: []string{"Microsoft.Storage/storageAccounts"},
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testData {
@@ -1936,3 +2004,122 @@ func TestExpandFeaturesDatabricksWorkspace(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesRoleAssignment(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"role_assignment": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				RoleAssignment: features.RoleAssignmentFeatures{
+					WaitForIdentityPropagation: false,
+				},
+			},
+		},
+		{
+			Name: "Role Assignment Features Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"role_assignment": []interface{}{
+						map[string]interface{}{
+							"wait_for_identity_propagation": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				RoleAssignment: features.RoleAssignmentFeatures{
+					WaitForIdentityPropagation: true,
+				},
+			},
+		},
+		{
+			Name: "Role Assignment Features Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"role_assignment": []interface{}{
+						map[string]interface{}{
+							"wait_for_identity_propagation": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				RoleAssignment: features.RoleAssignmentFeatures{
+					WaitForIdentityPropagation: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.RoleAssignment, testCase.Expected.RoleAssignment) {
+			t.Fatalf("Expected %+v but got %+v", result.RoleAssignment, testCase.Expected.RoleAssignment)
+		}
+	}
+}
+
+func TestExpandFeaturesClient(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"client": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Client: features.ClientFeatures{
+					LegacyClientRetryMaxAttempts: 3,
+					LegacyClientRetryBackoff:     30 * time.Second,
+					TimeoutMultiplier:            1,
+				},
+			},
+		},
+		{
+			Name: "Client Features Specified",
+			Input: []interface{}{
+				map[string]interface{}{
+					"client": []interface{}{
+						map[string]interface{}{
+							"legacy_client_retry_max_attempts": 8,
+							"legacy_client_retry_backoff":      45,
+							"timeout_multiplier":               1.5,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Client: features.ClientFeatures{
+					LegacyClientRetryMaxAttempts: 8,
+					LegacyClientRetryBackoff:     45 * time.Second,
+					TimeoutMultiplier:            1.5,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Client, testCase.Expected.Client) {
+			t.Fatalf("Expected %+v but got %+v", result.Client, testCase.Expected.Client)
+		}
+	}
+}
@@ -78,7 +78,11 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	enableOIDC := getEnvBoolIfValueAbsent(data.UseOIDC, "ARM_USE_OIDC") || getEnvBoolIfValueAbsent(data.UseAKSWorkloadIdentity, "ARM_USE_AKS_WORKLOAD_IDENTITY")
 	auxTenants := getEnvListOfStringsIfAbsent(data.AuxiliaryTenantIds, "ARM_AUXILIARY_TENANT_IDS", ";")
 
-	oidcReqURL := getEnvStringsOrDefault(data.OIDCRequestURL, []string{"ARM_OIDC_REQUEST_URL", "ACTIONS_ID_TOKEN_REQUEST_URL", "SYSTEM_OIDCREQUESTURI"}, "")
+	oidcReqURL, err := getOidcRequestUrl(data)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("", err.Error()))
+		return
+	}
 	oidcReqToken := getEnvStringsOrDefault(data.OIDCRequestToken, []string{"ARM_OIDC_REQUEST_TOKEN", "ACTIONS_ID_TOKEN_REQUEST_TOKEN", "SYSTEM_ACCESSTOKEN"}, "")
 
 	// ARM_OIDC_AZURE_SERVICE_CONNECTION_ID is to be compatible with `azapi` provider.
@@ -96,7 +100,7 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 		ClientSecret:              *clientSecret,
 
 		OIDCAssertionToken:    *oidcToken,
-		OIDCTokenRequestURL:   oidcReqURL,
+		OIDCTokenRequestURL:   *oidcReqURL,
 		OIDCTokenRequestToken: oidcReqToken,
 
 		ADOPipelineServiceConnectionID: adoPipelineServiceConnectionID,
@@ -126,6 +130,7 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	p.clientBuilder.DisableCorrelationRequestID = getEnvBoolOrDefault(data.DisableCorrelationRequestId, "ARM_DISABLE_CORRELATION_REQUEST_ID", false)
 	p.clientBuilder.DisableTerraformPartnerID = getEnvBoolOrDefault(data.DisableTerraformPartnerId, "ARM_DISABLE_TERRAFORM_PARTNER_ID", false)
 	p.clientBuilder.StorageUseAzureAD = getEnvBoolOrDefault(data.StorageUseAzureAD, "ARM_STORAGE_USE_AZUREAD", false)
+	p.clientBuilder.OIDCTokenFilePath = getOidcTokenFilePath(data)
 
 	f := providerfeatures.UserFeatures{}
 
@@ -390,6 +395,18 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 			if !feature[0].PreventDeletionIfContainsResources.IsNull() && !feature[0].PreventDeletionIfContainsResources.IsUnknown() {
 				f.ResourceGroup.PreventDeletionIfContainsResources = feature[0].PreventDeletionIfContainsResources.ValueBool()
 			}
+
+			if !feature[0].PreventDeletionIfContainsResourceTypes.IsNull() && !feature[0].PreventDeletionIfContainsResourceTypes.IsUnknown() {
+				resourceTypes := make([]string, 0)
+				d := feature[0].PreventDeletionIfContainsResourceTypes.ElementsAs(ctx, &resourceTypes, false)
+				diags.Append(d...)
+				if diags.HasError() {
+					return
+				}
+				if len(resourceTypes) > 0 {
+					f.ResourceGroup.PreventDeletionIfContainsResourceTypes = resourceTypes
+				}
+			}
 		} else {
 			f.ResourceGroup.PreventDeletionIfContainsResources = os.Getenv("TF_ACC") == ""
 		}
@@ -519,6 +536,48 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 		} else {
 			f.DatabricksWorkspace.ForceDelete = false
 		}
+
+		if !features.RoleAssignment.IsNull() && !features.RoleAssignment.IsUnknown() {
+			var feature []RoleAssignment
+			d := features.RoleAssignment.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.RoleAssignment.WaitForIdentityPropagation = providerfeatures.Default().RoleAssignment.WaitForIdentityPropagation
+			if !feature[0].WaitForIdentityPropagation.IsNull() && !feature[0].WaitForIdentityPropagation.IsUnknown() {
+				f.RoleAssignment.WaitForIdentityPropagation = feature[0].WaitForIdentityPropagation.ValueBool()
+			}
+		} else {
+			f.RoleAssignment = providerfeatures.Default().RoleAssignment
+		}
+
+		if !features.Client.IsNull() && !features.Client.IsUnknown() {
+			var feature []Client
+			d := features.Client.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.Client.LegacyClientRetryMaxAttempts = providerfeatures.Default().Client.LegacyClientRetryMaxAttempts
+			if !feature[0].LegacyClientRetryMaxAttempts.IsNull() && !feature[0].LegacyClientRetryMaxAttempts.IsUnknown() {
+				f.Client.LegacyClientRetryMaxAttempts = int(feature[0].LegacyClientRetryMaxAttempts.ValueInt64())
+			}
+
+			f.Client.LegacyClientRetryBackoff = providerfeatures.Default().Client.LegacyClientRetryBackoff
+			if !feature[0].LegacyClientRetryBackoff.IsNull() && !feature[0].LegacyClientRetryBackoff.IsUnknown() {
+				f.Client.LegacyClientRetryBackoff = time.Duration(feature[0].LegacyClientRetryBackoff.ValueInt64()) * time.Second
+			}
+
+			f.Client.TimeoutMultiplier = providerfeatures.Default().Client.TimeoutMultiplier
+			if !feature[0].TimeoutMultiplier.IsNull() && !feature[0].TimeoutMultiplier.IsUnknown() {
+				f.Client.TimeoutMultiplier = feature[0].TimeoutMultiplier.ValueFloat64()
+			}
+		} else {
+			f.Client = providerfeatures.Default().Client
+		}
 	}
 
 	p.clientBuilder.Features = f
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -214,6 +215,22 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 	if features.DatabricksWorkspace.ForceDelete {
 		t.Errorf("expected databricks_workspace.ForceDelete to be false")
 	}
+
+	if features.RoleAssignment.WaitForIdentityPropagation {
+		t.Errorf("expected role_assignment.WaitForIdentityPropagation to be false")
+	}
+
+	if features.Client.LegacyClientRetryMaxAttempts != 3 {
+		t.Errorf("expected client.LegacyClientRetryMaxAttempts to be 3")
+	}
+
+	if features.Client.LegacyClientRetryBackoff != 30*time.Second {
+		t.Errorf("expected client.LegacyClientRetryBackoff to be 30s")
+	}
+
+	if features.Client.TimeoutMultiplier != 1 {
+		t.Errorf("expected client.TimeoutMultiplier to be 1")
+	}
 }
 
 // TODO - helper functions to make setting up test date more easily so we can add more configuration coverage
@@ -279,7 +296,8 @@ func defaultFeaturesList() types.List {
 	virtualMachineScaleSetList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(VirtualMachineScaleSetAttributes), []attr.Value{virtualMachineScaleSet})
 
 	resourceGroup, _ := basetypes.NewObjectValueFrom(context.Background(), ResourceGroupAttributes, map[string]attr.Value{
-		"prevent_deletion_if_contains_resources": basetypes.NewBoolNull(),
+		"prevent_deletion_if_contains_resources":      basetypes.NewBoolNull(),
+		"prevent_deletion_if_contains_resource_types": basetypes.NewListNull(types.StringType),
 	})
 	resourceGroupList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(ResourceGroupAttributes), []attr.Value{resourceGroup})
 
@@ -333,6 +351,18 @@ func defaultFeaturesList() types.List {
 	})
 	databricksWorkspaceList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(DatabricksWorkspaceAttributes), []attr.Value{databricksWorkspace})
 
+	roleAssignment, _ := basetypes.NewObjectValueFrom(context.Background(), RoleAssignmentAttributes, map[string]attr.Value{
+		"wait_for_identity_propagation": basetypes.NewBoolNull(),
+	})
+	roleAssignmentList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(RoleAssignmentAttributes), []attr.Value{roleAssignment})
+
+	client, _ := basetypes.NewObjectValueFrom(context.Background(), ClientAttributes, map[string]attr.Value{
+		"legacy_client_retry_max_attempts": basetypes.NewInt64Null(),
+		"legacy_client_retry_backoff":      basetypes.NewInt64Null(),
+		"timeout_multiplier":               basetypes.NewFloat64Null(),
+	})
+	clientList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(ClientAttributes), []attr.Value{client})
+
 	fData, d := basetypes.NewObjectValue(FeaturesAttributes, map[string]attr.Value{
 		"api_management":             apiManagementList,
 		"app_configuration":          appConfigurationList,
@@ -353,6 +383,8 @@ func defaultFeaturesList() types.List {
 		"recovery_services_vaults":   recoveryServicesVaultsList,
 		"netapp":                     netappList,
 		"databricks_workspace":       databricksWorkspaceList,
+		"role_assignment":            roleAssignmentList,
+		"client":                     clientList,
 	})
 
 	fmt.Printf("%+v", d)
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"net/url"
 	"os"
 	"strings"
 
@@ -84,6 +85,35 @@ func getOidcToken(d *ProviderModel) (*string, error) {
 	return &idToken, nil
 }
 
+// getOidcTokenFilePath returns the path to the file which the OIDC token is read from, so that the token can be re-read
+// when it's rotated during a long-running apply
+func getOidcTokenFilePath(d *ProviderModel) string {
+	if getEnvBoolIfValueAbsent(d.UseAKSWorkloadIdentity, "ARM_USE_AKS_WORKLOAD_IDENTITY") && os.Getenv("AZURE_FEDERATED_TOKEN_FILE") != "" {
+		return os.Getenv("AZURE_FEDERATED_TOKEN_FILE")
+	}
+
+	return getEnvStringOrDefault(d.OIDCTokenFilePath, "ARM_OIDC_TOKEN_FILE_PATH", "")
+}
+
+func getOidcRequestUrl(d *ProviderModel) (*string, error) {
+	requestUrl := strings.TrimSpace(getEnvStringsOrDefault(d.OIDCRequestURL, []string{"ARM_OIDC_REQUEST_URL", "ACTIONS_ID_TOKEN_REQUEST_URL", "SYSTEM_OIDCREQUESTURI"}, ""))
+
+	if audience := strings.TrimSpace(getEnvStringOrDefault(d.OIDCAudience, "ARM_OIDC_AUDIENCE", "")); audience != "" && requestUrl != "" {
+		u, err := url.Parse(requestUrl)
+		if err != nil {
+			return nil, fmt.Errorf("parsing OIDC Request URL %q: %v", requestUrl, err)
+		}
+
+		query := u.Query()
+		query.Set("audience", audience)
+		u.RawQuery = query.Encode()
+
+		requestUrl = u.String()
+	}
+
+	return &requestUrl, nil
+}
+
 func getClientId(d *ProviderModel) (*string, error) {
 	clientId := getEnvStringOrDefault(d.ClientId, "ARM_CLIENT_ID", "")
 
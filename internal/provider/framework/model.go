@@ -26,6 +26,7 @@ type ProviderModel struct {
 	OIDCRequestURL                 types.String `tfsdk:"oidc_request_url"`
 	OIDCToken                      types.String `tfsdk:"oidc_token"`
 	OIDCTokenFilePath              types.String `tfsdk:"oidc_token_file_path"`
+	OIDCAudience                   types.String `tfsdk:"oidc_audience"`
 	UseOIDC                        types.Bool   `tfsdk:"use_oidc"`
 	UseMSI                         types.Bool   `tfsdk:"use_msi"`
 	MSIEndpoint                    types.String `tfsdk:"msi_endpoint"`
@@ -40,6 +41,7 @@ type ProviderModel struct {
 	SkipProviderRegistration       types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
 	ResourceProviderRegistrations  types.String `tfsdk:"resource_provider_registrations"`
 	ResourceProvidersToRegister    types.List   `tfsdk:"resource_providers_to_register"`
+	TimeoutProfile                 types.List   `tfsdk:"timeout_profile"`
 }
 
 type Features struct {
@@ -62,6 +64,8 @@ type Features struct {
 	RecoveryServicesVaults   types.List `tfsdk:"recovery_services_vaults"`
 	NetApp                   types.List `tfsdk:"netapp"`
 	DatabricksWorkspace      types.List `tfsdk:"databricks_workspace"`
+	RoleAssignment           types.List `tfsdk:"role_assignment"`
+	Client                   types.List `tfsdk:"client"`
 }
 
 // FeaturesAttributes and the other block attribute vars are required for unit testing on the Load func
@@ -86,6 +90,8 @@ var FeaturesAttributes = map[string]attr.Type{
 	"recovery_services_vaults":   types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes)),
 	"netapp":                     types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(NetAppAttributes)),
 	"databricks_workspace":       types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(DatabricksWorkspaceAttributes)),
+	"role_assignment":            types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RoleAssignmentAttributes)),
+	"client":                     types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(ClientAttributes)),
 }
 
 type APIManagement struct {
@@ -197,11 +203,13 @@ var VirtualMachineScaleSetAttributes = map[string]attr.Type{
 }
 
 type ResourceGroup struct {
-	PreventDeletionIfContainsResources types.Bool `tfsdk:"prevent_deletion_if_contains_resources"`
+	PreventDeletionIfContainsResources     types.Bool `tfsdk:"prevent_deletion_if_contains_resources"`
+	PreventDeletionIfContainsResourceTypes types.List `tfsdk:"prevent_deletion_if_contains_resource_types"`
 }
 
 var ResourceGroupAttributes = map[string]attr.Type{
-	"prevent_deletion_if_contains_resources": types.BoolType,
+	"prevent_deletion_if_contains_resources":      types.BoolType,
+	"prevent_deletion_if_contains_resource_types": types.ListType{ElemType: types.StringType},
 }
 
 type ManagedDisk struct {
@@ -281,3 +289,23 @@ type DatabricksWorkspace struct {
 var DatabricksWorkspaceAttributes = map[string]attr.Type{
 	"force_delete": types.BoolType,
 }
+
+type RoleAssignment struct {
+	WaitForIdentityPropagation types.Bool `tfsdk:"wait_for_identity_propagation"`
+}
+
+var RoleAssignmentAttributes = map[string]attr.Type{
+	"wait_for_identity_propagation": types.BoolType,
+}
+
+type Client struct {
+	LegacyClientRetryMaxAttempts types.Int64   `tfsdk:"legacy_client_retry_max_attempts"`
+	LegacyClientRetryBackoff     types.Int64   `tfsdk:"legacy_client_retry_backoff"`
+	TimeoutMultiplier            types.Float64 `tfsdk:"timeout_multiplier"`
+}
+
+var ClientAttributes = map[string]attr.Type{
+	"legacy_client_retry_max_attempts": types.Int64Type,
+	"legacy_client_retry_backoff":      types.Int64Type,
+	"timeout_multiplier":               types.Float64Type,
+}
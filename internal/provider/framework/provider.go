@@ -6,7 +6,10 @@ package framework
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -152,6 +155,11 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 				Description: "The path to a file containing an OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
 			},
 
+			"oidc_audience": schema.StringAttribute{
+				Optional:    true,
+				Description: "The audience to request the OIDC ID token for from the OIDC provider. For use when authenticating as a Service Principal using OpenID Connect in an Azure Environment which requires a different audience, such as `api://AzureADTokenExchangeUSGov` for US Government.",
+			},
+
 			"use_oidc": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Allow OpenID Connect to be used for authentication",
@@ -409,6 +417,10 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 									"prevent_deletion_if_contains_resources": schema.BoolAttribute{
 										Optional: true,
 									},
+									"prevent_deletion_if_contains_resource_types": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
 								},
 							},
 						},
@@ -505,6 +517,65 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 								},
 							},
 						},
+						"role_assignment": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"wait_for_identity_propagation": schema.BoolAttribute{
+										Optional:    true,
+										Description: "When enabled, the `azurerm_role_assignment` resource will wait for the Principal to be resolvable in Microsoft Graph before creating the Role Assignment.",
+									},
+								},
+							},
+						},
+						"client": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"legacy_client_retry_max_attempts": schema.Int64Attribute{
+										Optional:    true,
+										Description: "The maximum number of times a request made by an API client using the legacy Azure SDK which is throttled or fails with a transient error is attempted.",
+										Validators: []validator.Int64{
+											int64validator.Between(1, 20),
+										},
+									},
+									"legacy_client_retry_backoff": schema.Int64Attribute{
+										Optional:    true,
+										Description: "The number of seconds to wait between attempts of a request made by an API client using the legacy Azure SDK which is throttled or fails with a transient error.",
+										Validators: []validator.Int64{
+											int64validator.Between(1, 300),
+										},
+									},
+									"timeout_multiplier": schema.Float64Attribute{
+										Optional:    true,
+										Description: "Scales the default Create, Update and Delete timeouts of all resources.",
+										Validators: []validator.Float64{
+											float64validator.Between(1, 10),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"timeout_profile": schema.ListNestedBlock{
+				Description: "Scales the default Create, Update and Delete timeouts of the resources in the specified services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"multiplier": schema.Float64Attribute{
+							Required: true,
+							Validators: []validator.Float64{
+								float64validator.Between(1, 10),
+							},
+						},
+
+						"services": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(pluginsdkprovider.TimeoutProfileServiceNames()...)),
+							},
+						},
 					},
 				},
 			},
@@ -555,12 +626,26 @@ func (p *azureRmFrameworkProvider) Resources(_ context.Context) []func() resourc
 	var output []func() resource.Resource
 
 	for _, service := range pluginsdkprovider.SupportedFrameworkServices() {
-		output = append(output, service.FrameworkResources()...)
+		for _, f := range service.FrameworkResources() {
+			output = append(output, frameworkResourceInService(f, service.Name()))
+		}
 	}
 
 	return output
 }
 
+// frameworkResourceInService sets the name of the Service on Resources embedding the sdk.ResourceMetadata, so that
+// the timeout profiles for the Service are applied to the Resource
+func frameworkResourceInService(f func() resource.Resource, serviceName string) func() resource.Resource {
+	return func() resource.Resource {
+		r := f()
+		if v, ok := r.(interface{ SetServiceName(string) }); ok {
+			v.SetServiceName(serviceName)
+		}
+		return r
+	}
+}
+
 func (p *azureRmFrameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
 	var output []func() ephemeral.EphemeralResource
 
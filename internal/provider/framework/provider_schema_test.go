@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	pluginsdkprovider "github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// the provider schema is served by both the Plugin SDKv2 and the Plugin Framework providers, so each attribute must
+// be described, validated and defaulted identically in both
+type providerSchemaComparisonTestCase struct {
+	// path is the names of the blocks containing the attribute, followed by the name of the attribute
+	path []string
+
+	// defaultValue is the default which the Plugin Framework provider uses when the attribute is omitted
+	defaultValue interface{}
+
+	valid   []interface{}
+	invalid []interface{}
+}
+
+func TestProviderSchemaMatchesPluginSdkTimeoutProfile(t *testing.T) {
+	testProviderSchemaMatchesPluginSdk(t, []providerSchemaComparisonTestCase{
+		{
+			path:    []string{"timeout_profile", "multiplier"},
+			valid:   []interface{}{1.0, 2.5, 10.0},
+			invalid: []interface{}{0.0, 0.5, 10.5},
+		},
+		{
+			path:    []string{"timeout_profile", "services"},
+			valid:   []interface{}{"Compute", "Container Services", "Storage"},
+			invalid: []interface{}{"", "compute", "NotAService"},
+		},
+	})
+}
+
+func TestProviderSchemaMatchesPluginSdkClientFeatures(t *testing.T) {
+	testProviderSchemaMatchesPluginSdk(t, []providerSchemaComparisonTestCase{
+		{
+			path:         []string{"features", "client", "legacy_client_retry_max_attempts"},
+			defaultValue: features.Default().Client.LegacyClientRetryMaxAttempts,
+			valid:        []interface{}{1, 3, 20},
+			invalid:      []interface{}{0, 21},
+		},
+		{
+			path:         []string{"features", "client", "legacy_client_retry_backoff"},
+			defaultValue: int(features.Default().Client.LegacyClientRetryBackoff / time.Second),
+			valid:        []interface{}{1, 30, 300},
+			invalid:      []interface{}{0, 301},
+		},
+		{
+			path:         []string{"features", "client", "timeout_multiplier"},
+			defaultValue: features.Default().Client.TimeoutMultiplier,
+			valid:        []interface{}{1.0, 2.5, 10.0},
+			invalid:      []interface{}{0.5, 10.5},
+		},
+	})
+}
+
+func TestProviderSchemaMatchesPluginSdkRoleAssignmentFeatures(t *testing.T) {
+	testProviderSchemaMatchesPluginSdk(t, []providerSchemaComparisonTestCase{
+		{
+			path:         []string{"features", "role_assignment", "wait_for_identity_propagation"},
+			defaultValue: features.Default().RoleAssignment.WaitForIdentityPropagation,
+			valid:        []interface{}{true, false},
+		},
+	})
+}
+
+func testProviderSchemaMatchesPluginSdk(t *testing.T, testCases []providerSchemaComparisonTestCase) {
+	ctx := context.Background()
+
+	sdkSchema := pluginsdkprovider.AzureProvider().Schema
+
+	frameworkSchema := provider.SchemaResponse{}
+	(&azureRmFrameworkProvider{}).Schema(ctx, provider.SchemaRequest{}, &frameworkSchema)
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%v", tc.path), func(t *testing.T) {
+			sdkAttribute, err := pluginSdkProviderSchemaAttribute(sdkSchema, tc.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			frameworkAttribute, err := frameworkProviderSchemaAttribute(frameworkSchema.Schema, tc.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if sdkAttribute.Description != frameworkAttribute.GetDescription() {
+				t.Errorf("expected the description %q but the Plugin Framework provider has %q", sdkAttribute.Description, frameworkAttribute.GetDescription())
+			}
+			if sdkAttribute.Required != frameworkAttribute.IsRequired() || sdkAttribute.Optional != frameworkAttribute.IsOptional() {
+				t.Errorf("expected Required to be %t and Optional to be %t but the Plugin Framework provider has %t and %t", sdkAttribute.Required, sdkAttribute.Optional, frameworkAttribute.IsRequired(), frameworkAttribute.IsOptional())
+			}
+			if sdkAttribute.Default != tc.defaultValue {
+				t.Errorf("expected the default %v but the Plugin SDKv2 provider has %v", tc.defaultValue, sdkAttribute.Default)
+			}
+
+			for _, v := range tc.valid {
+				if !pluginSdkProviderSchemaAcceptsValue(sdkAttribute, v) {
+					t.Errorf("expected %v to be valid for the Plugin SDKv2 provider", v)
+				}
+				if !frameworkProviderSchemaAcceptsValue(ctx, frameworkAttribute, v) {
+					t.Errorf("expected %v to be valid for the Plugin Framework provider", v)
+				}
+			}
+			for _, v := range tc.invalid {
+				if pluginSdkProviderSchemaAcceptsValue(sdkAttribute, v) {
+					t.Errorf("expected %v to be invalid for the Plugin SDKv2 provider", v)
+				}
+				if frameworkProviderSchemaAcceptsValue(ctx, frameworkAttribute, v) {
+					t.Errorf("expected %v to be invalid for the Plugin Framework provider", v)
+				}
+			}
+		})
+	}
+}
+
+func pluginSdkProviderSchemaAttribute(input map[string]*pluginsdk.Schema, path []string) (*pluginsdk.Schema, error) {
+	current := input
+	for i, name := range path {
+		s, ok := current[name]
+		if !ok {
+			return nil, fmt.Errorf("%q was not found in the Plugin SDKv2 provider schema", path[:i+1])
+		}
+		if i == len(path)-1 {
+			return s, nil
+		}
+
+		block, ok := s.Elem.(*pluginsdk.Resource)
+		if !ok {
+			return nil, fmt.Errorf("%q isn't a block in the Plugin SDKv2 provider schema", path[:i+1])
+		}
+		current = block.Schema
+	}
+
+	return nil, fmt.Errorf("no path was specified")
+}
+
+func frameworkProviderSchemaAttribute(input schema.Schema, path []string) (schema.Attribute, error) {
+	attributes := input.Attributes
+	blocks := input.Blocks
+	for i, name := range path {
+		if i == len(path)-1 {
+			attribute, ok := attributes[name]
+			if !ok {
+				return nil, fmt.Errorf("%q was not found in the Plugin Framework provider schema", path)
+			}
+			return attribute, nil
+		}
+
+		block, ok := blocks[name].(schema.ListNestedBlock)
+		if !ok {
+			return nil, fmt.Errorf("%q isn't a block in the Plugin Framework provider schema", path[:i+1])
+		}
+		attributes = block.NestedObject.Attributes
+		blocks = block.NestedObject.Blocks
+	}
+
+	return nil, fmt.Errorf("no path was specified")
+}
+
+func pluginSdkProviderSchemaAcceptsValue(input *pluginsdk.Schema, value interface{}) bool {
+	validateFunc := input.ValidateFunc
+	if elem, ok := input.Elem.(*pluginsdk.Schema); ok {
+		validateFunc = elem.ValidateFunc
+	}
+	if validateFunc == nil {
+		return true
+	}
+
+	_, errs := validateFunc(value, "test")
+	return len(errs) == 0
+}
+
+func frameworkProviderSchemaAcceptsValue(ctx context.Context, input schema.Attribute, value interface{}) bool {
+	diags := diag.Diagnostics{}
+	switch attribute := input.(type) {
+	case schema.BoolAttribute:
+		for _, v := range attribute.Validators {
+			resp := validator.BoolResponse{}
+			v.ValidateBool(ctx, validator.BoolRequest{ConfigValue: types.BoolValue(value.(bool))}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	case schema.Int64Attribute:
+		for _, v := range attribute.Validators {
+			resp := validator.Int64Response{}
+			v.ValidateInt64(ctx, validator.Int64Request{ConfigValue: types.Int64Value(int64(value.(int)))}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	case schema.Float64Attribute:
+		for _, v := range attribute.Validators {
+			resp := validator.Float64Response{}
+			v.ValidateFloat64(ctx, validator.Float64Request{ConfigValue: types.Float64Value(value.(float64))}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	case schema.SetAttribute:
+		set := types.SetValueMust(types.StringType, []attr.Value{types.StringValue(value.(string))})
+		for _, v := range attribute.Validators {
+			resp := validator.SetResponse{}
+			v.ValidateSet(ctx, validator.SetRequest{ConfigValue: set}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	default:
+		panic(fmt.Sprintf("unsupported attribute type %T", input))
+	}
+
+	return !diags.HasError()
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"strings"
 
@@ -76,6 +77,35 @@ func getOidcToken(d *pluginsdk.ResourceData) (*string, error) {
 	return &idToken, nil
 }
 
+// getOidcTokenFilePath returns the path to the file which the OIDC token is read from, so that the token can be re-read
+// when it's rotated during a long-running apply
+func getOidcTokenFilePath(d *pluginsdk.ResourceData) string {
+	if d.Get("use_aks_workload_identity").(bool) && os.Getenv("AZURE_FEDERATED_TOKEN_FILE") != "" {
+		return os.Getenv("AZURE_FEDERATED_TOKEN_FILE")
+	}
+
+	return d.Get("oidc_token_file_path").(string)
+}
+
+func getOidcRequestUrl(d *pluginsdk.ResourceData) (*string, error) {
+	requestUrl := strings.TrimSpace(d.Get("oidc_request_url").(string))
+
+	if audience := strings.TrimSpace(d.Get("oidc_audience").(string)); audience != "" && requestUrl != "" {
+		u, err := url.Parse(requestUrl)
+		if err != nil {
+			return nil, fmt.Errorf("parsing OIDC Request URL %q: %v", requestUrl, err)
+		}
+
+		query := u.Query()
+		query.Set("audience", audience)
+		u.RawQuery = query.Encode()
+
+		requestUrl = u.String()
+	}
+
+	return &requestUrl, nil
+}
+
 func getClientId(d *pluginsdk.ResourceData) (*string, error) {
 	clientId := strings.TrimSpace(d.Get("client_id").(string))
 
@@ -82,6 +82,7 @@ func ValidatePartnerID(i interface{}, k string) ([]string, []error) {
 func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 	dataSources := make(map[string]*schema.Resource)
 	resources := make(map[string]*schema.Resource)
+	resourceServices := make(map[string]string)
 
 	// first handle the typed services
 	for _, service := range SupportedTypedServices() {
@@ -114,6 +115,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Errorf("creating Wrapper for Resource %q: %+v", key, err))
 			}
 			resources[key] = resource
+			resourceServices[key] = service.Name()
 		}
 	}
 
@@ -135,6 +137,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			}
 
 			resources[k] = v
+			resourceServices[k] = service.Name()
 		}
 	}
 
@@ -271,6 +274,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The path to a file containing an OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
 			},
 
+			"oidc_audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_AUDIENCE", nil),
+				Description: "The audience to request the OIDC ID token for from the OIDC provider. For use when authenticating as a Service Principal using OpenID Connect in an Azure Environment which requires a different audience, such as `api://AzureADTokenExchangeUSGov` for US Government.",
+			},
+
 			// Managed Service Identity specific fields
 			"use_msi": {
 				Type:        schema.TypeBool,
@@ -335,6 +345,8 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"timeout_profile": schemaTimeoutProfiles(timeoutProfileServiceNames(resourceServices)),
+
 			// Advanced feature flags
 			"resource_provider_registrations": {
 				Type:        schema.TypeString,
@@ -381,7 +393,24 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		ResourcesMap:   resources,
 	}
 
-	p.ConfigureContextFunc = providerConfigure(p)
+	for name, resource := range resources {
+		wrapResourceTimeouts(resource, resourceServices[name])
+	}
+
+	serviceNames := timeoutProfileServiceNames(resourceServices)
+	configure := providerConfigure(p)
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		meta, diags := configure(ctx, d)
+		if client, ok := meta.(*clients.Client); ok {
+			profiles := expandTimeoutProfiles(d.Get("timeout_profile").([]interface{}))
+			// the `timeout_multiplier` in the `client` features block applies to the resources in all services
+			if multiplier := client.Features.Client.TimeoutMultiplier; multiplier > 1 {
+				profiles = append(profiles, timeoutProfile{multiplier: multiplier})
+			}
+			client.TimeoutMultipliers = timeoutMultipliers(serviceNames, profiles)
+		}
+		return meta, diags
+	}
 
 	return p
 }
@@ -423,6 +452,11 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			return nil, diag.FromErr(err)
 		}
 
+		oidcRequestUrl, err := getOidcRequestUrl(d)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
 		clientSecret, err := getClientSecret(d)
 		if err != nil {
 			return nil, diag.FromErr(err)
@@ -475,7 +509,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			ClientSecret:              *clientSecret,
 
 			OIDCAssertionToken:    *oidcToken,
-			OIDCTokenRequestURL:   d.Get("oidc_request_url").(string),
+			OIDCTokenRequestURL:   *oidcRequestUrl,
 			OIDCTokenRequestToken: d.Get("oidc_request_token").(string),
 
 			ADOPipelineServiceConnectionID: d.Get("ado_pipeline_service_connection_id").(string),
@@ -528,6 +562,7 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
 		MetadataHost:                d.Get("metadata_host").(string),
+		OIDCTokenFilePath:           getOidcTokenFilePath(d),
 		PartnerID:                   d.Get("partner_id").(string),
 		RegisteredResourceProviders: requiredResourceProviders,
 		StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
@@ -226,6 +226,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		storagemover.Registration{},
 		streamanalytics.Registration{},
 		subscription.Registration{},
+		synapse.Registration{},
 		systemcentervirtualmachinemanager.Registration{},
 		videoindexer.Registration{},
 		vmware.Registration{},
@@ -345,6 +346,7 @@ func SupportedFrameworkServices() []sdk.FrameworkTypedServiceRegistration {
 		// e.g.
 		// resource.Registration{}
 		keyvault.Registration{},
+		oracle.Registration{},
 	}
 
 	return services
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+type timeoutProfile struct {
+	multiplier float64
+	services   map[string]struct{}
+}
+
+func schemaTimeoutProfiles(serviceNames []string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Scales the default Create, Update and Delete timeouts of the resources in the specified services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"multiplier": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					ValidateFunc: validation.FloatBetween(1, 10),
+				},
+
+				"services": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(serviceNames, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+// TimeoutProfileServiceNames returns the names of the services which can be specified in a `timeout_profile` block
+func TimeoutProfileServiceNames() []string {
+	resourceServices := make(map[string]string)
+	for _, service := range SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			resourceServices[r.ResourceType()] = service.Name()
+		}
+	}
+	for _, service := range SupportedUntypedServices() {
+		for k := range service.SupportedResources() {
+			resourceServices[k] = service.Name()
+		}
+	}
+
+	return timeoutProfileServiceNames(resourceServices)
+}
+
+func timeoutProfileServiceNames(resourceServices map[string]string) []string {
+	unique := make(map[string]struct{})
+	for _, name := range resourceServices {
+		unique[name] = struct{}{}
+	}
+
+	output := make([]string, 0, len(unique))
+	for name := range unique {
+		output = append(output, name)
+	}
+	sort.Strings(output)
+
+	return output
+}
+
+func expandTimeoutProfiles(input []interface{}) []timeoutProfile {
+	profiles := make([]timeoutProfile, 0)
+	for _, item := range input {
+		raw, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		profile := timeoutProfile{
+			multiplier: raw["multiplier"].(float64),
+			services:   make(map[string]struct{}),
+		}
+		if v, ok := raw["services"].(*schema.Set); ok {
+			for _, service := range v.List() {
+				profile.services[service.(string)] = struct{}{}
+			}
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	return profiles
+}
+
+// timeoutMultipliers returns the highest multiplier of the timeout profiles which apply to each service
+func timeoutMultipliers(serviceNames []string, profiles []timeoutProfile) map[string]float64 {
+	output := make(map[string]float64, len(serviceNames))
+	for _, service := range serviceNames {
+		multiplier := 1.0
+		for _, profile := range profiles {
+			if len(profile.services) > 0 {
+				if _, ok := profile.services[service]; !ok {
+					continue
+				}
+			}
+
+			if profile.multiplier > multiplier {
+				multiplier = profile.multiplier
+			}
+		}
+
+		output[service] = multiplier
+	}
+
+	return output
+}
+
+// wrapResourceTimeouts wraps the Create, Update and Delete functions of a resource so that the timeout profiles which
+// apply to the service the resource belongs to are resolved on each call. The resources are shared between all (aliased)
+// configurations of the provider, so these can't be modified when the provider is configured.
+func wrapResourceTimeouts(resource *schema.Resource, service string) {
+	if resource.Timeouts == nil {
+		return
+	}
+
+	if f := resource.Create; f != nil {
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, scopeLegacyTimeouts(meta, service))
+		}
+	}
+	if f := resource.Update; f != nil {
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, scopeLegacyTimeouts(meta, service))
+		}
+	}
+	if f := resource.Delete; f != nil {
+		resource.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, scopeLegacyTimeouts(meta, service))
+		}
+	}
+
+	// the Plugin SDK wraps the context passed to the `*Context` functions with the unscaled timeout before these are
+	// called, and a deadline can't be extended - as such these are switched to the `*WithoutTimeout` functions and the
+	// timeout is applied here instead. When no profile applies this is the same timeout the Plugin SDK would apply.
+	if f := resource.CreateContext; f != nil {
+		resource.CreateContext = nil
+		resource.CreateWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			ctx, cancel := timeouts.ForCreate(scopeTimeouts(ctx, meta, service), d)
+			defer cancel()
+			return f(ctx, d, meta)
+		}
+	}
+	if f := resource.UpdateContext; f != nil {
+		resource.UpdateContext = nil
+		resource.UpdateWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			ctx, cancel := timeouts.ForUpdate(scopeTimeouts(ctx, meta, service), d)
+			defer cancel()
+			return f(ctx, d, meta)
+		}
+	}
+	if f := resource.DeleteContext; f != nil {
+		resource.DeleteContext = nil
+		resource.DeleteWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			ctx, cancel := timeouts.ForDelete(scopeTimeouts(ctx, meta, service), d)
+			defer cancel()
+			return f(ctx, d, meta)
+		}
+	}
+}
+
+// scopeTimeouts returns a context which scales the default timeouts of the resource for a single call
+func scopeTimeouts(ctx context.Context, meta interface{}, service string) context.Context {
+	client, ok := meta.(*clients.Client)
+	if !ok {
+		return ctx
+	}
+
+	if multiplier := client.TimeoutMultiplier(service); multiplier > 1 {
+		return timeouts.WithScaledDefaults(ctx, multiplier)
+	}
+
+	return ctx
+}
+
+// scopeLegacyTimeouts returns a client which scales the default timeouts of the resource for a single call. Resources
+// using the legacy CRUD functions determine their timeouts from the StopContext of the client, as such when a profile
+// applies a (shallow) copy of the client is returned rather than modifying the client shared between all resources.
+func scopeLegacyTimeouts(meta interface{}, service string) interface{} {
+	client, ok := meta.(*clients.Client)
+	if !ok || client.TimeoutMultiplier(service) <= 1 {
+		return meta
+	}
+
+	scoped := *client
+	scoped.StopContext = scopeTimeouts(client.StopContext, meta, service)
+	return &scoped
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func TestTimeoutMultipliers(t *testing.T) {
+	serviceNames := []string{"Container Services", "Resources"}
+
+	testCases := []struct {
+		name                  string
+		profiles              []timeoutProfile
+		expectedCluster       float64
+		expectedResourceGroup float64
+	}{
+		{
+			name:                  "no profiles",
+			expectedCluster:       1,
+			expectedResourceGroup: 1,
+		},
+		{
+			name: "profile for all services",
+			profiles: []timeoutProfile{
+				{multiplier: 2},
+			},
+			expectedCluster:       2,
+			expectedResourceGroup: 2,
+		},
+		{
+			name: "profile for a single service",
+			profiles: []timeoutProfile{
+				{multiplier: 1.5, services: map[string]struct{}{"Container Services": {}}},
+			},
+			expectedCluster:       1.5,
+			expectedResourceGroup: 1,
+		},
+		{
+			name: "highest multiplier wins",
+			profiles: []timeoutProfile{
+				{multiplier: 2},
+				{multiplier: 3, services: map[string]struct{}{"Container Services": {}}},
+			},
+			expectedCluster:       3,
+			expectedResourceGroup: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			multipliers := timeoutMultipliers(serviceNames, tc.profiles)
+			if multipliers["Container Services"] != tc.expectedCluster {
+				t.Fatalf("expected the multiplier for Container Services to be %v but got %v", tc.expectedCluster, multipliers["Container Services"])
+			}
+			if multipliers["Resources"] != tc.expectedResourceGroup {
+				t.Fatalf("expected the multiplier for Resources to be %v but got %v", tc.expectedResourceGroup, multipliers["Resources"])
+			}
+		})
+	}
+}
+
+func TestWrapResourceTimeouts(t *testing.T) {
+	duration := func(d time.Duration) *time.Duration {
+		return &d
+	}
+
+	testCases := []struct {
+		name             string
+		multipliers      map[string]float64
+		configuredCreate string
+		expected         time.Duration
+	}{
+		{
+			name:     "no profiles",
+			expected: 90 * time.Minute,
+		},
+		{
+			name:        "profile for the service",
+			multipliers: map[string]float64{"Container Services": 2},
+			expected:    180 * time.Minute,
+		},
+		{
+			name:        "profile for another service",
+			multipliers: map[string]float64{"Resources": 2},
+			expected:    90 * time.Minute,
+		},
+		{
+			// a timeout specified in the `timeouts` block isn't scaled, even when it matches the default
+			name:             "profile for the service with a configured timeout",
+			multipliers:      map[string]float64{"Container Services": 2},
+			configuredCreate: "90m",
+			expected:         90 * time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var legacyTimeout, contextTimeout time.Duration
+			remaining := func(ctx context.Context) time.Duration {
+				deadline, ok := ctx.Deadline()
+				if !ok {
+					t.Fatalf("expected the context to have a deadline")
+				}
+				return time.Until(deadline).Round(time.Minute)
+			}
+
+			legacy := &schema.Resource{
+				Timeouts: &schema.ResourceTimeout{
+					Create: duration(90 * time.Minute),
+				},
+				Create: func(d *schema.ResourceData, meta interface{}) error {
+					ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+					defer cancel()
+					legacyTimeout = remaining(ctx)
+					return nil
+				},
+			}
+			typed := &schema.Resource{
+				Timeouts: &schema.ResourceTimeout{
+					Create: duration(90 * time.Minute),
+				},
+				CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+					contextTimeout = remaining(ctx)
+					return nil
+				},
+			}
+			wrapResourceTimeouts(legacy, "Container Services")
+			wrapResourceTimeouts(typed, "Container Services")
+
+			data := func(resource *schema.Resource) *schema.ResourceData {
+				if tc.configuredCreate == "" {
+					return resource.Data(nil)
+				}
+				return resource.Data(&terraform.InstanceState{
+					RawConfig: cty.ObjectVal(map[string]cty.Value{
+						"timeouts": cty.ObjectVal(map[string]cty.Value{
+							"create": cty.StringVal(tc.configuredCreate),
+						}),
+					}),
+				})
+			}
+
+			client := &clients.Client{
+				StopContext:        context.Background(),
+				TimeoutMultipliers: tc.multipliers,
+			}
+			if err := legacy.Create(data(legacy), client); err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if diags := typed.CreateWithoutTimeout(context.Background(), data(typed), client); diags.HasError() {
+				t.Fatalf("unexpected error: %+v", diags)
+			}
+
+			if legacyTimeout != tc.expected {
+				t.Fatalf("expected the legacy create timeout to be %s but got %s", tc.expected, legacyTimeout)
+			}
+			if contextTimeout != tc.expected {
+				t.Fatalf("expected the create timeout to be %s but got %s", tc.expected, contextTimeout)
+			}
+
+			// the timeouts are resolved per call, so neither the resource nor the client are modified
+			if *legacy.Timeouts.Create != 90*time.Minute || *typed.Timeouts.Create != 90*time.Minute {
+				t.Fatalf("expected the default timeouts of the resources to be unchanged")
+			}
+			if _, ok := client.StopContext.Deadline(); ok {
+				t.Fatalf("expected the StopContext of the client to be unchanged")
+			}
+		})
+	}
+}
@@ -42,6 +42,14 @@ type ResourceMetadata struct {
 	TimeoutUpdate *time.Duration
 
 	Features features.UserFeatures
+
+	serviceName string
+}
+
+// SetServiceName sets the name of the Service the Resource belongs to, which is used to determine the timeout
+// profiles which apply to the Resource.
+func (r *ResourceMetadata) SetServiceName(name string) {
+	r.serviceName = name
 }
 
 // Defaults configures the Resource Metadata for client access, Provider Features, and subscriptionId.
@@ -60,10 +68,11 @@ func (r *ResourceMetadata) Defaults(req resource.ConfigureRequest, resp *resourc
 	r.SubscriptionId = c.Account.SubscriptionId
 	r.Features = c.Features
 
-	r.TimeoutCreate = 30 * time.Minute
-	r.TimeoutUpdate = pointer.To(30 * time.Minute)
+	multiplier := c.TimeoutMultiplier(r.serviceName)
+	r.TimeoutCreate = time.Duration(float64(30*time.Minute) * multiplier)
+	r.TimeoutUpdate = pointer.To(time.Duration(float64(30*time.Minute) * multiplier))
 	r.TimeoutRead = 5 * time.Minute
-	r.TimeoutDelete = 30 * time.Minute
+	r.TimeoutDelete = time.Duration(float64(30*time.Minute) * multiplier)
 }
 
 // DecodeCreate reads a plan from a resource.CreateRequest into a pointer to a target model and sets resource.CreateResponse diags on error.
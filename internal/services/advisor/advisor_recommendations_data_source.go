@@ -42,6 +42,15 @@ func dataSourceAdvisorRecommendations() *pluginsdk.Resource {
 				},
 			},
 
+			"filter_by_impact": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice(getrecommendations.PossibleValuesForImpact(), false),
+				},
+			},
+
 			"filter_by_resource_groups": commonschema.ResourceGroupNameSetOptional(),
 
 			"recommendations": {
@@ -74,6 +83,11 @@ func dataSourceAdvisorRecommendations() *pluginsdk.Resource {
 							Computed: true,
 						},
 
+						"resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"resource_name": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -128,7 +142,13 @@ func dataSourceAdvisorRecommendationsRead(d *pluginsdk.ResourceData, meta interf
 		return fmt.Errorf("loading Advisor Recommendation for %q: %+v", id, err)
 	}
 
-	if err := d.Set("recommendations", flattenAzureRmAdvisorRecommendations(recomendations.Items)); err != nil {
+	// the API doesn't support filtering by impact, so this is done once the recommendations have been retrieved
+	items := recomendations.Items
+	if impacts := d.Get("filter_by_impact").(*pluginsdk.Set).List(); len(impacts) > 0 {
+		items = filterAzureRmAdvisorRecommendationsByImpact(items, impacts)
+	}
+
+	if err := d.Set("recommendations", flattenAzureRmAdvisorRecommendations(items)); err != nil {
 		return fmt.Errorf("setting `recommendations`: %+v", err)
 	}
 
@@ -146,6 +166,7 @@ func flattenAzureRmAdvisorRecommendations(recommends []getrecommendations.Resour
 
 	for _, r := range recommends {
 		var description string
+		var resourceId string
 		var suppressionIds []interface{}
 
 		v := r.Properties
@@ -154,6 +175,10 @@ func flattenAzureRmAdvisorRecommendations(recommends []getrecommendations.Resour
 			description = *v.ShortDescription.Problem
 		}
 
+		if v.ResourceMetadata != nil {
+			resourceId = pointer.From(v.ResourceMetadata.ResourceId)
+		}
+
 		if v.SuppressionIds != nil {
 			suppressionIds = flattenSuppressionSlice(v.SuppressionIds)
 		}
@@ -164,6 +189,7 @@ func flattenAzureRmAdvisorRecommendations(recommends []getrecommendations.Resour
 			"impact":                 string(pointer.From(v.Impact)),
 			"recommendation_name":    pointer.From(r.Name),
 			"recommendation_type_id": pointer.From(v.RecommendationTypeId),
+			"resource_id":            resourceId,
 			"resource_name":          pointer.From(v.ImpactedValue),
 			"resource_type":          pointer.From(v.ImpactedField),
 			"suppression_names":      suppressionIds,
@@ -174,6 +200,24 @@ func flattenAzureRmAdvisorRecommendations(recommends []getrecommendations.Resour
 	return result
 }
 
+func filterAzureRmAdvisorRecommendationsByImpact(input []getrecommendations.ResourceRecommendationBase, impacts []interface{}) []getrecommendations.ResourceRecommendationBase {
+	result := make([]getrecommendations.ResourceRecommendationBase, 0)
+	for _, r := range input {
+		if r.Properties == nil || r.Properties.Impact == nil {
+			continue
+		}
+
+		for _, impact := range impacts {
+			if strings.EqualFold(string(*r.Properties.Impact), impact.(string)) {
+				result = append(result, r)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
 func expandAzureRmAdvisorRecommendationsMapString(t string, input []interface{}) string {
 	if len(input) == 0 {
 		return ""
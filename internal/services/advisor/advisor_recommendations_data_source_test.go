@@ -61,6 +61,20 @@ func TestAccAdvisorRecommendationsDataSource_categoriesFilter(t *testing.T) {
 	})
 }
 
+func TestAccAdvisorRecommendationsDataSource_impactFilter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_advisor_recommendations", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: AdvisorRecommendationsDataSourceTests{}.impactFilterConfig(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("recommendations.#").Exists(),
+				check.That(data.ResourceName).Key("recommendations.0.impact").HasValue("High"),
+			),
+		},
+	})
+}
+
 func (AdvisorRecommendationsDataSourceTests) basicConfig() string {
 	return `provider "azurerm" {
   features {}
@@ -109,3 +123,14 @@ data "azurerm_advisor_recommendations" "test" {
 }
 `
 }
+
+func (AdvisorRecommendationsDataSourceTests) impactFilterConfig() string {
+	return `provider "azurerm" {
+  features {}
+}
+
+data "azurerm_advisor_recommendations" "test" {
+  filter_by_impact = ["High"]
+}
+`
+}
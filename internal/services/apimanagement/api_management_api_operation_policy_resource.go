@@ -69,6 +69,8 @@ func resourceApiManagementApiOperationPolicy() *pluginsdk.Resource {
 				ConflictsWith: []string{"xml_content"},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(apiManagementPolicyXmlContentCustomizeDiff),
 	}
 }
 
@@ -67,6 +67,8 @@ func resourceApiManagementApiPolicy() *pluginsdk.Resource {
 				ConflictsWith: []string{"xml_content"},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(apiManagementPolicyXmlContentCustomizeDiff),
 	}
 }
 
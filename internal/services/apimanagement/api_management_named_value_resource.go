@@ -91,6 +91,13 @@ func resourceApiManagementNamedValue() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"secret_refresh_trigger": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"value_from_key_vault"},
+			},
+
 			"tags": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -98,6 +105,27 @@ func resourceApiManagementNamedValue() *pluginsdk.Resource {
 					Type: pluginsdk.TypeString,
 				},
 			},
+
+			"value_from_key_vault_status": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"code": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"last_refreshed_at": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -148,6 +176,13 @@ func resourceApiManagementNamedValueCreateUpdate(d *pluginsdk.ResourceData, meta
 		return fmt.Errorf("creating or updating %s: %+v", id, err)
 	}
 
+	// the secret is retrieved from the Key Vault when the Named Value is created, so it only needs refreshing when the trigger changes afterwards
+	if !d.IsNewResource() && d.HasChange("secret_refresh_trigger") && d.Get("secret_refresh_trigger").(string) != "" {
+		if err := client.RefreshSecretThenPoll(ctx, id); err != nil {
+			return fmt.Errorf("refreshing the Key Vault secret for %s: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceApiManagementNamedValueRead(d, meta)
@@ -189,6 +224,9 @@ func resourceApiManagementNamedValueRead(d *pluginsdk.ResourceData, meta interfa
 			if err := d.Set("value_from_key_vault", flattenApiManagementNamedValueKeyVault(props.KeyVault)); err != nil {
 				return fmt.Errorf("setting `value_from_key_vault`: %+v", err)
 			}
+			if err := d.Set("value_from_key_vault_status", flattenApiManagementNamedValueKeyVaultStatus(props.KeyVault)); err != nil {
+				return fmt.Errorf("setting `value_from_key_vault_status`: %+v", err)
+			}
 			d.Set("tags", pointer.From(props.Tags))
 		}
 	}
@@ -244,3 +282,17 @@ func flattenApiManagementNamedValueKeyVault(input *namedvalue.KeyVaultContractPr
 		},
 	}
 }
+
+func flattenApiManagementNamedValueKeyVaultStatus(input *namedvalue.KeyVaultContractProperties) []interface{} {
+	if input == nil || input.LastStatus == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"code":              pointer.From(input.LastStatus.Code),
+			"message":           pointer.From(input.LastStatus.Message),
+			"last_refreshed_at": pointer.From(input.LastStatus.TimeStampUtc),
+		},
+	}
+}
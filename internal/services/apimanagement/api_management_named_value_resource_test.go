@@ -49,6 +49,37 @@ func TestAccApiManagementNamedValue_keyVault(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementNamedValue_keyVaultSecretRefresh(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_named_value", "test")
+	r := ApiManagementNamedValueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyVault(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value_from_key_vault_status.0.code").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.keyVaultSecretRefresh(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("secret_refresh_trigger"),
+		{
+			Config: r.keyVaultSecretRefresh(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value_from_key_vault_status.0.last_refreshed_at").Exists(),
+			),
+		},
+		data.ImportStep("secret_refresh_trigger"),
+	})
+}
+
 func TestAccApiManagementNamedValue_keyVaultSystemAssigned(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management_named_value", "test")
 	r := ApiManagementNamedValueResource{}
@@ -322,6 +353,29 @@ resource "azurerm_api_management_named_value" "test" {
 `, r.keyVaultTemplate(data), data.RandomInteger)
 }
 
+func (r ApiManagementNamedValueResource) keyVaultSecretRefresh(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_api_management_named_value" "test" {
+  name                = "acctestAMProperty-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "TestKeyVault%[2]d"
+  secret              = true
+  value_from_key_vault {
+    secret_id          = azurerm_key_vault_secret.test.id
+    identity_client_id = azurerm_user_assigned_identity.test.client_id
+  }
+  secret_refresh_trigger = "%[3]s"
+
+  tags = ["tag1", "tag2"]
+
+  depends_on = [azurerm_key_vault_access_policy.test2]
+}
+`, r.keyVaultTemplate(data), data.RandomInteger, trigger)
+}
+
 func (r ApiManagementNamedValueResource) keyVaultUpdate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -83,11 +83,41 @@ func resourceApiManagementPolicyFragment() *pluginsdk.Resource {
 				DiffSuppressFunc: XmlWhitespaceDiffSuppress,
 			},
 
+			"parameters": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"description": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			if !diff.NewValueKnown("value") || !diff.NewValueKnown("parameters") {
+				return nil
+			}
+
+			// only validate changes, so that fragments which the API accepted previously don't start failing to plan
+			if !diff.HasChange("value") && !diff.HasChange("parameters") {
+				return nil
+			}
+
+			value, err := renderApiManagementPolicyParameters(diff.Get("value").(string), diff.Get("parameters").(map[string]interface{}))
+			if err != nil {
+				return fmt.Errorf("rendering `value`: %+v", err)
+			}
+
+			if err := validateApiManagementPolicyXml(value, "fragment"); err != nil {
+				return fmt.Errorf("validating `value`: %+v", err)
+			}
+
+			return nil
+		}),
 	}
 }
 
@@ -117,7 +147,10 @@ func resourceApiManagementPolicyFragmentCreate(d *pluginsdk.ResourceData, meta i
 	}
 
 	description := d.Get("description").(string)
-	value := d.Get("value").(string)
+	value, err := renderApiManagementPolicyParameters(d.Get("value").(string), d.Get("parameters").(map[string]interface{}))
+	if err != nil {
+		return fmt.Errorf("rendering `value`: %+v", err)
+	}
 
 	parameters := policyfragment.PolicyFragmentContract{
 		Properties: &policyfragment.PolicyFragmentContractProperties{
@@ -168,14 +201,19 @@ func resourceApiManagementPolicyFragmentUpdate(d *pluginsdk.ResourceData, meta i
 		payload.Properties.Description = pointer.To(d.Get("description").(string))
 	}
 
-	if d.HasChange("value") {
-		payload.Properties.Value = d.Get("value").(string)
+	value, err := renderApiManagementPolicyParameters(d.Get("value").(string), d.Get("parameters").(map[string]interface{}))
+	if err != nil {
+		return fmt.Errorf("rendering `value`: %+v", err)
+	}
+
+	if d.HasChanges("value", "parameters") {
+		payload.Properties.Value = value
 	}
 
 	if d.HasChange("format") {
 		payload.Properties.Format = pointer.To(format)
 		// on format change we send the value also because it might be interpreted differently because of the changed format
-		payload.Properties.Value = d.Get("value").(string)
+		payload.Properties.Value = value
 	}
 
 	if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload, policyfragment.DefaultCreateOrUpdateOperationOptions()); err != nil {
@@ -218,7 +256,17 @@ func resourceApiManagementPolicyFragmentRead(d *pluginsdk.ResourceData, meta int
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("description", pointer.From(props.Description))
-			d.Set("value", props.Value)
+
+			// when `parameters` are used the API returns the rendered value, so the template is retained when rendering it
+			// still matches - otherwise the rendered value is set so that the drift is surfaced
+			value := props.Value
+			if parameters := d.Get("parameters").(map[string]interface{}); len(parameters) > 0 {
+				template := d.Get("value").(string)
+				if rendered, err := renderApiManagementPolicyParameters(template, parameters); err == nil && XmlWhitespaceDiffSuppress("value", props.Value, rendered, d) {
+					value = template
+				}
+			}
+			d.Set("value", value)
 			// the api only returns a format field when it's requested in the GET request as param '?format=rawxml' and only does so when it's not 'xml'
 			format := policyfragment.PolicyFragmentContentFormatXml
 			if props.Format != nil {
@@ -6,6 +6,7 @@ package apimanagement_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -91,6 +92,40 @@ func TestAccApiManagementPolicyFragment_rawxml(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementPolicyFragment_parameters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_policy_fragment", "test")
+	r := ApiManagementPolicyFragmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.parameters(data, "x-tier", "gold"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("value", "parameters"),
+		{
+			Config: r.parameters(data, "x-tier", "silver & bronze"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("value", "parameters"),
+	})
+}
+
+func TestAccApiManagementPolicyFragment_invalidValue(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_policy_fragment", "test")
+	r := ApiManagementPolicyFragmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidValue(data),
+			ExpectError: regexp.MustCompile("validating `value`"),
+		},
+	})
+}
+
 func (ApiManagementPolicyFragmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := policyfragment.ParsePolicyFragmentID(state.ID)
 	if err != nil {
@@ -159,6 +194,47 @@ resource "azurerm_api_management_policy_fragment" "test" {
 `, r.template(data))
 }
 
+func (r ApiManagementPolicyFragmentResource) parameters(data acceptance.TestData, header, value string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_policy_fragment" "test" {
+  api_management_id = azurerm_api_management.test.id
+  name              = "acctest-fragment-%d"
+
+  value = <<XML
+<fragment>
+  <set-header name="{{param:header}}" exists-action="override">
+    <value>{{param:value}}</value>
+  </set-header>
+</fragment>
+XML
+
+  parameters = {
+    header = "%s"
+    value  = "%s"
+  }
+}
+`, r.template(data), data.RandomInteger, header, value)
+}
+
+func (r ApiManagementPolicyFragmentResource) invalidValue(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_policy_fragment" "test" {
+  api_management_id = azurerm_api_management.test.id
+  name              = "acctest-fragment-%d"
+
+  value = <<XML
+<fragment>
+  <set-header name="x-tier" exists-action="override">
+</fragment>
+XML
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (ApiManagementPolicyFragmentResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -70,6 +70,8 @@ func resourceApiManagementPolicy() *pluginsdk.Resource {
 				ExactlyOneOf:  []string{"xml_link", "xml_content"},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(apiManagementPolicyXmlContentCustomizeDiff),
 	}
 }
 
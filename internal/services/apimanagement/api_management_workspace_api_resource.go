@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/api"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementWorkspaceApiModel struct {
+	Name                     string   `tfschema:"name"`
+	ApiManagementWorkspaceId string   `tfschema:"api_management_workspace_id"`
+	Revision                 string   `tfschema:"revision"`
+	DisplayName              string   `tfschema:"display_name"`
+	Path                     string   `tfschema:"path"`
+	Protocols                []string `tfschema:"protocols"`
+	ApiType                  string   `tfschema:"api_type"`
+	Description              string   `tfschema:"description"`
+	RevisionDescription      string   `tfschema:"revision_description"`
+	ServiceUrl               string   `tfschema:"service_url"`
+	SubscriptionRequired     bool     `tfschema:"subscription_required"`
+	TermsOfServiceUrl        string   `tfschema:"terms_of_service_url"`
+	IsCurrent                bool     `tfschema:"is_current"`
+	IsOnline                 bool     `tfschema:"is_online"`
+}
+
+type ApiManagementWorkspaceApiResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceApiResource{}
+
+func (r ApiManagementWorkspaceApiResource) ResourceType() string {
+	return "azurerm_api_management_workspace_api"
+}
+
+func (r ApiManagementWorkspaceApiResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceApiModel{}
+}
+
+func (r ApiManagementWorkspaceApiResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return api.ValidateWorkspaceApiID
+}
+
+func (r ApiManagementWorkspaceApiResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": schemaz.SchemaApiManagementApiName(),
+
+		"api_management_workspace_id": commonschema.ResourceIDReferenceRequiredForceNew(&workspace.WorkspaceId{}),
+
+		"revision": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ApiManagementApiPath,
+		},
+
+		"protocols": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(api.PossibleValuesForProtocol(), false),
+			},
+		},
+
+		"api_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(api.ApiTypeHTTP),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(api.ApiTypeGraphql),
+				string(api.ApiTypeHTTP),
+				string(api.ApiTypeSoap),
+				string(api.ApiTypeWebsocket),
+			}, false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"revision_description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"service_url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "ws", "wss"}),
+		},
+
+		"subscription_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"terms_of_service_url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"is_current": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"is_online": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			var model ApiManagementWorkspaceApiModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspace.ParseWorkspaceID(model.ApiManagementWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			apiId := fmt.Sprintf("%s;rev=%s", model.Name, model.Revision)
+			id := api.NewWorkspaceApiID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId, apiId)
+
+			existing, err := client.WorkspaceApiGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			apiType := api.ApiType(model.ApiType)
+			parameters := api.ApiCreateOrUpdateParameter{
+				Properties: &api.ApiCreateOrUpdateProperties{
+					Type:                 pointer.To(apiType),
+					ApiType:              pointer.To(soapApiTypeFromWorkspaceApiType(apiType)),
+					DisplayName:          pointer.To(model.DisplayName),
+					Path:                 model.Path,
+					Protocols:            expandApiManagementWorkspaceApiProtocols(model.Protocols),
+					SubscriptionRequired: pointer.To(model.SubscriptionRequired),
+				},
+			}
+
+			if model.Description != "" {
+				parameters.Properties.Description = pointer.To(model.Description)
+			}
+
+			if model.RevisionDescription != "" {
+				parameters.Properties.ApiRevisionDescription = pointer.To(model.RevisionDescription)
+			}
+
+			if model.ServiceUrl != "" {
+				parameters.Properties.ServiceURL = pointer.To(model.ServiceUrl)
+			}
+
+			if model.TermsOfServiceUrl != "" {
+				parameters.Properties.TermsOfServiceURL = pointer.To(model.TermsOfServiceUrl)
+			}
+
+			if err := client.WorkspaceApiCreateOrUpdateThenPoll(ctx, id, parameters, api.DefaultWorkspaceApiCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := api.ParseWorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceApiModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.WorkspaceApiGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			if resp.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			existing := resp.Model.Properties
+			parameters := api.ApiCreateOrUpdateParameter{
+				Properties: &api.ApiCreateOrUpdateProperties{
+					ApiRevisionDescription: existing.ApiRevisionDescription,
+					Description:            existing.Description,
+					DisplayName:            existing.DisplayName,
+					Path:                   existing.Path,
+					Protocols:              existing.Protocols,
+					ServiceURL:             existing.ServiceURL,
+					SubscriptionRequired:   existing.SubscriptionRequired,
+					TermsOfServiceURL:      existing.TermsOfServiceURL,
+					Type:                   existing.Type,
+				},
+			}
+
+			if metadata.ResourceData.HasChange("display_name") {
+				parameters.Properties.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			if metadata.ResourceData.HasChange("path") {
+				parameters.Properties.Path = model.Path
+			}
+
+			if metadata.ResourceData.HasChange("protocols") {
+				parameters.Properties.Protocols = expandApiManagementWorkspaceApiProtocols(model.Protocols)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				parameters.Properties.Description = pointer.To(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("revision_description") {
+				parameters.Properties.ApiRevisionDescription = pointer.To(model.RevisionDescription)
+			}
+
+			if metadata.ResourceData.HasChange("service_url") {
+				parameters.Properties.ServiceURL = pointer.To(model.ServiceUrl)
+			}
+
+			if metadata.ResourceData.HasChange("subscription_required") {
+				parameters.Properties.SubscriptionRequired = pointer.To(model.SubscriptionRequired)
+			}
+
+			if metadata.ResourceData.HasChange("terms_of_service_url") {
+				parameters.Properties.TermsOfServiceURL = pointer.To(model.TermsOfServiceUrl)
+			}
+
+			if err := client.WorkspaceApiCreateOrUpdateThenPoll(ctx, *id, parameters, api.WorkspaceApiCreateOrUpdateOperationOptions{IfMatch: pointer.To("*")}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := api.ParseWorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.WorkspaceApiGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementWorkspaceApiModel{
+				Name:                     getApiName(id.ApiId),
+				ApiManagementWorkspaceId: workspace.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					apiType := string(pointer.From(props.Type))
+					if apiType == "" {
+						apiType = string(api.ApiTypeHTTP)
+					}
+					state.ApiType = apiType
+					state.Description = pointer.From(props.Description)
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.IsCurrent = pointer.From(props.IsCurrent)
+					state.IsOnline = pointer.From(props.IsOnline)
+					state.Path = props.Path
+					state.Protocols = flattenApiManagementWorkspaceApiProtocols(props.Protocols)
+					state.Revision = pointer.From(props.ApiRevision)
+					state.RevisionDescription = pointer.From(props.ApiRevisionDescription)
+					state.ServiceUrl = pointer.From(props.ServiceURL)
+					state.SubscriptionRequired = pointer.From(props.SubscriptionRequired)
+					state.TermsOfServiceUrl = pointer.From(props.TermsOfServiceURL)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := api.ParseWorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.WorkspaceApiDelete(ctx, *id, api.DefaultWorkspaceApiDeleteOperationOptions()); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func soapApiTypeFromWorkspaceApiType(apiType api.ApiType) api.SoapApiType {
+	return map[api.ApiType]api.SoapApiType{
+		api.ApiTypeGraphql:   api.SoapApiTypeGraphql,
+		api.ApiTypeHTTP:      api.SoapApiTypeHTTP,
+		api.ApiTypeSoap:      api.SoapApiTypeSoap,
+		api.ApiTypeWebsocket: api.SoapApiTypeWebsocket,
+	}[apiType]
+}
+
+func expandApiManagementWorkspaceApiProtocols(input []string) *[]api.Protocol {
+	protocols := make([]api.Protocol, 0)
+	for _, v := range input {
+		protocols = append(protocols, api.Protocol(v))
+	}
+	return &protocols
+}
+
+func flattenApiManagementWorkspaceApiProtocols(input *[]api.Protocol) []string {
+	protocols := make([]string, 0)
+	if input == nil {
+		return protocols
+	}
+
+	for _, v := range *input {
+		protocols = append(protocols, string(v))
+	}
+	return protocols
+}
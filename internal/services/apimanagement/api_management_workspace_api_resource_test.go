@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/api"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiManagementWorkspaceApiTestResource struct{}
+
+func TestAccApiManagementWorkspaceApi_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_api", "test")
+	r := ApiManagementWorkspaceApiTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("is_current").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceApi_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_api", "test")
+	r := ApiManagementWorkspaceApiTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementWorkspaceApi_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_api", "test")
+	r := ApiManagementWorkspaceApiTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApiManagementWorkspaceApiTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := api.ParseWorkspaceApiID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.WorkspaceApiClient.WorkspaceApiGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiManagementWorkspaceApiTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_api" "test" {
+  name                        = "acctestapi-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  revision                    = "1"
+  display_name                = "api1"
+  path                        = "api1"
+  protocols                   = ["https"]
+}
+`, ApiManagementWorkspaceTestResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceApiTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_api" "import" {
+  name                        = azurerm_api_management_workspace_api.test.name
+  api_management_workspace_id = azurerm_api_management_workspace_api.test.api_management_workspace_id
+  revision                    = azurerm_api_management_workspace_api.test.revision
+  display_name                = azurerm_api_management_workspace_api.test.display_name
+  path                        = azurerm_api_management_workspace_api.test.path
+  protocols                   = azurerm_api_management_workspace_api.test.protocols
+}
+`, r.basic(data))
+}
+
+func (r ApiManagementWorkspaceApiTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_api" "test" {
+  name                        = "acctestapi-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  revision                    = "1"
+  revision_description        = "initial revision"
+  display_name                = "api1 updated"
+  path                        = "api1-updated"
+  protocols                   = ["http", "https"]
+  description                 = "acceptance test api"
+  service_url                 = "https://example.com/api"
+  subscription_required       = false
+  terms_of_service_url        = "https://example.com/terms"
+}
+`, ApiManagementWorkspaceTestResource{}.basic(data), data.RandomInteger)
+}
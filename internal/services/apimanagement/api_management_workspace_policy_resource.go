@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspace"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspacepolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementWorkspacePolicyModel struct {
+	ApiManagementWorkspaceId string `tfschema:"api_management_workspace_id"`
+	XmlContent               string `tfschema:"xml_content"`
+	XmlLink                  string `tfschema:"xml_link"`
+}
+
+type ApiManagementWorkspacePolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspacePolicyResource{}
+
+var _ sdk.ResourceWithCustomizeDiff = ApiManagementWorkspacePolicyResource{}
+
+func (r ApiManagementWorkspacePolicyResource) ResourceType() string {
+	return "azurerm_api_management_workspace_policy"
+}
+
+func (r ApiManagementWorkspacePolicyResource) ModelObject() interface{} {
+	return &ApiManagementWorkspacePolicyModel{}
+}
+
+func (r ApiManagementWorkspacePolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspacepolicy.ValidateWorkspaceID
+}
+
+func (r ApiManagementWorkspacePolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"api_management_workspace_id": commonschema.ResourceIDReferenceRequiredForceNew(&workspace.WorkspaceId{}),
+
+		"xml_content": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ExactlyOneOf:     []string{"xml_link", "xml_content"},
+			DiffSuppressFunc: XmlWithDotNetInterpolationsDiffSuppress,
+		},
+
+		"xml_link": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"xml_link", "xml_content"},
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+	}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementWorkspacePolicyResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return apiManagementPolicyXmlContentCustomizeDiff(ctx, metadata.ResourceDiff, metadata.Client)
+		},
+	}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspacePolicyClient
+
+			var model ApiManagementWorkspacePolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspace.ParseWorkspaceID(model.ApiManagementWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := workspacepolicy.NewWorkspaceID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId)
+
+			existing, err := client.Get(ctx, id, workspacepolicy.GetOperationOptions{Format: pointer.To(workspacepolicy.PolicyExportFormatXml)})
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandApiManagementWorkspacePolicy(model), workspacepolicy.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspacePolicyClient
+
+			id, err := workspacepolicy.ParseWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspacePolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// `xml_content` is computed, so a previously set value must be ignored when switching to `xml_link`
+			if metadata.ResourceData.HasChange("xml_link") && model.XmlLink != "" {
+				model.XmlContent = ""
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandApiManagementWorkspacePolicy(model), workspacepolicy.CreateOrUpdateOperationOptions{IfMatch: pointer.To("*")}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspacePolicyClient
+
+			id, err := workspacepolicy.ParseWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, workspacepolicy.GetOperationOptions{Format: pointer.To(workspacepolicy.PolicyExportFormatXml)})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config ApiManagementWorkspacePolicyModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ApiManagementWorkspacePolicyModel{
+				ApiManagementWorkspaceId: workspace.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId).ID(),
+				// the API downloads the policy from `xml_link` and only returns the content, so this is retained from the config
+				XmlLink: config.XmlLink,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.XmlContent = html.UnescapeString(props.Value)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspacePolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspacePolicyClient
+
+			id, err := workspacepolicy.ParseWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.Delete(ctx, *id, workspacepolicy.DeleteOperationOptions{IfMatch: pointer.To("*")}); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspacePolicy(model ApiManagementWorkspacePolicyModel) workspacepolicy.PolicyContract {
+	if model.XmlLink != "" {
+		return workspacepolicy.PolicyContract{
+			Properties: &workspacepolicy.PolicyContractProperties{
+				Format: pointer.To(workspacepolicy.PolicyContentFormatRawxmlNegativelink),
+				Value:  model.XmlLink,
+			},
+		}
+	}
+
+	return workspacepolicy.PolicyContract{
+		Properties: &workspacepolicy.PolicyContractProperties{
+			Format: pointer.To(workspacepolicy.PolicyContentFormatRawxml),
+			Value:  model.XmlContent,
+		},
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspacepolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiManagementWorkspacePolicyTestResource struct{}
+
+func TestAccApiManagementWorkspacePolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_policy", "test")
+	r := ApiManagementWorkspacePolicyTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspacePolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_policy", "test")
+	r := ApiManagementWorkspacePolicyTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementWorkspacePolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_policy", "test")
+	r := ApiManagementWorkspacePolicyTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApiManagementWorkspacePolicyTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workspacepolicy.ParseWorkspaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.WorkspacePolicyClient.Get(ctx, *id, workspacepolicy.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiManagementWorkspacePolicyTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_policy" "test" {
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+
+  xml_content = <<XML
+<policies>
+  <inbound>
+    <set-variable name="abc" value="@(context.Request.Headers.GetValueOrDefault("X-Header-Name", ""))" />
+    <find-and-replace from="xyz" to="abc" />
+  </inbound>
+</policies>
+XML
+}
+`, ApiManagementWorkspaceTestResource{}.basic(data))
+}
+
+func (r ApiManagementWorkspacePolicyTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_policy" "import" {
+  api_management_workspace_id = azurerm_api_management_workspace_policy.test.api_management_workspace_id
+  xml_content                 = azurerm_api_management_workspace_policy.test.xml_content
+}
+`, r.basic(data))
+}
+
+func (r ApiManagementWorkspacePolicyTestResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_policy" "test" {
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+
+  xml_content = <<XML
+<policies>
+  <inbound>
+    <find-and-replace from="abc" to="xyz" />
+  </inbound>
+</policies>
+XML
+}
+`, ApiManagementWorkspaceTestResource{}.basic(data))
+}
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/product"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementWorkspaceProductModel struct {
+	Name                     string `tfschema:"name"`
+	ApiManagementWorkspaceId string `tfschema:"api_management_workspace_id"`
+	DisplayName              string `tfschema:"display_name"`
+	Published                bool   `tfschema:"published"`
+	ApprovalRequired         bool   `tfschema:"approval_required"`
+	Description              string `tfschema:"description"`
+	SubscriptionRequired     bool   `tfschema:"subscription_required"`
+	SubscriptionsLimit       int64  `tfschema:"subscriptions_limit"`
+	Terms                    string `tfschema:"terms"`
+}
+
+type ApiManagementWorkspaceProductResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceProductResource{}
+
+func (r ApiManagementWorkspaceProductResource) ResourceType() string {
+	return "azurerm_api_management_workspace_product"
+}
+
+func (r ApiManagementWorkspaceProductResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceProductModel{}
+}
+
+func (r ApiManagementWorkspaceProductResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return product.ValidateWorkspaceProductID
+}
+
+func (r ApiManagementWorkspaceProductResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": schemaz.SchemaApiManagementChildName(),
+
+		"api_management_workspace_id": commonschema.ResourceIDReferenceRequiredForceNew(&workspace.WorkspaceId{}),
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"published": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+
+		"approval_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"subscription_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"subscriptions_limit": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"terms": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementWorkspaceProductResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			var model ApiManagementWorkspaceProductModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspace.ParseWorkspaceID(model.ApiManagementWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := product.NewWorkspaceProductID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId, model.Name)
+
+			existing, err := client.WorkspaceProductGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandApiManagementWorkspaceProductProperties(metadata, model)
+			if err != nil {
+				return err
+			}
+
+			parameters := product.ProductContract{
+				Properties: properties,
+			}
+
+			if _, err := client.WorkspaceProductCreateOrUpdate(ctx, id, parameters, product.DefaultWorkspaceProductCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := product.ParseWorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceProductModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.WorkspaceProductGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// the subscription related properties depend on each other, so the whole payload is rebuilt from the configuration
+			properties, err := expandApiManagementWorkspaceProductProperties(metadata, model)
+			if err != nil {
+				return err
+			}
+
+			parameters := *resp.Model
+			parameters.Properties = properties
+
+			if _, err := client.WorkspaceProductCreateOrUpdate(ctx, *id, parameters, product.WorkspaceProductCreateOrUpdateOperationOptions{IfMatch: pointer.To("*")}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := product.ParseWorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.WorkspaceProductGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementWorkspaceProductModel{
+				Name:                     id.ProductId,
+				ApiManagementWorkspaceId: workspace.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ApprovalRequired = pointer.From(props.ApprovalRequired)
+					state.Description = pointer.From(props.Description)
+					state.DisplayName = props.DisplayName
+					state.Published = pointer.From(props.State) == product.ProductStatePublished
+					state.SubscriptionRequired = pointer.From(props.SubscriptionRequired)
+					state.SubscriptionsLimit = pointer.From(props.SubscriptionsLimit)
+					state.Terms = pointer.From(props.Terms)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := product.ParseWorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.WorkspaceProductDelete(ctx, *id, product.WorkspaceProductDeleteOperationOptions{DeleteSubscriptions: pointer.To(true)}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspaceProductProperties(metadata sdk.ResourceMetaData, model ApiManagementWorkspaceProductModel) (*product.ProductContractProperties, error) {
+	state := product.ProductStateNotPublished
+	if model.Published {
+		state = product.ProductStatePublished
+	}
+
+	properties := product.ProductContractProperties{
+		Description:          pointer.To(model.Description),
+		DisplayName:          model.DisplayName,
+		State:                pointer.To(state),
+		SubscriptionRequired: pointer.To(model.SubscriptionRequired),
+		Terms:                pointer.To(model.Terms),
+	}
+
+	// `approval_required` and `subscriptions_limit` can only be sent when `subscription_required` is true
+	if model.SubscriptionRequired {
+		if model.ApprovalRequired && model.SubscriptionsLimit <= 0 {
+			return nil, fmt.Errorf("`subscriptions_limit` must be greater than 0 to use `approval_required`")
+		}
+
+		// omitting `subscriptions_limit` allows unlimited subscriptions per user, whereas `0` allows none
+		if !pluginsdk.IsExplicitlyNullInConfig(metadata.ResourceData, "subscriptions_limit") {
+			properties.ApprovalRequired = pointer.To(model.ApprovalRequired)
+			properties.SubscriptionsLimit = pointer.To(model.SubscriptionsLimit)
+		}
+	} else if model.ApprovalRequired {
+		return nil, fmt.Errorf("`subscription_required` must be true to use `approval_required`")
+	}
+
+	return &properties, nil
+}
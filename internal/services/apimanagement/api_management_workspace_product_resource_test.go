@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/product"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiManagementWorkspaceProductTestResource struct{}
+
+func TestAccApiManagementWorkspaceProduct_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_product", "test")
+	r := ApiManagementWorkspaceProductTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceProduct_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_product", "test")
+	r := ApiManagementWorkspaceProductTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementWorkspaceProduct_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_product", "test")
+	r := ApiManagementWorkspaceProductTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApiManagementWorkspaceProductTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := product.ParseWorkspaceProductID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.WorkspaceProductClient.WorkspaceProductGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiManagementWorkspaceProductTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_product" "test" {
+  name                        = "acctestproduct-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Test Product"
+  published                   = false
+}
+`, ApiManagementWorkspaceTestResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceProductTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_product" "import" {
+  name                        = azurerm_api_management_workspace_product.test.name
+  api_management_workspace_id = azurerm_api_management_workspace_product.test.api_management_workspace_id
+  display_name                = azurerm_api_management_workspace_product.test.display_name
+  published                   = azurerm_api_management_workspace_product.test.published
+}
+`, r.basic(data))
+}
+
+func (r ApiManagementWorkspaceProductTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_product" "test" {
+  name                        = "acctestproduct-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Test Product Updated"
+  published                   = true
+  approval_required           = true
+  subscription_required       = true
+  subscriptions_limit         = 2
+  description                 = "This is an example description"
+  terms                       = "These are some example terms and conditions"
+}
+`, ApiManagementWorkspaceTestResource{}.basic(data), data.RandomInteger)
+}
@@ -52,8 +52,11 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/tag"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/tenantaccess"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/user"
+	api_v2024_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/api"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apimanagementservice"
+	product_v2024_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/product"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspace"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspacepolicy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -105,7 +108,10 @@ type Client struct {
 	TagClient                          *tag.TagClient
 	TenantAccessClient                 *tenantaccess.TenantAccessClient
 	UsersClient                        *user.UserClient
+	WorkspaceApiClient                 *api_v2024_05_01.ApiClient
 	WorkspaceClient                    *workspace.WorkspaceClient
+	WorkspacePolicyClient              *workspacepolicy.WorkspacePolicyClient
+	WorkspaceProductClient             *product_v2024_05_01.ProductClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -397,6 +403,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(workspaceClient.Client, o.Authorizers.ResourceManager)
 
+	workspaceApiClient, err := api_v2024_05_01.NewApiClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building workspace api client: %+v", err)
+	}
+	o.Configure(workspaceApiClient.Client, o.Authorizers.ResourceManager)
+
+	workspacePolicyClient, err := workspacepolicy.NewWorkspacePolicyClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building workspace policy client: %+v", err)
+	}
+	o.Configure(workspacePolicyClient.Client, o.Authorizers.ResourceManager)
+
+	workspaceProductClient, err := product_v2024_05_01.NewProductClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building workspace product client: %+v", err)
+	}
+	o.Configure(workspaceProductClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		ApiClient:                          apiClient,
 		ApiDiagnosticClient:                apiDiagnosticClient,
@@ -445,6 +469,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		TagClient:                          tagClient,
 		TenantAccessClient:                 tenantAccessClient,
 		UsersClient:                        usersClient,
+		WorkspaceApiClient:                 workspaceApiClient,
 		WorkspaceClient:                    workspaceClient,
+		WorkspacePolicyClient:              workspacePolicyClient,
+		WorkspaceProductClient:             workspaceProductClient,
 	}, nil
 }
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// apiManagementPolicyParameterRegex matches the `{{param:name}}` placeholders which can be used within a Policy Fragment.
+// The `param:` prefix ensures these can't be confused with Named Values, which use `{{name}}` and can't contain a `:`
+var apiManagementPolicyParameterRegex = regexp.MustCompile(`{{param:([^{}]*)}}`)
+
+var apiManagementPolicyParameterNameRegex = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// renderApiManagementPolicyParameters replaces the `{{param:name}}` placeholders within the input with the XML escaped
+// value of the matching parameter, returning an error when a placeholder isn't defined or a parameter isn't used
+func renderApiManagementPolicyParameters(input string, parameters map[string]interface{}) (string, error) {
+	for name := range parameters {
+		if !apiManagementPolicyParameterNameRegex.MatchString(name) {
+			return "", fmt.Errorf("the parameter name %q is invalid, it may only contain alphanumeric characters, periods, underscores and hyphens", name)
+		}
+	}
+
+	used := make(map[string]struct{})
+	missing := make(map[string]struct{})
+	output := apiManagementPolicyParameterRegex.ReplaceAllStringFunc(input, func(match string) string {
+		name := apiManagementPolicyParameterRegex.FindStringSubmatch(match)[1]
+		value, ok := parameters[name]
+		if !ok {
+			missing[name] = struct{}{}
+			return match
+		}
+		used[name] = struct{}{}
+
+		var escaped strings.Builder
+		// writing to a strings.Builder can't fail
+		_ = xml.EscapeText(&escaped, []byte(value.(string)))
+		return escaped.String()
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("the parameters %s are used but aren't defined in `parameters`", sortedApiManagementPolicyParameterNames(missing))
+	}
+
+	unused := make(map[string]struct{})
+	for name := range parameters {
+		if _, ok := used[name]; !ok {
+			unused[name] = struct{}{}
+		}
+	}
+	if len(unused) > 0 {
+		return "", fmt.Errorf("the parameters %s are defined in `parameters` but aren't used", sortedApiManagementPolicyParameterNames(unused))
+	}
+
+	return output, nil
+}
+
+func sortedApiManagementPolicyParameterNames(input map[string]struct{}) string {
+	names := make([]string, 0, len(input))
+	for name := range input {
+		names = append(names, fmt.Sprintf("%q", name))
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// validateApiManagementPolicyXml validates that the input is a well-formed policy document with the specified root element.
+// Policy Expressions contain C# code (which is commonly not valid XML) and are evaluated by API Management at runtime,
+// as such these are replaced with a placeholder prior to validating the document
+func validateApiManagementPolicyXml(input, rootElement string) error {
+	content, err := replaceApiManagementPolicyExpressions(input)
+	if err != nil {
+		return err
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(content))
+	decoder.Entity = xml.HTMLEntity
+
+	depth := 0
+	roots := 0
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				roots++
+				if roots > 1 {
+					line, _ := decoder.InputPos()
+					return fmt.Errorf("line %d: expected a single root element but found %q", line, t.Name.Local)
+				}
+				if t.Name.Local != rootElement {
+					return fmt.Errorf("expected the root element to be %q but got %q", rootElement, t.Name.Local)
+				}
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 0 && strings.TrimSpace(string(t)) != "" {
+				line, _ := decoder.InputPos()
+				return fmt.Errorf("line %d: unexpected content outside of the %q element", line, rootElement)
+			}
+		}
+	}
+
+	if roots == 0 {
+		return fmt.Errorf("expected a root element %q but none was found", rootElement)
+	}
+
+	return nil
+}
+
+// replaceApiManagementPolicyExpressions replaces each single statement (`@(...)`) and multi statement (`@{...}`)
+// Policy Expression within the input with a placeholder
+func replaceApiManagementPolicyExpressions(input string) (string, error) {
+	var output strings.Builder
+	for i := 0; i < len(input); i++ {
+		if input[i] == '@' && i+1 < len(input) && (input[i+1] == '(' || input[i+1] == '{') {
+			end, err := findApiManagementPolicyExpressionEnd(input, i+1)
+			if err != nil {
+				return "", err
+			}
+			output.WriteString("expression")
+			i = end
+			continue
+		}
+		output.WriteByte(input[i])
+	}
+
+	return output.String(), nil
+}
+
+// findApiManagementPolicyExpressionEnd returns the offset of the bracket closing the Policy Expression which opens at
+// `start`, skipping over any brackets within C# comments, string and character literals
+func findApiManagementPolicyExpressionEnd(input string, start int) (int, error) {
+	depth := 0
+	for i := start; i < len(input); i++ {
+		switch {
+		case strings.HasPrefix(input[i:], "//"):
+			if end := strings.IndexByte(input[i:], '\n'); end != -1 {
+				i += end
+			} else {
+				i = len(input)
+			}
+			continue
+		case strings.HasPrefix(input[i:], "/*"):
+			if end := strings.Index(input[i+2:], "*/"); end != -1 {
+				i += end + 3
+			} else {
+				i = len(input)
+			}
+			continue
+		}
+
+		// literals can be quoted using either the raw or the XML escaped quotes, depending on the policy format
+		if quote := apiManagementPolicyExpressionQuote(input[i:]); quote != "" {
+			for i += len(quote); i < len(input); i++ {
+				if strings.HasPrefix(input[i:], quote) {
+					i += len(quote) - 1
+					break
+				}
+				if input[i] == '\\' {
+					i++
+				}
+			}
+			continue
+		}
+
+		switch input[i] {
+		case '(', '{':
+			depth++
+		case ')', '}':
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+
+	line := strings.Count(input[:start], "\n") + 1
+	return 0, fmt.Errorf("line %d: the policy expression isn't terminated", line)
+}
+
+func apiManagementPolicyExpressionQuote(input string) string {
+	for _, quote := range []string{`"`, `'`, "&quot;", "&apos;"} {
+		if strings.HasPrefix(input, quote) {
+			return quote
+		}
+	}
+	return ""
+}
+
+// apiManagementPolicyXmlContentCustomizeDiff validates the `xml_content` of a Policy at plan time, so that malformed
+// policies are surfaced before being sent to API Management
+func apiManagementPolicyXmlContentCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("xml_content") || !diff.HasChange("xml_content") {
+		return nil
+	}
+
+	// `xml_content` is computed when `xml_link` is used
+	content := diff.Get("xml_content").(string)
+	if content == "" {
+		return nil
+	}
+
+	if err := validateApiManagementPolicyXml(content, "policies"); err != nil {
+		return fmt.Errorf("validating `xml_content`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"os"
+	"testing"
+)
+
+func TestValidateApiManagementPolicyXml(t *testing.T) {
+	testData := []struct {
+		Input string
+		Root  string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Root:  "policies",
+			Valid: false,
+		},
+		{
+			Input: "<policies><inbound><base /></inbound></policies>",
+			Root:  "policies",
+			Valid: true,
+		},
+		{
+			// wrong root element
+			Input: "<fragment><base /></fragment>",
+			Root:  "policies",
+			Valid: false,
+		},
+		{
+			// multiple root elements
+			Input: "<fragment /><fragment />",
+			Root:  "fragment",
+			Valid: false,
+		},
+		{
+			// unclosed element
+			Input: "<policies><inbound><base /></policies>",
+			Root:  "policies",
+			Valid: false,
+		},
+		{
+			// content outside of the root element
+			Input: "<policies /> foo",
+			Root:  "policies",
+			Valid: false,
+		},
+		{
+			// raw single statement expression
+			Input: `<fragment><set-variable name="var" value="@(context.Request.Headers.GetValueOrDefault("X-Header", "(default)"))" /></fragment>`,
+			Root:  "fragment",
+			Valid: true,
+		},
+		{
+			// raw multi statement expression containing generics and comments
+			Input: "<policies><inbound><set-body>@{\n  // it's a comment }\n  var body = context.Request.Body.As<JObject>();\n  return body[\"id\"].ToString();\n}</set-body></inbound></policies>",
+			Root:  "policies",
+			Valid: true,
+		},
+		{
+			// escaped multi statement expression
+			Input: "<policies><inbound><set-body>@{ return $&quot;Bearer {context.Variables[&quot;jwt&quot;]}&quot;; }</set-body></inbound></policies>",
+			Root:  "policies",
+			Valid: true,
+		},
+		{
+			// unterminated expression
+			Input: `<fragment><set-variable name="var" value="@(context.User.Id" /></fragment>`,
+			Root:  "fragment",
+			Valid: false,
+		},
+		{
+			// named values
+			Input: `<fragment><set-header name="x-key" exists-action="override"><value>{{my-key}}</value></set-header></fragment>`,
+			Root:  "fragment",
+			Valid: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		err := validateApiManagementPolicyXml(v.Input, v.Root)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected %q to be valid but got: %+v", v.Input, err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected %q to be invalid but it was valid", v.Input)
+		}
+	}
+}
+
+func TestValidateApiManagementPolicyXmlTestData(t *testing.T) {
+	testData := map[string]string{
+		"testdata/api_management_api_operation_policy.xml":        "policies",
+		"testdata/api_management_policy_test.xml":                 "policies",
+		"testdata/api_management_policy_fragment_test_rawxml.xml": "fragment",
+		"testdata/api_management_policy_fragment_test_xml.xml":    "fragment",
+	}
+
+	for path, root := range testData {
+		t.Logf("[DEBUG] Testing %q", path)
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("reading %q: %+v", path, err)
+		}
+
+		if err := validateApiManagementPolicyXml(string(content), root); err != nil {
+			t.Fatalf("Expected %q to be valid but got: %+v", path, err)
+		}
+	}
+}
+
+func TestRenderApiManagementPolicyParameters(t *testing.T) {
+	testData := []struct {
+		Input      string
+		Parameters map[string]interface{}
+		Expected   string
+		Error      bool
+	}{
+		{
+			Input:    "<fragment><base /></fragment>",
+			Expected: "<fragment><base /></fragment>",
+		},
+		{
+			Input: `<fragment><set-header name="{{param:header}}" exists-action="override"><value>{{param:value}}</value></set-header></fragment>`,
+			Parameters: map[string]interface{}{
+				"header": "x-tier",
+				"value":  "gold & <silver>",
+			},
+			Expected: `<fragment><set-header name="x-tier" exists-action="override"><value>gold &amp; &lt;silver&gt;</value></set-header></fragment>`,
+		},
+		{
+			// named values are left as-is
+			Input: "<fragment><value>{{named-value}}{{param:a}}{{param:a}}</value></fragment>",
+			Parameters: map[string]interface{}{
+				"a": "b",
+			},
+			Expected: "<fragment><value>{{named-value}}bb</value></fragment>",
+		},
+		{
+			// undefined parameter
+			Input: "<fragment><value>{{param:a}}</value></fragment>",
+			Error: true,
+		},
+		{
+			// unused parameter
+			Input: "<fragment />",
+			Parameters: map[string]interface{}{
+				"a": "b",
+			},
+			Error: true,
+		},
+		{
+			// invalid parameter name
+			Input: "<fragment><value>{{param:a b}}</value></fragment>",
+			Parameters: map[string]interface{}{
+				"a b": "c",
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := renderApiManagementPolicyParameters(v.Input, v.Parameters)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -107,6 +107,9 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ApiManagementNotificationRecipientEmailResource{},
 		ApiManagementNotificationRecipientUserResource{},
+		ApiManagementWorkspaceApiResource{},
+		ApiManagementWorkspacePolicyResource{},
+		ApiManagementWorkspaceProductResource{},
 		ApiManagementWorkspaceResource{},
 	}
 }
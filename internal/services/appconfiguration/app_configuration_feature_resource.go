@@ -372,12 +372,16 @@ func (k FeatureResource) Read() sdk.ResourceFunc {
 				return metadata.MarkAsGone(nestedItemId)
 			}
 
-			client, err := metadata.Client.AppConfiguration.DataPlaneClientWithEndpoint(nestedItemId.ConfigurationStoreEndpoint)
-			if err != nil {
-				return err
-			}
+			var kv appconfiguration.KeyValue
+			err = metadata.Client.AppConfiguration.ReadWithReplicaFallback(ctx, *configurationStoreId, nestedItemId.ConfigurationStoreEndpoint, func(endpoint string) error {
+				client, err := metadata.Client.AppConfiguration.DataPlaneClientWithEndpoint(endpoint)
+				if err != nil {
+					return err
+				}
 
-			kv, err := client.GetKeyValue(ctx, nestedItemId.Key, nestedItemId.Label, "", "", "", []appconfiguration.KeyValueFields{})
+				kv, err = client.GetKeyValue(ctx, nestedItemId.Key, nestedItemId.Label, "", "", "", []appconfiguration.KeyValueFields{})
+				return err
+			})
 			if err != nil {
 				if v, ok := err.(autorest.DetailedError); ok {
 					if response.WasNotFound(v.Response) {
@@ -102,17 +102,21 @@ func (k KeyDataSource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving Endpoint for feature %q in %q: %s", model.Key, *configurationStoreId, err)
 			}
 
-			client, err := metadata.Client.AppConfiguration.DataPlaneClientWithEndpoint(*configurationStoreEndpoint)
+			nestedItemId, err := parse.NewNestedItemID(*configurationStoreEndpoint, model.Key, model.Label)
 			if err != nil {
 				return err
 			}
 
-			nestedItemId, err := parse.NewNestedItemID(client.Endpoint, model.Key, model.Label)
-			if err != nil {
-				return err
-			}
+			var kv appconfiguration.KeyValue
+			err = metadata.Client.AppConfiguration.ReadWithReplicaFallback(ctx, *configurationStoreId, *configurationStoreEndpoint, func(endpoint string) error {
+				client, err := metadata.Client.AppConfiguration.DataPlaneClientWithEndpoint(endpoint)
+				if err != nil {
+					return err
+				}
 
-			kv, err := client.GetKeyValue(ctx, model.Key, model.Label, "", "", "", []appconfiguration.KeyValueFields{})
+				kv, err = client.GetKeyValue(ctx, model.Key, model.Label, "", "", "", []appconfiguration.KeyValueFields{})
+				return err
+			})
 			if err != nil {
 				if v, ok := err.(autorest.DetailedError); ok {
 					if utils.ResponseWasNotFound(autorest.Response{Response: v.Response}) {
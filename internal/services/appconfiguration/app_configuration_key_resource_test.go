@@ -222,6 +222,21 @@ func TestAccAppConfigurationKey_lockUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigurationKey_withReplica(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_key", "test")
+	r := AppConfigurationKeyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withReplica(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_app_configuration.test").Key("replica.0.endpoint").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t AppConfigurationKeyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	nestedItemId, err := parse.ParseNestedItemID(state.ID)
 	if err != nil {
@@ -508,3 +523,48 @@ resource "azurerm_app_configuration_key" "test" {
 }
   `, t.base(data), data.RandomInteger, data.RandomInteger)
 }
+
+func (t AppConfigurationKeyResource) withReplica(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appconfig-%d"
+  location = "%s"
+}
+
+data "azurerm_client_config" "test" {
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "App Configuration Data Owner"
+  principal_id         = data.azurerm_client_config.test.object_id
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                = "testacc-appconf%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "premium"
+
+  replica {
+    name     = "replica1"
+    location = "%s"
+  }
+
+  depends_on = [
+    azurerm_role_assignment.test,
+  ]
+}
+
+resource "azurerm_app_configuration_key" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key                    = "acctest-ackey-%d"
+  label                  = "acctest-ackeylabel-%d"
+  value                  = "a test"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Secondary, data.RandomInteger, data.RandomInteger)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2024-05-01/configurationstores"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -136,21 +137,25 @@ func (k KeysDataSource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving Endpoint for feature %q in %q: %s", model.Key, *configurationStoreId, err)
 			}
 
-			// @favoretti: API returns pagination nextLink (Link header) without complete URI, only path:
-			// Link: "</kv?somepath...>; rel=next;"
-			// whereas the client expects a complete URI to be present and therefore fails to fetch all results if
-			// store contains more than 100 entries
-			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(*configurationStoreEndpoint)
-			if err != nil {
-				return err
-			}
-
 			nestedItemId, err := parse.NewNestedItemID(*configurationStoreEndpoint, model.Key, model.Label)
 			if err != nil {
 				return err
 			}
 
-			iter, err := client.GetKeyValuesComplete(ctx, model.Key, model.Label, "", "", []appconfiguration.KeyValueFields{})
+			var iter azuresdkhacks.KeyValueListResultIterator
+			err = metadata.Client.AppConfiguration.ReadWithReplicaFallback(ctx, *configurationStoreId, *configurationStoreEndpoint, func(endpoint string) error {
+				// @favoretti: API returns pagination nextLink (Link header) without complete URI, only path:
+				// Link: "</kv?somepath...>; rel=next;"
+				// whereas the client expects a complete URI to be present and therefore fails to fetch all results if
+				// store contains more than 100 entries
+				client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(endpoint)
+				if err != nil {
+					return err
+				}
+
+				iter, err = client.GetKeyValuesComplete(ctx, model.Key, model.Label, "", "", []appconfiguration.KeyValueFields{})
+				return err
+			})
 			if err != nil {
 				if v, ok := err.(autorest.DetailedError); ok {
 					if utils.ResponseWasNotFound(autorest.Response{Response: v.Response}) {
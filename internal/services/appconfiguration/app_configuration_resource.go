@@ -587,6 +587,8 @@ func resourceAppConfigurationUpdate(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("creating %s: %+v", replicaId, err)
 			}
 		}
+
+		meta.(*clients.Client).AppConfiguration.RemoveReplicaEndpointsFromCache(*id)
 	}
 
 	return resourceAppConfigurationRead(d, meta)
@@ -153,6 +153,7 @@ func (c *Client) RemoveFromCache(configurationStoreId configurationstores.Config
 	keysmith.Unlock()
 	lock[cacheKey].Lock()
 	delete(configurationStoreCache, cacheKey)
+	delete(replicaEndpointCache, cacheKey)
 	lock[cacheKey].Unlock()
 }
 
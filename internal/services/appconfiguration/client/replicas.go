@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2024-05-01/configurationstores"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2024-05-01/replicas"
+)
+
+var replicaEndpointCache = map[string][]string{}
+
+// ReplicaEndpointsForConfigurationStore returns the data plane endpoints of the successfully provisioned
+// Replicas of the Configuration Store, sorted by Replica name so that the failover order is stable.
+// The endpoints are retrieved once per Configuration Store and then cached.
+func (c *Client) ReplicaEndpointsForConfigurationStore(ctx context.Context, configurationStoreId configurationstores.ConfigurationStoreId) ([]string, error) {
+	cacheKey := c.cacheKeyForConfigurationStore(configurationStoreId.ConfigurationStoreName)
+	keysmith.Lock()
+	if lock[cacheKey] == nil {
+		lock[cacheKey] = &sync.RWMutex{}
+	}
+	keysmith.Unlock()
+	lock[cacheKey].Lock()
+	defer lock[cacheKey].Unlock()
+
+	if v, ok := replicaEndpointCache[cacheKey]; ok {
+		return v, nil
+	}
+
+	storeId := replicas.NewConfigurationStoreID(configurationStoreId.SubscriptionId, configurationStoreId.ResourceGroupName, configurationStoreId.ConfigurationStoreName)
+	resp, err := c.ReplicasClient.ListByConfigurationStoreComplete(ctx, storeId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving replicas for %s: %+v", configurationStoreId, err)
+	}
+
+	items := resp.Items
+	sort.Slice(items, func(i, j int) bool {
+		return strings.ToLower(pointer.From(items[i].Name)) < strings.ToLower(pointer.From(items[j].Name))
+	})
+
+	endpoints := make([]string, 0)
+	for _, item := range items {
+		if item.Properties == nil || item.Properties.Endpoint == nil {
+			continue
+		}
+		if pointer.From(item.Properties.ProvisioningState) != replicas.ReplicaProvisioningStateSucceeded {
+			continue
+		}
+		endpoints = append(endpoints, *item.Properties.Endpoint)
+	}
+
+	keysmith.Lock()
+	replicaEndpointCache[cacheKey] = endpoints
+	keysmith.Unlock()
+
+	return endpoints, nil
+}
+
+// RemoveReplicaEndpointsFromCache clears the cached Replica endpoints, so that they're retrieved again once the
+// Replicas of the Configuration Store have changed
+func (c *Client) RemoveReplicaEndpointsFromCache(configurationStoreId configurationstores.ConfigurationStoreId) {
+	cacheKey := c.cacheKeyForConfigurationStore(configurationStoreId.ConfigurationStoreName)
+	keysmith.Lock()
+	if lock[cacheKey] == nil {
+		lock[cacheKey] = &sync.RWMutex{}
+	}
+	keysmith.Unlock()
+	lock[cacheKey].Lock()
+	delete(replicaEndpointCache, cacheKey)
+	lock[cacheKey].Unlock()
+}
+
+// ReadWithReplicaFallback calls `read` with the primary endpoint of the Configuration Store. Should the primary endpoint
+// be unreachable or return a server error, `read` is retried against each Replica in turn, so that Keys and Features can
+// still be read during a regional outage. Replicas are read-only, so writes must always use the primary endpoint.
+func (c *Client) ReadWithReplicaFallback(ctx context.Context, configurationStoreId configurationstores.ConfigurationStoreId, configurationStoreEndpoint string, read func(endpoint string) error) error {
+	err := read(configurationStoreEndpoint)
+	if err == nil || !endpointWasUnavailable(err) {
+		return err
+	}
+
+	replicaEndpoints, replicaErr := c.ReplicaEndpointsForConfigurationStore(ctx, configurationStoreId)
+	if replicaErr != nil {
+		log.Printf("[DEBUG] Unable to retrieve the replicas of %s to fall back to: %+v", configurationStoreId, replicaErr)
+		return err
+	}
+
+	for _, endpoint := range replicaEndpoints {
+		log.Printf("[DEBUG] Primary endpoint %q for %s is unavailable - reading from replica endpoint %q", configurationStoreEndpoint, configurationStoreId, endpoint)
+		replicaErr = read(endpoint)
+		if replicaErr == nil || !endpointWasUnavailable(replicaErr) {
+			return replicaErr
+		}
+	}
+
+	// none of the replicas are available either, so surface the error from the primary endpoint
+	return err
+}
+
+// endpointWasUnavailable determines whether a data plane request failed because the endpoint couldn't be reached
+// or returned a server error, as opposed to e.g. the item not being found
+func endpointWasUnavailable(err error) bool {
+	var detailedErr autorest.DetailedError
+	if errors.As(err, &detailedErr) {
+		if detailedErr.Response == nil {
+			var netErr net.Error
+			return errors.As(detailedErr.Original, &netErr)
+		}
+		return detailedErr.Response.StatusCode >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2024-05-01/configurationstores"
+)
+
+func TestEndpointWasUnavailable(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    error
+		Expected bool
+	}{
+		{
+			Name:     "generic error",
+			Input:    errors.New("something went wrong"),
+			Expected: false,
+		},
+		{
+			Name:     "network error",
+			Input:    &net.OpError{Op: "dial", Err: errors.New("connection refused")},
+			Expected: true,
+		},
+		{
+			Name:     "wrapped network error",
+			Input:    fmt.Errorf("sending request: %w", &net.DNSError{Err: "no such host", Name: "example.azconfig.io"}),
+			Expected: true,
+		},
+		{
+			Name:     "detailed error without a response wrapping a network error",
+			Input:    autorest.NewErrorWithError(&net.OpError{Op: "dial", Err: errors.New("i/o timeout")}, "appconfiguration.BaseClient", "GetKeyValue", nil, "Failure sending request"),
+			Expected: true,
+		},
+		{
+			Name:     "detailed error without a response wrapping a generic error",
+			Input:    autorest.NewErrorWithError(errors.New("invalid request"), "appconfiguration.BaseClient", "GetKeyValue", nil, "Failure preparing request"),
+			Expected: false,
+		},
+		{
+			Name:     "not found",
+			Input:    detailedErrorWithStatusCode(http.StatusNotFound),
+			Expected: false,
+		},
+		{
+			Name:     "forbidden",
+			Input:    detailedErrorWithStatusCode(http.StatusForbidden),
+			Expected: false,
+		},
+		{
+			Name:     "internal server error",
+			Input:    detailedErrorWithStatusCode(http.StatusInternalServerError),
+			Expected: true,
+		},
+		{
+			Name:     "service unavailable",
+			Input:    detailedErrorWithStatusCode(http.StatusServiceUnavailable),
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := endpointWasUnavailable(v.Input); actual != v.Expected {
+			t.Fatalf("expected %t but got %t for %q", v.Expected, actual, v.Name)
+		}
+	}
+}
+
+func TestReadWithReplicaFallback(t *testing.T) {
+	const primary = "https://primary.azconfig.io"
+	replicaEndpoints := []string{
+		"https://primary-replica1.azconfig.io",
+		"https://primary-replica2.azconfig.io",
+	}
+
+	unavailable := detailedErrorWithStatusCode(http.StatusServiceUnavailable)
+	notFound := detailedErrorWithStatusCode(http.StatusNotFound)
+
+	testData := []struct {
+		Name          string
+		Responses     map[string]error
+		ExpectedCalls []string
+		ExpectedError error
+	}{
+		{
+			Name:          "primary available",
+			Responses:     map[string]error{},
+			ExpectedCalls: []string{primary},
+		},
+		{
+			Name: "primary returns a client error",
+			Responses: map[string]error{
+				primary: notFound,
+			},
+			ExpectedCalls: []string{primary},
+			ExpectedError: notFound,
+		},
+		{
+			Name: "primary unavailable",
+			Responses: map[string]error{
+				primary: unavailable,
+			},
+			ExpectedCalls: []string{primary, replicaEndpoints[0]},
+		},
+		{
+			Name: "primary and first replica unavailable",
+			Responses: map[string]error{
+				primary:             unavailable,
+				replicaEndpoints[0]: unavailable,
+			},
+			ExpectedCalls: []string{primary, replicaEndpoints[0], replicaEndpoints[1]},
+		},
+		{
+			Name: "first replica returns a client error",
+			Responses: map[string]error{
+				primary:             unavailable,
+				replicaEndpoints[0]: notFound,
+			},
+			ExpectedCalls: []string{primary, replicaEndpoints[0]},
+			ExpectedError: notFound,
+		},
+		{
+			Name: "first replica returns an unexpected error",
+			Responses: map[string]error{
+				primary:             unavailable,
+				replicaEndpoints[0]: errors.New("unexpected error"),
+			},
+			ExpectedCalls: []string{primary, replicaEndpoints[0]},
+			ExpectedError: errors.New("unexpected error"),
+		},
+		{
+			Name: "all endpoints unavailable surfaces the primary error",
+			Responses: map[string]error{
+				primary:             unavailable,
+				replicaEndpoints[0]: unavailable,
+				replicaEndpoints[1]: &net.OpError{Op: "dial", Err: errors.New("connection refused")},
+			},
+			ExpectedCalls: []string{primary, replicaEndpoints[0], replicaEndpoints[1]},
+			ExpectedError: unavailable,
+		},
+	}
+
+	c := &Client{}
+	configurationStoreId := configurationstores.NewConfigurationStoreID("12345678-1234-9876-4563-123456789012", "resGroup1", "primary")
+	cacheKey := c.cacheKeyForConfigurationStore(configurationStoreId.ConfigurationStoreName)
+
+	// seed the cache so that the Replicas aren't retrieved from the API
+	keysmith.Lock()
+	replicaEndpointCache[cacheKey] = replicaEndpoints
+	keysmith.Unlock()
+	defer c.RemoveReplicaEndpointsFromCache(configurationStoreId)
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		calls := make([]string, 0)
+		err := c.ReadWithReplicaFallback(context.TODO(), configurationStoreId, primary, func(endpoint string) error {
+			calls = append(calls, endpoint)
+			return v.Responses[endpoint]
+		})
+
+		if !reflect.DeepEqual(calls, v.ExpectedCalls) {
+			t.Fatalf("expected calls %+v but got %+v for %q", v.ExpectedCalls, calls, v.Name)
+		}
+
+		if v.ExpectedError == nil {
+			if err != nil {
+				t.Fatalf("expected no error but got %+v for %q", err, v.Name)
+			}
+			continue
+		}
+
+		if err == nil || err.Error() != v.ExpectedError.Error() {
+			t.Fatalf("expected error %+v but got %+v for %q", v.ExpectedError, err, v.Name)
+		}
+	}
+}
+
+func detailedErrorWithStatusCode(statusCode int) error {
+	return autorest.NewErrorWithResponse("appconfiguration.BaseClient", "GetKeyValue", &http.Response{StatusCode: statusCode}, "Failure responding to request")
+}
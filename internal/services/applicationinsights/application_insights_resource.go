@@ -4,7 +4,9 @@
 package applicationinsights
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -25,6 +27,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+// applicationInsightsLegacyEnterpriseBillingFeature is the billing feature of the legacy Enterprise (per node) pricing plan
+const applicationInsightsLegacyEnterpriseBillingFeature = "Application Insights Enterprise"
+
 func resourceApplicationInsights() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceApplicationInsightsCreate,
@@ -37,6 +42,8 @@ func resourceApplicationInsights() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceApplicationInsightsWorkspaceMigrationCustomizeDiff),
+
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.ComponentUpgradeV0ToV1{},
@@ -435,6 +442,7 @@ func resourceApplicationInsightsUpdate(d *pluginsdk.ResourceData, meta interface
 	if oldWorkspaceId.(string) != "" && newWorkspaceId.(string) == "" {
 		return fmt.Errorf("`workspace_id` cannot be removed after set. If `workspace_id` is not specified but you encounter a diff, this might indicate a Microsoft initiated automatic migration from classic resources to workspace-based resources. If this is the case, please update `workspace_id` in your config file to the new value.")
 	}
+	migratingToWorkspace := oldWorkspaceId.(string) == "" && newWorkspaceId.(string) != ""
 
 	if d.HasChange("sampling_percentage") {
 		componentProps.SamplingPercentage = pointer.To(d.Get("sampling_percentage").(float64))
@@ -472,6 +480,11 @@ func resourceApplicationInsightsUpdate(d *pluginsdk.ResourceData, meta interface
 			return err
 		}
 		componentProps.WorkspaceResourceId = pointer.To(workspaceID.ID())
+
+		// a classic component is only migrated once its ingestion mode is switched over to the workspace
+		if migratingToWorkspace {
+			componentProps.IngestionMode = pointer.To(components.IngestionModeLogAnalytics)
+		}
 	}
 
 	if d.HasChange("retention_in_days") {
@@ -493,6 +506,21 @@ func resourceApplicationInsightsUpdate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
+	if migratingToWorkspace {
+		log.Printf("[DEBUG] Waiting for %s to be migrated to a workspace-based component..", id)
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:                   []string{"Migrating"},
+			Target:                    []string{"Migrated"},
+			Refresh:                   applicationInsightsWorkspaceMigrationRefreshFunc(ctx, client, *id, newWorkspaceId.(string)),
+			Timeout:                   d.Timeout(pluginsdk.TimeoutUpdate),
+			MinTimeout:                15 * time.Second,
+			ContinuousTargetOccurence: 2,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for %s to be migrated to a workspace-based component: %+v", id, err)
+		}
+	}
+
 	read, err := client.ComponentsGet(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
@@ -569,3 +597,84 @@ func resourceApplicationInsightsDelete(d *pluginsdk.ResourceData, meta interface
 
 	return err
 }
+
+func applicationInsightsWorkspaceMigrationRefreshFunc(ctx context.Context, client *components.ComponentsAPIsClient, id components.ComponentId, workspaceId string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.ComponentsGet(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil {
+			return resp, "Migrating", nil
+		}
+
+		props := resp.Model.Properties
+		if !strings.EqualFold(pointer.From(props.WorkspaceResourceId), workspaceId) || pointer.From(props.IngestionMode) != components.IngestionModeLogAnalytics {
+			return resp, "Migrating", nil
+		}
+
+		if v := props.ProvisioningState; v != nil && !strings.EqualFold(*v, "Succeeded") {
+			return resp, "Migrating", nil
+		}
+
+		return resp, "Migrated", nil
+	}
+}
+
+// resourceApplicationInsightsWorkspaceMigrationCustomizeDiff validates, at plan time, that a classic component can be
+// migrated into the Log Analytics Workspace specified in `workspace_id`
+func resourceApplicationInsightsWorkspaceMigrationCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("workspace_id") || !diff.NewValueKnown("workspace_id") {
+		return nil
+	}
+
+	oldWorkspaceId, newWorkspaceId := diff.GetChange("workspace_id")
+	if oldWorkspaceId.(string) != "" || newWorkspaceId.(string) == "" {
+		return nil
+	}
+
+	id, err := components.ParseComponentID(diff.Id())
+	if err != nil {
+		return err
+	}
+
+	workspaceId, err := workspaces.ParseWorkspaceID(newWorkspaceId.(string))
+	if err != nil {
+		return err
+	}
+
+	workspace, err := meta.(*clients.Client).LogAnalytics.SharedKeyWorkspacesClient.Get(ctx, *workspaceId)
+	if err != nil {
+		if response.WasNotFound(workspace.HttpResponse) {
+			return fmt.Errorf("migrating %s to a workspace-based component: %s was not found", id, workspaceId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", workspaceId, err)
+	}
+
+	if model := workspace.Model; model != nil {
+		componentLocation := location.Normalize(diff.Get("location").(string))
+		if workspaceLocation := location.Normalize(model.Location); workspaceLocation != componentLocation {
+			return fmt.Errorf("migrating %s to a workspace-based component: %s is in %q but must be in the same region as the component (%q)", id, workspaceId, workspaceLocation, componentLocation)
+		}
+	}
+
+	billingId, err := billing.ParseComponentID(id.ID())
+	if err != nil {
+		return err
+	}
+	billingResp, err := meta.(*clients.Client).AppInsights.BillingClient.ComponentCurrentBillingFeaturesGet(ctx, *billingId)
+	if err != nil {
+		return fmt.Errorf("retrieving Billing Features for %s: %+v", id, err)
+	}
+
+	if model := billingResp.Model; model != nil {
+		for _, feature := range pointer.From(model.CurrentBillingFeatures) {
+			if strings.EqualFold(feature, applicationInsightsLegacyEnterpriseBillingFeature) {
+				return fmt.Errorf("migrating %s to a workspace-based component: workspace-based components don't support the legacy Enterprise (per node) pricing plan, the component must be moved to the Basic pricing plan before it can be migrated", id)
+			}
+		}
+	}
+
+	return nil
+}
@@ -45,6 +45,8 @@ type SiteConfigLinux struct {
 	HealthCheckPath               string                  `tfschema:"health_check_path"`
 	HealthCheckEvictionTime       int64                   `tfschema:"health_check_eviction_time_in_min"`
 	NumberOfWorkers               int64                   `tfschema:"worker_count"`
+	ElasticInstanceMinimum        int64                   `tfschema:"elastic_instance_minimum"`
+	MaximumInstanceCount          int64                   `tfschema:"maximum_instance_count"`
 	ApplicationStack              []ApplicationStackLinux `tfschema:"application_stack"`
 	MinTlsVersion                 string                  `tfschema:"minimum_tls_version"`
 	ScmMinTlsVersion              string                  `tfschema:"scm_minimum_tls_version"`
@@ -234,6 +236,20 @@ func SiteConfigSchemaLinux() *pluginsdk.Schema {
 					ValidateFunc: validation.IntBetween(1, 100),
 				},
 
+				"elastic_instance_minimum": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
+				"maximum_instance_count": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
 				"minimum_tls_version": {
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
@@ -413,6 +429,16 @@ func SiteConfigSchemaLinuxComputed() *pluginsdk.Schema {
 					Computed: true,
 				},
 
+				"elastic_instance_minimum": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"maximum_instance_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
 				"minimum_tls_version": {
 					Type:     pluginsdk.TypeString,
 					Computed: true,
@@ -911,6 +937,14 @@ func (s *SiteConfigLinux) ExpandForCreate(appSettings map[string]string) (*webap
 		expanded.NumberOfWorkers = pointer.To(s.NumberOfWorkers)
 	}
 
+	if s.ElasticInstanceMinimum != 0 {
+		expanded.MinimumElasticInstanceCount = pointer.To(s.ElasticInstanceMinimum)
+	}
+
+	if s.MaximumInstanceCount != 0 {
+		expanded.ElasticWebAppScaleLimit = pointer.To(s.MaximumInstanceCount)
+	}
+
 	if len(s.Cors) != 0 {
 		expanded.Cors = ExpandCorsSettings(s.Cors)
 	}
@@ -1057,6 +1091,14 @@ func (s *SiteConfigLinux) ExpandForUpdate(metadata sdk.ResourceMetaData, existin
 		expanded.NumberOfWorkers = pointer.To(s.NumberOfWorkers)
 	}
 
+	if metadata.ResourceData.HasChange("site_config.0.elastic_instance_minimum") {
+		expanded.MinimumElasticInstanceCount = pointer.To(s.ElasticInstanceMinimum)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.maximum_instance_count") {
+		expanded.ElasticWebAppScaleLimit = pointer.To(s.MaximumInstanceCount)
+	}
+
 	if metadata.ResourceData.HasChange("site_config.0.minimum_tls_version") {
 		expanded.MinTlsVersion = pointer.To(webapps.SupportedTlsVersions(s.MinTlsVersion))
 	}
@@ -1104,6 +1146,8 @@ func (s *SiteConfigLinux) Flatten(appSiteConfig *webapps.SiteConfig) {
 		s.LocalMysql = pointer.From(appSiteConfig.LocalMySqlEnabled)
 		s.MinTlsVersion = string(pointer.From(appSiteConfig.MinTlsVersion))
 		s.NumberOfWorkers = pointer.From(appSiteConfig.NumberOfWorkers)
+		s.ElasticInstanceMinimum = pointer.From(appSiteConfig.MinimumElasticInstanceCount)
+		s.MaximumInstanceCount = pointer.From(appSiteConfig.ElasticWebAppScaleLimit)
 		s.RemoteDebugging = pointer.From(appSiteConfig.RemoteDebuggingEnabled)
 		s.RemoteDebuggingVersion = strings.ToUpper(pointer.From(appSiteConfig.RemoteDebuggingVersion))
 		s.ScmIpRestriction = FlattenIpRestrictions(appSiteConfig.ScmIPSecurityRestrictions)
@@ -8,8 +8,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/appserviceplans"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -160,6 +164,48 @@ func PlanIsAppPlan(input *string) bool {
 	return false
 }
 
+// PlanSupportsAutomaticScaling returns whether apps on the Service Plan can use the per-app automatic scaling settings,
+// which requires a Premium SKU with `premium_plan_auto_scale_enabled` set to `true`
+func PlanSupportsAutomaticScaling(input *appserviceplans.AppServicePlan) bool {
+	if input == nil || input.Sku == nil || input.Properties == nil {
+		return false
+	}
+
+	return PlanIsPremium(pointer.From(input.Sku.Name)) && pointer.From(input.Properties.ElasticScaleEnabled)
+}
+
+// AutomaticScalingConfigured returns whether `elastic_instance_minimum` or `maximum_instance_count` are specified in
+// the `site_config` block of the configuration. Both are Optional and Computed, so the values in the state may have
+// been set by the service and can't be used to determine whether the user requires automatic scaling.
+func AutomaticScalingConfigured(d *schema.ResourceData) bool {
+	config := d.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() {
+		return false
+	}
+
+	siteConfig := config.GetAttr("site_config")
+	if !siteConfig.IsKnown() || siteConfig.IsNull() || siteConfig.LengthInt() == 0 {
+		return false
+	}
+
+	block := siteConfig.Index(cty.NumberIntVal(0))
+	if !block.IsKnown() || block.IsNull() {
+		return false
+	}
+
+	for _, key := range []string{"elastic_instance_minimum", "maximum_instance_count"} {
+		v := block.GetAttr(key)
+		if v.IsNull() {
+			continue
+		}
+		if !v.IsKnown() || v.AsBigFloat().Sign() != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 func PlanTypeFromSku(input string) string {
 	if PlanIsConsumption(&input) {
 		return ServicePlanTypeConsumption
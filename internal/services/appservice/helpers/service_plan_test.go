@@ -6,6 +6,11 @@ package helpers_test
 import (
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/appserviceplans"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -199,3 +204,127 @@ func TestPlanTypeFromSku(t *testing.T) {
 		}
 	}
 }
+
+func TestPlanSupportsAutomaticScaling(t *testing.T) {
+	input := []struct {
+		name     string
+		plan     *appserviceplans.AppServicePlan
+		expected bool
+	}{
+		{
+			name:     "nil plan",
+			plan:     nil,
+			expected: false,
+		},
+		{
+			name: "premium with automatic scaling",
+			plan: &appserviceplans.AppServicePlan{
+				Sku: &appserviceplans.SkuDescription{
+					Name: pointer.To("P1v3"),
+				},
+				Properties: &appserviceplans.AppServicePlanProperties{
+					ElasticScaleEnabled: pointer.To(true),
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "premium without automatic scaling",
+			plan: &appserviceplans.AppServicePlan{
+				Sku: &appserviceplans.SkuDescription{
+					Name: pointer.To("P1v3"),
+				},
+				Properties: &appserviceplans.AppServicePlanProperties{
+					ElasticScaleEnabled: pointer.To(false),
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "elastic premium",
+			plan: &appserviceplans.AppServicePlan{
+				Sku: &appserviceplans.SkuDescription{
+					Name: pointer.To("EP1"),
+				},
+				Properties: &appserviceplans.AppServicePlanProperties{
+					ElasticScaleEnabled: pointer.To(true),
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "standard",
+			plan: &appserviceplans.AppServicePlan{
+				Sku: &appserviceplans.SkuDescription{
+					Name: pointer.To("S1"),
+				},
+				Properties: &appserviceplans.AppServicePlanProperties{},
+			},
+			expected: false,
+		},
+	}
+
+	for _, v := range input {
+		if actual := helpers.PlanSupportsAutomaticScaling(v.plan); actual != v.expected {
+			t.Fatalf("expected %s to be %t, got %t", v.name, v.expected, actual)
+		}
+	}
+}
+
+func TestAutomaticScalingConfigured(t *testing.T) {
+	siteConfig := func(elasticInstanceMinimum, maximumInstanceCount cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"site_config": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"elastic_instance_minimum": elasticInstanceMinimum,
+					"maximum_instance_count":   maximumInstanceCount,
+				}),
+			}),
+		})
+	}
+
+	input := []struct {
+		name       string
+		config     cty.Value
+		configured bool
+	}{
+		{
+			name:       "no configuration",
+			config:     cty.NullVal(cty.DynamicPseudoType),
+			configured: false,
+		},
+		{
+			name:       "neither specified",
+			config:     siteConfig(cty.NullVal(cty.Number), cty.NullVal(cty.Number)),
+			configured: false,
+		},
+		{
+			name:       "zero",
+			config:     siteConfig(cty.NumberIntVal(0), cty.NullVal(cty.Number)),
+			configured: false,
+		},
+		{
+			name:       "elastic instance minimum",
+			config:     siteConfig(cty.NumberIntVal(2), cty.NullVal(cty.Number)),
+			configured: true,
+		},
+		{
+			name:       "maximum instance count",
+			config:     siteConfig(cty.NullVal(cty.Number), cty.NumberIntVal(10)),
+			configured: true,
+		},
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+	}
+
+	for _, v := range input {
+		d := resource.Data(&terraform.InstanceState{
+			RawConfig: v.config,
+		})
+		if actual := helpers.AutomaticScalingConfigured(d); actual != v.configured {
+			t.Fatalf("expected %s to be %t, got %t", v.name, v.configured, actual)
+		}
+	}
+}
@@ -4,11 +4,13 @@
 package helpers
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -1699,3 +1701,106 @@ func FlattenStickySettings(input *webapps.SlotConfigNames) []StickySettings {
 
 	return []StickySettings{result}
 }
+
+type TrafficRouting struct {
+	SlotName   string  `tfschema:"slot_name"`
+	Percentage float64 `tfschema:"percentage"`
+}
+
+func TrafficRoutingSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"slot_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"percentage": {
+					Type:         pluginsdk.TypeFloat,
+					Required:     true,
+					ValidateFunc: validation.FloatBetween(0, 100),
+				},
+			},
+		},
+	}
+}
+
+// ValidateTrafficRouting checks that each slot is only routed to once and that no more than all of the traffic is routed
+// away from the production slot
+func ValidateTrafficRouting(input []TrafficRouting) error {
+	slots := make(map[string]struct{})
+	total := 0.0
+	for _, v := range input {
+		slotName := strings.ToLower(v.SlotName)
+		if _, ok := slots[slotName]; ok {
+			return fmt.Errorf("`traffic_routing` contains more than one rule for the slot %q", v.SlotName)
+		}
+		slots[slotName] = struct{}{}
+
+		total += v.Percentage
+	}
+
+	if total > 100 {
+		return fmt.Errorf("the sum of `percentage` across all `traffic_routing` blocks cannot be greater than `100`")
+	}
+
+	return nil
+}
+
+// ExpandTrafficRouting builds the routing rules for the production slot of an app, each rule routes to the default
+// host name of the slot, so the slots must already exist
+func ExpandTrafficRouting(ctx context.Context, client *webapps.WebAppsClient, id commonids.AppServiceId, input []TrafficRouting) (*webapps.Experiments, error) {
+	if err := ValidateTrafficRouting(input); err != nil {
+		return nil, err
+	}
+
+	rules := make([]webapps.RampUpRule, 0)
+	for _, v := range input {
+		slotId := webapps.NewSlotID(id.SubscriptionId, id.ResourceGroupName, id.SiteName, v.SlotName)
+		slot, err := client.GetSlot(ctx, slotId)
+		if err != nil {
+			if response.WasNotFound(slot.HttpResponse) {
+				return nil, fmt.Errorf("routing traffic to %s: the slot was not found - slots must exist before traffic can be routed to them", slotId)
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", slotId, err)
+		}
+
+		hostName := ""
+		if model := slot.Model; model != nil && model.Properties != nil {
+			hostName = pointer.From(model.Properties.DefaultHostName)
+		}
+		if hostName == "" {
+			return nil, fmt.Errorf("retrieving %s: `defaultHostName` was nil", slotId)
+		}
+
+		rules = append(rules, webapps.RampUpRule{
+			Name:              pointer.To(v.SlotName),
+			ActionHostName:    pointer.To(hostName),
+			ReroutePercentage: pointer.To(v.Percentage),
+		})
+	}
+
+	return &webapps.Experiments{
+		RampUpRules: &rules,
+	}, nil
+}
+
+func FlattenTrafficRouting(input *webapps.Experiments) []TrafficRouting {
+	result := make([]TrafficRouting, 0)
+	if input == nil || input.RampUpRules == nil {
+		return result
+	}
+
+	for _, v := range *input.RampUpRules {
+		result = append(result, TrafficRouting{
+			SlotName:   pointer.From(v.Name),
+			Percentage: pointer.From(v.ReroutePercentage),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+)
+
+func TestValidateTrafficRouting(t *testing.T) {
+	cases := []struct {
+		name  string
+		input []helpers.TrafficRouting
+		error bool
+	}{
+		{
+			name:  "no rules",
+			input: []helpers.TrafficRouting{},
+		},
+		{
+			name: "single slot",
+			input: []helpers.TrafficRouting{
+				{
+					SlotName:   "staging",
+					Percentage: 10,
+				},
+			},
+		},
+		{
+			name: "multiple slots",
+			input: []helpers.TrafficRouting{
+				{
+					SlotName:   "Canary",
+					Percentage: 5,
+				},
+				{
+					SlotName:   "staging",
+					Percentage: 95,
+				},
+			},
+		},
+		{
+			name: "duplicate slot",
+			input: []helpers.TrafficRouting{
+				{
+					SlotName:   "staging",
+					Percentage: 10,
+				},
+				{
+					SlotName:   "Staging",
+					Percentage: 10,
+				},
+			},
+			error: true,
+		},
+		{
+			name: "more than all traffic",
+			input: []helpers.TrafficRouting{
+				{
+					SlotName:   "canary",
+					Percentage: 60,
+				},
+				{
+					SlotName:   "staging",
+					Percentage: 50,
+				},
+			},
+			error: true,
+		},
+	}
+
+	for _, c := range cases {
+		err := helpers.ValidateTrafficRouting(c.input)
+		if c.error && err == nil {
+			t.Fatalf("expected an error for %q but got none", c.name)
+		}
+		if !c.error && err != nil {
+			t.Fatalf("unexpected error for %q: %+v", c.name, err)
+		}
+	}
+}
@@ -45,6 +45,8 @@ type SiteConfigWindows struct {
 	HealthCheckPath               string                    `tfschema:"health_check_path"`
 	HealthCheckEvictionTime       int64                     `tfschema:"health_check_eviction_time_in_min"`
 	WorkerCount                   int64                     `tfschema:"worker_count"`
+	ElasticInstanceMinimum        int64                     `tfschema:"elastic_instance_minimum"`
+	MaximumInstanceCount          int64                     `tfschema:"maximum_instance_count"`
 	ApplicationStack              []ApplicationStackWindows `tfschema:"application_stack"`
 	HandlerMapping                []HandlerMappings         `tfschema:"handler_mapping"`
 	VirtualApplications           []VirtualApplication      `tfschema:"virtual_application"`
@@ -238,6 +240,20 @@ func SiteConfigSchemaWindows() *pluginsdk.Schema {
 					ValidateFunc: validation.IntBetween(1, 100),
 				},
 
+				"elastic_instance_minimum": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
+				"maximum_instance_count": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
 				"minimum_tls_version": {
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
@@ -426,6 +442,16 @@ func SiteConfigSchemaWindowsComputed() *pluginsdk.Schema {
 					Computed: true,
 				},
 
+				"elastic_instance_minimum": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"maximum_instance_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
 				"minimum_tls_version": {
 					Type:     pluginsdk.TypeString,
 					Computed: true,
@@ -589,6 +615,14 @@ func (s *SiteConfigWindows) ExpandForCreate(appSettings map[string]string) (*web
 		expanded.NumberOfWorkers = pointer.To(s.WorkerCount)
 	}
 
+	if s.ElasticInstanceMinimum != 0 {
+		expanded.MinimumElasticInstanceCount = pointer.To(s.ElasticInstanceMinimum)
+	}
+
+	if s.MaximumInstanceCount != 0 {
+		expanded.ElasticWebAppScaleLimit = pointer.To(s.MaximumInstanceCount)
+	}
+
 	if len(s.Cors) != 0 {
 		expanded.Cors = ExpandCorsSettings(s.Cors)
 	}
@@ -765,6 +799,14 @@ func (s *SiteConfigWindows) ExpandForUpdate(metadata sdk.ResourceMetaData, exist
 		expanded.NumberOfWorkers = pointer.To(s.WorkerCount)
 	}
 
+	if metadata.ResourceData.HasChange("site_config.0.elastic_instance_minimum") {
+		expanded.MinimumElasticInstanceCount = pointer.To(s.ElasticInstanceMinimum)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.maximum_instance_count") {
+		expanded.ElasticWebAppScaleLimit = pointer.To(s.MaximumInstanceCount)
+	}
+
 	if metadata.ResourceData.HasChange("site_config.0.minimum_tls_version") {
 		expanded.MinTlsVersion = pointer.To(webapps.SupportedTlsVersions(s.MinTlsVersion))
 	}
@@ -815,6 +857,8 @@ func (s *SiteConfigWindows) Flatten(appSiteConfig *webapps.SiteConfig, currentSt
 		s.ManagedPipelineMode = string(pointer.From(appSiteConfig.ManagedPipelineMode))
 		s.MinTlsVersion = string(pointer.From(appSiteConfig.MinTlsVersion))
 		s.WorkerCount = pointer.From(appSiteConfig.NumberOfWorkers)
+		s.ElasticInstanceMinimum = pointer.From(appSiteConfig.MinimumElasticInstanceCount)
+		s.MaximumInstanceCount = pointer.From(appSiteConfig.ElasticWebAppScaleLimit)
 		s.RemoteDebugging = pointer.From(appSiteConfig.RemoteDebuggingEnabled)
 		s.RemoteDebuggingVersion = strings.ToUpper(pointer.From(appSiteConfig.RemoteDebuggingVersion))
 		s.ScmIpRestriction = FlattenIpRestrictions(appSiteConfig.ScmIPSecurityRestrictions)
@@ -52,6 +52,7 @@ type LinuxWebAppModel struct {
 	LogsConfig                         []helpers.LogsConfig                       `tfschema:"logs"`
 	SiteConfig                         []helpers.SiteConfigLinux                  `tfschema:"site_config"`
 	StorageAccounts                    []helpers.StorageAccount                   `tfschema:"storage_account"`
+	TrafficRouting                     []helpers.TrafficRouting                   `tfschema:"traffic_routing"`
 	ConnectionStrings                  []helpers.ConnectionString                 `tfschema:"connection_string"`
 	ZipDeployFile                      string                                     `tfschema:"zip_deploy_file"`
 	Tags                               map[string]string                          `tfschema:"tags"`
@@ -205,6 +206,8 @@ func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"sticky_settings": helpers.StickySettingsSchema(),
 
+		"traffic_routing": helpers.TrafficRoutingSchema(),
+
 		"storage_account": helpers.StorageAccountSchema(),
 
 		"zip_deploy_file": {
@@ -313,6 +316,10 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 
 			sc := webApp.SiteConfig[0]
 
+			if err := helpers.ValidateTrafficRouting(webApp.TrafficRouting); err != nil {
+				return err
+			}
+
 			servicePlanId, err := commonids.ParseAppServicePlanID(webApp.ServicePlanId)
 			if err != nil {
 				return err
@@ -355,6 +362,10 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if helpers.AutomaticScalingConfigured(metadata.ResourceData) && !helpers.PlanSupportsAutomaticScaling(servicePlan.Model) {
+				return fmt.Errorf("`elastic_instance_minimum` and `maximum_instance_count` can only be set when the Service Plan is a Premium SKU with `premium_plan_auto_scale_enabled` set to `true`")
+			}
+
 			subscriptionID := commonids.NewSubscriptionID(subscriptionId)
 			checkName, err := resourceProvidersClient.CheckNameAvailability(ctx, subscriptionID, availabilityRequest)
 			if err != nil || checkName.Model == nil {
@@ -412,6 +423,13 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 				siteEnvelope.Properties.ClientCertExclusionPaths = pointer.To(webApp.ClientCertExclusionPaths)
 			}
 
+			if len(webApp.TrafficRouting) > 0 {
+				siteEnvelope.Properties.SiteConfig.Experiments, err = helpers.ExpandTrafficRouting(ctx, client, id, webApp.TrafficRouting)
+				if err != nil {
+					return err
+				}
+			}
+
 			if err := client.CreateOrUpdateThenPoll(ctx, id, siteEnvelope); err != nil {
 				return fmt.Errorf("creating Linux %s: %+v", id, err)
 			}
@@ -625,6 +643,7 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 					LogsConfig:        helpers.FlattenLogsConfig(logsConfig.Model),
 					StickySettings:    helpers.FlattenStickySettings(stickySettings.Model.Properties),
 					StorageAccounts:   helpers.FlattenStorageAccounts(storageAccounts.Model),
+					TrafficRouting:    helpers.FlattenTrafficRouting(webAppSiteConfig.Model.Properties.Experiments),
 					ConnectionStrings: helpers.FlattenConnectionStrings(connectionStrings.Model),
 					SiteCredentials:   helpers.FlattenSiteCredentials(siteCredentials),
 					Tags:              pointer.From(model.Tags),
@@ -779,6 +798,12 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 			}
 
 			sc := state.SiteConfig[0]
+			if metadata.ResourceData.HasChanges("site_config.0.elastic_instance_minimum", "site_config.0.maximum_instance_count") || servicePlanChange {
+				if helpers.AutomaticScalingConfigured(metadata.ResourceData) && !helpers.PlanSupportsAutomaticScaling(servicePlan.Model) {
+					return fmt.Errorf("`elastic_instance_minimum` and `maximum_instance_count` can only be set when the Service Plan is a Premium SKU with `premium_plan_auto_scale_enabled` set to `true`")
+				}
+			}
+
 			if servicePlan.Model != nil && servicePlan.Model.Sku != nil && servicePlan.Model.Sku.Name != nil {
 				if helpers.IsFreeOrSharedServicePlan(*servicePlan.Model.Sku.Name) {
 					if sc.AlwaysOn {
@@ -868,6 +893,13 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("traffic_routing") {
+				model.Properties.SiteConfig.Experiments, err = helpers.ExpandTrafficRouting(ctx, client, *id, state.TrafficRouting)
+				if err != nil {
+					return err
+				}
+			}
+
 			if err := client.CreateOrUpdateThenPoll(ctx, *id, *model); err != nil {
 				return fmt.Errorf("updating Linux %s: %+v", id, err)
 			}
@@ -74,6 +74,85 @@ func TestAccLinuxWebApp_freeSkuAlwaysOnShouldFail(t *testing.T) {
 	})
 }
 
+func TestAccLinuxWebApp_automaticScaling(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
+	r := LinuxWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.automaticScaling(data, 1, 5),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_config.0.elastic_instance_minimum").HasValue("1"),
+				check.That(data.ResourceName).Key("site_config.0.maximum_instance_count").HasValue("5"),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+		{
+			Config: r.automaticScaling(data, 2, 10),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_config.0.elastic_instance_minimum").HasValue("2"),
+				check.That(data.ResourceName).Key("site_config.0.maximum_instance_count").HasValue("10"),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+	})
+}
+
+func TestAccLinuxWebApp_automaticScalingUnsupportedPlanShouldFail(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
+	r := LinuxWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.automaticScalingUnsupportedPlan(data),
+			ExpectError: regexp.MustCompile("can only be set when the Service Plan is a Premium SKU"),
+		},
+	})
+}
+
+func TestAccLinuxWebApp_trafficRouting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
+	r := LinuxWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// the slot must exist before traffic can be routed to it
+			Config: r.trafficRoutingRemoved(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+		{
+			Config: r.trafficRouting(data, 10),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic_routing.0.slot_name").HasValue("staging"),
+				check.That(data.ResourceName).Key("traffic_routing.0.percentage").HasValue("10"),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+		{
+			Config: r.trafficRouting(data, 50),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic_routing.0.percentage").HasValue("50"),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+		{
+			Config: r.trafficRoutingRemoved(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic_routing.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+	})
+}
+
 func TestAccLinuxWebApp_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
 	r := LinuxWebAppResource{}
@@ -1765,6 +1844,106 @@ func (r LinuxWebAppResource) Exists(ctx context.Context, client *clients.Client,
 
 // Configs
 
+func (r LinuxWebAppResource) automaticScaling(data acceptance.TestData, minimum, maximum int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {
+    elastic_instance_minimum = %d
+    maximum_instance_count   = %d
+  }
+}
+`, r.premiumV3AutoScalePlanTemplate(data), data.RandomInteger, minimum, maximum)
+}
+
+func (r LinuxWebAppResource) automaticScalingUnsupportedPlan(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {
+    maximum_instance_count = 5
+  }
+}
+`, r.standardPlanTemplate(data), data.RandomInteger)
+}
+
+func (r LinuxWebAppResource) trafficRouting(data acceptance.TestData, percentage int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+
+  traffic_routing {
+    slot_name  = "staging"
+    percentage = %d
+  }
+}
+
+resource "azurerm_linux_web_app_slot" "test" {
+  name           = "staging"
+  app_service_id = azurerm_linux_web_app.test.id
+
+  site_config {}
+}
+`, r.standardPlanTemplate(data), data.RandomInteger, percentage)
+}
+
+func (r LinuxWebAppResource) trafficRoutingRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+
+resource "azurerm_linux_web_app_slot" "test" {
+  name           = "staging"
+  app_service_id = azurerm_linux_web_app.test.id
+
+  site_config {}
+}
+`, r.standardPlanTemplate(data), data.RandomInteger)
+}
+
 func (r LinuxWebAppResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -3655,6 +3834,26 @@ resource "azurerm_service_plan" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (LinuxWebAppResource) premiumV3AutoScalePlanTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                            = "acctestASP-%d"
+  location                        = azurerm_resource_group.test.location
+  resource_group_name             = azurerm_resource_group.test.name
+  os_type                         = "Linux"
+  sku_name                        = "P1v3"
+  premium_plan_auto_scale_enabled = true
+  maximum_elastic_worker_count    = 10
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (LinuxWebAppResource) premiumV3PlanTemplate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 
@@ -414,6 +414,13 @@ func (r ServicePlanResource) CustomizeDiff() sdk.ResourceFunc {
 				}
 			}
 
+			// With automatic scaling the platform scales out between `worker_count` and `maximum_elastic_worker_count`, so the floor cannot exceed the limit.
+			if newAutoScaleEnabled.(bool) && helpers.PlanIsPremium(servicePlanSku) {
+				if maxCount, workerCount := newEcValue.(int), rd.Get("worker_count").(int); maxCount > 0 && workerCount > maxCount {
+					return fmt.Errorf("`worker_count` (%d) cannot be greater than `maximum_elastic_worker_count` (%d) when `premium_plan_auto_scale_enabled` is set to `true`", workerCount, maxCount)
+				}
+			}
+
 			// Specifying the `zone_balancing_enabled` as `true`, SKU tier requires Premium.
 			zoneBalancing := rd.Get("zone_balancing_enabled").(bool)
 			if zoneBalancing {
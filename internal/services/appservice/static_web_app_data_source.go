@@ -36,6 +36,7 @@ type StaticWebAppDataSourceModel struct {
 	BasicAuth           []helpers.BasicAuthComputed                `tfschema:"basic_auth"`
 	ConfigFileChanges   bool                                       `tfschema:"configuration_file_changes_enabled"`
 	DefaultHostName     string                                     `tfschema:"default_host_name"`
+	EnterpriseGradeCdn  bool                                       `tfschema:"enterprise_grade_cdn_enabled"`
 	Identity            []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
 	PreviewEnvironments bool                                       `tfschema:"preview_environments_enabled"`
 	PublicNetworkAccess bool                                       `tfschema:"public_network_access_enabled"`
@@ -68,6 +69,11 @@ func (s StaticWebAppDataSource) Attributes() map[string]*pluginsdk.Schema {
 			Computed: true,
 		},
 
+		"enterprise_grade_cdn_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
 		"preview_environments_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Computed: true,
@@ -169,6 +175,7 @@ func (s StaticWebAppDataSource) Read() sdk.ResourceFunc {
 					state.ConfigFileChanges = pointer.From(props.AllowConfigFileUpdates)
 					state.DefaultHostName = pointer.From(props.DefaultHostname)
 					state.PreviewEnvironments = pointer.From(props.StagingEnvironmentPolicy) == staticsites.StagingEnvironmentPolicyEnabled
+					state.EnterpriseGradeCdn = pointer.From(props.EnterpriseGradeCdnStatus) == staticsites.EnterpriseGradeCdnStatusEnabled
 					state.RepositoryUrl = pointer.From(props.RepositoryURL)
 					state.RepositoryBranch = pointer.From(props.Branch)
 					state.PublicNetworkAccess = !strings.EqualFold(pointer.From(props.PublicNetworkAccess), helpers.PublicNetworkAccessDisabled)
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StaticWebAppLinkedBackendResource struct{}
+
+var _ sdk.Resource = StaticWebAppLinkedBackendResource{}
+
+type StaticWebAppLinkedBackendModel struct {
+	Name              string `tfschema:"name"`
+	StaticWebAppID    string `tfschema:"static_web_app_id"`
+	BackendResourceID string `tfschema:"backend_resource_id"`
+	Location          string `tfschema:"location"`
+}
+
+func (r StaticWebAppLinkedBackendResource) ResourceType() string {
+	return "azurerm_static_web_app_linked_backend"
+}
+
+func (r StaticWebAppLinkedBackendResource) ModelObject() interface{} {
+	return &StaticWebAppLinkedBackendModel{}
+}
+
+func (r StaticWebAppLinkedBackendResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return staticsites.ValidateLinkedBackendID
+}
+
+func (r StaticWebAppLinkedBackendResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"static_web_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: staticsites.ValidateStaticSiteID,
+		},
+
+		"backend_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StaticWebAppLinkedBackendID,
+		},
+
+		"location": commonschema.Location(),
+	}
+}
+
+func (r StaticWebAppLinkedBackendResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StaticWebAppLinkedBackendResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.StaticSitesClient
+
+			model := StaticWebAppLinkedBackendModel{}
+
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			staticAppId, err := staticsites.ParseStaticSiteID(model.StaticWebAppID)
+			if err != nil {
+				return err
+			}
+
+			id := staticsites.NewLinkedBackendID(staticAppId.SubscriptionId, staticAppId.ResourceGroupName, staticAppId.StaticSiteName, model.Name)
+
+			existing, err := client.GetLinkedBackend(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			backends, err := client.GetLinkedBackends(ctx, *staticAppId)
+			if err != nil {
+				return fmt.Errorf("checking for existing Static Site backends for %s: %+v", id, err)
+			}
+
+			if backendList := backends.Model; backendList != nil {
+				if len(*backendList) != 0 {
+					return fmt.Errorf("%s already has a backend and cannot have another", id)
+				}
+			}
+
+			payload := staticsites.StaticSiteLinkedBackendARMResource{
+				Properties: &staticsites.StaticSiteLinkedBackendARMResourceProperties{
+					BackendResourceId: pointer.To(model.BackendResourceID),
+					Region:            pointer.To(location.Normalize(model.Location)),
+				},
+			}
+
+			if err := client.ValidateBackendThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("validating backend for %s: %+v", id, err)
+			}
+
+			if err := client.LinkBackendThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r StaticWebAppLinkedBackendResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.StaticSitesClient
+
+			id, err := staticsites.ParseLinkedBackendID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			result, err := client.GetLinkedBackend(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(result.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StaticWebAppLinkedBackendModel{
+				Name:           id.LinkedBackendName,
+				StaticWebAppID: staticsites.NewStaticSiteID(id.SubscriptionId, id.ResourceGroupName, id.StaticSiteName).ID(),
+			}
+
+			if model := result.Model; model != nil {
+				if props := model.Properties; props != nil {
+					backendId, err := flattenStaticWebAppLinkedBackendID(pointer.From(props.BackendResourceId))
+					if err != nil {
+						return err
+					}
+					state.BackendResourceID = backendId
+					state.Location = location.Normalize(pointer.From(props.Region))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StaticWebAppLinkedBackendResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.StaticSitesClient
+
+			id, err := staticsites.ParseLinkedBackendID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the backend's authentication configuration is created by linking it, so is cleaned up along with it
+			options := staticsites.UnlinkBackendOperationOptions{
+				IsCleaningAuthConfig: pointer.To(true),
+			}
+			if _, err := client.UnlinkBackend(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// flattenStaticWebAppLinkedBackendID normalises the casing of the backend ID returned by the API, which can be any of
+// the resource types accepted by `validate.StaticWebAppLinkedBackendID`
+func flattenStaticWebAppLinkedBackendID(input string) (string, error) {
+	if strings.Contains(strings.ToLower(input), "/providers/microsoft.app/") {
+		containerAppId, err := containerapps.ParseContainerAppIDInsensitively(input)
+		if err != nil {
+			return "", err
+		}
+		return containerAppId.ID(), nil
+	}
+
+	appServiceId, err := commonids.ParseAppServiceIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+	return appServiceId.ID(), nil
+}
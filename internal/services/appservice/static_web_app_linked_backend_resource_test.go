@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StaticWebAppLinkedBackendResource struct{}
+
+func TestAccStaticWebAppLinkedBackend_functionApp(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_web_app_linked_backend", "test")
+	r := StaticWebAppLinkedBackendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.functionApp(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStaticWebAppLinkedBackend_webApp(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_web_app_linked_backend", "test")
+	r := StaticWebAppLinkedBackendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.webApp(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStaticWebAppLinkedBackend_containerApp(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_web_app_linked_backend", "test")
+	r := StaticWebAppLinkedBackendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.containerApp(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStaticWebAppLinkedBackend_multipleExpectError(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_web_app_linked_backend", "test")
+	r := StaticWebAppLinkedBackendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.functionApp(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.multipleBackends(data),
+			ExpectError: regexp.MustCompile("already has a backend and cannot have another"),
+		},
+	})
+}
+
+func TestAccStaticWebAppLinkedBackend_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_web_app_linked_backend", "test")
+	r := StaticWebAppLinkedBackendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.functionApp(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StaticWebAppLinkedBackendResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := staticsites.ParseLinkedBackendID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.StaticSitesClient.GetLinkedBackend(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StaticWebAppLinkedBackendResource) functionApp(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_function_app" "test" {
+  name                = "acctest-LFA-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  site_config {}
+
+  lifecycle {
+    ignore_changes = [auth_settings_v2]
+  }
+}
+
+resource "azurerm_static_web_app_linked_backend" "test" {
+  name                = "backend1"
+  static_web_app_id   = azurerm_static_web_app.test.id
+  backend_resource_id = azurerm_linux_function_app.test.id
+  location            = azurerm_linux_function_app.test.location
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StaticWebAppLinkedBackendResource) webApp(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+
+  lifecycle {
+    ignore_changes = [auth_settings_v2]
+  }
+}
+
+resource "azurerm_static_web_app_linked_backend" "test" {
+  name                = "backend1"
+  static_web_app_id   = azurerm_static_web_app.test.id
+  backend_resource_id = azurerm_linux_web_app.test.id
+  location            = azurerm_linux_web_app.test.location
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StaticWebAppLinkedBackendResource) containerApp(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_container_app_environment" "test" {
+  name                = "acctest-CAEnv%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Single"
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+  }
+
+  ingress {
+    external_enabled = true
+    target_port      = 5000
+
+    traffic_weight {
+      latest_revision = true
+      percentage      = 100
+    }
+  }
+}
+
+resource "azurerm_static_web_app_linked_backend" "test" {
+  name                = "backend1"
+  static_web_app_id   = azurerm_static_web_app.test.id
+  backend_resource_id = azurerm_container_app.test.id
+  location            = azurerm_container_app_environment.test.location
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StaticWebAppLinkedBackendResource) multipleBackends(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+
+  lifecycle {
+    ignore_changes = [auth_settings_v2]
+  }
+}
+
+resource "azurerm_static_web_app_linked_backend" "test2" {
+  name                = "backend2"
+  static_web_app_id   = azurerm_static_web_app.test.id
+  backend_resource_id = azurerm_linux_web_app.test.id
+  location            = azurerm_linux_web_app.test.location
+
+  depends_on = [azurerm_static_web_app_linked_backend.test]
+}
+`, r.functionApp(data), data.RandomInteger)
+}
+
+func (r StaticWebAppLinkedBackendResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_static_web_app_linked_backend" "import" {
+  name                = azurerm_static_web_app_linked_backend.test.name
+  static_web_app_id   = azurerm_static_web_app_linked_backend.test.static_web_app_id
+  backend_resource_id = azurerm_static_web_app_linked_backend.test.backend_resource_id
+  location            = azurerm_static_web_app_linked_backend.test.location
+}
+`, r.functionApp(data))
+}
+
+func (r StaticWebAppLinkedBackendResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-SWA-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_static_web_app" "test" {
+  name                = "acctestSS-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_size            = "Standard"
+  sku_tier            = "Standard"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "S1"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -38,6 +38,7 @@ type StaticWebAppResourceModel struct {
 	AppSettings         map[string]string                          `tfschema:"app_settings"`
 	BasicAuth           []helpers.BasicAuth                        `tfschema:"basic_auth"`
 	ConfigFileChanges   bool                                       `tfschema:"configuration_file_changes_enabled"`
+	EnterpriseGradeCdn  bool                                       `tfschema:"enterprise_grade_cdn_enabled"`
 	Identity            []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
 	PreviewEnvironments bool                                       `tfschema:"preview_environments_enabled"`
 	PublicNetworkAccess bool                                       `tfschema:"public_network_access_enabled"`
@@ -72,6 +73,12 @@ func (r StaticWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 			Default:  true,
 		},
 
+		"enterprise_grade_cdn_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"preview_environments_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
@@ -233,6 +240,10 @@ func (r StaticWebAppResource) Create() sdk.ResourceFunc {
 				props.PublicNetworkAccess = pointer.To(helpers.PublicNetworkAccessDisabled)
 			}
 
+			if model.EnterpriseGradeCdn {
+				props.EnterpriseGradeCdnStatus = pointer.To(staticsites.EnterpriseGradeCdnStatusEnabled)
+			}
+
 			envelope.Properties = props
 
 			if err := client.CreateOrUpdateStaticSiteThenPoll(ctx, id, envelope); err != nil {
@@ -241,6 +252,12 @@ func (r StaticWebAppResource) Create() sdk.ResourceFunc {
 
 			metadata.SetID(id)
 
+			if model.EnterpriseGradeCdn {
+				if err := waitForStaticWebAppEnterpriseGradeCdn(ctx, client, id); err != nil {
+					return err
+				}
+			}
+
 			if len(model.AppSettings) > 0 {
 				appSettings := staticsites.StringDictionary{
 					Properties: pointer.To(model.AppSettings),
@@ -312,6 +329,7 @@ func (r StaticWebAppResource) Read() sdk.ResourceFunc {
 					state.ConfigFileChanges = pointer.From(props.AllowConfigFileUpdates)
 					state.DefaultHostName = pointer.From(props.DefaultHostname)
 					state.PreviewEnvironments = pointer.From(props.StagingEnvironmentPolicy) == staticsites.StagingEnvironmentPolicyEnabled
+					state.EnterpriseGradeCdn = pointer.From(props.EnterpriseGradeCdnStatus) == staticsites.EnterpriseGradeCdnStatusEnabled
 
 					state.RepositoryUrl = pointer.From(props.RepositoryURL)
 					state.RepositoryBranch = pointer.From(props.Branch)
@@ -455,6 +473,14 @@ func (r StaticWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("enterprise_grade_cdn_enabled") {
+				if !config.EnterpriseGradeCdn {
+					model.Properties.EnterpriseGradeCdnStatus = pointer.To(staticsites.EnterpriseGradeCdnStatusDisabled)
+				} else {
+					model.Properties.EnterpriseGradeCdnStatus = pointer.To(staticsites.EnterpriseGradeCdnStatusEnabled)
+				}
+			}
+
 			if metadata.ResourceData.HasChange("tags") {
 				model.Tags = pointer.To(config.Tags)
 			}
@@ -463,6 +489,12 @@ func (r StaticWebAppResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
+			if metadata.ResourceData.HasChange("enterprise_grade_cdn_enabled") {
+				if err := waitForStaticWebAppEnterpriseGradeCdn(ctx, client, *id); err != nil {
+					return err
+				}
+			}
+
 			if metadata.ResourceData.HasChange("app_settings") {
 				appSettings := staticsites.StringDictionary{
 					Properties: pointer.To(config.AppSettings),
@@ -516,6 +548,10 @@ func (r StaticWebAppResource) CustomizeDiff() sdk.ResourceFunc {
 			skuTier := rd.Get("sku_tier").(string)
 			skuSize := rd.Get("sku_size").(string)
 
+			if rd.Get("enterprise_grade_cdn_enabled").(bool) && !strings.EqualFold(skuTier, string(resourceproviders.SkuNameStandard)) {
+				return fmt.Errorf("`enterprise_grade_cdn_enabled` can only be used with the Standard tier of Static Web Apps")
+			}
+
 			if strings.EqualFold(skuTier, string(resourceproviders.SkuNameFree)) && strings.EqualFold(skuSize, string(resourceproviders.SkuNameFree)) {
 				basicAuth, authOk := rd.GetOk("basic_auth")
 				if authOk && len(basicAuth.([]interface{})) > 0 {
@@ -531,3 +567,44 @@ func (r StaticWebAppResource) CustomizeDiff() sdk.ResourceFunc {
 		},
 	}
 }
+
+// waitForStaticWebAppEnterpriseGradeCdn waits for the Enterprise-Grade Edge to finish being enabled or disabled, which
+// includes provisioning the edge certificates for the default and custom domains, before the Static Web App is usable
+func waitForStaticWebAppEnterpriseGradeCdn(ctx context.Context, client *staticsites.StaticSitesClient, id staticsites.StaticSiteId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(staticsites.EnterpriseGradeCdnStatusEnabling),
+			string(staticsites.EnterpriseGradeCdnStatusDisabling),
+		},
+		Target: []string{
+			string(staticsites.EnterpriseGradeCdnStatusEnabled),
+			string(staticsites.EnterpriseGradeCdnStatusDisabled),
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetStaticSite(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			status := staticsites.EnterpriseGradeCdnStatusDisabled
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.EnterpriseGradeCdnStatus != nil {
+				status = *model.Properties.EnterpriseGradeCdnStatus
+			}
+
+			return resp, string(status), nil
+		},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the Enterprise-Grade Edge of %s to be updated: %+v", id, err)
+	}
+
+	return nil
+}
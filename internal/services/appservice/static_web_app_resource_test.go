@@ -346,6 +346,50 @@ func TestAccAzureStaticWebApp_publicNetworkAccessUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAzureStaticWebApp_enterpriseGradeCdnUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_web_app", "test")
+	r := StaticWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.enterpriseGradeCdn(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enterprise_grade_cdn_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.enterpriseGradeCdn(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enterprise_grade_cdn_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.enterpriseGradeCdn(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enterprise_grade_cdn_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureStaticWebApp_enterpriseGradeCdnFreeShouldFail(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_web_app", "test")
+	r := StaticWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.freeWithEnterpriseGradeCdn(data),
+			ExpectError: regexp.MustCompile("`enterprise_grade_cdn_enabled` can only be used with the Standard tier of Static Web Apps"),
+		},
+	})
+}
+
 func (r StaticWebAppResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := staticsites.ParseStaticSiteID(state.ID)
 	if err != nil {
@@ -714,3 +758,49 @@ resource "azurerm_static_web_app" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (r StaticWebAppResource) enterpriseGradeCdn(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_static_web_app" "test" {
+  name                = "acctestSS-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_size            = "Standard"
+  sku_tier            = "Standard"
+
+  enterprise_grade_cdn_enabled = %[3]t
+}
+`, data.RandomInteger, data.Locations.Primary, enabled)
+}
+
+func (r StaticWebAppResource) freeWithEnterpriseGradeCdn(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_static_web_app" "test" {
+  name                = "acctestSS-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_size            = "Free"
+  sku_tier            = "Free"
+
+  enterprise_grade_cdn_enabled = true
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -7,6 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerapps"
 )
 
 func StaticWebAppName(v interface{}, k string) (warnings []string, errors []error) {
@@ -55,3 +58,24 @@ func StaticWebAppPassword(v interface{}, k string) (warnings []string, errs []er
 
 	return
 }
+
+// StaticWebAppLinkedBackendID validates that the ID is of a resource which can be linked to a Static Web App as a
+// backend, i.e. a Function App or Web App (both of which are `Microsoft.Web/sites`) or a Container App
+func StaticWebAppLinkedBackendID(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %s to be a string", k))
+		return
+	}
+
+	if _, err := commonids.ParseAppServiceIDInsensitively(value); err == nil {
+		return
+	}
+
+	if _, err := containerapps.ParseContainerAppIDInsensitively(value); err == nil {
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("expected %s to be the ID of a Function App, Web App or Container App, got %q", k, value))
+	return
+}
@@ -54,6 +54,7 @@ type WindowsWebAppModel struct {
 	PublishingFTPBasicAuthEnabled      bool                                       `tfschema:"ftp_publish_basic_authentication_enabled"`
 	SiteConfig                         []helpers.SiteConfigWindows                `tfschema:"site_config"`
 	StorageAccounts                    []helpers.StorageAccount                   `tfschema:"storage_account"`
+	TrafficRouting                     []helpers.TrafficRouting                   `tfschema:"traffic_routing"`
 	ConnectionStrings                  []helpers.ConnectionString                 `tfschema:"connection_string"`
 	CustomDomainVerificationId         string                                     `tfschema:"custom_domain_verification_id"`
 	HostingEnvId                       string                                     `tfschema:"hosting_environment_id"`
@@ -182,6 +183,8 @@ func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"sticky_settings": helpers.StickySettingsSchema(),
 
+		"traffic_routing": helpers.TrafficRoutingSchema(),
+
 		"storage_account": helpers.StorageAccountSchemaWindows(),
 
 		"zip_deploy_file": {
@@ -300,6 +303,10 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 
 			sc := webApp.SiteConfig[0]
 
+			if err := helpers.ValidateTrafficRouting(webApp.TrafficRouting); err != nil {
+				return err
+			}
+
 			availabilityRequest := resourceproviders.ResourceNameAvailabilityRequest{
 				Name: (webApp.Name),
 				Type: resourceproviders.CheckNameResourceTypesMicrosoftPointWebSites,
@@ -346,6 +353,10 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if helpers.AutomaticScalingConfigured(metadata.ResourceData) && !helpers.PlanSupportsAutomaticScaling(servicePlan.Model) {
+				return fmt.Errorf("`elastic_instance_minimum` and `maximum_instance_count` can only be set when the Service Plan is a Premium SKU with `premium_plan_auto_scale_enabled` set to `true`")
+			}
+
 			subscriptionID := commonids.NewSubscriptionID(subscriptionId)
 			checkName, err := resourceProvidersClient.CheckNameAvailability(ctx, subscriptionID, availabilityRequest)
 			if err != nil || checkName.Model == nil {
@@ -408,6 +419,13 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 				siteEnvelope.Properties.ClientCertExclusionPaths = pointer.To(webApp.ClientCertExclusionPaths)
 			}
 
+			if len(webApp.TrafficRouting) > 0 {
+				siteEnvelope.Properties.SiteConfig.Experiments, err = helpers.ExpandTrafficRouting(ctx, client, *id, webApp.TrafficRouting)
+				if err != nil {
+					return err
+				}
+			}
+
 			if err = client.CreateOrUpdateThenPoll(ctx, *id, siteEnvelope); err != nil {
 				return fmt.Errorf("creating Windows %s: %+v", id, err)
 			}
@@ -636,6 +654,7 @@ func (r WindowsWebAppResource) Read() sdk.ResourceFunc {
 				StickySettings:    helpers.FlattenStickySettings(stickySettings.Model.Properties),
 				SiteCredentials:   helpers.FlattenSiteCredentials(siteCredentials),
 				StorageAccounts:   helpers.FlattenStorageAccounts(storageAccounts.Model),
+				TrafficRouting:    helpers.FlattenTrafficRouting(webAppSiteConfig.Model.Properties.Experiments),
 			}
 
 			if model := webApp.Model; model != nil {
@@ -870,6 +889,12 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 			currentStack := ""
 			sc := state.SiteConfig[0]
 
+			if metadata.ResourceData.HasChanges("site_config.0.elastic_instance_minimum", "site_config.0.maximum_instance_count") || servicePlanChange {
+				if helpers.AutomaticScalingConfigured(metadata.ResourceData) && !helpers.PlanSupportsAutomaticScaling(servicePlan.Model) {
+					return fmt.Errorf("`elastic_instance_minimum` and `maximum_instance_count` can only be set when the Service Plan is a Premium SKU with `premium_plan_auto_scale_enabled` set to `true`")
+				}
+			}
+
 			if servicePlan.Model != nil && servicePlan.Model.Sku != nil && servicePlan.Model.Sku.Name != nil {
 				if helpers.IsFreeOrSharedServicePlan(*servicePlan.Model.Sku.Name) {
 					if sc.AlwaysOn {
@@ -901,6 +926,13 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 				model.Properties.SiteConfig.PublicNetworkAccess = model.Properties.PublicNetworkAccess
 			}
 
+			if metadata.ResourceData.HasChange("traffic_routing") {
+				model.Properties.SiteConfig.Experiments, err = helpers.ExpandTrafficRouting(ctx, client, *id, state.TrafficRouting)
+				if err != nil {
+					return err
+				}
+			}
+
 			if err = client.CreateOrUpdateThenPoll(ctx, *id, model); err != nil {
 				return fmt.Errorf("updating Windows %s: %+v", id, err)
 			}
@@ -4,6 +4,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleassignmentscheduleinstances"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/rolemanagementpolicyassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-05-01-preview/roledefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients/graph"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -30,6 +32,8 @@ type Client struct {
 	RoleManagementPolicyAssignmentsClient  *rolemanagementpolicyassignments.RoleManagementPolicyAssignmentsClient
 	ScopedRoleAssignmentsClient            *roleassignments.RoleAssignmentsClient
 	ScopedRoleDefinitionsClient            *roledefinitions.RoleDefinitionsClient
+
+	o *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -105,5 +109,16 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		RoleManagementPolicyAssignmentsClient:  roleManagementPolicyAssignmentClient,
 		ScopedRoleAssignmentsClient:            scopedRoleAssignmentsClient,
 		ScopedRoleDefinitionsClient:            scopedRoleDefinitionsClient,
+		o:                                      o,
 	}, nil
 }
+
+// PrincipalExists returns whether the Principal with the specified Object ID can be resolved in Microsoft Graph
+func (c *Client) PrincipalExists(ctx context.Context, objectId string) (bool, error) {
+	authorizer, err := c.o.Authorizers.AuthorizerFunc(c.o.Environment.MicrosoftGraph)
+	if err != nil {
+		return false, fmt.Errorf("building Microsoft Graph authorizer: %+v", err)
+	}
+
+	return graph.DirectoryObjectExists(ctx, authorizer, c.o.Environment, objectId)
+}
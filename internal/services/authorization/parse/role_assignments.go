@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type RoleAssignmentsId struct {
+	SubscriptionId         string
+	RoleAssignmentBulkName string
+}
+
+func NewRoleAssignmentsID(subscriptionId, roleAssignmentBulkName string) RoleAssignmentsId {
+	return RoleAssignmentsId{
+		SubscriptionId:         subscriptionId,
+		RoleAssignmentBulkName: roleAssignmentBulkName,
+	}
+}
+
+func (id RoleAssignmentsId) String() string {
+	segments := []string{
+		fmt.Sprintf("Role Assignment Bulk Name %q", id.RoleAssignmentBulkName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Role Assignments", segmentsStr)
+}
+
+func (id RoleAssignmentsId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Authorization/roleAssignmentBulks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.RoleAssignmentBulkName)
+}
+
+// RoleAssignmentsID parses a RoleAssignments ID into an RoleAssignmentsId struct
+func RoleAssignmentsID(input string) (*RoleAssignmentsId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an RoleAssignments ID: %+v", input, err)
+	}
+
+	resourceId := RoleAssignmentsId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.RoleAssignmentBulkName, err = id.PopSegment("roleAssignmentBulks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -58,6 +58,7 @@ func (r Registration) Resources() []sdk.Resource {
 		PimActiveRoleAssignmentResource{},
 		PimEligibleRoleAssignmentResource{},
 		RoleAssignmentMarketplaceResource{},
+		RoleAssignmentsResource{},
 		RoleDefinitionResource{},
 		RoleManagementPolicyResource{},
 	}
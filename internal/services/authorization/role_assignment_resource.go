@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
 	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -263,6 +264,15 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 		props.PrincipalType = pointer.To(roleassignments.PrincipalType(principalType))
 	}
 
+	// the Principal may not have been replicated yet when it's been created in the same apply (e.g. a User Assigned Identity),
+	// when opted into we wait for the Principal to be resolvable rather than relying on retrying the PrincipalNotFound error
+	if meta.(*clients.Client).Features.RoleAssignment.WaitForIdentityPropagation && len(delegatedManagedIdentityResourceID) == 0 {
+		log.Printf("[DEBUG] Waiting for Principal %q to be resolvable..", principalId)
+		if err := waitForRoleAssignmentPrincipal(ctx, meta.(*clients.Client).Authorization, principalId); err != nil {
+			return err
+		}
+	}
+
 	// LinkedAuthorizationFailed may occur in cross tenant setup because of replication lag.
 	// Let's retry this error for cross tenant setup and when we are skipping principal check.
 	retryLinkedAuthorizationFailedError := len(delegatedManagedIdentityResourceID) > 0 && skipPrincipalCheck
@@ -411,6 +421,42 @@ func retryRoleAssignmentsClient(d *pluginsdk.ResourceData, id parse.ScopedRoleAs
 	}
 }
 
+func waitForRoleAssignmentPrincipal(ctx context.Context, client *client.Client, principalId string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			"pending",
+		},
+		Target: []string{
+			"ready",
+		},
+		Refresh: func() (interface{}, string, error) {
+			exists, err := client.PrincipalExists(ctx, principalId)
+			if err != nil {
+				return nil, "", fmt.Errorf("checking whether Principal %q exists: %+v", principalId, err)
+			}
+			if !exists {
+				return exists, "pending", nil
+			}
+			return exists, "ready", nil
+		},
+		MinTimeout: 5 * time.Second,
+		// the Principal can intermittently resolve whilst being replicated
+		ContinuousTargetOccurence: 3,
+		Timeout:                   time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for Principal %q to be resolvable: %+v", principalId, err)
+	}
+
+	return nil
+}
+
 func roleAssignmentCreateStateRefreshFunc(ctx context.Context, client *roleassignments.RoleAssignmentsClient, id parse.ScopedRoleAssignmentId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		options := roleassignments.DefaultGetOperationOptions()
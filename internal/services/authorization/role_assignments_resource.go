@@ -0,0 +1,638 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/validate"
+	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RoleAssignmentsResourceModel struct {
+	Name                  string                              `tfschema:"name"`
+	Assignments           []RoleAssignmentsResourceAssignment `tfschema:"assignment"`
+	MaxParallelOperations int64                               `tfschema:"max_parallel_operations"`
+	RoleAssignmentIds     map[string]string                   `tfschema:"role_assignment_ids"`
+}
+
+type RoleAssignmentsResourceAssignment struct {
+	Scope            string `tfschema:"scope"`
+	RoleDefinitionId string `tfschema:"role_definition_id"`
+	PrincipalId      string `tfschema:"principal_id"`
+	PrincipalType    string `tfschema:"principal_type"`
+	Description      string `tfschema:"description"`
+	Condition        string `tfschema:"condition"`
+	ConditionVersion string `tfschema:"condition_version"`
+}
+
+// key returns the value the Role Assignment is tracked by in `role_assignment_ids`, since the same Role can only be
+// assigned to a Principal once at a given Scope
+func (a RoleAssignmentsResourceAssignment) key() string {
+	return strings.ToLower(fmt.Sprintf("%s|%s|%s", a.Scope, a.RoleDefinitionId, a.PrincipalId))
+}
+
+type RoleAssignmentsResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate         = RoleAssignmentsResource{}
+	_ sdk.ResourceWithCustomizeDiff  = RoleAssignmentsResource{}
+	_ sdk.ResourceWithCustomImporter = RoleAssignmentsResource{}
+)
+
+func (r RoleAssignmentsResource) ResourceType() string {
+	return "azurerm_role_assignments"
+}
+
+func (r RoleAssignmentsResource) ModelObject() interface{} {
+	return &RoleAssignmentsResourceModel{}
+}
+
+func (r RoleAssignmentsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.RoleAssignmentsID
+}
+
+func (r RoleAssignmentsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"assignment": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"scope": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.Any(
+							validation.StringMatch(regexp.MustCompile("/"), "Root scope (/) is invalid"),
+							billingValidate.EnrollmentID,
+							commonids.ValidateManagementGroupID,
+							commonids.ValidateSubscriptionID,
+							commonids.ValidateResourceGroupID,
+							azure.ValidateResourceID,
+						),
+					},
+
+					"role_definition_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"principal_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"principal_type": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(roleassignments.PrincipalTypeUser),
+							string(roleassignments.PrincipalTypeGroup),
+							string(roleassignments.PrincipalTypeServicePrincipal),
+						}, false),
+					},
+
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"condition": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"condition_version": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"1.0",
+							"2.0",
+						}, false),
+					},
+				},
+			},
+		},
+
+		"max_parallel_operations": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, 50),
+		},
+	}
+}
+
+func (r RoleAssignmentsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"role_assignment_ids": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r RoleAssignmentsResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config RoleAssignmentsResourceModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			keys := make(map[string]struct{})
+			for _, assignment := range config.Assignments {
+				// the values may not be known until apply when they're sourced from another resource
+				if assignment.Scope == "" || assignment.RoleDefinitionId == "" || assignment.PrincipalId == "" {
+					continue
+				}
+
+				if _, ok := keys[assignment.key()]; ok {
+					return fmt.Errorf("the Role Definition %q is assigned to the Principal %q at the Scope %q by more than one `assignment`", assignment.RoleDefinitionId, assignment.PrincipalId, assignment.Scope)
+				}
+				keys[assignment.key()] = struct{}{}
+
+				if assignment.ConditionVersion != "" && assignment.Condition == "" {
+					return fmt.Errorf("`condition_version` should not be set without `condition`")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r RoleAssignmentsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model RoleAssignmentsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewRoleAssignmentsID(subscriptionId, model.Name)
+
+			if err := r.checkAssignmentsDoNotExist(ctx, metadata, int(model.MaxParallelOperations), model.Assignments); err != nil {
+				return err
+			}
+
+			model.RoleAssignmentIds = make(map[string]string)
+			if err := r.upsertAssignments(ctx, metadata, int(model.MaxParallelOperations), model.Assignments, model.RoleAssignmentIds); err != nil {
+				// returning an error once the ID has been set would taint the resource, replacing every Role Assignment on
+				// the next apply - as such the Role Assignments which were created are removed again instead
+				keys := make([]string, 0, len(model.RoleAssignmentIds))
+				for key := range model.RoleAssignmentIds {
+					keys = append(keys, key)
+				}
+				if deleteErr := utils.RunInParallel(int(model.MaxParallelOperations), keys, func(key string) error {
+					return r.deleteAssignment(ctx, metadata, model.RoleAssignmentIds[key])
+				}); deleteErr != nil {
+					return errors.Join(err, fmt.Errorf("removing the Role Assignments which were created: %+v", deleteErr))
+				}
+
+				return err
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r RoleAssignmentsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+
+			id, err := parse.RoleAssignmentsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state RoleAssignmentsResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.Name = id.RoleAssignmentBulkName
+			if state.MaxParallelOperations == 0 {
+				state.MaxParallelOperations = 10
+			}
+
+			keys := make([]string, 0, len(state.RoleAssignmentIds))
+			for key := range state.RoleAssignmentIds {
+				keys = append(keys, key)
+			}
+
+			var mutex sync.Mutex
+			existingIds := make(map[string]string)
+			err = utils.RunInParallel(int(state.MaxParallelOperations), keys, func(key string) error {
+				assignmentId, err := parse.ScopedRoleAssignmentID(state.RoleAssignmentIds[key])
+				if err != nil {
+					return err
+				}
+
+				resp, err := client.Get(ctx, assignmentId.ScopedId, roleassignments.DefaultGetOperationOptions())
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return nil
+					}
+					return fmt.Errorf("retrieving %s: %+v", *assignmentId, err)
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				existingIds[key] = state.RoleAssignmentIds[key]
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(state.RoleAssignmentIds) > 0 && len(existingIds) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			// assignments which have been removed outside of Terraform are dropped so that they're created again
+			assignments := make([]RoleAssignmentsResourceAssignment, 0)
+			for _, assignment := range state.Assignments {
+				if _, ok := existingIds[assignment.key()]; ok {
+					assignments = append(assignments, assignment)
+				}
+			}
+			state.Assignments = assignments
+			state.RoleAssignmentIds = existingIds
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r RoleAssignmentsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model RoleAssignmentsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			oldIds, _ := metadata.ResourceData.GetChange("role_assignment_ids")
+			trackedIds := make(map[string]string)
+			for key, v := range oldIds.(map[string]interface{}) {
+				trackedIds[key] = v.(string)
+			}
+
+			oldAssignments, _ := metadata.ResourceData.GetChange("assignment")
+			previous := make(map[string]interface{})
+			for _, raw := range oldAssignments.(*pluginsdk.Set).List() {
+				if v, ok := raw.(map[string]interface{}); ok {
+					previous[strings.ToLower(fmt.Sprintf("%s|%s|%s", v["scope"], v["role_definition_id"], v["principal_id"]))] = v
+				}
+			}
+
+			desired := make(map[string]struct{})
+			assignmentsToUpsert := make([]RoleAssignmentsResourceAssignment, 0)
+			for _, assignment := range model.Assignments {
+				desired[assignment.key()] = struct{}{}
+
+				_, isTracked := trackedIds[assignment.key()]
+				if prev, ok := previous[assignment.key()].(map[string]interface{}); isTracked && ok && roleAssignmentsAssignmentUnchanged(prev, assignment) {
+					continue
+				}
+				assignmentsToUpsert = append(assignmentsToUpsert, assignment)
+			}
+
+			keysToDelete := make([]string, 0)
+			for key := range trackedIds {
+				if _, ok := desired[key]; !ok {
+					keysToDelete = append(keysToDelete, key)
+				}
+			}
+
+			assignmentsToAdd := make([]RoleAssignmentsResourceAssignment, 0)
+			for _, assignment := range assignmentsToUpsert {
+				if _, ok := trackedIds[assignment.key()]; !ok {
+					assignmentsToAdd = append(assignmentsToAdd, assignment)
+				}
+			}
+			if err := r.checkAssignmentsDoNotExist(ctx, metadata, int(model.MaxParallelOperations), assignmentsToAdd); err != nil {
+				return err
+			}
+
+			var mutex sync.Mutex
+			deleteErr := utils.RunInParallel(int(model.MaxParallelOperations), keysToDelete, func(key string) error {
+				if err := r.deleteAssignment(ctx, metadata, trackedIds[key]); err != nil {
+					return err
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				delete(trackedIds, key)
+				return nil
+			})
+
+			upsertErr := r.upsertAssignments(ctx, metadata, int(model.MaxParallelOperations), assignmentsToUpsert, trackedIds)
+
+			model.RoleAssignmentIds = trackedIds
+			err := errors.Join(deleteErr, upsertErr)
+			if encodeErr := metadata.Encode(&model); encodeErr != nil {
+				return errors.Join(err, fmt.Errorf("encoding: %+v", encodeErr))
+			}
+
+			return err
+		},
+	}
+}
+
+func (r RoleAssignmentsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.RoleAssignmentsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model RoleAssignmentsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			keys := make([]string, 0, len(model.RoleAssignmentIds))
+			for key := range model.RoleAssignmentIds {
+				keys = append(keys, key)
+			}
+
+			if err := utils.RunInParallel(int(model.MaxParallelOperations), keys, func(key string) error {
+				return r.deleteAssignment(ctx, metadata, model.RoleAssignmentIds[key])
+			}); err != nil {
+				return fmt.Errorf("deleting the Role Assignments of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r RoleAssignmentsResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`azurerm_role_assignments` can't be imported since the Role Assignments it manages are only tracked in the state")
+	}
+}
+
+// checkAssignmentsDoNotExist checks the Role Assignments which are about to be created up front, since the API rejects
+// a second assignment of the same Role to a Principal at a Scope - which would otherwise only fail part way through
+// provisioning the Role Assignments
+func (r RoleAssignmentsResource) checkAssignmentsDoNotExist(ctx context.Context, metadata sdk.ResourceMetaData, maxParallel int, assignments []RoleAssignmentsResourceAssignment) error {
+	client := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+
+	return utils.RunInParallel(maxParallel, assignments, func(assignment RoleAssignmentsResourceAssignment) error {
+		options := roleassignments.DefaultListForScopeOperationOptions()
+		options.Filter = pointer.To(fmt.Sprintf("principalId eq '%s'", assignment.PrincipalId))
+
+		resp, err := client.ListForScopeComplete(ctx, commonids.NewScopeID(assignment.Scope), options)
+		if err != nil {
+			return fmt.Errorf("checking for existing Role Assignments of %q at %q: %+v", assignment.PrincipalId, assignment.Scope, err)
+		}
+
+		for _, existing := range resp.Items {
+			props := existing.Properties
+			if props == nil || !strings.EqualFold(pointer.From(props.Scope), assignment.Scope) {
+				continue
+			}
+
+			// the Role Definition ID may be returned scoped to the Subscription, so only the name of the Role Definition is compared
+			if strings.EqualFold(roleAssignmentsRoleDefinitionName(props.RoleDefinitionId), roleAssignmentsRoleDefinitionName(assignment.RoleDefinitionId)) {
+				return fmt.Errorf("the Role Definition %q is already assigned to the Principal %q at the Scope %q by %s and cannot be managed by `azurerm_role_assignments` - either delete it or remove it from the `assignment` blocks", assignment.RoleDefinitionId, assignment.PrincipalId, assignment.Scope, pointer.From(existing.Id))
+			}
+		}
+
+		return nil
+	})
+}
+
+// upsertAssignments creates (or updates) the Role Assignments in parallel and then waits for all of them to replicate
+// together, rather than waiting for each Role Assignment in turn. The IDs of the Role Assignments which were created
+// are added to trackedIds, and the errors for any which failed are returned together.
+func (r RoleAssignmentsResource) upsertAssignments(ctx context.Context, metadata sdk.ResourceMetaData, maxParallel int, assignments []RoleAssignmentsResourceAssignment, trackedIds map[string]string) error {
+	client := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	byKey := make(map[string]RoleAssignmentsResourceAssignment)
+	keys := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		// values which weren't known at plan time can't be checked for duplicates until now
+		if _, ok := byKey[assignment.key()]; ok {
+			return fmt.Errorf("the Role Definition %q is assigned to the Principal %q at the Scope %q by more than one `assignment`", assignment.RoleDefinitionId, assignment.PrincipalId, assignment.Scope)
+		}
+		byKey[assignment.key()] = assignment
+		keys = append(keys, assignment.key())
+	}
+
+	var mutex sync.Mutex
+	created := make([]parse.ScopedRoleAssignmentId, 0)
+	err := utils.RunInParallel(maxParallel, keys, func(key string) error {
+		assignment := byKey[key]
+
+		mutex.Lock()
+		existingId, isTracked := trackedIds[key]
+		mutex.Unlock()
+
+		var id parse.ScopedRoleAssignmentId
+		if isTracked {
+			parsed, err := parse.ScopedRoleAssignmentID(existingId)
+			if err != nil {
+				return err
+			}
+			id = *parsed
+		} else {
+			name, err := uuid.GenerateUUID()
+			if err != nil {
+				return fmt.Errorf("generating UUID for Role Assignment: %+v", err)
+			}
+			id = parse.NewScopedRoleAssignmentID(assignment.Scope, name, "")
+		}
+
+		payload := expandRoleAssignmentsResourceAssignment(assignment)
+		err := pluginsdk.Retry(time.Until(deadline), func() *pluginsdk.RetryError {
+			resp, err := client.Create(ctx, id.ScopedId, payload)
+			if err != nil {
+				switch {
+				case utils.ResponseErrorIsRetryable(err):
+					return pluginsdk.RetryableError(err)
+				case response.WasStatusCode(resp.HttpResponse, 400) && strings.Contains(err.Error(), "PrincipalNotFound"):
+					// When waiting for the principal to become available
+					return pluginsdk.RetryableError(err)
+				default:
+					return pluginsdk.NonRetryableError(err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("creating Role Assignment of %q to %q at %q: %+v", assignment.RoleDefinitionId, assignment.PrincipalId, assignment.Scope, err)
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		trackedIds[key] = id.ID()
+		created = append(created, id)
+		return nil
+	})
+
+	if len(created) == 0 {
+		return err
+	}
+
+	// the Role Assignments are eventually consistent, so wait for all of them to be consistently available
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			"pending",
+		},
+		Target: []string{
+			"ready",
+		},
+		Refresh: func() (interface{}, string, error) {
+			pending := 0
+			refreshErr := utils.RunInParallel(maxParallel, roleAssignmentsIds(created), func(v string) error {
+				id, err := parse.ScopedRoleAssignmentID(v)
+				if err != nil {
+					return err
+				}
+
+				resp, err := client.Get(ctx, id.ScopedId, roleassignments.DefaultGetOperationOptions())
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						mutex.Lock()
+						defer mutex.Unlock()
+						pending++
+						return nil
+					}
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				return nil
+			})
+			if refreshErr != nil {
+				return nil, "failed", refreshErr
+			}
+			if pending > 0 {
+				return pending, "pending", nil
+			}
+			return pending, "ready", nil
+		},
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 5,
+		Timeout:                   time.Until(deadline),
+	}
+
+	if _, waitErr := stateConf.WaitForStateContext(ctx); waitErr != nil {
+		err = errors.Join(err, fmt.Errorf("waiting for %d Role Assignments to finish replicating: %+v", len(created), waitErr))
+	}
+
+	return err
+}
+
+func (r RoleAssignmentsResource) deleteAssignment(ctx context.Context, metadata sdk.ResourceMetaData, input string) error {
+	client := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+
+	id, err := parse.ScopedRoleAssignmentID(input)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.ScopedId, roleassignments.DefaultDeleteOperationOptions())
+	if err != nil && !response.WasNotFound(resp.HttpResponse) {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func roleAssignmentsRoleDefinitionName(input string) string {
+	return input[strings.LastIndex(input, "/")+1:]
+}
+
+func roleAssignmentsIds(input []parse.ScopedRoleAssignmentId) []string {
+	output := make([]string, 0, len(input))
+	for _, id := range input {
+		output = append(output, id.ID())
+	}
+	return output
+}
+
+func roleAssignmentsAssignmentUnchanged(previous map[string]interface{}, assignment RoleAssignmentsResourceAssignment) bool {
+	return previous["principal_type"] == assignment.PrincipalType &&
+		previous["description"] == assignment.Description &&
+		previous["condition"] == assignment.Condition &&
+		previous["condition_version"] == assignment.ConditionVersion
+}
+
+func expandRoleAssignmentsResourceAssignment(input RoleAssignmentsResourceAssignment) roleassignments.RoleAssignmentCreateParameters {
+	payload := roleassignments.RoleAssignmentCreateParameters{
+		Properties: roleassignments.RoleAssignmentProperties{
+			RoleDefinitionId: input.RoleDefinitionId,
+			PrincipalId:      input.PrincipalId,
+		},
+	}
+
+	if input.PrincipalType != "" {
+		payload.Properties.PrincipalType = pointer.To(roleassignments.PrincipalType(input.PrincipalType))
+	}
+
+	if input.Description != "" {
+		payload.Properties.Description = pointer.To(input.Description)
+	}
+
+	if input.Condition != "" {
+		payload.Properties.Condition = pointer.To(input.Condition)
+		payload.Properties.ConditionVersion = pointer.To("2.0")
+		if input.ConditionVersion != "" {
+			payload.Properties.ConditionVersion = pointer.To(input.ConditionVersion)
+		}
+	}
+
+	return payload
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type RoleAssignmentsResource struct{}
+
+func TestAccRoleAssignments_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignments", "test")
+	r := RoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment_ids.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccRoleAssignments_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignments", "test")
+	r := RoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment_ids.%").HasValue("2"),
+			),
+		},
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment_ids.%").HasValue("3"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment_ids.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccRoleAssignments_duplicateAssignment(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignments", "test")
+	r := RoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.duplicateAssignment(data),
+			ExpectError: regexp.MustCompile("by more than one `assignment`"),
+		},
+	})
+}
+
+func (r RoleAssignmentsResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	count := 0
+	for key, v := range state.Attributes {
+		if !strings.HasPrefix(key, "role_assignment_ids.") || key == "role_assignment_ids.%" {
+			continue
+		}
+		id, err := parse.ScopedRoleAssignmentID(v)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.Authorization.ScopedRoleAssignmentsClient.Get(ctx, id.ScopedId, roleassignments.DefaultGetOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+		count++
+	}
+
+	return pointer.To(count > 0), nil
+}
+
+func (r RoleAssignmentsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_assignments" "test" {
+  name = "acctest-ra-%[2]d"
+
+  assignment {
+    scope              = azurerm_resource_group.test.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.reader.id}"
+    principal_id       = data.azurerm_client_config.test.object_id
+  }
+
+  assignment {
+    scope              = azurerm_resource_group.other.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.reader.id}"
+    principal_id       = data.azurerm_client_config.test.object_id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r RoleAssignmentsResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_assignments" "test" {
+  name                    = "acctest-ra-%[2]d"
+  max_parallel_operations = 2
+
+  assignment {
+    scope              = azurerm_resource_group.test.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.reader.id}"
+    principal_id       = data.azurerm_client_config.test.object_id
+    description        = "Updated by Terraform"
+  }
+
+  assignment {
+    scope              = azurerm_resource_group.other.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.reader.id}"
+    principal_id       = data.azurerm_client_config.test.object_id
+  }
+
+  assignment {
+    scope              = azurerm_resource_group.other.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.monitoring.id}"
+    principal_id       = data.azurerm_client_config.test.object_id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r RoleAssignmentsResource) duplicateAssignment(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_assignments" "test" {
+  name = "acctest-ra-%[2]d"
+
+  assignment {
+    scope              = data.azurerm_subscription.primary.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.reader.id}"
+    principal_id       = data.azurerm_client_config.test.object_id
+  }
+
+  assignment {
+    scope              = data.azurerm_subscription.primary.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.reader.id}"
+    principal_id       = data.azurerm_client_config.test.object_id
+    description        = "Duplicate"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r RoleAssignmentsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {}
+
+data "azurerm_client_config" "test" {}
+
+data "azurerm_role_definition" "reader" {
+  name = "Reader"
+}
+
+data "azurerm_role_definition" "monitoring" {
+  name = "Monitoring Reader"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ra-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_resource_group" "other" {
+  name     = "acctestRG-ra2-%[1]d"
+  location = "%[2]s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
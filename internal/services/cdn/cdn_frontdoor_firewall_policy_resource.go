@@ -17,12 +17,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	waf "github.com/hashicorp/go-azure-sdk/resource-manager/frontdoor/2024-02-01/webapplicationfirewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	wafHelper "github.com/hashicorp/terraform-provider-azurerm/helpers/waf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
@@ -198,15 +198,7 @@ func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 										}, false),
 									},
 
-									"match_values": {
-										Type:     pluginsdk.TypeList,
-										Required: true,
-										MaxItems: 600,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringLenBetween(1, 256),
-										},
-									},
+									"match_values": wafHelper.MatchValuesSchema(true, 600, validation.StringLenBetween(1, 256)),
 
 									"operator": {
 										Type:     pluginsdk.TypeString,
@@ -566,6 +558,11 @@ func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 				return nil
 			}),
 
+			// Verify that the 'GeoMatch' and 'IPMatch' custom rule match values are valid...
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				return wafHelper.ValidateCustomRules(diff.Get("custom_rule").([]interface{}), "match_condition")
+			}),
+
 			// Verify that the scrubbing_rule's are valid...
 			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
 				if v, ok := diff.GetOk("log_scrubbing"); ok {
@@ -947,13 +944,13 @@ func expandCdnFrontDoorFirewallMatchConditions(input []interface{}) []waf.MatchC
 		selector := match["selector"].(string)
 		operator := match["operator"].(string)
 		negateCondition := match["negation_condition"].(bool)
-		matchValues := utils.ExpandStringSlice(match["match_values"].([]interface{}))
+		matchValues := wafHelper.NormalizeMatchValues(operator, match["match_values"].([]interface{}))
 		transforms := match["transforms"].([]interface{})
 
 		matchCondition := waf.MatchCondition{
 			Operator:        waf.Operator(operator),
 			NegateCondition: &negateCondition,
-			MatchValue:      *matchValues,
+			MatchValue:      matchValues,
 			Transforms:      expandCdnFrontDoorFirewallTransforms(transforms),
 		}
 
@@ -716,6 +716,31 @@ func TestAccCdnFrontDoorFirewallPolicy_logScrubbingSelectorEqualsAnyError(t *tes
 	})
 }
 
+func TestAccCdnFrontDoorFirewallPolicy_geoAndIPv6Match(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_firewall_policy", "test")
+	r := CdnFrontDoorFirewallPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.geoAndIPv6Match(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCdnFrontDoorFirewallPolicy_invalidGeoMatchError(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_firewall_policy", "test")
+	r := CdnFrontDoorFirewallPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidGeoMatch(data),
+			ExpectError: regexp.MustCompile("must be a 2 letter country code"),
+		},
+	})
+}
+
 func (CdnFrontDoorFirewallPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := waf.ParseFrontDoorWebApplicationFirewallPolicyID(state.ID)
 	if err != nil {
@@ -2048,3 +2073,71 @@ resource "azurerm_cdn_frontdoor_firewall_policy" "test" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r CdnFrontDoorFirewallPolicyResource) geoAndIPv6Match(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_firewall_policy" "test" {
+  name                = "accTestWAF%d"
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = azurerm_cdn_frontdoor_profile.test.sku_name
+  mode                = "Prevention"
+
+  custom_rule {
+    name     = "GeoRule"
+    enabled  = true
+    priority = 1
+    type     = "MatchRule"
+    action   = "Block"
+
+    match_condition {
+      match_variable = "RemoteAddr"
+      operator       = "GeoMatch"
+      match_values   = ["us", "GB"]
+    }
+  }
+
+  custom_rule {
+    name     = "IPv6Rule"
+    enabled  = true
+    priority = 2
+    type     = "MatchRule"
+    action   = "Block"
+
+    match_condition {
+      match_variable = "RemoteAddr"
+      operator       = "IPMatch"
+      match_values   = ["2001:DB8:0::/32", "10.0.0.0/24"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CdnFrontDoorFirewallPolicyResource) invalidGeoMatch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_firewall_policy" "test" {
+  name                = "accTestWAF%d"
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = azurerm_cdn_frontdoor_profile.test.sku_name
+  mode                = "Prevention"
+
+  custom_rule {
+    name     = "GeoRule"
+    enabled  = true
+    priority = 1
+    type     = "MatchRule"
+    action   = "Block"
+
+    match_condition {
+      match_variable = "RemoteAddr"
+      operator       = "GeoMatch"
+      match_values   = ["USA"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
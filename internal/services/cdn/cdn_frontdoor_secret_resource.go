@@ -27,11 +27,13 @@ func resourceCdnFrontDoorSecret() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceCdnFrontDoorSecretCreate,
 		Read:   resourceCdnFrontDoorSecretRead,
+		Update: resourceCdnFrontDoorSecretUpdate,
 		Delete: resourceCdnFrontDoorSecretDelete,
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -90,11 +92,24 @@ func resourceCdnFrontDoorSecret() *pluginsdk.Resource {
 				},
 			},
 
+			"certificate_rotation_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"cdn_frontdoor_profile_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+
+			"key_vault_certificate_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(cdnFrontDoorSecretCustomizeDiff),
 	}
 }
 
@@ -178,11 +193,59 @@ func resourceCdnFrontDoorSecretRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 
 		d.Set("cdn_frontdoor_profile_name", props.ProfileName)
+
+		certificateVersion := ""
+		if props.Parameters != nil {
+			if customerCertificate, ok := props.Parameters.AsCustomerCertificateParameters(); ok {
+				certificateVersion = pointer.From(customerCertificate.SecretVersion)
+			}
+		}
+		d.Set("key_vault_certificate_version", certificateVersion)
 	}
 
+	d.Set("certificate_rotation_enabled", d.Get("certificate_rotation_enabled").(bool))
+
 	return nil
 }
 
+func resourceCdnFrontDoorSecretUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecretsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the secret itself can't be changed in-place, however re-submitting a secret which references the latest
+	// version of the certificate causes Front Door to pick up the current version immediately, rather than waiting
+	// for it to be rotated in the background
+	if d.HasChange("key_vault_certificate_version") {
+		secretParams, err := expandCdnFrontDoorBasicSecretParameters(ctx, d.Get("secret").([]interface{}), meta.(*clients.Client))
+		if err != nil {
+			return fmt.Errorf("expanding 'secret': %+v", err)
+		}
+
+		props := cdn.Secret{
+			SecretProperties: &cdn.SecretProperties{
+				Parameters: secretParams,
+			},
+		}
+
+		future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.SecretName, props)
+		if err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+		}
+	}
+
+	return resourceCdnFrontDoorSecretRead(d, meta)
+}
+
 func resourceCdnFrontDoorSecretDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Cdn.FrontDoorSecretsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -205,6 +268,35 @@ func resourceCdnFrontDoorSecretDelete(d *pluginsdk.ResourceData, meta interface{
 	return nil
 }
 
+// cdnFrontDoorSecretCustomizeDiff detects when a certificate referenced using a versionless ID has been rotated within
+// the Key Vault, so that Front Door can be updated to use the latest version during the same apply.
+func cdnFrontDoorSecretCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.HasChange("secret") || !d.Get("certificate_rotation_enabled").(bool) {
+		return nil
+	}
+
+	certificateId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(d.Get("secret.0.customer_certificate.0.key_vault_certificate_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// a specific version of the certificate has been requested, so there's nothing to rotate
+	if certificateId.Version != "" {
+		return nil
+	}
+
+	latestVersion, err := meta.(*clients.Client).KeyVault.LatestVersionOfNestedItem(ctx, *certificateId)
+	if err != nil {
+		return err
+	}
+
+	if *latestVersion != d.Get("key_vault_certificate_version").(string) {
+		return d.SetNew("key_vault_certificate_version", *latestVersion)
+	}
+
+	return nil
+}
+
 func expandCdnFrontDoorBasicSecretParameters(ctx context.Context, input []interface{}, clients *clients.Client) (cdn.BasicSecretParameters, error) {
 	if len(input) == 0 {
 		return nil, fmt.Errorf("'secret_parameter' is invalid, expected to receive a 'Customer Certificate Parameter', got %d", len(input))
@@ -70,6 +70,30 @@ func TestAccCdnFrontDoorSecret_complete(t *testing.T) {
 	})
 }
 
+func TestAccCdnFrontDoorSecret_certificateRotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_secret", "test")
+	r := CdnFrontdoorSecretResource{os.Getenv("ARM_TEST_DO_NOT_RUN_CDN_FRONT_DOOR_CUSTOM_DOMAIN")}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.certificateRotation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_vault_certificate_version").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("certificate_rotation_enabled"),
+	})
+}
+
 func (r CdnFrontdoorSecretResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FrontDoorSecretID(state.ID)
 	if err != nil {
@@ -173,3 +197,26 @@ resource "azurerm_cdn_frontdoor_secret" "test" {
 }
 `, template, data.RandomInteger)
 }
+
+func (r CdnFrontdoorSecretResource) certificateRotation(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_cdn_frontdoor_secret" "test" {
+  name                         = "accTestSecret-%d"
+  cdn_frontdoor_profile_id     = azurerm_cdn_frontdoor_profile.test.id
+  certificate_rotation_enabled = true
+
+  secret {
+    customer_certificate {
+      key_vault_certificate_id = azurerm_key_vault_certificate.test.versionless_id
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
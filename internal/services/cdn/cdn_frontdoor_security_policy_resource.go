@@ -4,6 +4,7 @@
 package cdn
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -27,11 +28,13 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceCdnFrontdoorSecurityPolicyCreate,
 		Read:   resourceCdnFrontdoorSecurityPolicyRead,
+		Update: resourceCdnFrontdoorSecurityPolicyUpdate,
 		Delete: resourceCdnFrontdoorSecurityPolicyDelete,
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -58,7 +61,6 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 			"security_policies": {
 				Type:     pluginsdk.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 
 				Elem: &pluginsdk.Resource{
@@ -66,7 +68,6 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 						"firewall": {
 							Type:     pluginsdk.TypeList,
 							Required: true,
-							ForceNew: true,
 							MaxItems: 1,
 
 							Elem: &pluginsdk.Resource{
@@ -81,16 +82,16 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 									"association": {
 										Type:     pluginsdk.TypeList,
 										Required: true,
-										ForceNew: true,
 										MaxItems: 1,
 
 										Elem: &pluginsdk.Resource{
 											Schema: map[string]*pluginsdk.Schema{
 												// NOTE: The max number of domains vary depending on sku: 100 Standard, 500 Premium
+												// NOTE: domains can be added to or removed from the association in-place, which avoids the
+												// Security Policy (and the protection it provides to the remaining domains) being removed
 												"domain": {
 													Type:     pluginsdk.TypeList,
 													Required: true,
-													ForceNew: true,
 													MaxItems: 500,
 
 													Elem: &pluginsdk.Resource{
@@ -98,7 +99,6 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 															"cdn_frontdoor_domain_id": {
 																Type:         pluginsdk.TypeString,
 																Required:     true,
-																ForceNew:     true,
 																ValidateFunc: validate.FrontDoorSecurityPolicyDomainID,
 															},
 
@@ -162,21 +162,9 @@ func resourceCdnFrontdoorSecurityPolicyCreate(d *pluginsdk.ResourceData, meta in
 		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_security_policy", id.ID())
 	}
 
-	profileClient := meta.(*clients.Client).Cdn.FrontDoorProfilesClient
-	resp, err := profileClient.Get(ctx, pointer.From(profileId))
+	isStandardSku, err := cdnFrontDoorSecurityPolicyProfileIsStandardSku(ctx, meta.(*clients.Client).Cdn.FrontDoorProfilesClient, pointer.From(profileId))
 	if err != nil {
-		return fmt.Errorf("unable to retrieve the 'sku_name' from the CDN FrontDoor Profile(Name: %q)': %+v", profileId.ProfileName, err)
-	}
-
-	profileModel := resp.Model
-
-	if profileModel == nil {
-		return fmt.Errorf("profileModel is 'nil'")
-	}
-
-	isStandardSku := true
-	if profileModel.Sku.Name != nil {
-		isStandardSku = strings.HasPrefix(strings.ToLower(string(pointer.From(profileModel.Sku.Name))), "standard")
+		return err
 	}
 
 	params, err := expandCdnFrontdoorFirewallPolicyParameters(d.Get("security_policies").([]interface{}), isStandardSku)
@@ -273,6 +261,41 @@ func resourceCdnFrontdoorSecurityPolicyRead(d *pluginsdk.ResourceData, meta inte
 	return nil
 }
 
+func resourceCdnFrontdoorSecurityPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := securitypolicies.ParseSecurityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	profileId := profiles.NewProfileID(id.SubscriptionId, id.ResourceGroupName, id.ProfileName)
+	isStandardSku, err := cdnFrontDoorSecurityPolicyProfileIsStandardSku(ctx, meta.(*clients.Client).Cdn.FrontDoorProfilesClient, profileId)
+	if err != nil {
+		return err
+	}
+
+	params, err := expandCdnFrontdoorFirewallPolicyParameters(d.Get("security_policies").([]interface{}), isStandardSku)
+	if err != nil {
+		return fmt.Errorf("expanding 'security_policies': %+v", err)
+	}
+
+	// NOTE: the associations are replaced as a whole, so the complete list of domains is always sent
+	payload := securitypolicies.SecurityPolicyUpdateParameters{
+		Properties: &securitypolicies.SecurityPolicyUpdateProperties{
+			Parameters: params,
+		},
+	}
+
+	if err := client.PatchThenPoll(ctx, pointer.From(id), payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontdoorSecurityPolicyRead(d, meta)
+}
+
 func resourceCdnFrontdoorSecurityPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -291,6 +314,26 @@ func resourceCdnFrontdoorSecurityPolicyDelete(d *pluginsdk.ResourceData, meta in
 	return nil
 }
 
+func cdnFrontDoorSecurityPolicyProfileIsStandardSku(ctx context.Context, client *profiles.ProfilesClient, profileId profiles.ProfileId) (bool, error) {
+	resp, err := client.Get(ctx, profileId)
+	if err != nil {
+		return false, fmt.Errorf("unable to retrieve the 'sku_name' from the CDN FrontDoor Profile(Name: %q)': %+v", profileId.ProfileName, err)
+	}
+
+	profileModel := resp.Model
+
+	if profileModel == nil {
+		return false, fmt.Errorf("profileModel is 'nil'")
+	}
+
+	isStandardSku := true
+	if profileModel.Sku.Name != nil {
+		isStandardSku = strings.HasPrefix(strings.ToLower(string(pointer.From(profileModel.Sku.Name))), "standard")
+	}
+
+	return isStandardSku, nil
+}
+
 func expandCdnFrontdoorFirewallPolicyParameters(input []interface{}, isStandardSku bool) (*securitypolicies.SecurityPolicyWebApplicationFirewallParameters, error) {
 	results := securitypolicies.SecurityPolicyWebApplicationFirewallParameters{}
 	if len(input) == 0 {
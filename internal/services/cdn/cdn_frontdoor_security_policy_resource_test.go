@@ -34,6 +34,36 @@ func TestAccCdnFrontDoorSecurityPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccCdnFrontDoorSecurityPolicy_updateDomains(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_security_policy", "test")
+	r := CdnFrontDoorSecurityPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("azurerm_cdn_frontdoor_custom_domain.test.cdn_frontdoor_profile_id"),
+		{
+			Config: r.multipleDomains(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("security_policies.0.firewall.0.association.0.domain.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("azurerm_cdn_frontdoor_custom_domain.test.cdn_frontdoor_profile_id"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("security_policies.0.firewall.0.association.0.domain.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("azurerm_cdn_frontdoor_custom_domain.test.cdn_frontdoor_profile_id"),
+	})
+}
+
 func TestAccCdnFrontDoorSecurityPolicy_basicEndpoint(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_security_policy", "test")
 	r := CdnFrontDoorSecurityPolicyResource{}
@@ -310,6 +340,53 @@ resource "azurerm_cdn_frontdoor_security_policy" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r CdnFrontDoorSecurityPolicyResource) multipleDomains(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_cdn_frontdoor_custom_domain" "wildcard" {
+  name                     = "accTestWildcardDomain-%[2]d"
+  cdn_frontdoor_profile_id = azurerm_cdn_frontdoor_profile.test.id
+
+  dns_zone_id = azurerm_dns_zone.test.id
+  host_name   = join(".", ["*", azurerm_dns_zone.test.name])
+
+  tls {
+    certificate_type    = "ManagedCertificate"
+    minimum_tls_version = "TLS12"
+  }
+}
+
+resource "azurerm_cdn_frontdoor_security_policy" "test" {
+  name                     = "accTestSecPol%[2]d"
+  cdn_frontdoor_profile_id = azurerm_cdn_frontdoor_profile.test.id
+
+  security_policies {
+    firewall {
+      cdn_frontdoor_firewall_policy_id = azurerm_cdn_frontdoor_firewall_policy.test.id
+
+      association {
+        domain {
+          cdn_frontdoor_domain_id = azurerm_cdn_frontdoor_custom_domain.test.id
+        }
+
+        domain {
+          cdn_frontdoor_domain_id = azurerm_cdn_frontdoor_custom_domain.wildcard.id
+        }
+
+        patterns_to_match = ["/*"]
+      }
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r CdnFrontDoorSecurityPolicyResource) basicEndpoint(data acceptance.TestData) string {
 	template := r.templateEndpoint(data)
 	return fmt.Sprintf(`
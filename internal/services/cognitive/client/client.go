@@ -8,16 +8,20 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/cognitiveservicesaccounts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/deployments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/modelcapacities"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/raiblocklists"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/raipolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/usages"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	AccountsClient      *cognitiveservicesaccounts.CognitiveServicesAccountsClient
-	DeploymentsClient   *deployments.DeploymentsClient
-	RaiBlocklistsClient *raiblocklists.RaiBlocklistsClient
-	RaiPoliciesClient   *raipolicies.RaiPoliciesClient
+	AccountsClient        *cognitiveservicesaccounts.CognitiveServicesAccountsClient
+	DeploymentsClient     *deployments.DeploymentsClient
+	ModelCapacitiesClient *modelcapacities.ModelCapacitiesClient
+	RaiBlocklistsClient   *raiblocklists.RaiBlocklistsClient
+	RaiPoliciesClient     *raipolicies.RaiPoliciesClient
+	UsagesClient          *usages.UsagesClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -33,6 +37,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(deploymentsClient.Client, o.Authorizers.ResourceManager)
 
+	modelCapacitiesClient, err := modelcapacities.NewModelCapacitiesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Model Capacities client: %+v", err)
+	}
+	o.Configure(modelCapacitiesClient.Client, o.Authorizers.ResourceManager)
+
 	raiPoliciesClient, err := raipolicies.NewRaiPoliciesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Rai Policies client: %+v", err)
@@ -45,10 +55,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(raiBlobklistsClient.Client, o.Authorizers.ResourceManager)
 
+	usagesClient, err := usages.NewUsagesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Usages client: %+v", err)
+	}
+	o.Configure(usagesClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
-		AccountsClient:      accountsClient,
-		DeploymentsClient:   deploymentsClient,
-		RaiBlocklistsClient: raiBlobklistsClient,
-		RaiPoliciesClient:   raiPoliciesClient,
+		AccountsClient:        accountsClient,
+		DeploymentsClient:     deploymentsClient,
+		ModelCapacitiesClient: modelCapacitiesClient,
+		RaiBlocklistsClient:   raiBlobklistsClient,
+		RaiPoliciesClient:     raiPoliciesClient,
+		UsagesClient:          usagesClient,
 	}, nil
 }
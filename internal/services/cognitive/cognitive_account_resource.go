@@ -53,6 +53,8 @@ func resourceCognitiveAccount() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(cognitiveAccountOutboundNetworkAccessCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -675,6 +677,27 @@ func resourceCognitiveAccountDelete(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
+// cognitiveAccountOutboundNetworkAccessCustomizeDiff validates the data loss prevention settings, since the allowed
+// FQDNs only apply when outbound network access is restricted, and the account then needs an identity to access any
+// of the resources it depends on, such as the storage accounts or the key vault
+func cognitiveAccountOutboundNetworkAccessCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.HasChanges("outbound_network_access_restricted", "fqdns", "identity") {
+		return nil
+	}
+
+	restricted := d.Get("outbound_network_access_restricted").(bool)
+
+	if d.NewValueKnown("fqdns") && !restricted && len(d.Get("fqdns").([]interface{})) > 0 {
+		return fmt.Errorf("`fqdns` can only be specified when `outbound_network_access_restricted` is `true`")
+	}
+
+	if restricted && d.NewValueKnown("identity") && len(d.Get("identity").([]interface{})) == 0 {
+		return fmt.Errorf("an `identity` block must be specified when `outbound_network_access_restricted` is `true`")
+	}
+
+	return nil
+}
+
 func cognitiveAccountStateRefreshFunc(ctx context.Context, client *cognitiveservicesaccounts.CognitiveServicesAccountsClient, id cognitiveservicesaccounts.AccountId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.AccountsGet(ctx, id)
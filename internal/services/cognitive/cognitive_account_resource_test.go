@@ -166,6 +166,22 @@ func TestAccCognitiveAccount_update(t *testing.T) {
 	})
 }
 
+func TestAccCognitiveAccount_outboundNetworkAccessRestrictedInvalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account", "test")
+	r := CognitiveAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.outboundNetworkAccessWithoutIdentity(data, false),
+			ExpectError: regexp.MustCompile("`fqdns` can only be specified when `outbound_network_access_restricted` is `true`"),
+		},
+		{
+			Config:      r.outboundNetworkAccessWithoutIdentity(data, true),
+			ExpectError: regexp.MustCompile("an `identity` block must be specified when `outbound_network_access_restricted` is `true`"),
+		},
+	})
+}
+
 func TestAccCognitiveAccount_qnaRuntimeEndpoint(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_cognitive_account", "test")
 	r := CognitiveAccountResource{}
@@ -663,6 +679,10 @@ resource "azurerm_cognitive_account" "test" {
   local_auth_enabled            = false
   %s
 
+  identity {
+    type = "SystemAssigned"
+  }
+
   tags = {
     Acceptance = "Test"
   }
@@ -670,6 +690,31 @@ resource "azurerm_cognitive_account" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, outboundNetworkAccessRestrictedName)
 }
 
+func (CognitiveAccountResource) outboundNetworkAccessWithoutIdentity(data acceptance.TestData, restricted bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cognitive-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cognitive_account" "test" {
+  name                  = "acctestcogacc-%[1]d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  kind                  = "OpenAI"
+  sku_name              = "S0"
+  custom_subdomain_name = "acctestcogacc-%[1]d"
+
+  outbound_network_access_restricted = %[3]t
+  fqdns                              = ["example.openai.azure.com"]
+}
+`, data.RandomInteger, data.Locations.Primary, restricted)
+}
+
 func (CognitiveAccountResource) qnaRuntimeEndpoint(data acceptance.TestData, url string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
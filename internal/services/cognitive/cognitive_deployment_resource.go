@@ -6,12 +6,16 @@ package cognitive
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/cognitiveservicesaccounts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/deployments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/modelcapacities"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/usages"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -45,7 +49,10 @@ type DeploymentSkuModel struct {
 
 type CognitiveDeploymentResource struct{}
 
-var _ sdk.Resource = CognitiveDeploymentResource{}
+var (
+	_ sdk.Resource                  = CognitiveDeploymentResource{}
+	_ sdk.ResourceWithCustomizeDiff = CognitiveDeploymentResource{}
+)
 
 func (r CognitiveDeploymentResource) ResourceType() string {
 	return "azurerm_cognitive_deployment"
@@ -118,10 +125,10 @@ func (r CognitiveDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 			MaxItems: 1,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
+					// changes between the provisioned SKUs can be made in-place, all others force a new resource in CustomizeDiff
 					"name": {
 						Type:     pluginsdk.TypeString,
 						Required: true,
-						ForceNew: true,
 						ValidateFunc: validation.StringInSlice([]string{
 							"Standard",
 							"DataZoneBatch",
@@ -284,6 +291,10 @@ func (r CognitiveDeploymentResource) Update() sdk.ResourceFunc {
 				properties.Properties.DynamicThrottlingEnabled = pointer.To(model.DynamicThrottlingEnabled)
 			}
 
+			if metadata.ResourceData.HasChange("sku.0.name") {
+				properties.Sku.Name = model.Sku[0].Name
+			}
+
 			if metadata.ResourceData.HasChange("sku.0.capacity") {
 				properties.Sku.Capacity = pointer.To(model.Sku[0].Capacity)
 			}
@@ -377,6 +388,146 @@ func (r CognitiveDeploymentResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+func (r CognitiveDeploymentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model cognitiveDeploymentModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if len(model.Sku) == 0 || len(model.Model) == 0 {
+				return nil
+			}
+
+			oldSkuName, newSkuName := metadata.ResourceDiff.GetChange("sku.0.name")
+			oldCapacity, newCapacity := metadata.ResourceDiff.GetChange("sku.0.capacity")
+			isNewResource := metadata.ResourceDiff.Id() == ""
+
+			skuChanged := !isNewResource && oldSkuName.(string) != newSkuName.(string)
+			if skuChanged && !(isProvisionedDeploymentSku(oldSkuName.(string)) && isProvisionedDeploymentSku(newSkuName.(string))) {
+				if err := metadata.ResourceDiff.ForceNew("sku.0.name"); err != nil {
+					return err
+				}
+				isNewResource = true
+			}
+
+			if !isProvisionedDeploymentSku(model.Sku[0].Name) {
+				return nil
+			}
+
+			// capacity which is already allocated to this deployment is available to it when scaling within the same SKU
+			requiredCapacity := int64(newCapacity.(int))
+			if !isNewResource && !skuChanged {
+				requiredCapacity -= int64(oldCapacity.(int))
+			}
+			if requiredCapacity <= 0 {
+				return nil
+			}
+
+			for _, key := range []string{"cognitive_account_id", "model.0.format", "model.0.name", "model.0.version", "sku.0.name", "sku.0.capacity"} {
+				if !metadata.ResourceDiff.NewValueKnown(key) {
+					return nil
+				}
+			}
+
+			// the version can be omitted in which case the default version is used, which we can't check ahead of time
+			if model.Model[0].Version == "" {
+				return nil
+			}
+
+			accountId, err := cognitiveservicesaccounts.ParseAccountID(model.CognitiveAccountId)
+			if err != nil {
+				return err
+			}
+
+			return validateProvisionedDeploymentCapacity(ctx, metadata, *accountId, model.Model[0], model.Sku[0].Name, requiredCapacity)
+		},
+	}
+}
+
+// validateProvisionedDeploymentCapacity checks that both the regional capacity for the model and the Subscription quota
+// for the provisioned SKU have room for the additional capacity, so that this fails during the plan rather than after
+// the deployment has been submitted.
+func validateProvisionedDeploymentCapacity(ctx context.Context, metadata sdk.ResourceMetaData, accountId cognitiveservicesaccounts.AccountId, model DeploymentModelModel, skuName string, requiredCapacity int64) error {
+	accountsClient := metadata.Client.Cognitive.AccountsClient
+	modelCapacitiesClient := metadata.Client.Cognitive.ModelCapacitiesClient
+	usagesClient := metadata.Client.Cognitive.UsagesClient
+
+	account, err := accountsClient.AccountsGet(ctx, accountId)
+	if err != nil {
+		// the account may not exist yet, in which case the check happens on the next plan
+		if response.WasNotFound(account.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+	if account.Model == nil || account.Model.Location == nil {
+		return nil
+	}
+	accountLocation := location.Normalize(*account.Model.Location)
+
+	capacityLocationId := modelcapacities.NewLocationID(accountId.SubscriptionId, accountLocation)
+	capacities, err := modelCapacitiesClient.LocationBasedModelCapacitiesListComplete(ctx, capacityLocationId, modelcapacities.LocationBasedModelCapacitiesListOperationOptions{
+		ModelFormat:  pointer.To(model.Format),
+		ModelName:    pointer.To(model.Name),
+		ModelVersion: pointer.To(model.Version),
+	})
+	if err != nil {
+		return fmt.Errorf("listing model capacities for %s: %+v", capacityLocationId, err)
+	}
+
+	found := false
+	for _, item := range capacities.Items {
+		if item.Properties == nil || !strings.EqualFold(pointer.From(item.Properties.SkuName), skuName) {
+			continue
+		}
+
+		found = true
+		if available := int64(pointer.From(item.Properties.AvailableCapacity)); available < requiredCapacity {
+			return fmt.Errorf("the `%s` SKU for model `%s` (version `%s`) in `%s` has %d units of capacity available but %d are required", skuName, model.Name, model.Version, accountLocation, available, requiredCapacity)
+		}
+	}
+	if !found {
+		return fmt.Errorf("the `%s` SKU is not available for model `%s` (version `%s`) in `%s`", skuName, model.Name, model.Version, accountLocation)
+	}
+
+	usagesLocationId := usages.NewLocationID(accountId.SubscriptionId, accountLocation)
+	quotas, err := usagesClient.ListComplete(ctx, usagesLocationId)
+	if err != nil {
+		return fmt.Errorf("listing usages for %s: %+v", usagesLocationId, err)
+	}
+
+	// provisioned quota is either shared across models (e.g. `OpenAI.ProvisionedManaged`) or assigned per model
+	// (e.g. `OpenAI.ProvisionedManaged.gpt-4o`), both are checked where present
+	quotaNames := []string{
+		fmt.Sprintf("%s.%s", model.Format, skuName),
+		fmt.Sprintf("%s.%s.%s", model.Format, skuName, model.Name),
+	}
+	for _, usage := range quotas.Items {
+		if usage.Name == nil || usage.Name.Value == nil || usage.Limit == nil {
+			continue
+		}
+
+		for _, quotaName := range quotaNames {
+			if !strings.EqualFold(*usage.Name.Value, quotaName) {
+				continue
+			}
+
+			if available := int64(*usage.Limit - pointer.From(usage.CurrentValue)); available < requiredCapacity {
+				return fmt.Errorf("the `%s` quota in `%s` has %d units available but %d are required", *usage.Name.Value, accountLocation, available, requiredCapacity)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isProvisionedDeploymentSku(name string) bool {
+	return strings.HasSuffix(name, "ProvisionedManaged")
+}
+
 func expandDeploymentModelModel(inputList []DeploymentModelModel) *deployments.DeploymentModel {
 	if len(inputList) == 0 {
 		return nil
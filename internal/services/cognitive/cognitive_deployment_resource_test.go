@@ -6,6 +6,7 @@ package cognitive_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -114,6 +115,18 @@ func TestAccCognitiveDeployment_update(t *testing.T) {
 	})
 }
 
+func TestAccCognitiveDeployment_provisionedCapacityExceeded(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_deployment", "test")
+	r := CognitiveDeploymentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.provisioned(data, "ProvisionedManaged", 100000),
+			ExpectError: regexp.MustCompile("units of capacity available but 100000 are required|units available but 100000 are required|is not available for model"),
+		},
+	})
+}
+
 func (r CognitiveDeploymentTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := deployments.ParseDeploymentID(state.ID)
 	if err != nil {
@@ -282,3 +295,24 @@ resource "azurerm_cognitive_deployment" "test" {
 }
 `, template, data.RandomInteger, versionUpgradeOption)
 }
+
+func (r CognitiveDeploymentTestResource) provisioned(data acceptance.TestData, skuName string, capacity int) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_deployment" "test" {
+  name                 = "acctest-cd-%d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+  model {
+    format  = "OpenAI"
+    name    = "gpt-4o"
+    version = "2024-08-06"
+  }
+  sku {
+    name     = "%s"
+    capacity = %d
+  }
+}
+`, template, data.RandomInteger, skuName, capacity)
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/modelcapacities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CognitiveModelCapacitiesDataSource struct{}
+
+var _ sdk.DataSource = CognitiveModelCapacitiesDataSource{}
+
+type CognitiveModelCapacitiesDataSourceModel struct {
+	Location     string                   `tfschema:"location"`
+	ModelFormat  string                   `tfschema:"model_format"`
+	ModelName    string                   `tfschema:"model_name"`
+	ModelVersion string                   `tfschema:"model_version"`
+	Capacities   []CognitiveModelCapacity `tfschema:"capacities"`
+}
+
+type CognitiveModelCapacity struct {
+	SkuName                   string `tfschema:"sku_name"`
+	AvailableCapacity         int64  `tfschema:"available_capacity"`
+	AvailableFinetuneCapacity int64  `tfschema:"available_finetune_capacity"`
+}
+
+func (d CognitiveModelCapacitiesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"model_format": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"OpenAI",
+				"Cohere",
+			}, false),
+		},
+
+		"model_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"model_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (d CognitiveModelCapacitiesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"capacities": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"sku_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"available_capacity": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"available_finetune_capacity": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d CognitiveModelCapacitiesDataSource) ModelObject() interface{} {
+	return &CognitiveModelCapacitiesDataSourceModel{}
+}
+
+func (d CognitiveModelCapacitiesDataSource) ResourceType() string {
+	return "azurerm_cognitive_model_capacities"
+}
+
+func (d CognitiveModelCapacitiesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.ModelCapacitiesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state CognitiveModelCapacitiesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := modelcapacities.NewLocationID(subscriptionId, location.Normalize(state.Location))
+
+			options := modelcapacities.LocationBasedModelCapacitiesListOperationOptions{
+				ModelFormat:  pointer.To(state.ModelFormat),
+				ModelName:    pointer.To(state.ModelName),
+				ModelVersion: pointer.To(state.ModelVersion),
+			}
+			resp, err := client.LocationBasedModelCapacitiesListComplete(ctx, id, options)
+			if err != nil {
+				return fmt.Errorf("listing model capacities for %s: %+v", id, err)
+			}
+
+			state.Location = location.Normalize(state.Location)
+			state.Capacities = flattenCognitiveModelCapacities(resp.Items)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenCognitiveModelCapacities(input []modelcapacities.ModelCapacityListResultValueInlined) []CognitiveModelCapacity {
+	output := make([]CognitiveModelCapacity, 0)
+	for _, item := range input {
+		props := item.Properties
+		if props == nil {
+			continue
+		}
+
+		output = append(output, CognitiveModelCapacity{
+			SkuName:                   pointer.From(props.SkuName),
+			AvailableCapacity:         int64(pointer.From(props.AvailableCapacity)),
+			AvailableFinetuneCapacity: int64(pointer.From(props.AvailableFinetuneCapacity)),
+		})
+	}
+
+	return output
+}
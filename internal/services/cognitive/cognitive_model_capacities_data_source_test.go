@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CognitiveModelCapacitiesDataSource struct{}
+
+func TestAccCognitiveModelCapacitiesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cognitive_model_capacities", "test")
+	r := CognitiveModelCapacitiesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("capacities.#").IsNotEmpty(),
+				check.That(data.ResourceName).Key("capacities.0.sku_name").IsNotEmpty(),
+				check.That(data.ResourceName).Key("capacities.0.available_capacity").Exists(),
+			),
+		},
+	})
+}
+
+func (CognitiveModelCapacitiesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_cognitive_model_capacities" "test" {
+  location      = "%s"
+  model_format  = "OpenAI"
+  model_name    = "gpt-4o"
+  model_version = "2024-08-06"
+}
+`, data.Locations.Secondary)
+}
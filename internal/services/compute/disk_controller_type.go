@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleryimages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-07-03/galleryimageversions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachineimages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// imageFeatureDiskControllerTypes is the name of the Image Feature which lists the Disk Controller Types supported by
+// an Image - Images which don't specify this feature only support SCSI
+const imageFeatureDiskControllerTypes = "DiskControllerTypes"
+
+func diskControllerTypeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(virtualmachines.DiskControllerTypesNVMe),
+			string(virtualmachines.DiskControllerTypesSCSI),
+		}, false),
+	}
+}
+
+// validateDiskControllerTypeSupportedByImage ensures that the source image of a Virtual Machine or Virtual Machine Scale
+// Set supports NVMe when `disk_controller_type` is set to `NVMe`, since the instances otherwise fail to boot
+func validateDiskControllerTypeSupportedByImage(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if diff.Get("disk_controller_type").(string) != string(virtualmachines.DiskControllerTypesNVMe) {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("disk_controller_type", "source_image_id", "source_image_reference") {
+		return nil
+	}
+
+	// the image can only be checked once it's known
+	for _, key := range []string{"location", "source_image_id", "source_image_reference"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	image, supported, err := diskControllerTypesSupportedByImage(ctx, meta.(*clients.Client), diff)
+	if err != nil {
+		return err
+	}
+
+	// the capabilities of managed images, community and shared galleries aren't available
+	if image == "" {
+		return nil
+	}
+
+	for _, v := range supported {
+		if strings.EqualFold(v, string(virtualmachines.DiskControllerTypesNVMe)) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("`disk_controller_type` cannot be set to `%s` since %s only supports the Disk Controller Types: %s", virtualmachines.DiskControllerTypesNVMe, image, strings.Join(supported, ", "))
+}
+
+// diskControllerTypesSupportedByImage returns a description of the source image alongside the Disk Controller Types
+// it supports, the description is empty when the capabilities of the source image can't be determined
+func diskControllerTypesSupportedByImage(ctx context.Context, client *clients.Client, diff *pluginsdk.ResourceDiff) (string, []string, error) {
+	if raw := diff.Get("source_image_reference").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		reference := raw[0].(map[string]interface{})
+		skuId := virtualmachineimages.NewSkuID(client.Account.SubscriptionId, location.Normalize(diff.Get("location").(string)), reference["publisher"].(string), reference["offer"].(string), reference["sku"].(string))
+
+		version := reference["version"].(string)
+		if strings.EqualFold(version, "latest") {
+			resp, err := client.Compute.VirtualMachineImagesClient.List(ctx, skuId, virtualmachineimages.DefaultListOperationOptions())
+			if err != nil {
+				return "", nil, fmt.Errorf("listing the versions of the Platform Image %s: %+v", skuId, err)
+			}
+			// the last value is the latest version
+			if resp.Model == nil || len(*resp.Model) == 0 {
+				return "", nil, nil
+			}
+			version = (*resp.Model)[len(*resp.Model)-1].Name
+		}
+
+		id := virtualmachineimages.NewSkuVersionID(skuId.SubscriptionId, skuId.LocationName, skuId.PublisherName, skuId.OfferName, skuId.SkuName, version)
+		resp, err := client.Compute.VirtualMachineImagesClient.Get(ctx, id)
+		if err != nil {
+			return "", nil, fmt.Errorf("retrieving the Platform Image %s: %+v", id, err)
+		}
+
+		features := make(map[string]string)
+		if resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.Features != nil {
+			for _, feature := range *resp.Model.Properties.Features {
+				features[pointer.From(feature.Name)] = pointer.From(feature.Value)
+			}
+		}
+
+		return fmt.Sprintf("the Platform Image %s", id), parseImageDiskControllerTypes(features), nil
+	}
+
+	sourceImageId := diff.Get("source_image_id").(string)
+	if sourceImageId == "" {
+		return "", nil, nil
+	}
+
+	var galleryImageId *galleryimages.GalleryImageId
+	if versionId, err := galleryimageversions.ParseImageVersionIDInsensitively(sourceImageId); err == nil {
+		galleryImageId = pointer.To(galleryimages.NewGalleryImageID(versionId.SubscriptionId, versionId.ResourceGroupName, versionId.GalleryName, versionId.ImageName))
+	} else if imageId, err := galleryimages.ParseGalleryImageIDInsensitively(sourceImageId); err == nil {
+		galleryImageId = imageId
+	}
+	if galleryImageId == nil {
+		return "", nil, nil
+	}
+
+	resp, err := client.Compute.GalleryImagesClient.Get(ctx, *galleryImageId)
+	if err != nil {
+		return "", nil, fmt.Errorf("retrieving %s: %+v", *galleryImageId, err)
+	}
+
+	features := make(map[string]string)
+	if resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.Features != nil {
+		for _, feature := range *resp.Model.Properties.Features {
+			features[pointer.From(feature.Name)] = pointer.From(feature.Value)
+		}
+	}
+
+	return galleryImageId.String(), parseImageDiskControllerTypes(features), nil
+}
+
+// parseImageDiskControllerTypes parses the value of the `DiskControllerTypes` Image Feature (e.g. `SCSI, NVMe`)
+func parseImageDiskControllerTypes(features map[string]string) []string {
+	for name, value := range features {
+		if !strings.EqualFold(name, imageFeatureDiskControllerTypes) {
+			continue
+		}
+
+		out := make([]string, 0)
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				out = append(out, v)
+			}
+		}
+		if len(out) > 0 {
+			return out
+		}
+	}
+
+	return []string{string(virtualmachines.DiskControllerTypesSCSI)}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseImageDiskControllerTypes(t *testing.T) {
+	testData := []struct {
+		input    map[string]string
+		expected []string
+	}{
+		{
+			input:    map[string]string{},
+			expected: []string{"SCSI"},
+		},
+		{
+			input: map[string]string{
+				"SecurityType": "TrustedLaunchSupported",
+			},
+			expected: []string{"SCSI"},
+		},
+		{
+			input: map[string]string{
+				"DiskControllerTypes": "",
+			},
+			expected: []string{"SCSI"},
+		},
+		{
+			input: map[string]string{
+				"DiskControllerTypes": "SCSI, NVMe",
+			},
+			expected: []string{"SCSI", "NVMe"},
+		},
+		{
+			input: map[string]string{
+				"diskcontrollertypes": "NVMe",
+			},
+			expected: []string{"NVMe"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.input)
+
+		actual := parseImageDiskControllerTypes(v.input)
+		if !reflect.DeepEqual(v.expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
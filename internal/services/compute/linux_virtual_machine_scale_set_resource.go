@@ -100,6 +100,8 @@ func resourceLinuxVirtualMachineScaleSet() *pluginsdk.Resource {
 
 				return nil
 			}),
+
+			pluginsdk.CustomizeDiffShim(validateDiskControllerTypeSupportedByImage),
 		),
 	}
 }
@@ -248,6 +250,10 @@ func resourceLinuxVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta i
 		},
 	}
 
+	if v, ok := d.GetOk("disk_controller_type"); ok {
+		virtualMachineProfile.StorageProfile.DiskControllerType = pointer.To(v.(string))
+	}
+
 	if galleryApplications := expandVirtualMachineScaleSetGalleryApplication(d.Get("gallery_application").([]interface{})); galleryApplications != nil {
 		virtualMachineProfile.ApplicationProfile = &virtualmachinescalesets.ApplicationProfile{
 			GalleryApplications: galleryApplications,
@@ -627,7 +633,7 @@ func resourceLinuxVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta i
 		updateProps.VirtualMachineProfile.OsProfile = &osProfile
 	}
 
-	if d.HasChange("data_disk") || d.HasChange("os_disk") || d.HasChange("source_image_id") || d.HasChange("source_image_reference") {
+	if d.HasChange("data_disk") || d.HasChange("disk_controller_type") || d.HasChange("os_disk") || d.HasChange("source_image_id") || d.HasChange("source_image_reference") {
 		updateInstances = true
 
 		if updateProps.VirtualMachineProfile.StorageProfile == nil {
@@ -643,6 +649,10 @@ func resourceLinuxVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta i
 			updateProps.VirtualMachineProfile.StorageProfile.DataDisks = dataDisks
 		}
 
+		if d.HasChange("disk_controller_type") {
+			updateProps.VirtualMachineProfile.StorageProfile.DiskControllerType = pointer.To(d.Get("disk_controller_type").(string))
+		}
+
 		if d.HasChange("os_disk") {
 			osDiskRaw := d.Get("os_disk").([]interface{})
 			updateProps.VirtualMachineProfile.StorageProfile.OsDisk = ExpandVirtualMachineScaleSetOSDiskUpdate(osDiskRaw)
@@ -957,6 +967,8 @@ func resourceLinuxVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta int
 						return fmt.Errorf("setting `data_disk`: %+v", err)
 					}
 
+					d.Set("disk_controller_type", pointer.From(storageProfile.DiskControllerType))
+
 					var storageImageId string
 					if storageProfile.ImageReference != nil && storageProfile.ImageReference.Id != nil {
 						storageImageId = *storageProfile.ImageReference.Id
@@ -1223,6 +1235,8 @@ func resourceLinuxVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema {
 
 		"data_disk": VirtualMachineScaleSetDataDiskSchema(),
 
+		"disk_controller_type": diskControllerTypeSchema(),
+
 		"disable_password_authentication": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
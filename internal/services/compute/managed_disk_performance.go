@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
+)
+
+// The performance of Ultra and Premium SSD v2 Disks can be adjusted whilst the disk is attached, up to a limit which
+// scales with the size of the disk: https://learn.microsoft.com/azure/virtual-machines/disks-types
+// The absolute limits vary by region and change over time, so they're left for the API to validate.
+
+const (
+	ultraDiskMaxIopsPerGb     = 1000
+	premiumV2DiskMaxIopsPerGb = 500
+
+	// every Premium SSD v2 Disk supports the baseline IOPS, regardless of its size
+	premiumV2DiskBaselineIops = 3000
+)
+
+// validateManagedDiskPerformance validates the provisioned IOPS of an Ultra or Premium SSD v2 Disk against the limit
+// for the size of the disk. Values which aren't known (or are zero) are skipped, as are other disk types
+func validateManagedDiskPerformance(storageAccountType string, diskSizeGb, diskIops int) error {
+	if diskSizeGb <= 0 || diskIops <= 0 {
+		return nil
+	}
+
+	var maxIops int
+	switch {
+	case strings.EqualFold(storageAccountType, string(disks.DiskStorageAccountTypesUltraSSDLRS)):
+		maxIops = ultraDiskMaxIopsPerGb * diskSizeGb
+	case strings.EqualFold(storageAccountType, string(disks.DiskStorageAccountTypesPremiumVTwoLRS)):
+		maxIops = max(premiumV2DiskMaxIopsPerGb*diskSizeGb, premiumV2DiskBaselineIops)
+	default:
+		return nil
+	}
+
+	if diskIops > maxIops {
+		return fmt.Errorf("the IOPS must be at most %d for a `%s` disk of %dGB, got %d", maxIops, storageAccountType, diskSizeGb, diskIops)
+	}
+
+	return nil
+}
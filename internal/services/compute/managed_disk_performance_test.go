@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"testing"
+)
+
+func TestValidateManagedDiskPerformance(t *testing.T) {
+	testData := []struct {
+		storageAccountType string
+		diskSizeGb         int
+		diskIops           int
+		valid              bool
+	}{
+		{
+			// other disk types aren't validated
+			storageAccountType: "Premium_LRS",
+			diskSizeGb:         4,
+			diskIops:           100000,
+			valid:              true,
+		},
+		{
+			// unknown values are skipped
+			storageAccountType: "UltraSSD_LRS",
+			valid:              true,
+		},
+		{
+			storageAccountType: "UltraSSD_LRS",
+			diskSizeGb:         4,
+			diskIops:           4000,
+			valid:              true,
+		},
+		{
+			storageAccountType: "UltraSSD_LRS",
+			diskSizeGb:         4,
+			diskIops:           4001,
+			valid:              false,
+		},
+		{
+			// the size may not be known until apply
+			storageAccountType: "UltraSSD_LRS",
+			diskIops:           4001,
+			valid:              true,
+		},
+		{
+			// the absolute limits are left for the API to validate
+			storageAccountType: "UltraSSD_LRS",
+			diskSizeGb:         1024,
+			diskIops:           1024000,
+			valid:              true,
+		},
+		{
+			storageAccountType: "PremiumV2_LRS",
+			diskSizeGb:         1,
+			diskIops:           3000,
+			valid:              true,
+		},
+		{
+			storageAccountType: "PremiumV2_LRS",
+			diskSizeGb:         1,
+			diskIops:           3001,
+			valid:              false,
+		},
+		{
+			storageAccountType: "PremiumV2_LRS",
+			diskSizeGb:         256,
+			diskIops:           128000,
+			valid:              true,
+		},
+		{
+			storageAccountType: "PremiumV2_LRS",
+			diskSizeGb:         256,
+			diskIops:           128001,
+			valid:              false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s with %dGB / %d IOPS", v.storageAccountType, v.diskSizeGb, v.diskIops)
+
+		err := validateManagedDiskPerformance(v.storageAccountType, v.diskSizeGb, v.diskIops)
+		if v.valid && err != nil {
+			t.Fatalf("expected valid but got: %+v", err)
+		}
+		if !v.valid && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
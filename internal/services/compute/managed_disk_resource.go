@@ -302,6 +302,11 @@ func resourceManagedDisk() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"bursting_enabled_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"zone": commonschema.ZoneSingleOptionalForceNew(),
 
 			"tags": commonschema.Tags(),
@@ -312,6 +317,23 @@ func resourceManagedDisk() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("encryption_settings", func(ctx context.Context, old, new, meta interface{}) bool {
 				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
 			}),
+
+			// the performance of Ultra and Premium SSD v2 Disks can be changed in-place, even when attached, so validate it
+			// against the limit for the size of the disk at plan time rather than failing part way through an apply
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				for _, key := range []string{"storage_account_type", "disk_size_gb", "disk_iops_read_write"} {
+					if !diff.NewValueKnown(key) {
+						return nil
+					}
+				}
+
+				storageAccountType := diff.Get("storage_account_type").(string)
+				if err := validateManagedDiskPerformance(storageAccountType, diff.Get("disk_size_gb").(int), diff.Get("disk_iops_read_write").(int)); err != nil {
+					return fmt.Errorf("validating `disk_iops_read_write`: %+v", err)
+				}
+
+				return nil
+			}),
 		),
 	}
 }
@@ -689,7 +711,7 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 		if d.HasChange("disk_iops_read_only") {
 			if maxShares == 0 {
-				return fmt.Errorf("[ERROR] disk_iops_read_only is only available for UltraSSD disks with shared disk enabled")
+				return fmt.Errorf("[ERROR] disk_iops_read_only is only available for UltraSSD disks and PremiumV2 disks with shared disk enabled")
 			}
 
 			v := d.Get("disk_iops_read_only")
@@ -698,7 +720,7 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 		if d.HasChange("disk_mbps_read_only") {
 			if maxShares == 0 {
-				return fmt.Errorf("[ERROR] disk_mbps_read_only is only available for UltraSSD disks with shared disk enabled")
+				return fmt.Errorf("[ERROR] disk_mbps_read_only is only available for UltraSSD disks and PremiumV2 disks with shared disk enabled")
 			}
 
 			v := d.Get("disk_mbps_read_only")
@@ -944,6 +966,7 @@ func resourceManagedDiskRead(d *pluginsdk.ResourceData, meta interface{}) error
 				onDemandBurstingEnabled = *props.BurstingEnabled
 			}
 			d.Set("on_demand_bursting_enabled", onDemandBurstingEnabled)
+			d.Set("bursting_enabled_time", pointer.From(props.BurstingEnabledTime))
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
@@ -146,6 +146,8 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 
 			"data_disk": OrchestratedVirtualMachineScaleSetDataDiskSchema(),
 
+			"disk_controller_type": diskControllerTypeSchema(),
+
 			// Optional
 			"additional_capabilities": OrchestratedVirtualMachineScaleSetAdditionalCapabilitiesSchema(),
 
@@ -361,6 +363,8 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 
 				return nil
 			}),
+
+			pluginsdk.CustomizeDiffShim(validateDiskControllerTypeSupportedByImage),
 		),
 	}
 }
@@ -669,6 +673,10 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 		virtualMachineProfile.StorageProfile.DataDisks = dataDisks
 	}
 
+	if v, ok := d.GetOk("disk_controller_type"); ok {
+		virtualMachineProfile.StorageProfile.DiskControllerType = pointer.To(v.(string))
+	}
+
 	if v, ok := d.GetOk("network_interface"); ok {
 		networkInterfaces, err := ExpandOrchestratedVirtualMachineScaleSetNetworkInterface(v.([]interface{}))
 		if err != nil {
@@ -1013,7 +1021,7 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 			updateProps.VirtualMachineProfile.OsProfile = &vmssOsProfile
 		}
 
-		if d.HasChange("data_disk") || d.HasChange("os_disk") || d.HasChange("source_image_id") || d.HasChange("source_image_reference") {
+		if d.HasChange("data_disk") || d.HasChange("disk_controller_type") || d.HasChange("os_disk") || d.HasChange("source_image_id") || d.HasChange("source_image_reference") {
 			updateInstances = true
 
 			if updateProps.VirtualMachineProfile.StorageProfile == nil {
@@ -1029,6 +1037,10 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 				updateProps.VirtualMachineProfile.StorageProfile.DataDisks = dataDisks
 			}
 
+			if d.HasChange("disk_controller_type") {
+				updateProps.VirtualMachineProfile.StorageProfile.DiskControllerType = pointer.To(d.Get("disk_controller_type").(string))
+			}
+
 			if d.HasChange("os_disk") {
 				osDiskRaw := d.Get("os_disk").([]interface{})
 				updateProps.VirtualMachineProfile.StorageProfile.OsDisk = ExpandOrchestratedVirtualMachineScaleSetOSDiskUpdate(osDiskRaw)
@@ -1379,6 +1391,8 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, m
 						return fmt.Errorf("setting `data_disk`: %w", err)
 					}
 
+					d.Set("disk_controller_type", pointer.From(storageProfile.DiskControllerType))
+
 					var storageImageId string
 					if storageProfile.ImageReference != nil && storageProfile.ImageReference.Id != nil {
 						storageImageId = *storageProfile.ImageReference.Id
@@ -137,6 +137,11 @@ func dataSourceSharedImage() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"trusted_launch_and_confidential_vm_supported": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"confidential_vm_supported": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -210,6 +215,7 @@ func dataSourceSharedImageRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			trustedLaunchEnabled := false
 			cvmEnabled := false
 			cvmSupported := false
+			trustedLaunchAndCvmSupported := false
 			acceleratedNetworkSupportEnabled := false
 			hibernationEnabled := false
 			if model.Properties.Features != nil {
@@ -223,6 +229,7 @@ func dataSourceSharedImageRead(d *pluginsdk.ResourceData, meta interface{}) erro
 						trustedLaunchEnabled = strings.EqualFold(*feature.Value, "TrustedLaunch")
 						cvmSupported = strings.EqualFold(*feature.Value, "ConfidentialVmSupported")
 						cvmEnabled = strings.EqualFold(*feature.Value, "ConfidentialVm")
+						trustedLaunchAndCvmSupported = strings.EqualFold(*feature.Value, "TrustedLaunchAndConfidentialVmSupported")
 					}
 
 					if strings.EqualFold(*feature.Name, "IsAcceleratedNetworkSupported") {
@@ -239,6 +246,7 @@ func dataSourceSharedImageRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			d.Set("confidential_vm_enabled", cvmEnabled)
 			d.Set("trusted_launch_supported", trustedLaunchSupported)
 			d.Set("trusted_launch_enabled", trustedLaunchEnabled)
+			d.Set("trusted_launch_and_confidential_vm_supported", trustedLaunchAndCvmSupported)
 			d.Set("accelerated_network_support_enabled", acceleratedNetworkSupportEnabled)
 			d.Set("hibernation_enabled", hibernationEnabled)
 		}
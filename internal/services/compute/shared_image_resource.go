@@ -233,28 +233,35 @@ func resourceSharedImage() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"trusted_launch_enabled", "confidential_vm_supported", "confidential_vm_enabled"},
+				ConflictsWith: []string{"trusted_launch_enabled", "confidential_vm_supported", "confidential_vm_enabled", "trusted_launch_and_confidential_vm_supported"},
 			},
 
 			"trusted_launch_enabled": {
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"trusted_launch_supported", "confidential_vm_supported", "confidential_vm_enabled"},
+				ConflictsWith: []string{"trusted_launch_supported", "confidential_vm_supported", "confidential_vm_enabled", "trusted_launch_and_confidential_vm_supported"},
 			},
 
 			"confidential_vm_supported": {
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"trusted_launch_supported", "trusted_launch_enabled", "confidential_vm_enabled"},
+				ConflictsWith: []string{"trusted_launch_supported", "trusted_launch_enabled", "confidential_vm_enabled", "trusted_launch_and_confidential_vm_supported"},
 			},
 
 			"confidential_vm_enabled": {
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"trusted_launch_supported", "trusted_launch_enabled", "confidential_vm_supported"},
+				ConflictsWith: []string{"trusted_launch_supported", "trusted_launch_enabled", "confidential_vm_supported", "trusted_launch_and_confidential_vm_supported"},
+			},
+
+			"trusted_launch_and_confidential_vm_supported": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"trusted_launch_supported", "trusted_launch_enabled", "confidential_vm_supported", "confidential_vm_enabled"},
 			},
 
 			"accelerated_network_support_enabled": {
@@ -282,10 +289,27 @@ func resourceSharedImage() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("end_of_life_date", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old.(string) != "" && new.(string) == ""
 			}),
+			pluginsdk.CustomizeDiffShim(sharedImageSecurityTypeCustomizeDiff),
 		),
 	}
 }
 
+// sharedImageSecurityTypeCustomizeDiff validates that a Security Type is only specified for Generation 2 images, since
+// Trusted Launch and Confidential VMs require UEFI firmware
+func sharedImageSecurityTypeCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("hyper_v_generation") || diff.Get("hyper_v_generation").(string) == string(galleryimages.HyperVGenerationVTwo) {
+		return nil
+	}
+
+	for _, field := range []string{"trusted_launch_supported", "trusted_launch_enabled", "confidential_vm_supported", "confidential_vm_enabled", "trusted_launch_and_confidential_vm_supported"} {
+		if diff.Get(field).(bool) {
+			return fmt.Errorf("`%s` can only be specified when `hyper_v_generation` is `%s`", field, galleryimages.HyperVGenerationVTwo)
+		}
+	}
+
+	return nil
+}
+
 func resourceSharedImageCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.GalleryImagesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -532,6 +556,7 @@ func resourceSharedImageRead(d *pluginsdk.ResourceData, meta interface{}) error
 			trustedLaunchEnabled := false
 			cvmEnabled := false
 			cvmSupported := false
+			trustedLaunchAndCvmSupported := false
 			acceleratedNetworkSupportEnabled := false
 			hibernationEnabled := false
 			diskControllerTypeNVMEEnabled := false
@@ -546,6 +571,7 @@ func resourceSharedImageRead(d *pluginsdk.ResourceData, meta interface{}) error
 						trustedLaunchEnabled = strings.EqualFold(*feature.Value, "TrustedLaunch")
 						cvmSupported = strings.EqualFold(*feature.Value, "ConfidentialVmSupported")
 						cvmEnabled = strings.EqualFold(*feature.Value, "ConfidentialVm")
+						trustedLaunchAndCvmSupported = strings.EqualFold(*feature.Value, "TrustedLaunchAndConfidentialVmSupported")
 					}
 
 					if strings.EqualFold(*feature.Name, "IsAcceleratedNetworkSupported") {
@@ -565,6 +591,7 @@ func resourceSharedImageRead(d *pluginsdk.ResourceData, meta interface{}) error
 			d.Set("confidential_vm_enabled", cvmEnabled)
 			d.Set("trusted_launch_supported", trustedLaunchSupported)
 			d.Set("trusted_launch_enabled", trustedLaunchEnabled)
+			d.Set("trusted_launch_and_confidential_vm_supported", trustedLaunchAndCvmSupported)
 			d.Set("accelerated_network_support_enabled", acceleratedNetworkSupportEnabled)
 			d.Set("hibernation_enabled", hibernationEnabled)
 			d.Set("disk_controller_type_nvme_enabled", diskControllerTypeNVMEEnabled)
@@ -797,6 +824,13 @@ func expandSharedImageFeatures(d *pluginsdk.ResourceData) *[]galleryimages.Galle
 		})
 	}
 
+	if supported := d.Get("trusted_launch_and_confidential_vm_supported").(bool); supported {
+		features = append(features, galleryimages.GalleryImageFeature{
+			Name:  pointer.To("SecurityType"),
+			Value: pointer.To("TrustedLaunchAndConfidentialVmSupported"),
+		})
+	}
+
 	if hibernationEnabled := d.Get("hibernation_enabled").(bool); hibernationEnabled {
 		features = append(features, galleryimages.GalleryImageFeature{
 			Name:  pointer.To("IsHibernateSupported"),
@@ -191,6 +191,34 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"uefi_settings": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"signature_template_names": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice(galleryimageversions.PossibleValuesForUefiSignatureTemplateName(), false),
+							},
+						},
+
+						"additional_db_signature": sharedImageVersionUefiKeySchema(),
+
+						"additional_dbx_signature": sharedImageVersionUefiKeySchema(),
+
+						"additional_kek_signature": sharedImageVersionUefiKeySchema(),
+
+						"platform_key": sharedImageVersionUefiPlatformKeySchema(),
+					},
+				},
+			},
+
 			"tags": commonschema.Tags(),
 		},
 
@@ -249,6 +277,12 @@ func resourceSharedImageVersionCreate(d *pluginsdk.ResourceData, meta interface{
 		}.String())
 	}
 
+	if v, ok := d.GetOk("uefi_settings"); ok {
+		version.Properties.SecurityProfile = &galleryimageversions.ImageVersionSecurityProfile{
+			UefiSettings: expandSharedImageVersionUefiSettings(v.([]interface{})),
+		}
+	}
+
 	if v, ok := d.GetOk("managed_image_id"); ok {
 		_, err := virtualmachines.ParseVirtualMachineID(v.(string))
 		if err == nil {
@@ -439,6 +473,14 @@ func resourceSharedImageVersionRead(d *pluginsdk.ResourceData, meta interface{})
 			if safetyProfile := props.SafetyProfile; safetyProfile != nil {
 				d.Set("deletion_of_replicated_locations_enabled", pointer.From(safetyProfile.AllowDeletionOfReplicatedLocations))
 			}
+
+			var uefiSettings *galleryimageversions.GalleryImageVersionUefiSettings
+			if securityProfile := props.SecurityProfile; securityProfile != nil {
+				uefiSettings = securityProfile.UefiSettings
+			}
+			if err := d.Set("uefi_settings", flattenSharedImageVersionUefiSettings(uefiSettings)); err != nil {
+				return fmt.Errorf("setting `uefi_settings`: %+v", err)
+			}
 		}
 		return tags.FlattenAndSet(d, model.Tags)
 	}
@@ -565,3 +607,139 @@ func flattenSharedImageVersionTargetRegions(input *[]galleryimageversions.Target
 
 	return results
 }
+
+func sharedImageVersionUefiKeySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(galleryimageversions.PossibleValuesForUefiKeyType(), false),
+				},
+
+				"value": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					ForceNew: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsBase64,
+					},
+				},
+			},
+		},
+	}
+}
+
+// the Platform Key (pk) is a single key, unlike the signature databases
+func sharedImageVersionUefiPlatformKeySchema() *pluginsdk.Schema {
+	s := sharedImageVersionUefiKeySchema()
+	s.MaxItems = 1
+	return s
+}
+
+func expandSharedImageVersionUefiSettings(input []interface{}) *galleryimageversions.GalleryImageVersionUefiSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	templateNames := make([]galleryimageversions.UefiSignatureTemplateName, 0)
+	for _, v := range raw["signature_template_names"].(*pluginsdk.Set).List() {
+		templateNames = append(templateNames, galleryimageversions.UefiSignatureTemplateName(v.(string)))
+	}
+
+	output := &galleryimageversions.GalleryImageVersionUefiSettings{
+		SignatureTemplateNames: &templateNames,
+	}
+
+	db := expandSharedImageVersionUefiKeys(raw["additional_db_signature"].([]interface{}))
+	dbx := expandSharedImageVersionUefiKeys(raw["additional_dbx_signature"].([]interface{}))
+	kek := expandSharedImageVersionUefiKeys(raw["additional_kek_signature"].([]interface{}))
+	var pk *galleryimageversions.UefiKey
+	if v := expandSharedImageVersionUefiKeys(raw["platform_key"].([]interface{})); v != nil {
+		pk = &(*v)[0]
+	}
+	if db != nil || dbx != nil || kek != nil || pk != nil {
+		output.AdditionalSignatures = &galleryimageversions.UefiKeySignatures{
+			Db:  db,
+			Dbx: dbx,
+			Kek: kek,
+			Pk:  pk,
+		}
+	}
+
+	return output
+}
+
+func expandSharedImageVersionUefiKeys(input []interface{}) *[]galleryimageversions.UefiKey {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make([]galleryimageversions.UefiKey, 0)
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		output = append(output, galleryimageversions.UefiKey{
+			Type:  pointer.To(galleryimageversions.UefiKeyType(raw["type"].(string))),
+			Value: utils.ExpandStringSlice(raw["value"].([]interface{})),
+		})
+	}
+
+	return &output
+}
+
+func flattenSharedImageVersionUefiSettings(input *galleryimageversions.GalleryImageVersionUefiSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	templateNames := make([]interface{}, 0)
+	if input.SignatureTemplateNames != nil {
+		for _, v := range *input.SignatureTemplateNames {
+			templateNames = append(templateNames, string(v))
+		}
+	}
+
+	var db, dbx, kek, pk []interface{}
+	if signatures := input.AdditionalSignatures; signatures != nil {
+		db = flattenSharedImageVersionUefiKeys(signatures.Db)
+		dbx = flattenSharedImageVersionUefiKeys(signatures.Dbx)
+		kek = flattenSharedImageVersionUefiKeys(signatures.Kek)
+		if signatures.Pk != nil {
+			pk = flattenSharedImageVersionUefiKeys(&[]galleryimageversions.UefiKey{*signatures.Pk})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"signature_template_names": templateNames,
+			"additional_db_signature":  db,
+			"additional_dbx_signature": dbx,
+			"additional_kek_signature": kek,
+			"platform_key":             pk,
+		},
+	}
+}
+
+func flattenSharedImageVersionUefiKeys(input *[]galleryimageversions.UefiKey) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, map[string]interface{}{
+			"type":  string(pointer.From(v.Type)),
+			"value": pointer.From(v.Value),
+		})
+	}
+
+	return output
+}
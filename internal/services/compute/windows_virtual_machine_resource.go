@@ -47,6 +47,8 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 			return err
 		}, importVirtualMachine(virtualmachines.OperatingSystemTypesWindows, "azurerm_windows_virtual_machine")),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(validateDiskControllerTypeSupportedByImage),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(45 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -185,15 +187,7 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 				},
 			},
 
-			"disk_controller_type": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				Computed: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					string(virtualmachines.DiskControllerTypesNVMe),
-					string(virtualmachines.DiskControllerTypesSCSI),
-				}, false),
-			},
+			"disk_controller_type": diskControllerTypeSchema(),
 
 			"edge_zone": commonschema.EdgeZoneOptionalForceNew(),
 
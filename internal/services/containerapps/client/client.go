@@ -9,8 +9,10 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/certificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerapps"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappsrevisions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappssessionpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/daprcomponents"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/jobs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/managedcertificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/managedenvironmentsstorages"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -21,9 +23,11 @@ type Client struct {
 	ContainerAppClient         *containerapps.ContainerAppsClient
 	ContainerAppRevisionClient *containerappsrevisions.ContainerAppsRevisionsClient
 	DaprComponentsClient       *daprcomponents.DaprComponentsClient
+	ManagedCertificatesClient  *managedcertificates.ManagedCertificatesClient
 	ManagedEnvironmentClient   *managedenvironments.ManagedEnvironmentsClient
 	StorageClient              *managedenvironmentsstorages.ManagedEnvironmentsStoragesClient
 	JobClient                  *jobs.JobsClient
+	SessionPoolClient          *containerappssessionpools.ContainerAppsSessionPoolsClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -45,6 +49,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(containerAppsRevisionsClient.Client, o.Authorizers.ResourceManager)
 
+	managedCertificatesClient, err := managedcertificates.NewManagedCertificatesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Managed Certificates client : %+v", err)
+	}
+	o.Configure(managedCertificatesClient.Client, o.Authorizers.ResourceManager)
+
 	managedEnvironmentClient, err := managedenvironments.NewManagedEnvironmentsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Managed Environment client : %+v", err)
@@ -69,13 +79,21 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(jobsClient.Client, o.Authorizers.ResourceManager)
 
+	sessionPoolsClient, err := containerappssessionpools.NewContainerAppsSessionPoolsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Session Pools client : %+v", err)
+	}
+	o.Configure(sessionPoolsClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		CertificatesClient:         certificatesClient,
 		ContainerAppClient:         containerAppsClient,
 		ContainerAppRevisionClient: containerAppsRevisionsClient,
 		DaprComponentsClient:       daprComponentClient,
+		ManagedCertificatesClient:  managedCertificatesClient,
 		ManagedEnvironmentClient:   managedEnvironmentClient,
 		StorageClient:              managedEnvironmentStoragesClient,
 		JobClient:                  jobsClient,
+		SessionPoolClient:          sessionPoolsClient,
 	}, nil
 }
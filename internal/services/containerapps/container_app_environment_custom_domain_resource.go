@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -24,9 +25,15 @@ type ContainerAppEnvironmentCustomDomainResource struct{}
 type ContainerAppEnvironmentCustomDomainModel struct {
 	ManagedEnvironmentId string `tfschema:"container_app_environment_id"`
 
-	CertificatePassword string `tfschema:"certificate_password"`
-	CertificateValue    string `tfschema:"certificate_blob_base64"`
-	DnsSuffix           string `tfschema:"dns_suffix"`
+	CertificatePassword string                                            `tfschema:"certificate_password"`
+	CertificateValue    string                                            `tfschema:"certificate_blob_base64"`
+	CertificateKeyVault []ContainerAppEnvironmentCertificateKeyVaultModel `tfschema:"certificate_key_vault"`
+	DnsSuffix           string                                            `tfschema:"dns_suffix"`
+}
+
+type ContainerAppEnvironmentCertificateKeyVaultModel struct {
+	Identity         string `tfschema:"identity"`
+	KeyVaultSecretId string `tfschema:"key_vault_secret_id"`
 }
 
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentCustomDomainResource{}
@@ -55,16 +62,46 @@ func (r ContainerAppEnvironmentCustomDomainResource) Arguments() map[string]*plu
 
 		"certificate_blob_base64": {
 			Type:         pluginsdk.TypeString,
-			Required:     true,
+			Optional:     true,
 			ValidateFunc: validation.StringIsBase64,
+			ExactlyOneOf: []string{"certificate_blob_base64", "certificate_key_vault"},
+			RequiredWith: []string{"certificate_password"},
 			Description:  "The Custom Domain Certificate Private Key as a base64 encoded PFX or PEM.",
 		},
 
 		"certificate_password": {
-			Type:        pluginsdk.TypeString,
-			Required:    true,
-			Sensitive:   true,
-			Description: "The Custom Domain Certificate password.",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{"certificate_blob_base64"},
+			Description:  "The Custom Domain Certificate password.",
+		},
+
+		"certificate_key_vault": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"certificate_blob_base64", "certificate_key_vault"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"identity": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.Any(
+							commonids.ValidateUserAssignedIdentityID,
+							validation.StringInSlice([]string{"System"}, false),
+						),
+						Description: "The identity used to access the Key Vault. Either the ID of a User Assigned Identity assigned to the Container App Environment, or `System` to use its System Assigned Identity.",
+					},
+
+					"key_vault_secret_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+						Description:  "The ID of the Key Vault Secret containing the Custom Domain Certificate. Could be either one of `id` or `versionless_id`.",
+					},
+				},
+			},
 		},
 
 		"dns_suffix": {
@@ -132,11 +169,7 @@ func (r ContainerAppEnvironmentCustomDomainResource) Create() sdk.ResourceFunc {
 				existing.Model.Properties.AppLogsConfiguration = nil
 			}
 
-			existing.Model.Properties.CustomDomainConfiguration = &managedenvironments.CustomDomainConfiguration{
-				DnsSuffix:           pointer.To(model.DnsSuffix),
-				CertificateValue:    pointer.To(model.CertificateValue),
-				CertificatePassword: pointer.To(model.CertificatePassword),
-			}
+			existing.Model.Properties.CustomDomainConfiguration = expandContainerAppEnvironmentCustomDomainConfiguration(model)
 
 			if err := client.CreateOrUpdateThenPoll(ctx, *id, *existing.Model); err != nil {
 				return fmt.Errorf("updating %s: %+v", id, err)
@@ -171,13 +204,22 @@ func (r ContainerAppEnvironmentCustomDomainResource) Read() sdk.ResourceFunc {
 
 			if model := existing.Model; model != nil {
 				if props := model.Properties; props != nil {
-					if customdomain := props.CustomDomainConfiguration; customdomain.DnsSuffix != nil {
+					if customdomain := props.CustomDomainConfiguration; customdomain != nil && customdomain.DnsSuffix != nil {
 						state.DnsSuffix = pointer.From(customdomain.DnsSuffix)
-						if certValue, ok := metadata.ResourceData.GetOk("certificate_blob_base64"); ok {
-							state.CertificateValue = certValue.(string)
-						}
-						if certPassword, ok := metadata.ResourceData.GetOk("certificate_password"); ok {
-							state.CertificatePassword = certPassword.(string)
+						if keyVault := customdomain.CertificateKeyVaultProperties; keyVault != nil && keyVault.KeyVaultURL != nil {
+							state.CertificateKeyVault = []ContainerAppEnvironmentCertificateKeyVaultModel{
+								{
+									Identity:         pointer.From(keyVault.Identity),
+									KeyVaultSecretId: pointer.From(keyVault.KeyVaultURL),
+								},
+							}
+						} else {
+							if certValue, ok := metadata.ResourceData.GetOk("certificate_blob_base64"); ok {
+								state.CertificateValue = certValue.(string)
+							}
+							if certPassword, ok := metadata.ResourceData.GetOk("certificate_password"); ok {
+								state.CertificatePassword = certPassword.(string)
+							}
 						}
 						state.ManagedEnvironmentId = metadata.ResourceData.Id()
 					}
@@ -281,12 +323,9 @@ func (r ContainerAppEnvironmentCustomDomainResource) Update() sdk.ResourceFunc {
 			// If custom domain dns suffix or its certificate changed, update all the required attributes
 			if metadata.ResourceData.HasChange("dns_suffix") ||
 				metadata.ResourceData.HasChange("certificate_blob_base64") ||
-				metadata.ResourceData.HasChange("certificate_password") {
-				existing.Model.Properties.CustomDomainConfiguration = &managedenvironments.CustomDomainConfiguration{
-					DnsSuffix:           pointer.To(model.DnsSuffix),
-					CertificateValue:    pointer.To(model.CertificateValue),
-					CertificatePassword: pointer.To(model.CertificatePassword),
-				}
+				metadata.ResourceData.HasChange("certificate_password") ||
+				metadata.ResourceData.HasChange("certificate_key_vault") {
+				existing.Model.Properties.CustomDomainConfiguration = expandContainerAppEnvironmentCustomDomainConfiguration(model)
 			}
 
 			if err := client.CreateOrUpdateThenPoll(ctx, *id, *existing.Model); err != nil {
@@ -298,6 +337,26 @@ func (r ContainerAppEnvironmentCustomDomainResource) Update() sdk.ResourceFunc {
 	}
 }
 
+func expandContainerAppEnvironmentCustomDomainConfiguration(input ContainerAppEnvironmentCustomDomainModel) *managedenvironments.CustomDomainConfiguration {
+	result := &managedenvironments.CustomDomainConfiguration{
+		DnsSuffix: pointer.To(input.DnsSuffix),
+	}
+
+	if len(input.CertificateKeyVault) > 0 {
+		keyVault := input.CertificateKeyVault[0]
+		result.CertificateKeyVaultProperties = &managedenvironments.CertificateKeyVaultProperties{
+			Identity:    pointer.To(keyVault.Identity),
+			KeyVaultURL: pointer.To(keyVault.KeyVaultSecretId),
+		}
+		return result
+	}
+
+	result.CertificateValue = pointer.To(input.CertificateValue)
+	result.CertificatePassword = pointer.To(input.CertificatePassword)
+
+	return result
+}
+
 func findLogAnalyticsWorkspaceSecret(ctx context.Context, client *workspaces.WorkspacesClient, subscriptionId, targetCustomerId string) (string, error) {
 	parsedSubscriptionId := commonids.NewSubscriptionID(subscriptionId)
 
@@ -107,6 +107,25 @@ func TestAccContainerAppEnvironmentCustomDomainResource_update(t *testing.T) {
 	})
 }
 
+func TestAccContainerAppEnvironmentCustomDomainResource_keyVault(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skipf("Skipping as either ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_custom_domain", "test")
+	r := ContainerAppEnvironmentCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyVault(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r ContainerAppEnvironmentCustomDomainResource) basic(data acceptance.TestData) string {
 	dnsZone := os.Getenv("ARM_TEST_DNS_ZONE")
 
@@ -172,6 +191,129 @@ resource "azurerm_container_app_environment_custom_domain" "test" {
 `, r.template(data), dnsZone)
 }
 
+func (r ContainerAppEnvironmentCustomDomainResource) keyVault(data acceptance.TestData) string {
+	dnsZone := os.Getenv("ARM_TEST_DNS_ZONE")
+
+	return fmt.Sprintf(`
+provider azurerm {
+  features {}
+}
+
+%[1]s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault" "test" {
+  name                      = "acctestkv%[4]s"
+  location                  = azurerm_resource_group.test.location
+  resource_group_name       = azurerm_resource_group.test.name
+  tenant_id                 = data.azurerm_client_config.current.tenant_id
+  sku_name                  = "standard"
+  enable_rbac_authorization = true
+}
+
+resource "azurerm_role_assignment" "current" {
+  scope                = azurerm_key_vault.test.id
+  role_definition_name = "Key Vault Certificates Officer"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_key_vault.test.id
+  role_definition_name = "Key Vault Secrets User"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_key_vault_certificate" "test" {
+  name         = "acctestcert%[4]s"
+  key_vault_id = azurerm_key_vault.test.id
+
+  certificate {
+    contents = filebase64("testdata/testacc.pfx")
+    password = "TestAcc"
+  }
+
+  depends_on = [azurerm_role_assignment.current]
+}
+
+resource "azurerm_container_app_environment_custom_domain" "test" {
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  dns_suffix                   = "%[3]s"
+
+  certificate_key_vault {
+    identity            = azurerm_user_assigned_identity.test.id
+    key_vault_secret_id = azurerm_key_vault_certificate.test.versionless_secret_id
+  }
+
+  depends_on = [
+    time_sleep.wait_60_seconds,
+    azurerm_role_assignment.test,
+  ]
+}
+`, r.templateWithIdentity(data), data.RandomInteger, dnsZone, data.RandomString)
+}
+
+func (r ContainerAppEnvironmentCustomDomainResource) templateWithIdentity(data acceptance.TestData) string {
+	dnsZone := os.Getenv("ARM_TEST_DNS_ZONE")
+	dataResourceGroup := os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP")
+
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-CAE-%[1]d"
+  location = "%[2]s"
+}
+
+data "azurerm_dns_zone" "test" {
+  name                = "%[3]s"
+  resource_group_name = "%[4]s"
+}
+
+resource "azurerm_dns_txt_record" "test" {
+  name                = "asuid"
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  zone_name           = data.azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    value = azurerm_container_app_environment.test.custom_domain_verification_id
+  }
+}
+
+resource "time_sleep" "wait_60_seconds" {
+  depends_on = [azurerm_dns_txt_record.test]
+
+  create_duration = "60s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestCAEnv-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_container_app_environment" "test" {
+  name                       = "acctest-CAEnv%[1]d"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+`, data.RandomInteger, data.Locations.Primary, dnsZone, dataResourceGroup)
+}
+
 func (r ContainerAppEnvironmentCustomDomainResource) template(data acceptance.TestData) string {
 	dnsZone := os.Getenv("ARM_TEST_DNS_ZONE")
 	dataResourceGroup := os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP")
@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/managedcertificates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppEnvironmentManagedCertificateResource struct{}
+
+type ContainerAppEnvironmentManagedCertificateModel struct {
+	Name                       string                 `tfschema:"name"`
+	ManagedEnvironmentId       string                 `tfschema:"container_app_environment_id"`
+	SubjectName                string                 `tfschema:"subject_name"`
+	DomainControlValidation    string                 `tfschema:"domain_control_validation"`
+	ContainerAppCustomDomainId string                 `tfschema:"container_app_custom_domain_id"`
+	Tags                       map[string]interface{} `tfschema:"tags"`
+
+	ValidationToken string `tfschema:"validation_token"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentManagedCertificateResource{}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentManagedCertificateModel{}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) ResourceType() string {
+	return "azurerm_container_app_environment_managed_certificate"
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managedcertificates.ValidateManagedCertificateID
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CertificateName,
+			Description:  "The name of the Container Apps Environment Managed Certificate.",
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedcertificates.ValidateManagedEnvironmentID,
+			Description:  "The Container App Managed Environment ID to configure this Managed Certificate on.",
+		},
+
+		"subject_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The hostname to issue the Managed Certificate for.",
+		},
+
+		"domain_control_validation": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(managedcertificates.PossibleValuesForManagedCertificateDomainControlValidation(), false),
+			Description:  "The method used to validate the ownership of the domain. Possible values are `CNAME`, `HTTP` and `TXT`. Apex domains must use `HTTP` or `TXT`.",
+		},
+
+		"container_app_custom_domain_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerAppCustomDomainId,
+			Description:  "The ID of a Container App Custom Domain to bind this Managed Certificate to once it has been issued.",
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"validation_token": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The token used to validate the ownership of the domain when `domain_control_validation` is `TXT`.",
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedCertificatesClient
+			environmentsClient := metadata.Client.ContainerApps.ManagedEnvironmentClient
+
+			var model ContainerAppEnvironmentManagedCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			envId, err := managedcertificates.ParseManagedEnvironmentID(model.ManagedEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := managedcertificates.NewManagedCertificateID(envId.SubscriptionId, envId.ResourceGroupName, envId.ManagedEnvironmentName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			env, err := environmentsClient.Get(ctx, managedenvironments.NewManagedEnvironmentID(envId.SubscriptionId, envId.ResourceGroupName, envId.ManagedEnvironmentName))
+			if err != nil {
+				return fmt.Errorf("reading %s for %s: %+v", *envId, id, err)
+			}
+			if env.Model == nil {
+				return fmt.Errorf("reading %s for %s: `model` was nil", *envId, id)
+			}
+
+			payload := managedcertificates.ManagedCertificate{
+				Location: env.Model.Location,
+				Name:     pointer.To(id.ManagedCertificateName),
+				Properties: &managedcertificates.ManagedCertificateProperties{
+					SubjectName:             pointer.To(model.SubjectName),
+					DomainControlValidation: pointer.To(managedcertificates.ManagedCertificateDomainControlValidation(model.DomainControlValidation)),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if model.ContainerAppCustomDomainId != "" {
+				customDomainId, err := parse.ContainerAppCustomDomainID(model.ContainerAppCustomDomainId)
+				if err != nil {
+					return err
+				}
+
+				if err := bindContainerAppCustomDomainCertificate(ctx, metadata.Client.ContainerApps.ContainerAppClient, *customDomainId, pointer.To(id.ID())); err != nil {
+					return fmt.Errorf("binding %s to %s: %+v", id, customDomainId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedCertificatesClient
+
+			id, err := managedcertificates.ParseManagedCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			state := ContainerAppEnvironmentManagedCertificateModel{
+				Name:                       id.ManagedCertificateName,
+				ManagedEnvironmentId:       managedcertificates.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID(),
+				ContainerAppCustomDomainId: metadata.ResourceData.Get("container_app_custom_domain_id").(string),
+			}
+
+			if model := existing.Model; model != nil {
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.SubjectName = pointer.From(props.SubjectName)
+					state.DomainControlValidation = string(pointer.From(props.DomainControlValidation))
+					state.ValidationToken = pointer.From(props.ValidationToken)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedCertificatesClient
+
+			id, err := managedcertificates.ParseManagedCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentManagedCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := managedcertificates.ManagedCertificatePatch{
+					Tags: tags.Expand(model.Tags),
+				}
+
+				if _, err := client.Update(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating tags for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentManagedCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedCertificatesClient
+
+			id, err := managedcertificates.ParseManagedCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentManagedCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			// a Managed Certificate can't be deleted whilst it's bound to a Custom Domain
+			if model.ContainerAppCustomDomainId != "" {
+				customDomainId, err := parse.ContainerAppCustomDomainID(model.ContainerAppCustomDomainId)
+				if err != nil {
+					return err
+				}
+
+				if err := bindContainerAppCustomDomainCertificate(ctx, metadata.Client.ContainerApps.ContainerAppClient, *customDomainId, nil); err != nil {
+					return fmt.Errorf("unbinding %s from %s: %+v", *id, customDomainId, err)
+				}
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// bindContainerAppCustomDomainCertificate binds the certificate with the given ID to the Custom Domain, or unbinds the
+// Custom Domain when certificateId is nil. Managed Certificates can only be issued for hostnames which are already
+// present on a Container App, so the binding has to happen after both the Custom Domain and the Certificate exist.
+func bindContainerAppCustomDomainCertificate(ctx context.Context, client *containerapps.ContainerAppsClient, id parse.ContainerAppCustomDomainId, certificateId *string) error {
+	containerAppId := containerapps.NewContainerAppID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName)
+
+	locks.ByID(containerAppId.ID())
+	defer locks.UnlockByID(containerAppId.ID())
+
+	containerApp, err := client.Get(ctx, containerAppId)
+	if err != nil {
+		if response.WasNotFound(containerApp.HttpResponse) && certificateId == nil {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", containerAppId, err)
+	}
+
+	if containerApp.Model == nil || containerApp.Model.Properties == nil || containerApp.Model.Properties.Configuration == nil || containerApp.Model.Properties.Configuration.Ingress == nil {
+		return fmt.Errorf("specified Container App (%s) has no Ingress configuration for Custom Domains", containerAppId)
+	}
+
+	ingress := containerApp.Model.Properties.Configuration.Ingress
+
+	found := false
+	customDomains := pointer.From(ingress.CustomDomains)
+	for i, v := range customDomains {
+		if !strings.EqualFold(v.Name, id.CustomDomainName) {
+			continue
+		}
+
+		found = true
+		if certificateId != nil {
+			customDomains[i].CertificateId = certificateId
+			customDomains[i].BindingType = pointer.To(containerapps.BindingTypeSniEnabled)
+		} else {
+			customDomains[i].CertificateId = nil
+			customDomains[i].BindingType = pointer.To(containerapps.BindingTypeDisabled)
+		}
+	}
+
+	if !found {
+		if certificateId == nil {
+			return nil
+		}
+		return fmt.Errorf("%s was not found", id)
+	}
+
+	ingress.CustomDomains = pointer.To(customDomains)
+
+	// Delta-updates need the secrets back from the list API, or we'll end up removing them or erroring out.
+	secretsResp, err := client.ListSecrets(ctx, containerAppId)
+	if err != nil || secretsResp.Model == nil {
+		if !response.WasStatusCode(secretsResp.HttpResponse, http.StatusNoContent) {
+			return fmt.Errorf("retrieving secrets for update for %s: %+v", containerAppId, err)
+		}
+	}
+	containerApp.Model.Properties.Configuration.Secrets = helpers.UnpackContainerSecretsCollection(secretsResp.Model)
+
+	if err := client.CreateOrUpdateThenPoll(ctx, containerAppId, *containerApp.Model); err != nil {
+		return fmt.Errorf("updating %s: %+v", containerAppId, err)
+	}
+
+	return nil
+}
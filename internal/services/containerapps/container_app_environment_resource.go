@@ -51,6 +51,7 @@ type ContainerAppEnvironmentModel struct {
 	WorkloadProfiles                        []helpers.WorkloadProfileModel             `tfschema:"workload_profile"`
 	InfrastructureResourceGroup             string                                     `tfschema:"infrastructure_resource_group_name"`
 	Mtls                                    bool                                       `tfschema:"mutual_tls_enabled"`
+	PeerTrafficEncryption                   bool                                       `tfschema:"peer_traffic_encryption_enabled"`
 
 	CustomDomainVerificationId string `tfschema:"custom_domain_verification_id"`
 
@@ -172,6 +173,13 @@ func (r ContainerAppEnvironmentResource) Arguments() map[string]*pluginsdk.Schem
 			Default:     false,
 		},
 
+		"peer_traffic_encryption_enabled": {
+			Description: "Should the traffic between the Container Apps in this Container App Environment be encrypted? Defaults to the value of `mutual_tls_enabled`.",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+
 		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
 		"tags": commonschema.Tags(),
@@ -275,7 +283,7 @@ func (r ContainerAppEnvironmentResource) Create() sdk.ResourceFunc {
 					},
 					PeerTrafficConfiguration: &managedenvironments.ManagedEnvironmentPropertiesPeerTrafficConfiguration{
 						Encryption: &managedenvironments.ManagedEnvironmentPropertiesPeerTrafficConfigurationEncryption{
-							Enabled: pointer.To(containerAppEnvironment.Mtls),
+							Enabled: pointer.To(peerTrafficEncryptionEnabled(metadata, containerAppEnvironment)),
 						},
 					},
 				},
@@ -394,6 +402,9 @@ func (r ContainerAppEnvironmentResource) Read() sdk.ResourceFunc {
 					state.WorkloadProfiles = helpers.FlattenWorkloadProfiles(props.WorkloadProfiles, consumptionDefined)
 					state.InfrastructureResourceGroup = pointer.From(props.InfrastructureResourceGroup)
 					state.Mtls = pointer.From(props.PeerAuthentication.Mtls.Enabled)
+					if peerTraffic := props.PeerTrafficConfiguration; peerTraffic != nil && peerTraffic.Encryption != nil {
+						state.PeerTrafficEncryption = pointer.From(peerTraffic.Encryption.Enabled)
+					}
 				}
 			}
 
@@ -477,7 +488,7 @@ func (r ContainerAppEnvironmentResource) Update() sdk.ResourceFunc {
 				payload.Properties.WorkloadProfiles = helpers.ExpandWorkloadProfiles(state.WorkloadProfiles)
 			}
 
-			if metadata.ResourceData.HasChange("mutual_tls_enabled") {
+			if metadata.ResourceData.HasChanges("mutual_tls_enabled", "peer_traffic_encryption_enabled") {
 				payload.Properties.PeerAuthentication = &managedenvironments.ManagedEnvironmentPropertiesPeerAuthentication{
 					Mtls: &managedenvironments.Mtls{
 						Enabled: pointer.To(state.Mtls),
@@ -485,7 +496,7 @@ func (r ContainerAppEnvironmentResource) Update() sdk.ResourceFunc {
 				}
 				payload.Properties.PeerTrafficConfiguration = &managedenvironments.ManagedEnvironmentPropertiesPeerTrafficConfiguration{
 					Encryption: &managedenvironments.ManagedEnvironmentPropertiesPeerTrafficConfigurationEncryption{
-						Enabled: pointer.To(state.Mtls),
+						Enabled: pointer.To(peerTrafficEncryptionEnabled(metadata, state)),
 					},
 				}
 			}
@@ -683,3 +694,12 @@ func getSharedKeyForWorkspace(ctx context.Context, meta sdk.ResourceMetaData, wo
 
 	return workspace.Model.Properties.CustomerId, keys.Model.PrimarySharedKey, nil
 }
+
+// peerTrafficEncryptionEnabled returns whether peer traffic encryption should be enabled, which follows `mutual_tls_enabled` unless
+// `peer_traffic_encryption_enabled` is explicitly configured
+func peerTrafficEncryptionEnabled(metadata sdk.ResourceMetaData, model ContainerAppEnvironmentModel) bool {
+	if metadata.ResourceData.GetRawConfig().AsValueMap()["peer_traffic_encryption_enabled"].IsNull() {
+		return model.Mtls
+	}
+	return model.PeerTrafficEncryption
+}
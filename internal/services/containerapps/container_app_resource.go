@@ -42,6 +42,7 @@ type ContainerAppModel struct {
 	Identity             []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
 	WorkloadProfileName  string                                     `tfschema:"workload_profile_name"`
 	MaxInactiveRevisions int64                                      `tfschema:"max_inactive_revisions"`
+	Rollout              []ContainerAppRollout                      `tfschema:"rollout"`
 	Tags                 map[string]interface{}                     `tfschema:"tags"`
 
 	OutboundIpAddresses        []string `tfschema:"outbound_ip_addresses"`
@@ -119,6 +120,8 @@ func (r ContainerAppResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: validation.IntBetween(0, 100),
 		},
 
+		"rollout": ContainerAppRolloutSchema(),
+
 		"tags": commonschema.Tags(),
 	}
 }
@@ -257,7 +260,15 @@ func (r ContainerAppResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading %s: %+v", *id, err)
 			}
 
-			var state ContainerAppModel
+			// `rollout` only controls how updates are applied and isn't returned by the API, so is retained from the state
+			var config ContainerAppModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			state := ContainerAppModel{
+				Rollout: config.Rollout,
+			}
 
 			state.Name = id.ContainerAppName
 			state.ResourceGroup = id.ResourceGroupName
@@ -355,6 +366,7 @@ func (r ContainerAppResource) Update() sdk.ResourceFunc {
 			if model.Properties == nil {
 				return fmt.Errorf("retreiving properties for %s for update: %+v", *id, err)
 			}
+			previousRevision := pointer.From(model.Properties.LatestRevisionName)
 
 			if model.Properties.Configuration == nil {
 				model.Properties.Configuration = &containerapps.Configuration{}
@@ -429,6 +441,16 @@ func (r ContainerAppResource) Update() sdk.ResourceFunc {
 
 			model.Properties.Template = helpers.ExpandContainerAppTemplate(state.Template, metadata)
 
+			// changes to the template create a new revision, which can be rolled out gradually when there's a previous
+			// revision to shift the traffic from
+			if len(state.Rollout) > 0 && metadata.ResourceData.HasChange("template") && previousRevision != "" && model.Properties.Configuration.Ingress != nil {
+				rolloutClient := containerAppRolloutClient{
+					client:         client,
+					revisionClient: metadata.Client.ContainerApps.ContainerAppRevisionClient,
+				}
+				return rolloutClient.run(ctx, *id, *model, previousRevision, state.Rollout[0])
+			}
+
 			if err := client.CreateOrUpdateThenPoll(ctx, *id, *model); err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
@@ -459,6 +481,10 @@ func (r ContainerAppResource) CustomizeDiff() sdk.ResourceFunc {
 				}
 			}
 
+			if err := validateContainerAppRollout(app); err != nil {
+				return err
+			}
+
 			for _, s := range app.Secrets {
 				if s.KeyVaultSecretId != "" && s.Identity == "" {
 					return fmt.Errorf("secret %s must supply identity for key vault secret id", s.Name)
@@ -597,6 +597,42 @@ func TestAccContainerAppResource_maxInactiveRevisionsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccContainerAppResource_rollout(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app", "test")
+	r := ContainerAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rollout(data, "rev1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("latest_revision_name").HasValue(fmt.Sprintf("acctest-capp-%d--rev1", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("rollout"),
+		{
+			Config: r.rollout(data, "rev2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("latest_revision_name").HasValue(fmt.Sprintf("acctest-capp-%d--rev2", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("rollout"),
+	})
+}
+
+func TestAccContainerAppResource_rolloutValidation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app", "test")
+	r := ContainerAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.rolloutSingleRevisionMode(data),
+			ExpectError: regexp.MustCompile("`rollout` can only be specified when `revision_mode` is `Multiple`"),
+		},
+	})
+}
+
 func (r ContainerAppResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := containerapps.ParseContainerAppID(state.ID)
 	if err != nil {
@@ -2958,3 +2994,82 @@ resource "azurerm_container_app" "test" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r ContainerAppResource) rollout(data acceptance.TestData, revisionSuffix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Multiple"
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+
+    revision_suffix = "%[3]s"
+  }
+
+  ingress {
+    allow_insecure_connections = true
+    external_enabled           = true
+    target_port                = 5000
+    transport                  = "http"
+
+    traffic_weight {
+      latest_revision = true
+      percentage      = 100
+    }
+  }
+
+  rollout {
+    traffic_steps            = [25, 50, 100]
+    step_interval_in_seconds = 30
+  }
+}
+`, r.template(data), data.RandomInteger, revisionSuffix)
+}
+
+func (r ContainerAppResource) rolloutSingleRevisionMode(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Single"
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+  }
+
+  ingress {
+    allow_insecure_connections = true
+    external_enabled           = true
+    target_port                = 5000
+    transport                  = "http"
+
+    traffic_weight {
+      latest_revision = true
+      percentage      = 100
+    }
+  }
+
+  rollout {
+    traffic_steps = [50, 100]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappsrevisions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppRollout struct {
+	TrafficSteps                []int64 `tfschema:"traffic_steps"`
+	StepIntervalInSeconds       int64   `tfschema:"step_interval_in_seconds"`
+	DeactivatePreviousRevisions bool    `tfschema:"deactivate_previous_revisions"`
+}
+
+func ContainerAppRolloutSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"traffic_steps": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeInt,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+					Description: "The percentages of traffic to shift to a new revision in each step of the rollout. The values must be increasing and the last value must be `100`.",
+				},
+
+				"step_interval_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      60,
+					ValidateFunc: validation.IntBetween(0, 3600),
+					Description:  "The number of seconds to wait between each step of the rollout, after which the health of the new revision is checked again. Defaults to `60`.",
+				},
+
+				"deactivate_previous_revisions": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Should the revision which previously received the traffic be deactivated once the rollout has completed? Defaults to `true`.",
+				},
+			},
+		},
+	}
+}
+
+// validateContainerAppRollout validates that the rollout can be used with the configuration of the Container App, the
+// traffic is managed by the rollout, so the configured traffic must send all traffic to the latest revision
+func validateContainerAppRollout(app ContainerAppModel) error {
+	if len(app.Rollout) == 0 {
+		return nil
+	}
+	rollout := app.Rollout[0]
+
+	if app.RevisionMode != string(containerapps.ActiveRevisionsModeMultiple) {
+		return fmt.Errorf("`rollout` can only be specified when `revision_mode` is `%s`", containerapps.ActiveRevisionsModeMultiple)
+	}
+
+	if len(app.Ingress) == 0 {
+		return fmt.Errorf("`ingress` must be specified when `rollout` is specified")
+	}
+
+	trafficWeights := app.Ingress[0].TrafficWeights
+	if len(trafficWeights) != 1 || !trafficWeights[0].LatestRevision || trafficWeights[0].Weight != 100 {
+		return fmt.Errorf("when `rollout` is specified `ingress.0.traffic_weight` must contain a single block with `latest_revision` set to `true` and a `percentage` of `100`")
+	}
+
+	previous := int64(0)
+	for i, step := range rollout.TrafficSteps {
+		if step <= previous {
+			return fmt.Errorf("`rollout.0.traffic_steps.%d` must be greater than the previous step, got %d", i, step)
+		}
+		previous = step
+	}
+	if previous != 100 {
+		return fmt.Errorf("the last value of `rollout.0.traffic_steps` must be `100`, got %d", previous)
+	}
+
+	return nil
+}
+
+// containerAppRolloutTraffic returns the traffic weights sending the specified percentage of traffic to the new revision
+// and the remainder to the previous revision
+func containerAppRolloutTraffic(newRevision, previousRevision string, percentage int64) *[]containerapps.TrafficWeight {
+	traffic := []containerapps.TrafficWeight{
+		{
+			RevisionName: pointer.To(newRevision),
+			Weight:       pointer.To(percentage),
+		},
+	}
+
+	if percentage < 100 {
+		traffic = append(traffic, containerapps.TrafficWeight{
+			RevisionName: pointer.To(previousRevision),
+			Weight:       pointer.To(100 - percentage),
+		})
+	}
+
+	return &traffic
+}
+
+type containerAppRolloutClient struct {
+	client         *containerapps.ContainerAppsClient
+	revisionClient *containerappsrevisions.ContainerAppsRevisionsClient
+}
+
+// run creates a new revision of the Container App whilst the traffic stays with the previous revision, then shifts the
+// traffic to the new revision in steps once it's healthy. Should the new revision become unhealthy during the rollout
+// the traffic is returned to the previous revision.
+func (c containerAppRolloutClient) run(ctx context.Context, id containerapps.ContainerAppId, model containerapps.ContainerApp, previousRevision string, rollout ContainerAppRollout) error {
+	configuredTraffic := model.Properties.Configuration.Ingress.Traffic
+
+	model.Properties.Configuration.Ingress.Traffic = &[]containerapps.TrafficWeight{
+		{
+			RevisionName: pointer.To(previousRevision),
+			Weight:       pointer.To(int64(100)),
+		},
+	}
+	if err := c.client.CreateOrUpdateThenPoll(ctx, id, model); err != nil {
+		return fmt.Errorf("creating a new revision for %s: %+v", id, err)
+	}
+
+	existing, err := c.client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	newRevision := ""
+	if existing.Model != nil && existing.Model.Properties != nil {
+		newRevision = pointer.From(existing.Model.Properties.LatestRevisionName)
+	}
+
+	if newRevision == "" || strings.EqualFold(newRevision, previousRevision) {
+		// nothing changed within the scope of a revision, so only the configured traffic needs restoring
+		log.Printf("[DEBUG] no new revision was created for %s, skipping the rollout", id)
+		model.Properties.Configuration.Ingress.Traffic = configuredTraffic
+		if err := c.client.CreateOrUpdateThenPoll(ctx, id, model); err != nil {
+			return fmt.Errorf("updating the traffic for %s: %+v", id, err)
+		}
+		return nil
+	}
+
+	newRevisionId := containerappsrevisions.NewRevisionID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName, newRevision)
+	if err := c.waitForRevisionToBeHealthy(ctx, newRevisionId); err != nil {
+		return c.rollback(ctx, id, model, previousRevision, err)
+	}
+
+	for i, step := range rollout.TrafficSteps {
+		log.Printf("[DEBUG] shifting %d%% of the traffic for %s to %s", step, id, newRevision)
+		model.Properties.Configuration.Ingress.Traffic = containerAppRolloutTraffic(newRevision, previousRevision, step)
+		if step == 100 {
+			model.Properties.Configuration.Ingress.Traffic = configuredTraffic
+		}
+		if err := c.client.CreateOrUpdateThenPoll(ctx, id, model); err != nil {
+			return c.rollback(ctx, id, model, previousRevision, fmt.Errorf("shifting %d%% of the traffic to %s: %+v", step, newRevision, err))
+		}
+
+		if i == len(rollout.TrafficSteps)-1 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return c.rollback(ctx, id, model, previousRevision, fmt.Errorf("waiting to shift more traffic to %s: %+v", newRevision, ctx.Err()))
+		case <-time.After(time.Duration(rollout.StepIntervalInSeconds) * time.Second):
+		}
+
+		if err := c.checkRevisionIsHealthy(ctx, newRevisionId); err != nil {
+			return c.rollback(ctx, id, model, previousRevision, err)
+		}
+	}
+
+	if rollout.DeactivatePreviousRevisions {
+		previousRevisionId := containerappsrevisions.NewRevisionID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName, previousRevision)
+		if _, err := c.revisionClient.DeactivateRevision(ctx, previousRevisionId); err != nil {
+			return fmt.Errorf("deactivating %s: %+v", previousRevisionId, err)
+		}
+	}
+
+	return nil
+}
+
+// rollback returns all traffic to the previous revision, the error which caused the rollback is always returned
+func (c containerAppRolloutClient) rollback(ctx context.Context, id containerapps.ContainerAppId, model containerapps.ContainerApp, previousRevision string, cause error) error {
+	log.Printf("[DEBUG] rolling back the traffic for %s to %s: %+v", id, previousRevision, cause)
+
+	// the rollback should be attempted even when the rollout timed out
+	rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Minute)
+	defer cancel()
+
+	model.Properties.Configuration.Ingress.Traffic = &[]containerapps.TrafficWeight{
+		{
+			RevisionName: pointer.To(previousRevision),
+			Weight:       pointer.To(int64(100)),
+		},
+	}
+	if err := c.client.CreateOrUpdateThenPoll(rollbackCtx, id, model); err != nil {
+		return fmt.Errorf("rolling back the traffic for %s to %s: %+v, the rollout failed: %+v", id, previousRevision, err, cause)
+	}
+
+	return fmt.Errorf("rolling out a new revision for %s, the traffic was returned to %s: %+v", id, previousRevision, cause)
+}
+
+func (c containerAppRolloutClient) waitForRevisionToBeHealthy(ctx context.Context, id containerappsrevisions.RevisionId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Pending"},
+		Target:     []string{"Healthy"},
+		Refresh:    containerAppRevisionHealthRefreshFunc(ctx, c.revisionClient, id),
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to become healthy: %+v", id, err)
+	}
+
+	return nil
+}
+
+func (c containerAppRolloutClient) checkRevisionIsHealthy(ctx context.Context, id containerappsrevisions.RevisionId) error {
+	_, state, err := containerAppRevisionHealthRefreshFunc(ctx, c.revisionClient, id)()
+	if err != nil {
+		return err
+	}
+	if state != "Healthy" {
+		return fmt.Errorf("%s is no longer healthy", id)
+	}
+
+	return nil
+}
+
+func containerAppRevisionHealthRefreshFunc(ctx context.Context, client *containerappsrevisions.ContainerAppsRevisionsClient, id containerappsrevisions.RevisionId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetRevision(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil {
+			return resp, "Pending", nil
+		}
+		props := resp.Model.Properties
+
+		provisioningState := pointer.From(props.ProvisioningState)
+		if provisioningState == containerappsrevisions.RevisionProvisioningStateFailed {
+			return nil, "", fmt.Errorf("provisioning %s failed: %s", id, pointer.From(props.ProvisioningError))
+		}
+
+		runningState := pointer.From(props.RunningState)
+		if runningState == containerappsrevisions.RevisionRunningStateFailed || runningState == containerappsrevisions.RevisionRunningStateDegraded {
+			return nil, "", fmt.Errorf("%s is in the running state %q", id, runningState)
+		}
+
+		healthState := pointer.From(props.HealthState)
+		if healthState == containerappsrevisions.RevisionHealthStateUnhealthy {
+			return nil, "", fmt.Errorf("%s is unhealthy", id)
+		}
+
+		if provisioningState == containerappsrevisions.RevisionProvisioningStateProvisioned && healthState == containerappsrevisions.RevisionHealthStateHealthy {
+			return resp, "Healthy", nil
+		}
+
+		return resp, "Pending", nil
+	}
+}
@@ -0,0 +1,793 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappssessionpools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppSessionPoolResource struct{}
+
+type ContainerAppSessionPoolModel struct {
+	Name                    string                                     `tfschema:"name"`
+	ResourceGroup           string                                     `tfschema:"resource_group_name"`
+	Location                string                                     `tfschema:"location"`
+	ManagedEnvironmentId    string                                     `tfschema:"container_app_environment_id"`
+	ContainerType           string                                     `tfschema:"container_type"`
+	PoolManagementType      string                                     `tfschema:"pool_management_type"`
+	MaxConcurrentSessions   int64                                      `tfschema:"max_concurrent_sessions"`
+	ReadySessionInstances   int64                                      `tfschema:"ready_session_instances"`
+	LifecycleType           string                                     `tfschema:"lifecycle_type"`
+	CooldownPeriodInSeconds int64                                      `tfschema:"cooldown_period_in_seconds"`
+	MaxAlivePeriodInSeconds int64                                      `tfschema:"max_alive_period_in_seconds"`
+	EgressEnabled           bool                                       `tfschema:"egress_enabled"`
+	Containers              []SessionPoolContainer                     `tfschema:"container"`
+	TargetPort              int64                                      `tfschema:"target_port"`
+	Registry                []SessionPoolRegistry                      `tfschema:"registry"`
+	Secrets                 []SessionPoolSecret                        `tfschema:"secret"`
+	Identity                []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Tags                    map[string]interface{}                     `tfschema:"tags"`
+	PoolManagementEndpoint  string                                     `tfschema:"pool_management_endpoint"`
+	NodeCount               int64                                      `tfschema:"node_count"`
+}
+
+type SessionPoolContainer struct {
+	Name    string                    `tfschema:"name"`
+	Image   string                    `tfschema:"image"`
+	CPU     float64                   `tfschema:"cpu"`
+	Memory  string                    `tfschema:"memory"`
+	Command []string                  `tfschema:"command"`
+	Args    []string                  `tfschema:"args"`
+	Env     []SessionPoolContainerEnv `tfschema:"env"`
+}
+
+type SessionPoolContainerEnv struct {
+	Name       string `tfschema:"name"`
+	Value      string `tfschema:"value"`
+	SecretName string `tfschema:"secret_name"`
+}
+
+type SessionPoolRegistry struct {
+	Server             string `tfschema:"server"`
+	Username           string `tfschema:"username"`
+	PasswordSecretName string `tfschema:"password_secret_name"`
+	Identity           string `tfschema:"identity"`
+}
+
+type SessionPoolSecret struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate        = ContainerAppSessionPoolResource{}
+	_ sdk.ResourceWithCustomizeDiff = ContainerAppSessionPoolResource{}
+)
+
+func (r ContainerAppSessionPoolResource) ModelObject() interface{} {
+	return &ContainerAppSessionPoolModel{}
+}
+
+func (r ContainerAppSessionPoolResource) ResourceType() string {
+	return "azurerm_container_app_session_pool"
+}
+
+func (r ContainerAppSessionPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerappssessionpools.ValidateSessionPoolID
+}
+
+func (r ContainerAppSessionPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.SessionPoolName,
+			Description:  "The name for this Session Pool.",
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"container_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(containerappssessionpools.PossibleValuesForContainerType(), false),
+			Description:  "The type of container used by the sessions in this Session Pool. Possible values are `CustomContainer` and `PythonLTS`.",
+		},
+
+		"max_concurrent_sessions": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of sessions which can run concurrently in this Session Pool.",
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+			Description:  "The ID of the Container App Environment to host this Session Pool. Required when `container_type` is `CustomContainer`.",
+		},
+
+		"pool_management_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(containerappssessionpools.PoolManagementTypeDynamic),
+			ValidateFunc: validation.StringInSlice(containerappssessionpools.PossibleValuesForPoolManagementType(), false),
+			Description:  "The management type of this Session Pool. Possible values are `Dynamic` and `Manual`. Defaults to `Dynamic`.",
+		},
+
+		"ready_session_instances": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The number of sessions which are kept ready to be allocated in this Session Pool.",
+		},
+
+		"lifecycle_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(containerappssessionpools.LifecycleTypeTimed),
+			ValidateFunc: validation.StringInSlice(containerappssessionpools.PossibleValuesForLifecycleType(), false),
+			Description:  "How the lifetime of a session is determined. Possible values are `OnContainerExit` and `Timed`. Defaults to `Timed`.",
+		},
+
+		"cooldown_period_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(300, 3600),
+			Description:  "The number of seconds a session can be idle before it's terminated. Can only be specified when `lifecycle_type` is `Timed`.",
+		},
+
+		"max_alive_period_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(300, 21600),
+			Description:  "The maximum number of seconds a session can be alive for. Can only be specified when `lifecycle_type` is `OnContainerExit`.",
+		},
+
+		"egress_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Should the sessions in this Session Pool be allowed outbound network access? Defaults to `false`.",
+		},
+
+		"container": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.ContainerAppContainerName,
+						Description:  "The name of the container.",
+					},
+
+					"image": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The image to use to create the container.",
+					},
+
+					"cpu": {
+						Type:         pluginsdk.TypeFloat,
+						Required:     true,
+						ValidateFunc: validation.FloatAtLeast(0.1),
+						Description:  "The amount of vCPU to allocate to the container.",
+					},
+
+					"memory": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The amount of memory to allocate to the container, for example `0.5Gi`.",
+					},
+
+					"command": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						Description: "A command to pass to the container to override the default.",
+					},
+
+					"args": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						Description: "A list of extra arguments to pass to the container.",
+					},
+
+					"env": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									Description:  "The name of the environment variable.",
+								},
+
+								"value": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									Description:  "The value of the environment variable.",
+								},
+
+								"secret_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validate.SecretName,
+									Description:  "The name of the secret which contains the value of the environment variable.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"target_port": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IsPortNumber,
+			Description:  "The port which the custom container is listening on.",
+		},
+
+		"registry": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"server": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The hostname of the Container Registry.",
+					},
+
+					"username": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						RequiredWith: []string{"registry.0.password_secret_name"},
+						Description:  "The username to use for this Container Registry.",
+					},
+
+					"password_secret_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.SecretName,
+						RequiredWith: []string{"registry.0.username"},
+						Description:  "The name of the secret which contains the password for this Container Registry.",
+					},
+
+					"identity": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ValidateFunc:  validation.StringIsNotEmpty,
+						ConflictsWith: []string{"registry.0.username", "registry.0.password_secret_name"},
+						Description:   "The Resource ID of the User Assigned Managed Identity, or `system` for the System Assigned Managed Identity, to use when pulling from the Container Registry.",
+					},
+				},
+			},
+		},
+
+		"secret": {
+			Type:      pluginsdk.TypeSet,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.SecretName,
+						Description:  "The name of the secret.",
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The value of the secret.",
+					},
+				},
+			},
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"pool_management_endpoint": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The endpoint used to manage the sessions in this Session Pool.",
+		},
+
+		"node_count": {
+			Type:        pluginsdk.TypeInt,
+			Computed:    true,
+			Description: "The number of nodes this Session Pool is using.",
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var pool ContainerAppSessionPoolModel
+			if err := metadata.Decode(&pool); err != nil {
+				return err
+			}
+
+			id := containerappssessionpools.NewSessionPoolID(subscriptionId, pool.ResourceGroup, pool.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			ident, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(pool.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := containerappssessionpools.SessionPool{
+				Location: location.Normalize(pool.Location),
+				Identity: ident,
+				Properties: &containerappssessionpools.SessionPoolProperties{
+					ContainerType:               pointer.To(containerappssessionpools.ContainerType(pool.ContainerType)),
+					CustomContainerTemplate:     expandSessionPoolCustomContainerTemplate(pool),
+					DynamicPoolConfiguration:    expandSessionPoolDynamicPoolConfiguration(pool),
+					PoolManagementType:          pointer.To(containerappssessionpools.PoolManagementType(pool.PoolManagementType)),
+					ScaleConfiguration:          expandSessionPoolScaleConfiguration(pool, metadata),
+					Secrets:                     expandSessionPoolSecrets(pool.Secrets),
+					SessionNetworkConfiguration: expandSessionPoolNetworkConfiguration(pool.EgressEnabled),
+				},
+				Tags: tags.Expand(pool.Tags),
+			}
+
+			if pool.ManagedEnvironmentId != "" {
+				payload.Properties.EnvironmentId = pointer.To(pool.ManagedEnvironmentId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolClient
+
+			id, err := containerappssessionpools.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the values of the secrets aren't returned by the API, so are retained from the state
+			var config ContainerAppSessionPoolModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			state := ContainerAppSessionPoolModel{
+				Name:          id.SessionPoolName,
+				ResourceGroup: id.ResourceGroupName,
+				Secrets:       config.Secrets,
+			}
+
+			if model := existing.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				ident, err := identity.FlattenLegacySystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = ident
+
+				if props := model.Properties; props != nil {
+					if props.EnvironmentId != nil {
+						envId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(*props.EnvironmentId)
+						if err != nil {
+							return err
+						}
+						state.ManagedEnvironmentId = envId.ID()
+					}
+
+					state.ContainerType = string(pointer.From(props.ContainerType))
+					state.PoolManagementType = string(pointer.From(props.PoolManagementType))
+					state.PoolManagementEndpoint = pointer.From(props.PoolManagementEndpoint)
+					state.NodeCount = pointer.From(props.NodeCount)
+
+					if scale := props.ScaleConfiguration; scale != nil {
+						state.MaxConcurrentSessions = pointer.From(scale.MaxConcurrentSessions)
+						state.ReadySessionInstances = pointer.From(scale.ReadySessionInstances)
+					}
+
+					if dynamicPool := props.DynamicPoolConfiguration; dynamicPool != nil && dynamicPool.LifecycleConfiguration != nil {
+						lifecycle := dynamicPool.LifecycleConfiguration
+						state.LifecycleType = string(pointer.From(lifecycle.LifecycleType))
+						state.CooldownPeriodInSeconds = pointer.From(lifecycle.CooldownPeriodInSeconds)
+						state.MaxAlivePeriodInSeconds = pointer.From(lifecycle.MaxAlivePeriodInSeconds)
+					}
+
+					if network := props.SessionNetworkConfiguration; network != nil {
+						state.EgressEnabled = pointer.From(network.Status) == containerappssessionpools.SessionNetworkStatusEgressEnabled
+					}
+
+					if template := props.CustomContainerTemplate; template != nil {
+						state.Containers = flattenSessionPoolContainers(template.Containers)
+						if template.Ingress != nil {
+							state.TargetPort = pointer.From(template.Ingress.TargetPort)
+						}
+						state.Registry = flattenSessionPoolRegistry(template.RegistryCredentials)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolClient
+
+			id, err := containerappssessionpools.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var pool ContainerAppSessionPoolModel
+			if err := metadata.Decode(&pool); err != nil {
+				return err
+			}
+
+			payload := containerappssessionpools.SessionPoolUpdatableProperties{
+				Properties: &containerappssessionpools.SessionPoolUpdatablePropertiesProperties{},
+			}
+
+			if metadata.ResourceData.HasChanges("container", "target_port", "registry") {
+				payload.Properties.CustomContainerTemplate = expandSessionPoolCustomContainerTemplate(pool)
+			}
+
+			if metadata.ResourceData.HasChanges("lifecycle_type", "cooldown_period_in_seconds", "max_alive_period_in_seconds") {
+				payload.Properties.DynamicPoolConfiguration = expandSessionPoolDynamicPoolConfiguration(pool)
+			}
+
+			if metadata.ResourceData.HasChanges("max_concurrent_sessions", "ready_session_instances") {
+				payload.Properties.ScaleConfiguration = expandSessionPoolScaleConfiguration(pool, metadata)
+			}
+
+			if metadata.ResourceData.HasChange("secret") {
+				payload.Properties.Secrets = expandSessionPoolSecrets(pool.Secrets)
+			}
+
+			if metadata.ResourceData.HasChange("egress_enabled") {
+				payload.Properties.SessionNetworkConfiguration = expandSessionPoolNetworkConfiguration(pool.EgressEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				ident, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(pool.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = ident
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.Expand(pool.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolClient
+
+			id, err := containerappssessionpools.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var pool ContainerAppSessionPoolModel
+			if err := metadata.DecodeDiff(&pool); err != nil {
+				return err
+			}
+
+			rd := metadata.ResourceDiff
+			if pool.ContainerType == string(containerappssessionpools.ContainerTypeCustomContainer) {
+				if len(pool.Containers) == 0 {
+					return fmt.Errorf("at least one `container` must be specified when `container_type` is `%s`", containerappssessionpools.ContainerTypeCustomContainer)
+				}
+				if rd.NewValueKnown("container_app_environment_id") && pool.ManagedEnvironmentId == "" {
+					return fmt.Errorf("`container_app_environment_id` must be specified when `container_type` is `%s`", containerappssessionpools.ContainerTypeCustomContainer)
+				}
+			} else if len(pool.Containers) > 0 || pool.TargetPort != 0 || len(pool.Registry) > 0 {
+				return fmt.Errorf("`container`, `target_port` and `registry` can only be specified when `container_type` is `%s`", containerappssessionpools.ContainerTypeCustomContainer)
+			}
+
+			lifecycleType := pool.LifecycleType
+			if lifecycleType == string(containerappssessionpools.LifecycleTypeOnContainerExit) {
+				if v, ok := rd.GetRawConfig().AsValueMap()["cooldown_period_in_seconds"]; ok && !v.IsNull() {
+					return fmt.Errorf("`cooldown_period_in_seconds` can only be specified when `lifecycle_type` is `%s`", containerappssessionpools.LifecycleTypeTimed)
+				}
+			}
+			if lifecycleType == string(containerappssessionpools.LifecycleTypeTimed) {
+				if v, ok := rd.GetRawConfig().AsValueMap()["max_alive_period_in_seconds"]; ok && !v.IsNull() {
+					return fmt.Errorf("`max_alive_period_in_seconds` can only be specified when `lifecycle_type` is `%s`", containerappssessionpools.LifecycleTypeOnContainerExit)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSessionPoolScaleConfiguration(pool ContainerAppSessionPoolModel, metadata sdk.ResourceMetaData) *containerappssessionpools.ScaleConfiguration {
+	output := &containerappssessionpools.ScaleConfiguration{
+		MaxConcurrentSessions: pointer.To(pool.MaxConcurrentSessions),
+	}
+
+	if v, ok := metadata.ResourceData.GetOk("ready_session_instances"); ok {
+		output.ReadySessionInstances = pointer.To(int64(v.(int)))
+	}
+
+	return output
+}
+
+func expandSessionPoolDynamicPoolConfiguration(pool ContainerAppSessionPoolModel) *containerappssessionpools.DynamicPoolConfiguration {
+	lifecycle := &containerappssessionpools.LifecycleConfiguration{
+		LifecycleType: pointer.To(containerappssessionpools.LifecycleType(pool.LifecycleType)),
+	}
+
+	if pool.LifecycleType == string(containerappssessionpools.LifecycleTypeTimed) && pool.CooldownPeriodInSeconds != 0 {
+		lifecycle.CooldownPeriodInSeconds = pointer.To(pool.CooldownPeriodInSeconds)
+	}
+
+	if pool.LifecycleType == string(containerappssessionpools.LifecycleTypeOnContainerExit) && pool.MaxAlivePeriodInSeconds != 0 {
+		lifecycle.MaxAlivePeriodInSeconds = pointer.To(pool.MaxAlivePeriodInSeconds)
+	}
+
+	return &containerappssessionpools.DynamicPoolConfiguration{
+		LifecycleConfiguration: lifecycle,
+	}
+}
+
+func expandSessionPoolNetworkConfiguration(egressEnabled bool) *containerappssessionpools.SessionNetworkConfiguration {
+	status := containerappssessionpools.SessionNetworkStatusEgressDisabled
+	if egressEnabled {
+		status = containerappssessionpools.SessionNetworkStatusEgressEnabled
+	}
+
+	return &containerappssessionpools.SessionNetworkConfiguration{
+		Status: pointer.To(status),
+	}
+}
+
+func expandSessionPoolSecrets(input []SessionPoolSecret) *[]containerappssessionpools.SessionPoolSecret {
+	result := make([]containerappssessionpools.SessionPoolSecret, 0)
+	for _, v := range input {
+		result = append(result, containerappssessionpools.SessionPoolSecret{
+			Name:  pointer.To(v.Name),
+			Value: pointer.To(v.Value),
+		})
+	}
+
+	return &result
+}
+
+func expandSessionPoolCustomContainerTemplate(pool ContainerAppSessionPoolModel) *containerappssessionpools.CustomContainerTemplate {
+	if len(pool.Containers) == 0 {
+		return nil
+	}
+
+	containers := make([]containerappssessionpools.SessionContainer, 0)
+	for _, v := range pool.Containers {
+		container := containerappssessionpools.SessionContainer{
+			Name:  pointer.To(v.Name),
+			Image: pointer.To(v.Image),
+			Resources: &containerappssessionpools.SessionContainerResources{
+				Cpu:    pointer.To(v.CPU),
+				Memory: pointer.To(v.Memory),
+			},
+		}
+
+		if len(v.Command) > 0 {
+			container.Command = pointer.To(v.Command)
+		}
+
+		if len(v.Args) > 0 {
+			container.Args = pointer.To(v.Args)
+		}
+
+		if len(v.Env) > 0 {
+			env := make([]containerappssessionpools.EnvironmentVar, 0)
+			for _, e := range v.Env {
+				envVar := containerappssessionpools.EnvironmentVar{
+					Name: pointer.To(e.Name),
+				}
+				if e.SecretName != "" {
+					envVar.SecretRef = pointer.To(e.SecretName)
+				} else {
+					envVar.Value = pointer.To(e.Value)
+				}
+				env = append(env, envVar)
+			}
+			container.Env = &env
+		}
+
+		containers = append(containers, container)
+	}
+
+	output := &containerappssessionpools.CustomContainerTemplate{
+		Containers: &containers,
+	}
+
+	if pool.TargetPort != 0 {
+		output.Ingress = &containerappssessionpools.SessionIngress{
+			TargetPort: pointer.To(pool.TargetPort),
+		}
+	}
+
+	if len(pool.Registry) > 0 {
+		registry := pool.Registry[0]
+		credentials := &containerappssessionpools.SessionRegistryCredentials{
+			Server: pointer.To(registry.Server),
+		}
+		if registry.Identity != "" {
+			credentials.Identity = pointer.To(registry.Identity)
+		}
+		if registry.Username != "" {
+			credentials.Username = pointer.To(registry.Username)
+			credentials.PasswordSecretRef = pointer.To(registry.PasswordSecretName)
+		}
+		output.RegistryCredentials = credentials
+	}
+
+	return output
+}
+
+func flattenSessionPoolContainers(input *[]containerappssessionpools.SessionContainer) []SessionPoolContainer {
+	result := make([]SessionPoolContainer, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		container := SessionPoolContainer{
+			Name:    pointer.From(v.Name),
+			Image:   pointer.From(v.Image),
+			Command: pointer.From(v.Command),
+			Args:    pointer.From(v.Args),
+		}
+
+		if resources := v.Resources; resources != nil {
+			container.CPU = pointer.From(resources.Cpu)
+			container.Memory = pointer.From(resources.Memory)
+		}
+
+		if v.Env != nil {
+			for _, e := range *v.Env {
+				container.Env = append(container.Env, SessionPoolContainerEnv{
+					Name:       pointer.From(e.Name),
+					Value:      pointer.From(e.Value),
+					SecretName: pointer.From(e.SecretRef),
+				})
+			}
+		}
+
+		result = append(result, container)
+	}
+
+	return result
+}
+
+func flattenSessionPoolRegistry(input *containerappssessionpools.SessionRegistryCredentials) []SessionPoolRegistry {
+	if input == nil || pointer.From(input.Server) == "" {
+		return []SessionPoolRegistry{}
+	}
+
+	return []SessionPoolRegistry{
+		{
+			Server:             pointer.From(input.Server),
+			Username:           pointer.From(input.Username),
+			PasswordSecretName: pointer.From(input.PasswordSecretRef),
+			Identity:           pointer.From(input.Identity),
+		},
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappssessionpools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppSessionPoolResource struct{}
+
+func TestAccContainerAppSessionPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pool_management_endpoint").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppSessionPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppSessionPool_customContainer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("secret"),
+	})
+}
+
+func TestAccContainerAppSessionPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("secret"),
+		{
+			Config: r.customContainerUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("secret"),
+		{
+			Config: r.customContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("secret"),
+	})
+}
+
+func TestAccContainerAppSessionPool_customContainerRequiresContainer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.customContainerWithoutContainer(data),
+			ExpectError: regexp.MustCompile("at least one `container` must be specified when `container_type` is `CustomContainer`"),
+		},
+	})
+}
+
+func (r ContainerAppSessionPoolResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := containerappssessionpools.ParseSessionPoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.SessionPoolClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerAppSessionPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-CAE-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_container_app_session_pool" "test" {
+  name                    = "acctest-csp%[1]d"
+  resource_group_name     = azurerm_resource_group.test.name
+  location                = azurerm_resource_group.test.location
+  container_type          = "PythonLTS"
+  max_concurrent_sessions = 5
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ContainerAppSessionPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_session_pool" "import" {
+  name                    = azurerm_container_app_session_pool.test.name
+  resource_group_name     = azurerm_container_app_session_pool.test.resource_group_name
+  location                = azurerm_container_app_session_pool.test.location
+  container_type          = azurerm_container_app_session_pool.test.container_type
+  max_concurrent_sessions = azurerm_container_app_session_pool.test.max_concurrent_sessions
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppSessionPoolResource) customContainer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_app_session_pool" "test" {
+  name                         = "acctest-csp%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  container_type               = "CustomContainer"
+  max_concurrent_sessions      = 5
+  ready_session_instances      = 1
+  cooldown_period_in_seconds   = 300
+  target_port                  = 5000
+
+  container {
+    name   = "acctest-cont-%[2]d"
+    image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+    cpu    = 0.25
+    memory = "0.5Gi"
+
+    env {
+      name        = "API_KEY"
+      secret_name = "api-key"
+    }
+  }
+
+  secret {
+    name  = "api-key"
+    value = "secret-value"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, ContainerAppEnvironmentResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ContainerAppSessionPoolResource) customContainerUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_app_session_pool" "test" {
+  name                         = "acctest-csp%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  container_type               = "CustomContainer"
+  max_concurrent_sessions      = 10
+  ready_session_instances      = 2
+  cooldown_period_in_seconds   = 600
+  egress_enabled               = true
+  target_port                  = 5000
+
+  container {
+    name    = "acctest-cont-%[2]d"
+    image   = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+    cpu     = 0.5
+    memory  = "1Gi"
+    command = ["python3"]
+    args    = ["app.py"]
+
+    env {
+      name        = "API_KEY"
+      secret_name = "api-key"
+    }
+
+    env {
+      name  = "MODE"
+      value = "test"
+    }
+  }
+
+  secret {
+    name  = "api-key"
+    value = "updated-secret-value"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    ENV = "Test2"
+  }
+}
+`, ContainerAppEnvironmentResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ContainerAppSessionPoolResource) customContainerWithoutContainer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_app_session_pool" "test" {
+  name                         = "acctest-csp%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  container_type               = "CustomContainer"
+  max_concurrent_sessions      = 5
+}
+`, ContainerAppEnvironmentResource{}.basic(data), data.RandomInteger)
+}
@@ -436,3 +436,54 @@ func TestValidateContainerAppContainerName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateSessionPoolName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+		},
+		{
+			Input: "a",
+		},
+		{
+			Input: "9a",
+		},
+		{
+			Input: "a-",
+		},
+		{
+			Input: "a--a",
+		},
+		{
+			Input: "Cannothavecapitals",
+		},
+		{
+			Input: "invalid123456789012345678901234567",
+		},
+		{
+			Input: "a9",
+			Valid: true,
+		},
+		{
+			Input: "val-1-id",
+			Valid: true,
+		},
+		{
+			Input: "valid12345678901234567890123456",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := SessionPoolName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %s: %+v", tc.Valid, valid, tc.Input, errors)
+		}
+	}
+}
@@ -0,0 +1,450 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/tasks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// containerRegistryPurgeTaskTimerTriggerName is the name of the timer trigger which runs the purge on the configured schedule
+const containerRegistryPurgeTaskTimerTriggerName = "purge"
+
+type ContainerRegistryPurgeTaskResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContainerRegistryPurgeTaskResource{}
+
+type ContainerRegistryPurgeTaskModel struct {
+	Name                string                             `tfschema:"name"`
+	ContainerRegistryId string                             `tfschema:"container_registry_id"`
+	Filter              []ContainerRegistryPurgeTaskFilter `tfschema:"filter"`
+	Ago                 string                             `tfschema:"ago"`
+	Keep                int64                              `tfschema:"keep"`
+	UntaggedEnabled     bool                               `tfschema:"untagged_enabled"`
+	DryRunEnabled       bool                               `tfschema:"dry_run_enabled"`
+	Concurrency         int64                              `tfschema:"concurrency"`
+	Schedule            string                             `tfschema:"schedule"`
+	Enabled             bool                               `tfschema:"enabled"`
+	AgentPoolName       string                             `tfschema:"agent_pool_name"`
+	TimeoutInSec        int64                              `tfschema:"timeout_in_seconds"`
+	Tags                map[string]string                  `tfschema:"tags"`
+}
+
+type ContainerRegistryPurgeTaskFilter struct {
+	Repository string `tfschema:"repository"`
+	Tag        string `tfschema:"tag"`
+}
+
+func (r ContainerRegistryPurgeTaskResource) Arguments() map[string]*pluginsdk.Schema {
+	// the filters are rendered into a single quoted argument of the `acr purge` command
+	filterValidation := validation.All(
+		validation.StringIsNotEmpty,
+		validation.StringDoesNotContainAny("' \t\r\n"),
+	)
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryTaskName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"filter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"repository": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: filterValidation,
+					},
+
+					"tag": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      ".*",
+						ValidateFunc: filterValidation,
+					},
+				},
+			},
+		},
+
+		"ago": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ContainerRegistryPurgeAgo,
+		},
+
+		"schedule": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"agent_pool_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"concurrency": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 32),
+		},
+
+		"dry_run_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"keep": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"timeout_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(300, 28800),
+			Default:      3600,
+		},
+
+		"untagged_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerRegistryPurgeTaskResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryPurgeTaskResource) ResourceType() string {
+	return "azurerm_container_registry_purge_task"
+}
+
+func (r ContainerRegistryPurgeTaskResource) ModelObject() interface{} {
+	return &ContainerRegistryPurgeTaskModel{}
+}
+
+func (r ContainerRegistryPurgeTaskResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return tasks.ValidateTaskID
+}
+
+func (r ContainerRegistryPurgeTaskResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2019_06_01_preview.Tasks
+			registryClient := metadata.Client.Containers.ContainerRegistryClient.Registries
+
+			var model ContainerRegistryPurgeTaskModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			registry, err := registryClient.Get(ctx, *registryId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", registryId, err)
+			}
+			if registry.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", registryId)
+			}
+
+			id := tasks.NewTaskID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// The location of the task must be the same as the registry, otherwise the API will raise error complaining can't find the registry.
+			params := expandContainerRegistryPurgeTask(model, location.Normalize(registry.Model.Location))
+			if err := client.CreateThenPoll(ctx, id, params); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryPurgeTaskResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2019_06_01_preview.Tasks
+
+			id, err := tasks.ParseTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			task, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(task.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ContainerRegistryPurgeTaskModel{
+				Name:                id.TaskName,
+				ContainerRegistryId: registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+			}
+
+			if model := task.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.AgentPoolName = pointer.From(props.AgentPoolName)
+					state.Enabled = pointer.From(props.Status) == tasks.TaskStatusEnabled
+					state.TimeoutInSec = pointer.From(props.Timeout)
+
+					if step, ok := props.Step.(tasks.EncodedTaskStep); ok {
+						flattenContainerRegistryPurgeTaskCommand(step.EncodedTaskContent, &state)
+					}
+
+					if trigger := props.Trigger; trigger != nil && trigger.TimerTriggers != nil {
+						for _, timer := range *trigger.TimerTriggers {
+							if timer.Name == containerRegistryPurgeTaskTimerTriggerName {
+								state.Schedule = timer.Schedule
+							}
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerRegistryPurgeTaskResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2019_06_01_preview.Tasks
+
+			id, err := tasks.ParseTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			var model ContainerRegistryPurgeTaskModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the whole task is generated from the configuration, so it's sent in full rather than patched
+			params := expandContainerRegistryPurgeTask(model, location.Normalize(existing.Model.Location))
+			params.Identity = existing.Model.Identity
+			if err := client.CreateThenPoll(ctx, *id, params); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryPurgeTaskResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2019_06_01_preview.Tasks
+
+			id, err := tasks.ParseTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerRegistryPurgeTask(model ContainerRegistryPurgeTaskModel, location string) tasks.Task {
+	taskStatus := tasks.TaskStatusDisabled
+	triggerStatus := tasks.TriggerStatusDisabled
+	if model.Enabled {
+		taskStatus = tasks.TaskStatusEnabled
+		triggerStatus = tasks.TriggerStatusEnabled
+	}
+
+	task := tasks.Task{
+		Location: location,
+		Properties: &tasks.TaskProperties{
+			Platform: &tasks.PlatformProperties{
+				Os:           tasks.OSLinux,
+				Architecture: pointer.To(tasks.ArchitectureAmdSixFour),
+			},
+			Step: tasks.EncodedTaskStep{
+				EncodedTaskContent: base64.StdEncoding.EncodeToString([]byte(expandContainerRegistryPurgeTaskContent(model))),
+			},
+			Trigger: &tasks.TriggerProperties{
+				TimerTriggers: &[]tasks.TimerTrigger{
+					{
+						Name:     containerRegistryPurgeTaskTimerTriggerName,
+						Schedule: model.Schedule,
+						Status:   pointer.To(triggerStatus),
+					},
+				},
+			},
+			Status:  pointer.To(taskStatus),
+			Timeout: pointer.To(model.TimeoutInSec),
+		},
+		Tags: pointer.To(model.Tags),
+	}
+
+	if model.AgentPoolName != "" {
+		task.Properties.AgentPoolName = pointer.To(model.AgentPoolName)
+	}
+
+	return task
+}
+
+func expandContainerRegistryPurgeTaskCommand(model ContainerRegistryPurgeTaskModel) string {
+	args := []string{"acr", "purge"}
+	for _, filter := range model.Filter {
+		args = append(args, "--filter", fmt.Sprintf("'%s:%s'", filter.Repository, filter.Tag))
+	}
+	args = append(args, "--ago", model.Ago)
+
+	if model.UntaggedEnabled {
+		args = append(args, "--untagged")
+	}
+	if model.Keep > 0 {
+		args = append(args, "--keep", strconv.FormatInt(model.Keep, 10))
+	}
+	if model.Concurrency > 0 {
+		args = append(args, "--concurrency", strconv.FormatInt(model.Concurrency, 10))
+	}
+	if model.DryRunEnabled {
+		args = append(args, "--dry-run")
+	}
+
+	return strings.Join(args, " ")
+}
+
+// expandContainerRegistryPurgeTaskContent builds the task definition in the same shape as `az acr task create --cmd` does
+func expandContainerRegistryPurgeTaskContent(model ContainerRegistryPurgeTaskModel) string {
+	return fmt.Sprintf(`version: v1.1.0
+steps:
+  - cmd: %s
+    disableWorkingDirectoryOverride: true
+    timeout: %d
+`, expandContainerRegistryPurgeTaskCommand(model), model.TimeoutInSec)
+}
+
+// flattenContainerRegistryPurgeTaskCommand parses the `acr purge` command out of the task definition, so that any changes made
+// outside of Terraform are detected. Arguments which aren't recognised are ignored, leaving the related fields empty.
+func flattenContainerRegistryPurgeTaskCommand(encodedContent string, state *ContainerRegistryPurgeTaskModel) {
+	content := encodedContent
+	if decoded, err := base64.StdEncoding.DecodeString(encodedContent); err == nil {
+		content = string(decoded)
+	}
+
+	var command string
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "-"))
+		if v, ok := strings.CutPrefix(line, "cmd:"); ok && strings.HasPrefix(strings.TrimSpace(v), "acr purge") {
+			command = strings.TrimSpace(v)
+			break
+		}
+	}
+
+	args := strings.Fields(strings.TrimPrefix(command, "acr purge"))
+	filters := make([]ContainerRegistryPurgeTaskFilter, 0)
+	for i := 0; i < len(args); i++ {
+		value := ""
+		if i+1 < len(args) {
+			value = args[i+1]
+		}
+
+		switch args[i] {
+		case "--filter":
+			repository, tag, _ := strings.Cut(strings.Trim(value, `'"`), ":")
+			filters = append(filters, ContainerRegistryPurgeTaskFilter{
+				Repository: repository,
+				Tag:        tag,
+			})
+			i++
+		case "--ago":
+			state.Ago = value
+			i++
+		case "--keep":
+			state.Keep, _ = strconv.ParseInt(value, 10, 64)
+			i++
+		case "--concurrency":
+			state.Concurrency, _ = strconv.ParseInt(value, 10, 64)
+			i++
+		case "--untagged":
+			state.UntaggedEnabled = true
+		case "--dry-run":
+			state.DryRunEnabled = true
+		}
+	}
+	state.Filter = filters
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/tasks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerRegistryPurgeTaskResource struct{}
+
+func TestAccContainerRegistryPurgeTask_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_purge_task", "test")
+	r := ContainerRegistryPurgeTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryPurgeTask_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_purge_task", "test")
+	r := ContainerRegistryPurgeTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryPurgeTask_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_purge_task", "test")
+	r := ContainerRegistryPurgeTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryPurgeTask_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_purge_task", "test")
+	r := ContainerRegistryPurgeTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerRegistryPurgeTaskResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := tasks.ParseTaskID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.ContainerRegistryClient_v2019_06_01_preview.Tasks.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerRegistryPurgeTaskResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_purge_task" "test" {
+  name                  = "testacccrPurge%d"
+  container_registry_id = azurerm_container_registry.test.id
+  ago                   = "7d"
+  schedule              = "0 1 * * Sun"
+
+  filter {
+    repository = "hello-world"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryPurgeTaskResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_purge_task" "import" {
+  name                  = azurerm_container_registry_purge_task.test.name
+  container_registry_id = azurerm_container_registry_purge_task.test.container_registry_id
+  ago                   = azurerm_container_registry_purge_task.test.ago
+  schedule              = azurerm_container_registry_purge_task.test.schedule
+
+  filter {
+    repository = "hello-world"
+  }
+}
+`, r.basic(data))
+}
+
+func (r ContainerRegistryPurgeTaskResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_purge_task" "test" {
+  name                  = "testacccrPurge%d"
+  container_registry_id = azurerm_container_registry.test.id
+  ago                   = "2d3h6m"
+  schedule              = "0 */6 * * *"
+  concurrency           = 8
+  dry_run_enabled       = true
+  enabled               = false
+  keep                  = 3
+  timeout_in_seconds    = 1800
+  untagged_enabled      = true
+
+  filter {
+    repository = "hello-world"
+    tag        = "^1\\..*"
+  }
+
+  filter {
+    repository = "samples/.*"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryPurgeTaskResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ACRTask-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccrpurge%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
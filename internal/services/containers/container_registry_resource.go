@@ -214,6 +214,12 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(0, 365),
 			},
 
+			"soft_delete_policy_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 90),
+			},
+
 			"trust_policy_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -296,6 +302,11 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 				return fmt.Errorf("ACR retention policy can only be applied when using the Premium Sku. If you are downgrading from a Premium SKU please unset `retention_policy_in_days`")
 			}
 
+			// soft delete isn't supported for geo-replicated registries
+			if softDeleteInDays := d.Get("soft_delete_policy_in_days").(int); softDeleteInDays > 0 && len(geoReplications) > 0 {
+				return fmt.Errorf("ACR soft delete policy cannot be applied to a geo-replicated Container Registry. Please unset `soft_delete_policy_in_days` or remove the `georeplications`")
+			}
+
 			trustPolicyEnabled, ok := d.GetOk("trust_policy_enabled")
 			if ok && trustPolicyEnabled.(bool) && !strings.EqualFold(sku, string(registries.SkuNamePremium)) {
 				return fmt.Errorf("ACR trust policy can only be applied when using the Premium Sku. If you are downgrading from a Premium SKU please unset `trust_policy_enabled` or set `trust_policy_enabled = false`")
@@ -423,6 +434,12 @@ func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}
 		retentionPolicy.Status = pointer.To(registries.PolicyStatusEnabled)
 	}
 
+	softDeletePolicy := &registries.SoftDeletePolicy{}
+	if v, ok := d.GetOk("soft_delete_policy_in_days"); ok && v.(int) > 0 {
+		softDeletePolicy.RetentionDays = pointer.To(int64(v.(int)))
+		softDeletePolicy.Status = pointer.To(registries.PolicyStatusEnabled)
+	}
+
 	trustPolicy := &registries.TrustPolicy{}
 	if v, ok := d.GetOk("trust_policy_enabled"); ok && v.(bool) {
 		trustPolicy.Status = pointer.To(registries.PolicyStatusEnabled)
@@ -442,6 +459,7 @@ func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}
 			Policies: &registries.Policies{
 				QuarantinePolicy: expandQuarantinePolicy(d.Get("quarantine_policy_enabled").(bool)),
 				RetentionPolicy:  retentionPolicy,
+				SoftDeletePolicy: softDeletePolicy,
 				TrustPolicy:      trustPolicy,
 				ExportPolicy:     expandExportPolicy(d.Get("export_policy_enabled").(bool)),
 			},
@@ -543,7 +561,7 @@ func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}
 		"export_policy_enabled",
 	}
 
-	policyKeys = append(policyKeys, []string{"retention_policy_in_days", "trust_policy_enabled"}...)
+	policyKeys = append(policyKeys, []string{"retention_policy_in_days", "soft_delete_policy_in_days", "trust_policy_enabled"}...)
 
 	if d.HasChanges(policyKeys...) {
 		payload.Properties.Policies = &registries.Policies{}
@@ -562,6 +580,19 @@ func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if d.HasChange("soft_delete_policy_in_days") {
+		payload.Properties.Policies.SoftDeletePolicy = &registries.SoftDeletePolicy{
+			Status: pointer.To(registries.PolicyStatusDisabled),
+		}
+
+		if v := d.Get("soft_delete_policy_in_days").(int); v != 0 {
+			payload.Properties.Policies.SoftDeletePolicy = &registries.SoftDeletePolicy{
+				Status:        pointer.To(registries.PolicyStatusEnabled),
+				RetentionDays: pointer.To(int64(v)),
+			}
+		}
+	}
+
 	if d.HasChange("trust_policy_enabled") {
 		payload.Properties.Policies.TrustPolicy = &registries.TrustPolicy{
 			Status: pointer.To(registries.PolicyStatusDisabled),
@@ -866,6 +897,13 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 				}
 				d.Set("retention_policy_in_days", retentionInDays)
 
+				// the API returns the default number of retention days even when soft delete is disabled
+				var softDeleteInDays int64
+				if policies.SoftDeletePolicy != nil && policies.SoftDeletePolicy.Status != nil && *policies.SoftDeletePolicy.Status == registries.PolicyStatusEnabled {
+					softDeleteInDays = pointer.From(policies.SoftDeletePolicy.RetentionDays)
+				}
+				d.Set("soft_delete_policy_in_days", softDeleteInDays)
+
 				if policies.TrustPolicy != nil && policies.TrustPolicy.Status != nil {
 					policyEnabled := *policies.TrustPolicy.Status == registries.PolicyStatusEnabled
 					d.Set("trust_policy_enabled", policyEnabled)
@@ -330,6 +330,38 @@ func TestAccContainerRegistry_anonymousPull(t *testing.T) {
 	})
 }
 
+func TestAccContainerRegistry_softDeletePolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry", "test")
+	r := ContainerRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.softDeletePolicy(data, 7),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("soft_delete_policy_in_days").HasValue("7"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.softDeletePolicy(data, 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("soft_delete_policy_in_days").HasValue("30"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basicManaged(data, "Standard"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("soft_delete_policy_in_days").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccContainerRegistry_dataEndpoint(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_registry", "test")
 	r := ContainerRegistryResource{}
@@ -852,6 +884,27 @@ resource "azurerm_container_registry" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, enabled)
 }
 
+func (ContainerRegistryResource) softDeletePolicy(data acceptance.TestData, days int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                       = "testacccr%d"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  sku                        = "Standard"
+  soft_delete_policy_in_days = %d
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, days)
+}
+
 func (ContainerRegistryResource) dataEndpointPremium(data acceptance.TestData, enabled bool) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const kubernetesClusterControlPlaneLoggingDefaultName = "aks-control-plane-logging"
+
+func kubernetesClusterControlPlaneLoggingSchema() *pluginsdk.Schema {
+	destinations := []string{
+		"control_plane_logging.0.log_analytics_workspace_id",
+		"control_plane_logging.0.storage_account_id",
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"log_categories": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      kubernetesClusterControlPlaneLoggingDefaultName,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"log_analytics_workspace_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: workspaces.ValidateWorkspaceID,
+					AtLeastOneOf: destinations,
+				},
+
+				"metrics_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"storage_account_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: commonids.ValidateStorageAccountID,
+					AtLeastOneOf: destinations,
+				},
+			},
+		},
+	}
+}
+
+func kubernetesClusterControlPlaneLoggingName(input []interface{}) string {
+	if len(input) == 0 || input[0] == nil {
+		return kubernetesClusterControlPlaneLoggingDefaultName
+	}
+
+	return input[0].(map[string]interface{})["name"].(string)
+}
+
+func expandKubernetesClusterControlPlaneLogging(input []interface{}) diagnosticsettings.DiagnosticSettingsResource {
+	config := input[0].(map[string]interface{})
+
+	logs := make([]diagnosticsettings.LogSettings, 0)
+	for _, category := range config["log_categories"].(*pluginsdk.Set).List() {
+		logs = append(logs, diagnosticsettings.LogSettings{
+			Category: pointer.To(category.(string)),
+			Enabled:  true,
+		})
+	}
+
+	properties := diagnosticsettings.DiagnosticSettings{
+		Logs: &logs,
+		Metrics: &[]diagnosticsettings.MetricSettings{
+			{
+				Category: pointer.To("AllMetrics"),
+				Enabled:  config["metrics_enabled"].(bool),
+			},
+		},
+	}
+
+	if v := config["log_analytics_workspace_id"].(string); v != "" {
+		properties.WorkspaceId = pointer.To(v)
+	}
+	if v := config["storage_account_id"].(string); v != "" {
+		properties.StorageAccountId = pointer.To(v)
+	}
+
+	return diagnosticsettings.DiagnosticSettingsResource{
+		Properties: &properties,
+	}
+}
+
+func flattenKubernetesClusterControlPlaneLogging(name string, input *diagnosticsettings.DiagnosticSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	logCategories := make([]interface{}, 0)
+	if input.Logs != nil {
+		for _, v := range *input.Logs {
+			if v.Enabled && v.Category != nil {
+				logCategories = append(logCategories, *v.Category)
+			}
+		}
+	}
+
+	metricsEnabled := false
+	if input.Metrics != nil {
+		for _, v := range *input.Metrics {
+			if v.Enabled && pointer.From(v.Category) == "AllMetrics" {
+				metricsEnabled = true
+			}
+		}
+	}
+
+	workspaceId := ""
+	if v := pointer.From(input.WorkspaceId); v != "" {
+		if parsed, err := workspaces.ParseWorkspaceIDInsensitively(v); err == nil {
+			workspaceId = parsed.ID()
+		}
+	}
+
+	storageAccountId := ""
+	if v := pointer.From(input.StorageAccountId); v != "" {
+		if parsed, err := commonids.ParseStorageAccountIDInsensitively(v); err == nil {
+			storageAccountId = parsed.ID()
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"log_categories":             logCategories,
+			"name":                       name,
+			"log_analytics_workspace_id": workspaceId,
+			"metrics_enabled":            metricsEnabled,
+			"storage_account_id":         storageAccountId,
+		},
+	}
+}
+
+func createOrUpdateKubernetesClusterControlPlaneLogging(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, id commonids.KubernetesClusterId, input []interface{}) error {
+	settingId := diagnosticsettings.NewScopedDiagnosticSettingID(id.ID(), kubernetesClusterControlPlaneLoggingName(input))
+	if _, err := client.CreateOrUpdate(ctx, settingId, expandKubernetesClusterControlPlaneLogging(input)); err != nil {
+		return fmt.Errorf("creating/updating Control Plane Logging %s: %+v", settingId, err)
+	}
+
+	// the Diagnostic Settings API is eventually consistent, so wait for the setting to be returned consistently
+	return waitForKubernetesClusterControlPlaneLoggingState(ctx, client, settingId, "NotFound", "Exists")
+}
+
+func deleteKubernetesClusterControlPlaneLogging(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, id commonids.KubernetesClusterId, name string) error {
+	settingId := diagnosticsettings.NewScopedDiagnosticSettingID(id.ID(), name)
+	if resp, err := client.Delete(ctx, settingId); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("deleting Control Plane Logging %s: %+v", settingId, err)
+	}
+
+	return waitForKubernetesClusterControlPlaneLoggingState(ctx, client, settingId, "Exists", "NotFound")
+}
+
+func waitForKubernetesClusterControlPlaneLoggingState(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, id diagnosticsettings.ScopedDiagnosticSettingId, pending string, target string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	log.Printf("[DEBUG] Waiting for Control Plane Logging %s to be %q", id, target)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:                   []string{pending},
+		Target:                    []string{target},
+		Refresh:                   kubernetesClusterControlPlaneLoggingRefreshFunc(ctx, client, id),
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Timeout:                   time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for Control Plane Logging %s to be %q: %+v", id, target, err)
+	}
+
+	return nil
+}
+
+func kubernetesClusterControlPlaneLoggingRefreshFunc(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, id diagnosticsettings.ScopedDiagnosticSettingId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return "NotFound", "NotFound", nil
+			}
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		return resp, "Exists", nil
+	}
+}
@@ -81,6 +81,40 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("upgrade_settings.0.drain_timeout_in_minutes", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old != 0 && new == 0
 			}),
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				return validateNodePoolScaleDownMode(d.Get("scale_down_mode").(string), d.Get("os_disk_type").(string), d.Get("priority").(string))
+			},
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				nodePublicIPEnabled := d.Get("node_public_ip_enabled").(bool)
+				if err := validateNodePoolNetworkProfile(d.Get("node_network_profile").([]interface{}), nodePublicIPEnabled, ""); err != nil {
+					return err
+				}
+
+				if !nodePublicIPEnabled || (d.Id() != "" && !d.HasChange("node_public_ip_enabled")) || !d.NewValueKnown("kubernetes_cluster_id") {
+					return nil
+				}
+
+				clusterId, err := commonids.ParseKubernetesClusterID(d.Get("kubernetes_cluster_id").(string))
+				if err != nil {
+					return err
+				}
+
+				cluster, err := meta.(*clients.Client).Containers.KubernetesClustersClient.Get(ctx, *clusterId)
+				if err != nil {
+					// the Cluster may not exist yet when it's created within the same apply
+					if response.WasNotFound(cluster.HttpResponse) {
+						return nil
+					}
+					return fmt.Errorf("retrieving %s: %+v", *clusterId, err)
+				}
+
+				if model := cluster.Model; model != nil && model.Properties != nil && model.Properties.NetworkProfile != nil {
+					profile := model.Properties.NetworkProfile
+					warnNodePoolPublicIPWithLoadBalancerOutbound(d.Get("name").(string), string(pointer.From(profile.OutboundType)), string(pointer.From(profile.LoadBalancerSku)))
+				}
+
+				return nil
+			},
 		),
 	}
 
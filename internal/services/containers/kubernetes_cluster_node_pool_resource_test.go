@@ -6,6 +6,7 @@ package containers_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -947,6 +948,25 @@ func TestAccKubernetesClusterNodePool_networkProfileComplete(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesClusterNodePool_networkProfileHostPortRanges(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.networkProfileHostPortRanges(data, 7000, 7500),
+			ExpectError: regexp.MustCompile("overlaps with another `allowed_host_ports` range"),
+		},
+		{
+			Config: r.networkProfileHostPortRanges(data, 8001, 8500),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKubernetesClusterNodePool_networkProfileUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
 	r := KubernetesClusterNodePoolResource{}
@@ -2895,6 +2915,61 @@ resource "azurerm_kubernetes_cluster_node_pool" "test" {
  `, data.Locations.Primary, data.RandomInteger)
 }
 
+func (KubernetesClusterNodePoolResource) networkProfileHostPortRanges(data acceptance.TestData, udpPortStart, udpPortEnd int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%[2]d"
+  location = "%[1]s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%[2]d"
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_D2s_v3"
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                   = "gameserver"
+  kubernetes_cluster_id  = azurerm_kubernetes_cluster.test.id
+  vm_size                = "Standard_D2s_v3"
+  node_public_ip_enabled = true
+  node_network_profile {
+    allowed_host_ports {
+      port_start = 7000
+      port_end   = 8000
+      protocol   = "UDP"
+    }
+    allowed_host_ports {
+      port_start = 7000
+      port_end   = 8000
+      protocol   = "TCP"
+    }
+    allowed_host_ports {
+      port_start = %[3]d
+      port_end   = %[4]d
+      protocol   = "UDP"
+    }
+  }
+}
+`, data.Locations.Primary, data.RandomInteger, udpPortStart, udpPortEnd)
+}
+
 func (KubernetesClusterNodePoolResource) snapshotSource(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
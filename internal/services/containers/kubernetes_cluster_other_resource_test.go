@@ -6,6 +6,7 @@ package containers_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -322,6 +323,18 @@ func TestAccKubernetesCluster_scaleDownMode(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCluster_scaleDownModeDeallocateWithEphemeralOSDisk(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.scaleDownModeEphemeralOSDisk(data, "Deallocate"),
+			ExpectError: regexp.MustCompile("`scale_down_mode` cannot be set to `Deallocate` when `os_disk_type` is `Ephemeral`"),
+		},
+	})
+}
+
 func TestAccKubernetesCluster_tags(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
 	r := KubernetesClusterResource{}
@@ -1124,6 +1137,92 @@ func TestAccKubernetesCluster_costAnalysis(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCluster_controlPlaneLogging(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.controlPlaneLogging(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// the Diagnostic Setting is only read when it's tracked in the state, so isn't imported
+		data.ImportStep("control_plane_logging"),
+		{
+			Config: r.controlPlaneLoggingUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// the Diagnostic Setting is only read when it's tracked in the state, so isn't imported
+		data.ImportStep("control_plane_logging"),
+		{
+			Config: r.controlPlaneLoggingTemplate(data, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("control_plane_logging.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesCluster_waitForHealthy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.waitForHealthy(data, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("wait_for_healthy"),
+		{
+			Config: r.waitForHealthy(data, `pod_label_selectors = ["k8s-app=kube-dns", "component=konnectivity-agent"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("wait_for_healthy"),
+	})
+}
+
+func TestAccKubernetesCluster_powerState(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.powerState(data, "Stopped"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("power_state").HasValue("Stopped"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.powerState(data, "Running"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("power_state").HasValue("Running"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.powerState(data, "Stopped"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("power_state").HasValue("Stopped"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKubernetesCluster_VMSizeOmitted(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
 	r := KubernetesClusterResource{}
@@ -2786,6 +2885,41 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, ultraSSDEnabled)
 }
 
+func (KubernetesClusterResource) scaleDownModeEphemeralOSDisk(data acceptance.TestData, scaleDownMode string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name            = "default"
+    node_count      = 1
+    vm_size         = "Standard_DS3_v2"
+    os_disk_type    = "Ephemeral"
+    scale_down_mode = "%s"
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, scaleDownMode)
+}
+
 func (KubernetesClusterResource) scaleDownMode(data acceptance.TestData, scaleDownMode string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -3511,6 +3645,76 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, data.Locations.Primary, data.RandomInteger, enabled)
 }
 
+func (r KubernetesClusterResource) controlPlaneLogging(data acceptance.TestData) string {
+	return r.controlPlaneLoggingTemplate(data, `
+  control_plane_logging {
+    log_categories             = ["kube-apiserver", "kube-audit-admin"]
+    log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  }
+`)
+}
+
+func (r KubernetesClusterResource) controlPlaneLoggingUpdated(data acceptance.TestData) string {
+	return r.controlPlaneLoggingTemplate(data, `
+  control_plane_logging {
+    name                       = "acctest-control-plane"
+    log_categories             = ["kube-apiserver", "kube-audit", "kube-controller-manager", "kube-scheduler"]
+    metrics_enabled            = true
+    log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+    storage_account_id         = azurerm_storage_account.test.id
+  }
+`)
+}
+
+func (KubernetesClusterResource) controlPlaneLoggingTemplate(data acceptance.TestData, controlPlaneLogging string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%[2]d"
+  location = "%[1]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  location                 = azurerm_resource_group.test.location
+  resource_group_name      = azurerm_resource_group.test.name
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%[2]d"
+
+  default_node_pool {
+    name       = "default"
+    vm_size    = "Standard_DS2_v2"
+    node_count = 1
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+%[4]s
+}
+`, data.Locations.Primary, data.RandomInteger, data.RandomString, controlPlaneLogging)
+}
+
 func (KubernetesClusterResource) VMSizeOmitted(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -3575,3 +3779,74 @@ resource "azurerm_kubernetes_cluster" "test" {
 }
 `, data.Locations.Primary, data.RandomInteger, certsString)
 }
+
+func (KubernetesClusterResource) waitForHealthy(data acceptance.TestData, podLabelSelectors string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%[1]d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  wait_for_healthy {
+    %[3]s
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, podLabelSelectors)
+}
+
+func (KubernetesClusterResource) powerState(data acceptance.TestData, powerState string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%[1]d"
+  power_state         = "%[3]s"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, powerState)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-02-01/maintenanceconfigurations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-02-01/managedclusters"
 	dnsValidate "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatezones"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -94,6 +95,32 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("network_profile.0.network_data_plane", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old != ""
 			}),
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if _, ok := d.GetOk("default_node_pool"); !ok {
+					return nil
+				}
+
+				return validateNodePoolScaleDownMode(d.Get("default_node_pool.0.scale_down_mode").(string), d.Get("default_node_pool.0.os_disk_type").(string), "")
+			},
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if _, ok := d.GetOk("default_node_pool"); !ok {
+					return nil
+				}
+
+				nodePublicIPEnabled := d.Get("default_node_pool.0.node_public_ip_enabled").(bool)
+				if nodePublicIPEnabled {
+					// when the `network_profile` block is omitted the Cluster uses a Standard Load Balancer for outbound traffic
+					outboundType := string(managedclusters.OutboundTypeLoadBalancer)
+					loadBalancerSku := string(managedclusters.LoadBalancerSkuStandard)
+					if _, ok := d.GetOk("network_profile"); ok {
+						outboundType = d.Get("network_profile.0.outbound_type").(string)
+						loadBalancerSku = d.Get("network_profile.0.load_balancer_sku").(string)
+					}
+					warnNodePoolPublicIPWithLoadBalancerOutbound(d.Get("default_node_pool.0.name").(string), outboundType, loadBalancerSku)
+				}
+
+				return validateNodePoolNetworkProfile(d.Get("default_node_pool.0.node_network_profile").([]interface{}), nodePublicIPEnabled, "default_node_pool.0.")
+			},
 			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				if d.HasChange("oidc_issuer_enabled") {
 					d.SetNewComputed("oidc_issuer_url")
@@ -113,6 +140,17 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				// Once it is GA, an additional logic is needed to handle the uninstallation of network policy.
 				return old.(string) != ""
 			}),
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				selectors := d.Get("wait_for_healthy.0.pod_label_selectors").([]interface{})
+				if len(selectors) == 0 {
+					return nil
+				}
+				// the Pods are checked using the Run Command API, which authenticates using the Cluster's local accounts
+				if !d.Get("run_command_enabled").(bool) || d.Get("local_account_disabled").(bool) {
+					return fmt.Errorf("`wait_for_healthy.0.pod_label_selectors` can only be specified when `run_command_enabled` is `true` and `local_account_disabled` is `false`")
+				}
+				return nil
+			},
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -353,6 +391,8 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"control_plane_logging": kubernetesClusterControlPlaneLoggingSchema(),
+
 			"cost_analysis_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -1294,6 +1334,13 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"power_state": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(managedclusters.PossibleValuesForCode(), false),
+			},
+
 			"private_cluster_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -1496,6 +1543,8 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"wait_for_healthy": kubernetesClusterWaitForHealthySchema(),
+
 			"windows_profile": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -1907,7 +1956,29 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if controlPlaneLoggingRaw, ok := d.GetOk("control_plane_logging"); ok {
+		diagnosticSettingsClient := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+		if err := createOrUpdateKubernetesClusterControlPlaneLogging(ctx, diagnosticSettingsClient, id, controlPlaneLoggingRaw.([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
+
+	// the Cluster is always created in a Running state, so it has to be stopped once everything has been provisioned
+	if d.Get("power_state").(string) == string(managedclusters.CodeStopped) {
+		log.Printf("[DEBUG] Stopping %s..", id)
+		if err := client.StopThenPoll(ctx, id); err != nil {
+			return fmt.Errorf("stopping %s: %+v", id, err)
+		}
+
+		return resourceKubernetesClusterRead(d, meta)
+	}
+
+	if err := waitForKubernetesClusterHealthy(ctx, client, id, d.Get("wait_for_healthy").([]interface{})); err != nil {
+		return err
+	}
+
 	return resourceKubernetesClusterRead(d, meta)
 }
 
@@ -1941,6 +2012,28 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
+	powerState := d.Get("power_state").(string)
+	if powerState == string(managedclusters.CodeStopped) && !d.HasChange("power_state") && d.HasChangesExcept("power_state", "wait_for_healthy") {
+		return fmt.Errorf("%s can't be updated whilst it's Stopped - `power_state` must be set to `Running` to apply these changes", *id)
+	}
+
+	// a Stopped Cluster rejects any other changes, so it's started before they're applied
+	if d.HasChange("power_state") && powerState == string(managedclusters.CodeRunning) {
+		log.Printf("[DEBUG] Starting %s..", *id)
+		if err := clusterClient.StartThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("starting %s: %+v", *id, err)
+		}
+
+		existing, err = clusterClient.Get(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving existing %s: %+v", *id, err)
+		}
+		if existing.Model == nil || existing.Model.Properties == nil {
+			return fmt.Errorf("retrieving existing %s: `properties` was nil", *id)
+		}
+		props = existing.Model.Properties
+	}
+
 	// when update, we should set the value of `Identity.UserAssignedIdentities` empty
 	// otherwise the rest api will report error - this is tracked here: https://github.com/Azure/azure-rest-api-specs/issues/13631
 	if existing.Model.Identity != nil && existing.Model.Identity.IdentityIds != nil {
@@ -2300,7 +2393,10 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 	if d.HasChanges("key_management_service") {
 		updateCluster = true
 		azureKeyVaultKmsRaw := d.Get("key_management_service").([]interface{})
-		azureKeyVaultKms, _ := expandKubernetesClusterAzureKeyVaultKms(ctx, keyVaultsClient, id.SubscriptionId, d, azureKeyVaultKmsRaw)
+		azureKeyVaultKms, err := expandKubernetesClusterAzureKeyVaultKms(ctx, keyVaultsClient, id.SubscriptionId, d, azureKeyVaultKmsRaw)
+		if err != nil {
+			return fmt.Errorf("expanding `key_management_service`: %+v", err)
+		}
 		if existing.Model.Properties.SecurityProfile == nil {
 			existing.Model.Properties.SecurityProfile = &managedclusters.ManagedClusterSecurityProfile{}
 		}
@@ -2628,6 +2724,37 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if d.HasChange("control_plane_logging") {
+		diagnosticSettingsClient := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+		oldRaw, newRaw := d.GetChange("control_plane_logging")
+		oldLogging := oldRaw.([]interface{})
+		newLogging := newRaw.([]interface{})
+
+		// the name forms part of the ID of the Diagnostic Setting, so the existing one must be removed when it changes
+		if len(oldLogging) > 0 && (len(newLogging) == 0 || kubernetesClusterControlPlaneLoggingName(oldLogging) != kubernetesClusterControlPlaneLoggingName(newLogging)) {
+			if err := deleteKubernetesClusterControlPlaneLogging(ctx, diagnosticSettingsClient, *id, kubernetesClusterControlPlaneLoggingName(oldLogging)); err != nil {
+				return err
+			}
+		}
+
+		if len(newLogging) > 0 {
+			if err := createOrUpdateKubernetesClusterControlPlaneLogging(ctx, diagnosticSettingsClient, *id, newLogging); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("power_state") && powerState == string(managedclusters.CodeStopped) {
+		log.Printf("[DEBUG] Stopping %s..", *id)
+		if err := clusterClient.StopThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("stopping %s: %+v", *id, err)
+		}
+	} else {
+		if err := waitForKubernetesClusterHealthy(ctx, clusterClient, *id, d.Get("wait_for_healthy").([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	d.Partial(false)
 
 	return resourceKubernetesClusterRead(d, meta)
@@ -2681,6 +2808,12 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("fqdn", props.Fqdn)
 			d.Set("private_fqdn", props.PrivateFQDN)
 			d.Set("portal_fqdn", props.AzurePortalFQDN)
+
+			powerState := string(managedclusters.CodeRunning)
+			if props.PowerState != nil && props.PowerState.Code != nil {
+				powerState = string(*props.PowerState.Code)
+			}
+			d.Set("power_state", powerState)
 			d.Set("disk_encryption_set_id", props.DiskEncryptionSetID)
 			d.Set("kubernetes_version", props.KubernetesVersion)
 			d.Set("current_kubernetes_version", props.CurrentKubernetesVersion)
@@ -2953,6 +3086,30 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 		d.Set("maintenance_window_node_os", maintenanceWindowNodeOS)
 
+		// the Diagnostic Setting is only looked up when it's managed by this resource, so that reading the cluster doesn't
+		// require access to Microsoft.Insights, nor adopt a Diagnostic Setting managed by `azurerm_monitor_diagnostic_setting`
+		if existingLogging := d.Get("control_plane_logging").([]interface{}); len(existingLogging) > 0 {
+			diagnosticSettingsClient := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+			controlPlaneLoggingName := kubernetesClusterControlPlaneLoggingName(existingLogging)
+			controlPlaneLoggingId := diagnosticsettings.NewScopedDiagnosticSettingID(id.ID(), controlPlaneLoggingName)
+			controlPlaneLoggingResp, err := diagnosticSettingsClient.Get(ctx, controlPlaneLoggingId)
+			switch {
+			case err == nil || response.WasNotFound(controlPlaneLoggingResp.HttpResponse):
+				controlPlaneLogging := make([]interface{}, 0)
+				if controlPlaneLoggingModel := controlPlaneLoggingResp.Model; controlPlaneLoggingModel != nil {
+					controlPlaneLogging = flattenKubernetesClusterControlPlaneLogging(controlPlaneLoggingName, controlPlaneLoggingModel.Properties)
+				}
+				if err := d.Set("control_plane_logging", controlPlaneLogging); err != nil {
+					return fmt.Errorf("setting `control_plane_logging`: %+v", err)
+				}
+			case response.WasForbidden(controlPlaneLoggingResp.HttpResponse):
+				// the existing value is retained when the caller isn't permitted to read the Diagnostic Setting
+				log.Printf("[DEBUG] unable to retrieve Control Plane Logging %s as access was forbidden: %+v", controlPlaneLoggingId, err)
+			default:
+				return fmt.Errorf("retrieving Control Plane Logging %s: %+v", controlPlaneLoggingId, err)
+			}
+		}
+
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return fmt.Errorf("setting `tags`: %+v", err)
 		}
@@ -2979,6 +3136,14 @@ func resourceKubernetesClusterDelete(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	// Diagnostic Settings outlive the resource they're attached to, so must be removed before the cluster
+	if v, ok := d.GetOk("control_plane_logging"); ok {
+		diagnosticSettingsClient := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+		if err := deleteKubernetesClusterControlPlaneLogging(ctx, diagnosticSettingsClient, *id, kubernetesClusterControlPlaneLoggingName(v.([]interface{}))); err != nil {
+			return err
+		}
+	}
+
 	err = client.DeleteThenPoll(ctx, *id, managedclusters.DefaultDeleteOperationOptions())
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
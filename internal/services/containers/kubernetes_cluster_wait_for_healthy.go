@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-02-01/managedclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// kubernetesClusterWaitForHealthyMaxCommandTimeout is the longest a single `kubectl wait` is allowed to run for, since
+// the command is run inside the cluster and the Run Command API has its own time limit
+const kubernetesClusterWaitForHealthyMaxCommandTimeout = 15 * time.Minute
+
+func kubernetesClusterWaitForHealthySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"namespace": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "kube-system",
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`), "`namespace` must be a valid Kubernetes namespace name"),
+				},
+
+				"pod_label_selectors": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9._/=!,-]+$`), "`pod_label_selectors` must be Kubernetes label selectors, such as `k8s-app=kube-dns`"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// waitForKubernetesClusterHealthy waits for all of the running Node Pools within the Kubernetes Cluster to finish
+// provisioning, then when any `pod_label_selectors` are specified uses the Run Command API to wait for the Nodes
+// and the matching Pods to become Ready
+func waitForKubernetesClusterHealthy(ctx context.Context, client *managedclusters.ManagedClustersClient, id commonids.KubernetesClusterId, input []interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	log.Printf("[DEBUG] Waiting for the Node Pools within %s to become healthy..", id)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:                   []string{"Pending"},
+		Target:                    []string{"Succeeded"},
+		Refresh:                   kubernetesClusterNodePoolsProvisioningStateRefreshFunc(ctx, client, id),
+		MinTimeout:                15 * time.Second,
+		ContinuousTargetOccurence: 2,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the Node Pools within %s to become healthy: %+v", id, err)
+	}
+
+	selectors := make([]string, 0)
+	for _, v := range raw["pod_label_selectors"].([]interface{}) {
+		if s, ok := v.(string); ok && s != "" {
+			selectors = append(selectors, s)
+		}
+	}
+	if len(selectors) == 0 {
+		return nil
+	}
+
+	timeout := time.Until(deadline)
+	if timeout > kubernetesClusterWaitForHealthyMaxCommandTimeout {
+		timeout = kubernetesClusterWaitForHealthyMaxCommandTimeout
+	}
+	command := kubernetesClusterWaitForHealthyCommand(raw["namespace"].(string), selectors, timeout)
+
+	log.Printf("[DEBUG] Waiting for the Nodes and Pods within %s to become Ready..", id)
+	return runKubernetesClusterHealthCommand(ctx, client, id, command)
+}
+
+func kubernetesClusterNodePoolsProvisioningStateRefreshFunc(ctx context.Context, client *managedclusters.ManagedClustersClient, id commonids.KubernetesClusterId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.AgentPoolProfiles == nil {
+			return resp, "Pending", nil
+		}
+
+		for _, profile := range *resp.Model.Properties.AgentPoolProfiles {
+			// Node Pools which have been stopped intentionally aren't expected to become healthy
+			if profile.PowerState != nil && pointer.From(profile.PowerState.Code) == managedclusters.CodeStopped {
+				continue
+			}
+
+			switch state := pointer.From(profile.ProvisioningState); {
+			case strings.EqualFold(state, "Succeeded"):
+				continue
+			case strings.EqualFold(state, "Failed"), strings.EqualFold(state, "Canceled"):
+				return resp, "", fmt.Errorf("the Node Pool %q has the Provisioning State %q", profile.Name, state)
+			default:
+				log.Printf("[DEBUG] the Node Pool %q within %s has the Provisioning State %q", profile.Name, id, state)
+				return resp, "Pending", nil
+			}
+		}
+
+		return resp, "Succeeded", nil
+	}
+}
+
+func kubernetesClusterWaitForHealthyCommand(namespace string, selectors []string, timeout time.Duration) string {
+	seconds := int(timeout.Seconds())
+
+	commands := []string{
+		fmt.Sprintf("kubectl wait --for=condition=Ready nodes --all --timeout=%ds", seconds),
+	}
+	for _, selector := range selectors {
+		commands = append(commands, fmt.Sprintf("kubectl wait --for=condition=Ready pods --selector='%s' --namespace='%s' --timeout=%ds", selector, namespace, seconds))
+	}
+
+	return strings.Join(commands, " && ")
+}
+
+func runKubernetesClusterHealthCommand(ctx context.Context, client *managedclusters.ManagedClustersClient, id commonids.KubernetesClusterId, command string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	payload := managedclusters.RunCommandRequest{
+		Command: command,
+	}
+	resp, err := client.RunCommand(ctx, id, payload)
+	if err != nil {
+		return fmt.Errorf("running the health check command on %s: %+v", id, err)
+	}
+
+	// the result of the command is retrieved from the Command Result referenced by the `Location` header
+	if resp.HttpResponse == nil || resp.HttpResponse.Header.Get("Location") == "" {
+		return fmt.Errorf("running the health check command on %s: the `Location` header was missing from the response", id)
+	}
+	location, err := url.Parse(resp.HttpResponse.Header.Get("Location"))
+	if err != nil {
+		return fmt.Errorf("parsing the `Location` header for the health check command on %s: %+v", id, err)
+	}
+	commandResultId, err := managedclusters.ParseCommandResultIDInsensitively(location.Path)
+	if err != nil {
+		return fmt.Errorf("parsing the health check Command Result ID for %s: %+v", id, err)
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"Running"},
+		Target:  []string{"Succeeded"},
+		Refresh: func() (interface{}, string, error) {
+			result, err := client.GetCommandResult(ctx, *commandResultId)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", *commandResultId, err)
+			}
+
+			if result.Model == nil || result.Model.Properties == nil {
+				return result, "Running", nil
+			}
+			props := result.Model.Properties
+
+			switch state := pointer.From(props.ProvisioningState); {
+			case strings.EqualFold(state, "Succeeded"):
+				if exitCode := pointer.From(props.ExitCode); exitCode != 0 {
+					return result, "", fmt.Errorf("the health check command exited with code %d: %s", exitCode, strings.TrimSpace(pointer.From(props.Logs)))
+				}
+				return result, "Succeeded", nil
+			case strings.EqualFold(state, "Failed"):
+				return result, "", fmt.Errorf("the health check command failed: %s", pointer.From(props.Reason))
+			default:
+				return result, "Running", nil
+			}
+		},
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the Nodes and Pods within %s to become Ready: %+v", id, err)
+	}
+
+	return nil
+}
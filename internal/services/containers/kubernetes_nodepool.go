@@ -5,6 +5,7 @@ package containers
 
 import (
 	"fmt"
+	"log"
 	"regexp"
 	"strconv"
 	"strings"
@@ -137,6 +138,11 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 						Optional:     true,
 						Computed:     true,
 						ValidateFunc: validation.IntBetween(1, 1000),
+						// the Nodes are deallocated whilst the Cluster is Stopped, so any difference is ignored until it's Running
+						DiffSuppressFunc: func(_, _, _ string, d *pluginsdk.ResourceData) bool {
+							old, new := d.GetChange("power_state")
+							return old.(string) == string(managedclusters.CodeStopped) && new.(string) == string(managedclusters.CodeStopped)
+						},
 					},
 
 					"node_labels": {
@@ -663,6 +669,85 @@ func schemaNodePoolNetworkProfile() *pluginsdk.Schema {
 	}
 }
 
+// validateNodePoolScaleDownMode validates that the `Deallocate` Scale Down Mode isn't used in conjunction with
+// features which require the Nodes to be deleted when the Node Pool is scaled down
+func validateNodePoolScaleDownMode(scaleDownMode, osDiskType, priority string) error {
+	if scaleDownMode != string(managedclusters.ScaleDownModeDeallocate) {
+		return nil
+	}
+
+	if osDiskType == string(managedclusters.OSDiskTypeEphemeral) {
+		return fmt.Errorf("`scale_down_mode` cannot be set to `%s` when `os_disk_type` is `%s`", managedclusters.ScaleDownModeDeallocate, managedclusters.OSDiskTypeEphemeral)
+	}
+
+	if priority == string(managedclusters.ScaleSetPrioritySpot) {
+		return fmt.Errorf("`scale_down_mode` cannot be set to `%s` when `priority` is `%s`", managedclusters.ScaleDownModeDeallocate, managedclusters.ScaleSetPrioritySpot)
+	}
+
+	return nil
+}
+
+// validateNodePoolNetworkProfile validates the `node_network_profile` block of a Node Pool, since the API only
+// reports invalid host port ranges and public IP tags once the Node Pool is being provisioned
+func validateNodePoolNetworkProfile(input []interface{}, nodePublicIPEnabled bool, prefix string) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	if tags := raw["node_public_ip_tags"].(map[string]interface{}); len(tags) > 0 && !nodePublicIPEnabled {
+		return fmt.Errorf("`%snode_network_profile.0.node_public_ip_tags` can only be specified when `%snode_public_ip_enabled` is `true`", prefix, prefix)
+	}
+
+	// the Application Security Groups are silently ignored when no host ports are allowed
+	if asgs := raw["application_security_group_ids"].([]interface{}); len(asgs) > 0 && len(raw["allowed_host_ports"].([]interface{})) == 0 {
+		return fmt.Errorf("at least one `%snode_network_profile.0.allowed_host_ports` block must be specified when `%snode_network_profile.0.application_security_group_ids` is set", prefix, prefix)
+	}
+
+	type portRange struct {
+		start int
+		end   int
+	}
+	rangesByProtocol := make(map[string][]portRange)
+	for i, v := range raw["allowed_host_ports"].([]interface{}) {
+		if v == nil {
+			continue
+		}
+		hostPorts := v.(map[string]interface{})
+		field := fmt.Sprintf("%snode_network_profile.0.allowed_host_ports.%d", prefix, i)
+
+		start := hostPorts["port_start"].(int)
+		end := hostPorts["port_end"].(int)
+		if start == 0 || end == 0 {
+			return fmt.Errorf("`%s.port_start` and `%s.port_end` must both be specified", field, field)
+		}
+		if start > end {
+			return fmt.Errorf("`%s.port_start` (%d) must be less than or equal to `%s.port_end` (%d)", field, start, field, end)
+		}
+
+		protocol := hostPorts["protocol"].(string)
+		for _, existing := range rangesByProtocol[protocol] {
+			if start <= existing.end && existing.start <= end {
+				return fmt.Errorf("`%s` (%d-%d) overlaps with another `allowed_host_ports` range (%d-%d) using the same `protocol`", field, start, end, existing.start, existing.end)
+			}
+		}
+		rangesByProtocol[protocol] = append(rangesByProtocol[protocol], portRange{start: start, end: end})
+	}
+
+	return nil
+}
+
+// warnNodePoolPublicIPWithLoadBalancerOutbound logs a warning when Nodes with a Public IP are used alongside a Standard
+// Load Balancer which is used for outbound traffic, since the Nodes then egress using their own Public IP rather than
+// the outbound rules of the Load Balancer
+func warnNodePoolPublicIPWithLoadBalancerOutbound(nodePoolName string, outboundType string, loadBalancerSku string) {
+	if !strings.EqualFold(outboundType, string(managedclusters.OutboundTypeLoadBalancer)) || !strings.EqualFold(loadBalancerSku, string(managedclusters.LoadBalancerSkuStandard)) {
+		return
+	}
+
+	log.Printf("[WARN] the Nodes within the Node Pool %q have a Public IP, so their outbound traffic won't use the outbound rules of the Standard Load Balancer", nodePoolName)
+}
+
 func ConvertDefaultNodePoolToAgentPool(input *[]managedclusters.ManagedClusterAgentPoolProfile) agentpools.AgentPool {
 	defaultCluster := (*input)[0]
 
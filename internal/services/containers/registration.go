@@ -74,6 +74,7 @@ func (r Registration) Resources() []sdk.Resource {
 		ContainerRegistryCacheRule{},
 		ContainerRegistryTaskResource{},
 		ContainerRegistryCredentialSetResource{},
+		ContainerRegistryPurgeTaskResource{},
 		ContainerRegistryTaskScheduleResource{},
 		ContainerRegistryTokenPasswordResource{},
 		KubernetesClusterExtensionResource{},
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ContainerRegistryPurgeAgo validates the `--ago` argument of `acr purge`, which is a Go-style duration
+// that additionally supports `d` for days, e.g. `7d` or `2d3h6m`.
+func ContainerRegistryPurgeAgo(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" || !regexp.MustCompile(`^(\d+d)?(\d+h)?(\d+m)?(\d+s)?$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be a duration made up of days, hours, minutes and seconds, for example `7d` or `2d3h6m`, got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerRegistryPurgeAgo(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "0d",
+			ErrCount: 0,
+		},
+		{
+			Value:    "7d",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2d3h6m",
+			ErrCount: 0,
+		},
+		{
+			Value:    "30m",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1h30s",
+			ErrCount: 0,
+		},
+		{
+			Value:    "3h2d",
+			ErrCount: 1,
+		},
+		{
+			Value:    "7 days",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1w",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-1d",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryPurgeAgo(tc.Value, "ago")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Container Registry Purge Ago %q to trigger %d validation errors, got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
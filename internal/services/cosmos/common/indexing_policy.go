@@ -7,7 +7,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2025-04-15/cosmosdb"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -92,6 +92,23 @@ func ExpandAzureRmCosmosDBIndexingPolicySpatialIndexes(input []interface{}) *[]c
 	return &indexes
 }
 
+func expandAzureRmCosmosDBIndexingPolicyVectorIndexes(input []interface{}) *[]cosmosdb.VectorIndex {
+	if len(input) == 0 {
+		return nil
+	}
+
+	indexes := make([]cosmosdb.VectorIndex, 0, len(input))
+	for _, v := range input {
+		block := v.(map[string]interface{})
+		indexes = append(indexes, cosmosdb.VectorIndex{
+			Path: block["path"].(string),
+			Type: cosmosdb.VectorIndexType(block["type"].(string)),
+		})
+	}
+
+	return &indexes
+}
+
 func ExpandAzureRmCosmosDbIndexingPolicy(d *pluginsdk.ResourceData) *cosmosdb.IndexingPolicy {
 	i := d.Get("indexing_policy").([]interface{})
 
@@ -115,6 +132,11 @@ func ExpandAzureRmCosmosDbIndexingPolicy(d *pluginsdk.ResourceData) *cosmosdb.In
 
 	policy.SpatialIndexes = ExpandAzureRmCosmosDBIndexingPolicySpatialIndexes(input["spatial_index"].([]interface{}))
 
+	// `vector_index` is only available within the indexing policy of SQL containers
+	if v, ok := input["vector_index"].([]interface{}); ok {
+		policy.VectorIndexes = expandAzureRmCosmosDBIndexingPolicyVectorIndexes(v)
+	}
+
 	return policy
 }
 
@@ -245,6 +267,34 @@ func FlattenAzureRmCosmosDbIndexingPolicy(indexingPolicy *cosmosdb.IndexingPolic
 	return results
 }
 
+func flattenCosmosDBIndexingPolicyVectorIndexes(input *[]cosmosdb.VectorIndex) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	indexes := make([]interface{}, 0)
+	for _, v := range *input {
+		indexes = append(indexes, map[string]interface{}{
+			"path": v.Path,
+			"type": string(v.Type),
+		})
+	}
+
+	return indexes
+}
+
+func FlattenAzureRmCosmosDbSQLContainerIndexingPolicy(indexingPolicy *cosmosdb.IndexingPolicy) []interface{} {
+	results := FlattenAzureRmCosmosDbIndexingPolicy(indexingPolicy)
+	if len(results) == 0 {
+		return results
+	}
+
+	result := results[0].(map[string]interface{})
+	result["vector_index"] = flattenCosmosDBIndexingPolicyVectorIndexes(indexingPolicy.VectorIndexes)
+
+	return results
+}
+
 func ValidateAzureRmCosmosDbIndexingPolicy(indexingPolicy *cosmosdb.IndexingPolicy) error {
 	if indexingPolicy == nil {
 		return nil
@@ -5,6 +5,7 @@ package common
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2025-04-15/cosmosdb"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -150,6 +151,32 @@ func CosmosDbIndexingPolicySchema() *pluginsdk.Schema {
 	}
 }
 
+// CosmosDbSQLContainerIndexingPolicySchema extends the shared indexing policy with the index types only available for SQL containers
+func CosmosDbSQLContainerIndexingPolicySchema() *pluginsdk.Schema {
+	s := CosmosDbIndexingPolicySchema()
+	s.Elem.(*pluginsdk.Resource).Schema["vector_index"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"path": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(cosmosdb.PossibleValuesForVectorIndexType(), false),
+				},
+			},
+		},
+	}
+
+	return s
+}
+
 func ConflictResolutionPolicy() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
@@ -21,7 +21,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2025-04-15/cosmosdb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -1206,10 +1206,52 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 			log.Printf("[INFO] [SKIP] AzureRM Cosmos DB Account: Updating 'EnableMultipleWriteLocations' [NO CHANGE]")
 		}
 
-		// determine if any locations have been renamed/priority reordered and remove them
+		// if the only thing that changed is the order of the failover priorities we can use the
+		// dedicated 'failoverPriorityChange' API which reorders all of the regions in a single call,
+		// rather than removing and then re-adding each of the reordered regions one at a time...
+		failoverPriorityOnly := d.HasChange("geo_location") && cosmosDbAccountOnlyFailoverPrioritiesChanged(configLocations, cosmosLocationsMap)
+		if failoverPriorityOnly {
+			log.Printf("[INFO] Updating AzureRM Cosmos DB Account: Changing 'FailoverPriority' of 'Locations'")
+
+			policies := cosmosdb.FailoverPolicies{
+				FailoverPolicies: make([]cosmosdb.FailoverPolicy, 0, len(configLocations)),
+			}
+			for _, configLoc := range configLocations {
+				policies.FailoverPolicies = append(policies.FailoverPolicies, cosmosdb.FailoverPolicy{
+					LocationName:     configLoc.LocationName,
+					FailoverPriority: configLoc.FailoverPriority,
+				})
+			}
+
+			if err = client.DatabaseAccountsFailoverPriorityChangeThenPoll(ctx, *id, policies); err != nil {
+				return fmt.Errorf("changing the failover priorities of %s: %+v", id, err)
+			}
+
+			if err = resourceCosmosDbAccountWaitForLocations(client, ctx, *id, configLocations, d); err != nil {
+				return fmt.Errorf("waiting for the failover priorities of %s to change: %+v", id, err)
+			}
+		}
+
+		// determine if any locations have been renamed/priority reordered/zone redundancy changed and remove them
 		updateLocations := false
 		for _, configLoc := range configLocations {
+			if failoverPriorityOnly {
+				break
+			}
+
 			if cosmosLoc, ok := cosmosLocationsMap[pointer.From(configLoc.LocationName)]; ok {
+				// zone redundancy cannot be toggled in-place for an existing region, the region has to be
+				// removed and then added back with the new value which isn't possible for the write region
+				if pointer.From(configLoc.IsZoneRedundant) != pointer.From(cosmosLoc.IsZoneRedundant) {
+					if pointer.From(cosmosLoc.FailoverPriority) == 0 || pointer.From(configLoc.FailoverPriority) == 0 {
+						return fmt.Errorf("cannot change `zone_redundant` of the write region %q of %s, fail over to another region before changing `zone_redundant`", pointer.From(configLoc.LocationName), id)
+					}
+
+					delete(cosmosLocationsMap, pointer.From(configLoc.LocationName))
+					updateLocations = true
+					continue
+				}
+
 				// is the location in the config also in the database with the same 'FailoverPriority'?
 				if pointer.From(configLoc.FailoverPriority) != pointer.From(cosmosLoc.FailoverPriority) {
 					// The Failover Priority has been changed in the config...
@@ -1244,7 +1286,7 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 			log.Printf("[INFO] [SKIP] AzureRM Cosmos DB Account: Removing renamed 'Locations' [NO CHANGE]")
 		}
 
-		if d.HasChanges("geo_location") {
+		if d.HasChanges("geo_location") && !failoverPriorityOnly {
 			log.Printf("[INFO] Updating AzureRM Cosmos DB Account: Updating 'Locations'")
 			// add any new/renamed locations
 			account.Properties.Locations = configLocations
@@ -1647,7 +1689,11 @@ func resourceCosmosDbAccountApiCreateOrUpdate(client *cosmosdb.CosmosDBClient, c
 		return fmt.Errorf("creating/updating CosmosDB Account %q (Resource Group %q): %+v", id.DatabaseAccountName, id.ResourceGroupName, err)
 	}
 
-	// if a replication location is added or removed it can take some time to provision
+	return resourceCosmosDbAccountWaitForLocations(client, ctx, id, account.Properties.Locations, d)
+}
+
+func resourceCosmosDbAccountWaitForLocations(client *cosmosdb.CosmosDBClient, ctx context.Context, id cosmosdb.DatabaseAccountId, desiredLocations []cosmosdb.Location, d *pluginsdk.ResourceData) error {
+	// if a replication location is added, removed or reordered it can take some time to provision
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:    []string{"Creating", "Updating", "Deleting", "Initializing", "Dequeued", "Enqueued"},
 		Target:     []string{"Succeeded"},
@@ -1675,7 +1721,7 @@ func resourceCosmosDbAccountApiCreateOrUpdate(client *cosmosdb.CosmosDBClient, c
 					}
 				}
 
-				for _, desiredLocation := range account.Properties.Locations {
+				for _, desiredLocation := range desiredLocations {
 					for index, l := range locations {
 						if azure.NormalizeLocation(*desiredLocation.LocationName) == azure.NormalizeLocation(*l.LocationName) {
 							break
@@ -1706,6 +1752,32 @@ func resourceCosmosDbAccountApiCreateOrUpdate(client *cosmosdb.CosmosDBClient, c
 	return nil
 }
 
+// cosmosDbAccountOnlyFailoverPrioritiesChanged returns true when the configured locations are the same
+// regions (with the same zone redundancy) as the existing locations and only the failover priorities differ
+func cosmosDbAccountOnlyFailoverPrioritiesChanged(configLocations []cosmosdb.Location, existingLocations map[string]cosmosdb.Location) bool {
+	if len(configLocations) != len(existingLocations) {
+		return false
+	}
+
+	priorityChanged := false
+	for _, configLoc := range configLocations {
+		existingLoc, ok := existingLocations[azure.NormalizeLocation(pointer.From(configLoc.LocationName))]
+		if !ok {
+			return false
+		}
+
+		if pointer.From(configLoc.IsZoneRedundant) != pointer.From(existingLoc.IsZoneRedundant) {
+			return false
+		}
+
+		if pointer.From(configLoc.FailoverPriority) != pointer.From(existingLoc.FailoverPriority) {
+			priorityChanged = true
+		}
+	}
+
+	return priorityChanged
+}
+
 func expandAzureRmCosmosDBAccountConsistencyPolicy(d *pluginsdk.ResourceData) *cosmosdb.ConsistencyPolicy {
 	i := d.Get("consistency_policy").([]interface{})
 	if len(i) == 0 || i[0] == nil {
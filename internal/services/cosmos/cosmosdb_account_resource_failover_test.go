@@ -111,6 +111,28 @@ func TestAccCosmosDBAccount_failover_geoReplicated(t *testing.T) {
 	})
 }
 
+func TestAccCosmosDBAccount_failover_priorityChange(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_account", "test")
+	r := CosmosDBAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.failover_priorityChange(data, 0, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.failover_priorityChange(data, 1, 0),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (CosmosDBAccountResource) failover_boundedStaleness(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -327,3 +349,37 @@ resource "azurerm_cosmosdb_account" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Secondary)
 }
+
+func (CosmosDBAccountResource) failover_priorityChange(data acceptance.TestData, primaryPriority, secondaryPriority int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = %d
+  }
+
+  geo_location {
+    location          = "%s"
+    failover_priority = %d
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, primaryPriority, data.Locations.Secondary, secondaryPriority)
+}
@@ -12,7 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2025-04-15/cosmosdb"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
@@ -142,7 +142,89 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 					},
 				},
 			},
-			"indexing_policy": common.CosmosDbIndexingPolicySchema(),
+			"indexing_policy": common.CosmosDbSQLContainerIndexingPolicySchema(),
+
+			"vector_embedding_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"vector_embedding": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"path": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"data_type": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(cosmosdb.PossibleValuesForVectorDataType(), false),
+									},
+
+									"dimensions": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 4096),
+									},
+
+									"distance_function": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(cosmosdb.PossibleValuesForDistanceFunction(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"full_text_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"default_language": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"full_text_path": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"path": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"language": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 
 		CustomizeDiff: pluginsdk.CustomDiffWithAll(
@@ -155,6 +237,8 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 				// The behavior of the Azure API is that `partition_key_version` can be updated to `1` when it is not set at creation time, but it can not be updated to `2`.
 				return !(old.(int) == 0 && new.(int) == 1)
 			}),
+
+			validateCosmosSQLContainerVectorIndexes,
 		),
 	}
 
@@ -191,6 +275,8 @@ func resourceCosmosDbSQLContainerCreate(d *pluginsdk.ResourceData, meta interfac
 				Id:                       id.ContainerName,
 				IndexingPolicy:           indexingPolicy,
 				ConflictResolutionPolicy: common.ExpandCosmosDbConflicResolutionPolicy(d.Get("conflict_resolution_policy").([]interface{})),
+				VectorEmbeddingPolicy:    expandCosmosSQLContainerVectorEmbeddingPolicy(d.Get("vector_embedding_policy").([]interface{})),
+				FullTextPolicy:           expandCosmosSQLContainerFullTextPolicy(d.Get("full_text_policy").([]interface{})),
 			},
 			Options: &cosmosdb.CreateUpdateOptions{},
 		},
@@ -266,8 +352,10 @@ func resourceCosmosDbSQLContainerUpdate(d *pluginsdk.ResourceData, meta interfac
 	db := cosmosdb.SqlContainerCreateUpdateParameters{
 		Properties: cosmosdb.SqlContainerCreateUpdateProperties{
 			Resource: cosmosdb.SqlContainerResource{
-				Id:             id.ContainerName,
-				IndexingPolicy: indexingPolicy,
+				Id:                    id.ContainerName,
+				IndexingPolicy:        indexingPolicy,
+				VectorEmbeddingPolicy: expandCosmosSQLContainerVectorEmbeddingPolicy(d.Get("vector_embedding_policy").([]interface{})),
+				FullTextPolicy:        expandCosmosSQLContainerFullTextPolicy(d.Get("full_text_policy").([]interface{})),
 			},
 			Options: &cosmosdb.CreateUpdateOptions{},
 		},
@@ -373,7 +461,15 @@ func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{
 				}
 
 				if indexingPolicy := res.IndexingPolicy; indexingPolicy != nil {
-					d.Set("indexing_policy", common.FlattenAzureRmCosmosDbIndexingPolicy(indexingPolicy))
+					d.Set("indexing_policy", common.FlattenAzureRmCosmosDbSQLContainerIndexingPolicy(indexingPolicy))
+				}
+
+				if err := d.Set("vector_embedding_policy", flattenCosmosSQLContainerVectorEmbeddingPolicy(res.VectorEmbeddingPolicy)); err != nil {
+					return fmt.Errorf("setting `vector_embedding_policy`: %+v", err)
+				}
+
+				if err := d.Set("full_text_policy", flattenCosmosSQLContainerFullTextPolicy(res.FullTextPolicy)); err != nil {
+					return fmt.Errorf("setting `full_text_policy`: %+v", err)
 				}
 
 				if err := d.Set("conflict_resolution_policy", common.FlattenCosmosDbConflictResolutionPolicy(res.ConflictResolutionPolicy)); err != nil {
@@ -469,3 +565,133 @@ func flattenCosmosSQLContainerUniqueKeys(keys *[]cosmosdb.UniqueKey) *[]map[stri
 
 	return &slice
 }
+
+func expandCosmosSQLContainerVectorEmbeddingPolicy(input []interface{}) *cosmosdb.VectorEmbeddingPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	embeddings := make([]cosmosdb.VectorEmbedding, 0)
+	for _, item := range v["vector_embedding"].([]interface{}) {
+		embedding := item.(map[string]interface{})
+		embeddings = append(embeddings, cosmosdb.VectorEmbedding{
+			Path:             embedding["path"].(string),
+			DataType:         cosmosdb.VectorDataType(embedding["data_type"].(string)),
+			Dimensions:       int64(embedding["dimensions"].(int)),
+			DistanceFunction: cosmosdb.DistanceFunction(embedding["distance_function"].(string)),
+		})
+	}
+
+	return &cosmosdb.VectorEmbeddingPolicy{
+		VectorEmbeddings: &embeddings,
+	}
+}
+
+func flattenCosmosSQLContainerVectorEmbeddingPolicy(input *cosmosdb.VectorEmbeddingPolicy) []interface{} {
+	if input == nil || input.VectorEmbeddings == nil || len(*input.VectorEmbeddings) == 0 {
+		return []interface{}{}
+	}
+
+	embeddings := make([]interface{}, 0)
+	for _, embedding := range *input.VectorEmbeddings {
+		embeddings = append(embeddings, map[string]interface{}{
+			"path":              embedding.Path,
+			"data_type":         string(embedding.DataType),
+			"dimensions":        int(embedding.Dimensions),
+			"distance_function": string(embedding.DistanceFunction),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"vector_embedding": embeddings,
+		},
+	}
+}
+
+func expandCosmosSQLContainerFullTextPolicy(input []interface{}) *cosmosdb.FullTextPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	paths := make([]cosmosdb.FullTextPath, 0)
+	for _, item := range v["full_text_path"].([]interface{}) {
+		path := item.(map[string]interface{})
+		fullTextPath := cosmosdb.FullTextPath{
+			Path: path["path"].(string),
+		}
+
+		if language := path["language"].(string); language != "" {
+			fullTextPath.Language = pointer.To(language)
+		}
+
+		paths = append(paths, fullTextPath)
+	}
+
+	return &cosmosdb.FullTextPolicy{
+		DefaultLanguage: pointer.To(v["default_language"].(string)),
+		FullTextPaths:   &paths,
+	}
+}
+
+func flattenCosmosSQLContainerFullTextPolicy(input *cosmosdb.FullTextPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	paths := make([]interface{}, 0)
+	if input.FullTextPaths != nil {
+		for _, path := range *input.FullTextPaths {
+			paths = append(paths, map[string]interface{}{
+				"path":     path.Path,
+				"language": pointer.From(path.Language),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"default_language": pointer.From(input.DefaultLanguage),
+			"full_text_path":   paths,
+		},
+	}
+}
+
+// validateCosmosSQLContainerVectorIndexes ensures that each `vector_index` references a path defined in the `vector_embedding_policy`
+func validateCosmosSQLContainerVectorIndexes(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	embeddingPaths := make(map[string]struct{})
+	for _, item := range diff.Get("vector_embedding_policy.0.vector_embedding").([]interface{}) {
+		embedding, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		path := embedding["path"].(string)
+		// the paths can't be compared until apply when they're sourced from another resource
+		if path == "" {
+			return nil
+		}
+		embeddingPaths[path] = struct{}{}
+	}
+
+	for _, item := range diff.Get("indexing_policy.0.vector_index").([]interface{}) {
+		index, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		path := index["path"].(string)
+		if path == "" {
+			continue
+		}
+		if _, ok := embeddingPaths[path]; !ok {
+			return fmt.Errorf("the `vector_index` path %q must also be defined as a `vector_embedding` within the `vector_embedding_policy`", path)
+		}
+	}
+
+	return nil
+}
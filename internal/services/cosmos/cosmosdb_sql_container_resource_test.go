@@ -6,9 +6,10 @@ package cosmos_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2025-04-15/cosmosdb"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -245,6 +246,33 @@ func TestAccCosmosDbSqlContainer_hierarchicalPartitionKeys(t *testing.T) {
 	})
 }
 
+func TestAccCosmosDbSqlContainer_vectorAndFullTextPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container", "test")
+	r := CosmosSqlContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.vectorAndFullTextPolicy(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCosmosDbSqlContainer_vectorIndexWithoutEmbedding(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container", "test")
+	r := CosmosSqlContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.vectorIndexWithoutEmbedding(data),
+			ExpectError: regexp.MustCompile("must also be defined as a `vector_embedding` within the `vector_embedding_policy`"),
+		},
+	})
+}
+
 func (t CosmosSqlContainerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := cosmosdb.ParseContainerID(state.ID)
 	if err != nil {
@@ -634,3 +662,100 @@ resource "azurerm_cosmosdb_sql_container" "test" {
 }
 `, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger)
 }
+
+func (CosmosSqlContainerResource) vectorAndFullTextPolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-CSQLC-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_sql_database.test.name
+  partition_key_paths = ["/id"]
+
+  indexing_policy {
+    indexing_mode = "consistent"
+
+    included_path {
+      path = "/*"
+    }
+
+    excluded_path {
+      path = "/embedding/*"
+    }
+
+    vector_index {
+      path = "/embedding"
+      type = "quantizedFlat"
+    }
+  }
+
+  vector_embedding_policy {
+    vector_embedding {
+      path              = "/embedding"
+      data_type         = "float32"
+      dimensions        = 1536
+      distance_function = "cosine"
+    }
+  }
+
+  full_text_policy {
+    default_language = "en-US"
+
+    full_text_path {
+      path     = "/description"
+      language = "en-US"
+    }
+  }
+}
+`, CosmosDBAccountResource{}.capabilities(data, cosmosdb.DatabaseAccountKindGlobalDocumentDB, []string{"EnableNoSQLVectorSearch", "EnableNoSQLFullTextSearch"}), data.RandomInteger)
+}
+
+func (CosmosSqlContainerResource) vectorIndexWithoutEmbedding(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-CSQLC-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_sql_database.test.name
+  partition_key_paths = ["/id"]
+
+  indexing_policy {
+    indexing_mode = "consistent"
+
+    included_path {
+      path = "/*"
+    }
+
+    vector_index {
+      path = "/otherEmbedding"
+      type = "quantizedFlat"
+    }
+  }
+
+  vector_embedding_policy {
+    vector_embedding {
+      path              = "/embedding"
+      data_type         = "float32"
+      dimensions        = 1536
+      distance_function = "cosine"
+    }
+  }
+}
+`, CosmosDBAccountResource{}.capabilities(data, cosmosdb.DatabaseAccountKindGlobalDocumentDB, []string{"EnableNoSQLVectorSearch"}), data.RandomInteger)
+}
@@ -4,18 +4,49 @@
 package client
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2022-10-01-preview/accessconnector"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2024-05-01/vnetpeering"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2024-05-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/unitycatalog"
 )
 
 type Client struct {
 	AccessConnectorClient *accessconnector.AccessConnectorClient
 	WorkspacesClient      *workspaces.WorkspacesClient
 	VnetPeeringClient     *vnetpeering.VNetPeeringClient
+
+	authorizerFunc            common.ApiAuthorizerFunc
+	configureClientFunc       func(c client.BaseClient, authorizer auth.Authorizer)
+	dataBricksApi             environments.Api
+	resourceManagerAuthorizer auth.Authorizer
+}
+
+// UnityCatalogClient returns a client for the Unity Catalog APIs of the Databricks Workspace, which authenticates using
+// a token for Azure Databricks alongside a Resource Manager token for the Workspace.
+func (c *Client) UnityCatalogClient(workspaceId workspaces.WorkspaceId, workspaceUrl string) (*unitycatalog.Client, error) {
+	appId, ok := c.dataBricksApi.AppId()
+	if !ok {
+		return nil, errors.New("unable to build SDK Client since Azure Databricks is not available in this Azure Environment")
+	}
+
+	api := environments.NewApiEndpoint(c.dataBricksApi.Name(), fmt.Sprintf("https://%s", workspaceUrl), appId).WithResourceIdentifier(*appId)
+	authorizer, err := c.authorizerFunc(api)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", api.Name(), err)
+	}
+
+	unityCatalogClient := unitycatalog.NewClient(workspaceUrl)
+	c.configureClientFunc(unityCatalogClient.Client, authorizer)
+	unityCatalogClient.AuthorizeWithResourceManager(workspaceId.ID(), c.resourceManagerAuthorizer)
+
+	return unityCatalogClient, nil
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -41,5 +72,10 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		AccessConnectorClient: accessConnectorClient,
 		WorkspacesClient:      workspacesClient,
 		VnetPeeringClient:     vnetPeeringClient,
+
+		authorizerFunc:            o.Authorizers.AuthorizerFunc,
+		configureClientFunc:       o.Configure,
+		dataBricksApi:             o.Environment.DataBricks,
+		resourceManagerAuthorizer: o.Authorizers.ResourceManager,
 	}, nil
 }
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databricks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2024-05-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/unitycatalog"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type WorkspaceMetastoreAssignmentResource struct{}
+
+var _ sdk.ResourceWithUpdate = WorkspaceMetastoreAssignmentResource{}
+
+type WorkspaceMetastoreAssignmentResourceModel struct {
+	WorkspaceId        string `tfschema:"workspace_id"`
+	MetastoreId        string `tfschema:"metastore_id"`
+	DefaultCatalogName string `tfschema:"default_catalog_name"`
+}
+
+func (r WorkspaceMetastoreAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"metastore_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"default_catalog_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r WorkspaceMetastoreAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WorkspaceMetastoreAssignmentResource) ModelObject() interface{} {
+	return &WorkspaceMetastoreAssignmentResourceModel{}
+}
+
+func (r WorkspaceMetastoreAssignmentResource) ResourceType() string {
+	return "azurerm_databricks_workspace_metastore_assignment"
+}
+
+func (r WorkspaceMetastoreAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspaces.ValidateWorkspaceID
+}
+
+func (r WorkspaceMetastoreAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model WorkspaceMetastoreAssignmentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			client, workspaceNumericId, err := databricksWorkspaceUnityCatalogClient(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return fmt.Errorf("%s was not found", id)
+			}
+
+			existing, err := client.GetCurrentMetastoreAssignment(ctx)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for an existing Metastore Assignment for %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := unitycatalog.MetastoreAssignment{
+				MetastoreId: model.MetastoreId,
+			}
+			if model.DefaultCatalogName != "" {
+				payload.DefaultCatalogName = pointer.To(model.DefaultCatalogName)
+			}
+
+			if _, err := client.AssignMetastore(ctx, workspaceNumericId, payload); err != nil {
+				return fmt.Errorf("assigning Metastore %q to %s: %+v", model.MetastoreId, id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WorkspaceMetastoreAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := workspaces.ParseWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, _, err := databricksWorkspaceUnityCatalogClient(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.GetCurrentMetastoreAssignment(ctx)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving the Metastore Assignment for %s: %+v", id, err)
+			}
+
+			state := WorkspaceMetastoreAssignmentResourceModel{
+				WorkspaceId: id.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.MetastoreId = model.MetastoreId
+				state.DefaultCatalogName = pointer.From(model.DefaultCatalogName)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WorkspaceMetastoreAssignmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := workspaces.ParseWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WorkspaceMetastoreAssignmentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, workspaceNumericId, err := databricksWorkspaceUnityCatalogClient(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return fmt.Errorf("%s was not found", id)
+			}
+
+			payload := unitycatalog.MetastoreAssignment{
+				MetastoreId: model.MetastoreId,
+			}
+			if metadata.ResourceData.HasChange("default_catalog_name") {
+				payload.DefaultCatalogName = pointer.To(model.DefaultCatalogName)
+			}
+
+			if _, err := client.UpdateMetastoreAssignment(ctx, workspaceNumericId, payload); err != nil {
+				return fmt.Errorf("updating the Metastore Assignment for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WorkspaceMetastoreAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := workspaces.ParseWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WorkspaceMetastoreAssignmentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, workspaceNumericId, err := databricksWorkspaceUnityCatalogClient(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				// the Metastore Assignment is removed alongside the Workspace
+				return nil
+			}
+
+			if _, err := client.UnassignMetastore(ctx, workspaceNumericId, model.MetastoreId); err != nil {
+				return fmt.Errorf("removing the Metastore Assignment for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// databricksWorkspaceUnityCatalogClient returns a Unity Catalog client for the Workspace along with the numeric ID of the
+// Workspace used by the Databricks APIs, or a nil client if the Workspace no longer exists.
+func databricksWorkspaceUnityCatalogClient(ctx context.Context, metadata sdk.ResourceMetaData, id workspaces.WorkspaceId) (*unitycatalog.Client, int64, error) {
+	resp, err := metadata.Client.DataBricks.WorkspacesClient.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, 0, nil
+		}
+		return nil, 0, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if resp.Model == nil {
+		return nil, 0, fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+
+	workspaceUrl := pointer.From(resp.Model.Properties.WorkspaceURL)
+	if workspaceUrl == "" {
+		return nil, 0, fmt.Errorf("retrieving %s: `properties.workspaceUrl` was empty", id)
+	}
+
+	workspaceNumericId, err := strconv.ParseInt(pointer.From(resp.Model.Properties.WorkspaceId), 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("parsing `properties.workspaceId` of %s: %+v", id, err)
+	}
+
+	client, err := metadata.Client.DataBricks.UnityCatalogClient(id, workspaceUrl)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return client, workspaceNumericId, nil
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databricks_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2024-05-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DatabricksWorkspaceMetastoreAssignmentResource struct {
+	metastoreId string
+}
+
+func TestAccDatabricksWorkspaceMetastoreAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_databricks_workspace_metastore_assignment", "test")
+	r := DatabricksWorkspaceMetastoreAssignmentResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_catalog_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDatabricksWorkspaceMetastoreAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_databricks_workspace_metastore_assignment", "test")
+	r := DatabricksWorkspaceMetastoreAssignmentResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDatabricksWorkspaceMetastoreAssignment_defaultCatalogName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_databricks_workspace_metastore_assignment", "test")
+	r := DatabricksWorkspaceMetastoreAssignmentResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.defaultCatalogName(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_catalog_name").HasValue("main"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r *DatabricksWorkspaceMetastoreAssignmentResource) preCheck(t *testing.T) {
+	r.metastoreId = os.Getenv("ARM_TEST_DATABRICKS_METASTORE_ID")
+	if r.metastoreId == "" {
+		t.Skip("Skipping: Test requires the `ARM_TEST_DATABRICKS_METASTORE_ID` environment variable to be set to the ID of a Unity Catalog Metastore in the primary test location")
+	}
+}
+
+func (DatabricksWorkspaceMetastoreAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workspaces.ParseWorkspaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := clients.DataBricks.WorkspacesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if workspace.Model == nil || workspace.Model.Properties.WorkspaceURL == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.workspaceUrl` was nil", *id)
+	}
+
+	client, err := clients.DataBricks.UnityCatalogClient(*id, *workspace.Model.Properties.WorkspaceURL)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetCurrentMetastoreAssignment(ctx)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving the Metastore Assignment for %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (DatabricksWorkspaceMetastoreAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-db-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_databricks_workspace" "test" {
+  name                = "acctestDBW-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "premium"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r DatabricksWorkspaceMetastoreAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_databricks_workspace_metastore_assignment" "test" {
+  workspace_id = azurerm_databricks_workspace.test.id
+  metastore_id = "%s"
+}
+`, r.template(data), r.metastoreId)
+}
+
+func (r DatabricksWorkspaceMetastoreAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_databricks_workspace_metastore_assignment" "import" {
+  workspace_id = azurerm_databricks_workspace_metastore_assignment.test.workspace_id
+  metastore_id = azurerm_databricks_workspace_metastore_assignment.test.metastore_id
+}
+`, r.basic(data))
+}
+
+func (r DatabricksWorkspaceMetastoreAssignmentResource) defaultCatalogName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_databricks_workspace_metastore_assignment" "test" {
+  workspace_id         = azurerm_databricks_workspace.test.id
+  metastore_id         = "%s"
+  default_catalog_name = "main"
+}
+`, r.template(data), r.metastoreId)
+}
@@ -731,6 +731,18 @@ func resourceDatabricksWorkspaceCreate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
+	// since the Tags above are only applied to the workspace, they're updated separately so that they're also
+	// propagated to the Managed Resource Group and the resources within it, as happens when they're updated
+	if tagsRaw := d.Get("tags").(map[string]interface{}); len(tagsRaw) > 0 {
+		workspaceUpdate := workspaces.WorkspaceUpdate{
+			Tags: tags.Expand(tagsRaw),
+		}
+
+		if err := client.UpdateThenPoll(ctx, id, workspaceUpdate); err != nil {
+			return fmt.Errorf("updating %s Tags: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 
 	// I have to set the custom_parameters so I can pass the public and private
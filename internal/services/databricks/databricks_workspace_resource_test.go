@@ -229,6 +229,30 @@ func TestAccDatabricksWorkspace_update(t *testing.T) {
 	})
 }
 
+func TestAccDatabricksWorkspace_managedResourceGroupTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_databricks_workspace", "test")
+	r := DatabricksWorkspaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedResourceGroupTags(data, "Production"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("data.azurerm_resource_group.managed").Key("tags.Environment").HasValue("Production"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.managedResourceGroupTags(data, "Staging"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("data.azurerm_resource_group.managed").Key("tags.Environment").HasValue("Staging"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccDatabricksWorkspace_extendedUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_databricks_workspace", "test")
 	r := DatabricksWorkspaceResource{}
@@ -617,6 +641,37 @@ resource "azurerm_databricks_workspace" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, sku)
 }
 
+func (DatabricksWorkspaceResource) managedResourceGroupTags(data acceptance.TestData, environment string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-databricks-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_databricks_workspace" "test" {
+  name                = "acctestDBW-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+
+  tags = {
+    Environment = "%[3]s"
+  }
+}
+
+data "azurerm_resource_group" "managed" {
+  name = azurerm_databricks_workspace.test.managed_resource_group_name
+
+  # ensures the tags are read after the workspace has been updated
+  depends_on = [azurerm_databricks_workspace.test]
+}
+`, data.RandomInteger, data.Locations.Primary, environment)
+}
+
 func (DatabricksWorkspaceResource) defaultStorageFirewall(data acceptance.TestData, sku string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
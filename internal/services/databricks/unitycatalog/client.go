@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package unitycatalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// there's no SDK for the Databricks Workspace APIs available in `hashicorp/go-azure-sdk`, so this is a minimal client
+// for the Unity Catalog Metastore Assignment APIs
+type Client struct {
+	*dataplane.Client
+}
+
+func NewClient(workspaceUrl string) *Client {
+	return &Client{
+		Client: dataplane.NewDataPlaneClient(fmt.Sprintf("https://%s", workspaceUrl), "databricks", ""),
+	}
+}
+
+// AuthorizeWithResourceManager adds the headers which allow a Service Principal with access to the Workspace through
+// Resource Manager to be automatically added to the Workspace, so that it doesn't need to be added as a user first.
+// These are added by a request middleware, so this must be called after any logging middleware has been appended to
+// avoid the token being logged.
+func (c *Client) AuthorizeWithResourceManager(workspaceId string, resourceManagerAuthorizer auth.Authorizer) {
+	c.AppendRequestMiddleware(func(req *http.Request) (*http.Request, error) {
+		token, err := resourceManagerAuthorizer.Token(req.Context(), req)
+		if err != nil {
+			return nil, fmt.Errorf("obtaining Resource Manager token: %+v", err)
+		}
+
+		req.Header.Set("X-Databricks-Azure-SP-Management-Token", token.AccessToken)
+		req.Header.Set("X-Databricks-Azure-Workspace-Resource-Id", workspaceId)
+		return req, nil
+	})
+}
+
+type MetastoreAssignment struct {
+	DefaultCatalogName *string `json:"default_catalog_name,omitempty"`
+	MetastoreId        string  `json:"metastore_id"`
+	WorkspaceId        *int64  `json:"workspace_id,omitempty"`
+}
+
+type GetCurrentMetastoreAssignmentOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *MetastoreAssignment
+}
+
+// GetCurrentMetastoreAssignment retrieves the Metastore currently assigned to the Workspace.
+func (c Client) GetCurrentMetastoreAssignment(ctx context.Context) (result GetCurrentMetastoreAssignmentOperationResponse, err error) {
+	req, err := c.newRequest(ctx, client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       "/api/2.1/unity-catalog/current-metastore-assignment",
+	})
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model MetastoreAssignment
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type AssignMetastoreOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+// AssignMetastore assigns the Metastore to the Workspace, replacing any existing assignment.
+func (c Client) AssignMetastore(ctx context.Context, workspaceId int64, input MetastoreAssignment) (result AssignMetastoreOperationResponse, err error) {
+	return c.sendMetastoreAssignment(ctx, http.MethodPut, workspaceId, input)
+}
+
+// UpdateMetastoreAssignment updates the Metastore or Default Catalog assigned to the Workspace.
+func (c Client) UpdateMetastoreAssignment(ctx context.Context, workspaceId int64, input MetastoreAssignment) (result AssignMetastoreOperationResponse, err error) {
+	return c.sendMetastoreAssignment(ctx, http.MethodPatch, workspaceId, input)
+}
+
+type UnassignMetastoreOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+// UnassignMetastore removes the assignment of the Metastore from the Workspace.
+func (c Client) UnassignMetastore(ctx context.Context, workspaceId int64, metastoreId string) (result UnassignMetastoreOperationResponse, err error) {
+	req, err := c.newRequest(ctx, client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNotFound,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		OptionsObject: unassignMetastoreOptions{
+			MetastoreId: metastoreId,
+		},
+		Path: metastoreAssignmentPath(workspaceId),
+	})
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (c Client) sendMetastoreAssignment(ctx context.Context, method string, workspaceId int64, input MetastoreAssignment) (result AssignMetastoreOperationResponse, err error) {
+	req, err := c.newRequest(ctx, client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: method,
+		Path:       metastoreAssignmentPath(workspaceId),
+	})
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (c Client) newRequest(ctx context.Context, input client.RequestOptions) (*client.Request, error) {
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("internal-error: pre-validating request payload: %+v", err)
+	}
+
+	req, err := c.Client.NewRequest(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("building %s request: %+v", input.HttpMethod, err)
+	}
+
+	return req, nil
+}
+
+func metastoreAssignmentPath(workspaceId int64) string {
+	return fmt.Sprintf("/api/2.1/unity-catalog/workspaces/%d/metastore", workspaceId)
+}
+
+var _ client.Options = unassignMetastoreOptions{}
+
+type unassignMetastoreOptions struct {
+	MetastoreId string
+}
+
+func (o unassignMetastoreOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o unassignMetastoreOptions) ToOData() *odata.Query {
+	return &odata.Query{}
+}
+
+func (o unassignMetastoreOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	out.Append("metastore_id", o.MetastoreId)
+	return &out
+}
@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datafactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/integrationruntimes"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const dataFactoryIntegrationRuntimeTypeAirflow = integrationruntimes.IntegrationRuntimeType("Airflow")
+
+func resourceDataFactoryIntegrationRuntimeAirflow() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryIntegrationRuntimeAirflowCreateUpdate,
+		Read:   resourceDataFactoryIntegrationRuntimeAirflowRead,
+		Update: resourceDataFactoryIntegrationRuntimeAirflowCreateUpdate,
+		Delete: resourceDataFactoryIntegrationRuntimeAirflowDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := integrationruntimes.ParseIntegrationRuntimeID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^([a-zA-Z0-9](-|-?[a-zA-Z0-9]+)+[a-zA-Z0-9])$`),
+					`Invalid name for Airflow Integration Runtime: minimum 3 characters, must start and end with a number or a letter, may only consist of letters, numbers and dashes and no consecutive dashes.`,
+				),
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: factories.ValidateFactoryID,
+			},
+
+			"location": commonschema.Location(),
+
+			"airflow_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "2.6.3",
+				ValidateFunc: validation.StringInSlice([]string{
+					"2.4.3",
+					"2.6.3",
+				}, false),
+			},
+
+			"compute_size": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "Small",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Small",
+					"Large",
+				}, false),
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"environment_variables": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"extra_nodes": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 50),
+			},
+
+			"requirements": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryIntegrationRuntimeAirflowCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := factories.ParseFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := integrationruntimes.NewIntegrationRuntimeID(dataFactoryId.SubscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id, integrationruntimes.DefaultGetOperationOptions())
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_data_factory_integration_runtime_airflow", id.ID())
+		}
+	}
+
+	environmentVariables := make(map[string]string)
+	for k, v := range d.Get("environment_variables").(map[string]interface{}) {
+		environmentVariables[k] = v.(string)
+	}
+
+	integrationRuntime := integrationruntimes.IntegrationRuntimeResource{
+		Name: &id.IntegrationRuntimeName,
+		Properties: dataFactoryAirflowIntegrationRuntime{
+			Description: pointer.To(d.Get("description").(string)),
+			TypeProperties: dataFactoryAirflowIntegrationRuntimeTypeProperties{
+				ComputeProperties: &dataFactoryAirflowComputeProperties{
+					Location:    pointer.To(location.Normalize(d.Get("location").(string))),
+					ComputeSize: pointer.To(d.Get("compute_size").(string)),
+					ExtraNodes:  pointer.To(int64(d.Get("extra_nodes").(int))),
+				},
+				AirflowProperties: &dataFactoryAirflowProperties{
+					AirflowVersion:           pointer.To(d.Get("airflow_version").(string)),
+					AirflowRequiredArguments: utils.ExpandStringSlice(d.Get("requirements").([]interface{})),
+					EnvironmentVariables:     pointer.To(environmentVariables),
+					// Basic authentication requires credentials to be supplied in plain text, Microsoft Entra ID is used instead
+					EnableAADIntegration: pointer.To(true),
+				},
+			},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, integrationRuntime, integrationruntimes.DefaultCreateOrUpdateOperationOptions()); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryIntegrationRuntimeAirflowRead(d, meta)
+}
+
+func resourceDataFactoryIntegrationRuntimeAirflowRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := integrationruntimes.ParseIntegrationRuntimeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dataFactoryId := factories.NewFactoryID(id.SubscriptionId, id.ResourceGroupName, id.FactoryName)
+
+	resp, err := client.Get(ctx, *id, integrationruntimes.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.IntegrationRuntimeName)
+	d.Set("data_factory_id", dataFactoryId.ID())
+
+	if model := resp.Model; model != nil {
+		runTime, err := decodeDataFactoryAirflowIntegrationRuntime(model.Properties)
+		if err != nil {
+			return fmt.Errorf("decoding %s: %+v", *id, err)
+		}
+
+		d.Set("description", pointer.From(runTime.Description))
+
+		if computeProps := runTime.TypeProperties.ComputeProperties; computeProps != nil {
+			d.Set("location", location.NormalizeNilable(computeProps.Location))
+			d.Set("compute_size", pointer.From(computeProps.ComputeSize))
+			d.Set("extra_nodes", pointer.From(computeProps.ExtraNodes))
+		}
+
+		if airflowProps := runTime.TypeProperties.AirflowProperties; airflowProps != nil {
+			d.Set("airflow_version", pointer.From(airflowProps.AirflowVersion))
+			d.Set("environment_variables", pointer.From(airflowProps.EnvironmentVariables))
+			d.Set("requirements", pointer.From(airflowProps.AirflowRequiredArguments))
+		}
+	}
+
+	return nil
+}
+
+func resourceDataFactoryIntegrationRuntimeAirflowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := integrationruntimes.ParseIntegrationRuntimeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, *id)
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+// dataFactoryAirflowIntegrationRuntime models the Integration Runtime backing a Workflow Orchestration Manager
+// (Managed Airflow) environment, which isn't part of the Data Factory API Specification and so isn't defined by the SDK.
+type dataFactoryAirflowIntegrationRuntime struct {
+	Description    *string                                            `json:"description,omitempty"`
+	TypeProperties dataFactoryAirflowIntegrationRuntimeTypeProperties `json:"typeProperties"`
+}
+
+type dataFactoryAirflowIntegrationRuntimeTypeProperties struct {
+	ComputeProperties *dataFactoryAirflowComputeProperties `json:"computeProperties,omitempty"`
+	AirflowProperties *dataFactoryAirflowProperties        `json:"airflowProperties,omitempty"`
+}
+
+type dataFactoryAirflowComputeProperties struct {
+	Location    *string `json:"location,omitempty"`
+	ComputeSize *string `json:"computeSize,omitempty"`
+	ExtraNodes  *int64  `json:"extraNodes,omitempty"`
+}
+
+type dataFactoryAirflowProperties struct {
+	AirflowVersion           *string            `json:"airflowVersion,omitempty"`
+	AirflowRequiredArguments *[]string          `json:"airflowRequiredArguments,omitempty"`
+	EnableAADIntegration     *bool              `json:"enableAADIntegration,omitempty"`
+	EnvironmentVariables     *map[string]string `json:"environmentVariables,omitempty"`
+}
+
+var _ integrationruntimes.IntegrationRuntime = dataFactoryAirflowIntegrationRuntime{}
+
+func (s dataFactoryAirflowIntegrationRuntime) IntegrationRuntime() integrationruntimes.BaseIntegrationRuntimeImpl {
+	return integrationruntimes.BaseIntegrationRuntimeImpl{
+		Description: s.Description,
+		Type:        dataFactoryIntegrationRuntimeTypeAirflow,
+	}
+}
+
+var _ json.Marshaler = dataFactoryAirflowIntegrationRuntime{}
+
+func (s dataFactoryAirflowIntegrationRuntime) MarshalJSON() ([]byte, error) {
+	type wrapper dataFactoryAirflowIntegrationRuntime
+	encoded, err := json.Marshal(wrapper(s))
+	if err != nil {
+		return nil, fmt.Errorf("marshaling dataFactoryAirflowIntegrationRuntime: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err = json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling dataFactoryAirflowIntegrationRuntime: %+v", err)
+	}
+
+	decoded["type"] = string(dataFactoryIntegrationRuntimeTypeAirflow)
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling dataFactoryAirflowIntegrationRuntime: %+v", err)
+	}
+
+	return encoded, nil
+}
+
+// decodeDataFactoryAirflowIntegrationRuntime converts the raw Integration Runtime returned by the SDK, which can't
+// unmarshal the `Airflow` type itself, into a dataFactoryAirflowIntegrationRuntime.
+func decodeDataFactoryAirflowIntegrationRuntime(input integrationruntimes.IntegrationRuntime) (*dataFactoryAirflowIntegrationRuntime, error) {
+	raw, ok := input.(integrationruntimes.RawIntegrationRuntimeImpl)
+	if !ok || !strings.EqualFold(raw.Type, string(dataFactoryIntegrationRuntimeTypeAirflow)) {
+		return nil, fmt.Errorf("expected an Integration Runtime of type %q but got %q", dataFactoryIntegrationRuntimeTypeAirflow, input.IntegrationRuntime().Type)
+	}
+
+	encoded, err := json.Marshal(raw.Values)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling Integration Runtime: %+v", err)
+	}
+
+	var out dataFactoryAirflowIntegrationRuntime
+	if err := json.Unmarshal(encoded, &out); err != nil {
+		return nil, fmt.Errorf("unmarshaling Integration Runtime: %+v", err)
+	}
+
+	return &out, nil
+}
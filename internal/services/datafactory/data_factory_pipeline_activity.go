@@ -0,0 +1,527 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datafactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/pipelines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var dataFactoryPipelineActivityTypes = []string{"copy", "execute_pipeline", "lookup", "web"}
+
+func dataFactoryPipelineActivitySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{"activities_json"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"description": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+				},
+
+				"depends_on": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"activity": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"conditions": {
+								Type:     pluginsdk.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringInSlice(pipelines.PossibleValuesForDependencyCondition(), false),
+								},
+							},
+						},
+					},
+				},
+
+				"copy": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"source_dataset_name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"source_type": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"sink_dataset_name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"sink_type": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+
+				"execute_pipeline": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"pipeline_name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"parameters": {
+								Type:     pluginsdk.TypeMap,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+							},
+
+							"wait_on_completion_enabled": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+						},
+					},
+				},
+
+				"lookup": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"dataset_name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"source_type": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"first_row_only_enabled": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+						},
+					},
+				},
+
+				"web": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"method": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(pipelines.PossibleValuesForWebActivityMethod(), false),
+							},
+
+							"url": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"body": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"headers": {
+								Type:     pluginsdk.TypeMap,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateDataFactoryPipelineActivities checks that each `activity` defines exactly one type of activity and that
+// the activities it depends on exist, so that these are caught during the plan rather than by the API. Names which
+// aren't known until apply are empty during the plan and so are skipped.
+func validateDataFactoryPipelineActivities(input []interface{}) error {
+	names := make(map[string]bool)
+	for _, item := range input {
+		if v, ok := item.(map[string]interface{}); ok {
+			name := v["name"].(string)
+			if name == "" {
+				continue
+			}
+			if names[name] {
+				return fmt.Errorf("the name %q is used by more than one `activity`", name)
+			}
+			names[name] = true
+		}
+	}
+
+	for _, item := range input {
+		v, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := v["name"].(string)
+
+		definedTypes := make([]string, 0)
+		for _, activityType := range dataFactoryPipelineActivityTypes {
+			if blocks, ok := v[activityType].([]interface{}); ok && len(blocks) > 0 {
+				definedTypes = append(definedTypes, activityType)
+			}
+		}
+		if len(definedTypes) != 1 {
+			return fmt.Errorf("the `activity` %q must define exactly one of `%s`, got %d", name, strings.Join(dataFactoryPipelineActivityTypes, "`, `"), len(definedTypes))
+		}
+
+		for _, raw := range v["depends_on"].([]interface{}) {
+			dependency, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			dependsOn := dependency["activity"].(string)
+			if dependsOn == "" || name == "" {
+				continue
+			}
+			if dependsOn == name {
+				return fmt.Errorf("the `activity` %q can't depend on itself", name)
+			}
+			if !names[dependsOn] {
+				return fmt.Errorf("the `activity` %q depends on %q which isn't defined", name, dependsOn)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandDataFactoryPipelineActivities(input []interface{}) (*[]pipelines.Activity, error) {
+	if err := validateDataFactoryPipelineActivities(input); err != nil {
+		return nil, err
+	}
+
+	activities := make([]pipelines.Activity, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		name := v["name"].(string)
+		description := pointer.To(v["description"].(string))
+		dependsOn := expandDataFactoryPipelineActivityDependencies(v["depends_on"].([]interface{}))
+
+		if blocks := v["copy"].([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+			copyActivity := blocks[0].(map[string]interface{})
+			activities = append(activities, pipelines.CopyActivity{
+				Name:        name,
+				Description: description,
+				DependsOn:   dependsOn,
+				Inputs: &[]pipelines.DatasetReference{
+					{
+						ReferenceName: copyActivity["source_dataset_name"].(string),
+						Type:          pipelines.DatasetReferenceTypeDatasetReference,
+					},
+				},
+				Outputs: &[]pipelines.DatasetReference{
+					{
+						ReferenceName: copyActivity["sink_dataset_name"].(string),
+						Type:          pipelines.DatasetReferenceTypeDatasetReference,
+					},
+				},
+				TypeProperties: pipelines.CopyActivityTypeProperties{
+					Source: pipelines.BaseCopySourceImpl{
+						Type: copyActivity["source_type"].(string),
+					},
+					Sink: pipelines.BaseCopySinkImpl{
+						Type: copyActivity["sink_type"].(string),
+					},
+				},
+			})
+			continue
+		}
+
+		if blocks := v["execute_pipeline"].([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+			executePipeline := blocks[0].(map[string]interface{})
+			activities = append(activities, pipelines.ExecutePipelineActivity{
+				Name:        name,
+				Description: description,
+				DependsOn:   dependsOn,
+				TypeProperties: pipelines.ExecutePipelineActivityTypeProperties{
+					Parameters: pointer.To(executePipeline["parameters"].(map[string]interface{})),
+					Pipeline: pipelines.PipelineReference{
+						ReferenceName: executePipeline["pipeline_name"].(string),
+						Type:          pipelines.PipelineReferenceTypePipelineReference,
+					},
+					WaitOnCompletion: pointer.To(executePipeline["wait_on_completion_enabled"].(bool)),
+				},
+			})
+			continue
+		}
+
+		if blocks := v["lookup"].([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+			lookup := blocks[0].(map[string]interface{})
+			activities = append(activities, pipelines.LookupActivity{
+				Name:        name,
+				Description: description,
+				DependsOn:   dependsOn,
+				TypeProperties: pipelines.LookupActivityTypeProperties{
+					Dataset: pipelines.DatasetReference{
+						ReferenceName: lookup["dataset_name"].(string),
+						Type:          pipelines.DatasetReferenceTypeDatasetReference,
+					},
+					FirstRowOnly: pointer.To(lookup["first_row_only_enabled"].(bool)),
+					Source: pipelines.BaseCopySourceImpl{
+						Type: lookup["source_type"].(string),
+					},
+				},
+			})
+			continue
+		}
+
+		if blocks := v["web"].([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+			web := blocks[0].(map[string]interface{})
+			props := pipelines.WebActivityTypeProperties{
+				Method: pipelines.WebActivityMethod(web["method"].(string)),
+				Url:    web["url"].(string),
+			}
+			if body := web["body"].(string); body != "" {
+				props.Body = pointer.To(interface{}(body))
+			}
+			if headers := web["headers"].(map[string]interface{}); len(headers) > 0 {
+				props.Headers = pointer.To(headers)
+			}
+
+			activities = append(activities, pipelines.WebActivity{
+				Name:           name,
+				Description:    description,
+				DependsOn:      dependsOn,
+				TypeProperties: props,
+			})
+			continue
+		}
+	}
+
+	return &activities, nil
+}
+
+func expandDataFactoryPipelineActivityDependencies(input []interface{}) *[]pipelines.ActivityDependency {
+	if len(input) == 0 {
+		return nil
+	}
+
+	dependencies := make([]pipelines.ActivityDependency, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		conditions := make([]pipelines.DependencyCondition, 0)
+		for _, condition := range v["conditions"].([]interface{}) {
+			conditions = append(conditions, pipelines.DependencyCondition(condition.(string)))
+		}
+
+		dependencies = append(dependencies, pipelines.ActivityDependency{
+			Activity:             v["activity"].(string),
+			DependencyConditions: conditions,
+		})
+	}
+
+	return &dependencies
+}
+
+// flattenDataFactoryPipelineActivities returns the activities as `activity` blocks, the second return value is false
+// when one of the activities can't be represented by an `activity` block and so must be managed using `activities_json`.
+func flattenDataFactoryPipelineActivities(input *[]pipelines.Activity) ([]interface{}, bool) {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output, true
+	}
+
+	for _, item := range *input {
+		activity := map[string]interface{}{
+			"copy":             []interface{}{},
+			"execute_pipeline": []interface{}{},
+			"lookup":           []interface{}{},
+			"web":              []interface{}{},
+		}
+
+		switch v := item.(type) {
+		case pipelines.CopyActivity:
+			if v.Inputs == nil || len(*v.Inputs) != 1 || v.Outputs == nil || len(*v.Outputs) != 1 || v.TypeProperties.Source == nil || v.TypeProperties.Sink == nil {
+				return nil, false
+			}
+			activity["name"] = v.Name
+			activity["description"] = pointer.From(v.Description)
+			activity["depends_on"] = flattenDataFactoryPipelineActivityDependencies(v.DependsOn)
+			activity["copy"] = []interface{}{
+				map[string]interface{}{
+					"source_dataset_name": (*v.Inputs)[0].ReferenceName,
+					"source_type":         v.TypeProperties.Source.CopySource().Type,
+					"sink_dataset_name":   (*v.Outputs)[0].ReferenceName,
+					"sink_type":           v.TypeProperties.Sink.CopySink().Type,
+				},
+			}
+
+		case pipelines.ExecutePipelineActivity:
+			parameters, ok := flattenDataFactoryPipelineActivityStringMap(v.TypeProperties.Parameters)
+			if !ok {
+				return nil, false
+			}
+			activity["name"] = v.Name
+			activity["description"] = pointer.From(v.Description)
+			activity["depends_on"] = flattenDataFactoryPipelineActivityDependencies(v.DependsOn)
+			activity["execute_pipeline"] = []interface{}{
+				map[string]interface{}{
+					"pipeline_name":              v.TypeProperties.Pipeline.ReferenceName,
+					"parameters":                 parameters,
+					"wait_on_completion_enabled": pointer.From(v.TypeProperties.WaitOnCompletion),
+				},
+			}
+
+		case pipelines.LookupActivity:
+			if v.TypeProperties.Source == nil {
+				return nil, false
+			}
+			activity["name"] = v.Name
+			activity["description"] = pointer.From(v.Description)
+			activity["depends_on"] = flattenDataFactoryPipelineActivityDependencies(v.DependsOn)
+			activity["lookup"] = []interface{}{
+				map[string]interface{}{
+					"dataset_name":           v.TypeProperties.Dataset.ReferenceName,
+					"source_type":            v.TypeProperties.Source.CopySource().Type,
+					"first_row_only_enabled": pointer.From(v.TypeProperties.FirstRowOnly),
+				},
+			}
+
+		case pipelines.WebActivity:
+			url, ok := v.TypeProperties.Url.(string)
+			if !ok {
+				return nil, false
+			}
+			headers, ok := flattenDataFactoryPipelineActivityStringMap(v.TypeProperties.Headers)
+			if !ok {
+				return nil, false
+			}
+			body := ""
+			if v.TypeProperties.Body != nil {
+				if body, ok = (*v.TypeProperties.Body).(string); !ok {
+					return nil, false
+				}
+			}
+			activity["name"] = v.Name
+			activity["description"] = pointer.From(v.Description)
+			activity["depends_on"] = flattenDataFactoryPipelineActivityDependencies(v.DependsOn)
+			activity["web"] = []interface{}{
+				map[string]interface{}{
+					"method":  string(v.TypeProperties.Method),
+					"url":     url,
+					"body":    body,
+					"headers": headers,
+				},
+			}
+
+		default:
+			return nil, false
+		}
+
+		output = append(output, activity)
+	}
+
+	return output, true
+}
+
+func flattenDataFactoryPipelineActivityDependencies(input *[]pipelines.ActivityDependency) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		conditions := make([]interface{}, 0)
+		for _, condition := range item.DependencyConditions {
+			conditions = append(conditions, string(condition))
+		}
+
+		output = append(output, map[string]interface{}{
+			"activity":   item.Activity,
+			"conditions": conditions,
+		})
+	}
+
+	return output
+}
+
+// flattenDataFactoryPipelineActivityStringMap returns false when a value isn't a string (e.g. an expression object),
+// since these can't be represented in a map of strings.
+func flattenDataFactoryPipelineActivityStringMap(input *map[string]interface{}) (map[string]interface{}, bool) {
+	output := make(map[string]interface{})
+	if input == nil {
+		return output, true
+	}
+
+	for k, v := range *input {
+		s, ok := v.(string)
+		if !ok {
+			return nil, false
+		}
+		output[k] = s
+	}
+
+	return output, true
+}
+
+func flattenDataFactoryPipelineActivitiesJson(input *[]pipelines.Activity) (string, error) {
+	if input == nil {
+		return "", nil
+	}
+
+	activities, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return string(activities), nil
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datafactory
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/pipelines"
+)
+
+func TestDataFactoryPipelineActivitiesRoundTrip(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name":             "lookup",
+			"description":      "looks up the watermark",
+			"depends_on":       []interface{}{},
+			"copy":             []interface{}{},
+			"execute_pipeline": []interface{}{},
+			"lookup": []interface{}{
+				map[string]interface{}{
+					"dataset_name":           "watermark",
+					"source_type":            "AzureSqlSource",
+					"first_row_only_enabled": true,
+				},
+			},
+			"web": []interface{}{},
+		},
+		map[string]interface{}{
+			"name":        "copy",
+			"description": "",
+			"depends_on": []interface{}{
+				map[string]interface{}{
+					"activity":   "lookup",
+					"conditions": []interface{}{"Succeeded"},
+				},
+			},
+			"copy": []interface{}{
+				map[string]interface{}{
+					"source_dataset_name": "source",
+					"source_type":         "DelimitedTextSource",
+					"sink_dataset_name":   "sink",
+					"sink_type":           "ParquetSink",
+				},
+			},
+			"execute_pipeline": []interface{}{},
+			"lookup":           []interface{}{},
+			"web":              []interface{}{},
+		},
+		map[string]interface{}{
+			"name":        "child",
+			"description": "",
+			"depends_on": []interface{}{
+				map[string]interface{}{
+					"activity":   "copy",
+					"conditions": []interface{}{"Succeeded", "Failed"},
+				},
+			},
+			"copy": []interface{}{},
+			"execute_pipeline": []interface{}{
+				map[string]interface{}{
+					"pipeline_name": "child",
+					"parameters": map[string]interface{}{
+						"date": "@pipeline().TriggerTime",
+					},
+					"wait_on_completion_enabled": false,
+				},
+			},
+			"lookup": []interface{}{},
+			"web":    []interface{}{},
+		},
+		map[string]interface{}{
+			"name":             "notify",
+			"description":      "",
+			"depends_on":       []interface{}{},
+			"copy":             []interface{}{},
+			"execute_pipeline": []interface{}{},
+			"lookup":           []interface{}{},
+			"web": []interface{}{
+				map[string]interface{}{
+					"method": "POST",
+					"url":    "https://example.com/notify",
+					"body":   `{"status":"done"}`,
+					"headers": map[string]interface{}{
+						"Content-Type": "application/json",
+					},
+				},
+			},
+		},
+	}
+
+	expanded, err := expandDataFactoryPipelineActivities(input)
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+
+	// round-trip through the API representation, as the activities are read back from the API
+	encoded, err := json.Marshal(pipelines.Pipeline{Activities: expanded})
+	if err != nil {
+		t.Fatalf("marshaling: %+v", err)
+	}
+	var decoded pipelines.Pipeline
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+
+	actual, ok := flattenDataFactoryPipelineActivities(decoded.Activities)
+	if !ok {
+		t.Fatalf("expected the activities to be representable as `activity` blocks")
+	}
+	if !reflect.DeepEqual(input, actual) {
+		t.Fatalf("expected %+v but got %+v", input, actual)
+	}
+}
+
+func TestDataFactoryPipelineActivitiesUnsupported(t *testing.T) {
+	input := []pipelines.Activity{
+		pipelines.WaitActivity{
+			Name: "wait",
+			TypeProperties: pipelines.WaitActivityTypeProperties{
+				WaitTimeInSeconds: 10,
+			},
+		},
+	}
+
+	if _, ok := flattenDataFactoryPipelineActivities(&input); ok {
+		t.Fatalf("expected a `Wait` activity not to be representable as an `activity` block")
+	}
+}
+
+func TestValidateDataFactoryPipelineActivities(t *testing.T) {
+	activity := func(name string, dependsOn []string, types ...string) interface{} {
+		dependencies := make([]interface{}, 0)
+		for _, d := range dependsOn {
+			dependencies = append(dependencies, map[string]interface{}{
+				"activity":   d,
+				"conditions": []interface{}{"Succeeded"},
+			})
+		}
+
+		v := map[string]interface{}{
+			"name":             name,
+			"depends_on":       dependencies,
+			"copy":             []interface{}{},
+			"execute_pipeline": []interface{}{},
+			"lookup":           []interface{}{},
+			"web":              []interface{}{},
+		}
+		for _, activityType := range types {
+			v[activityType] = []interface{}{map[string]interface{}{}}
+		}
+		return v
+	}
+
+	cases := []struct {
+		Name  string
+		Input []interface{}
+		Valid bool
+	}{
+		{
+			Name:  "single type",
+			Input: []interface{}{activity("a", nil, "web")},
+			Valid: true,
+		},
+		{
+			Name:  "no type",
+			Input: []interface{}{activity("a", nil)},
+			Valid: false,
+		},
+		{
+			Name:  "multiple types",
+			Input: []interface{}{activity("a", nil, "web", "lookup")},
+			Valid: false,
+		},
+		{
+			Name:  "duplicate names",
+			Input: []interface{}{activity("a", nil, "web"), activity("a", nil, "lookup")},
+			Valid: false,
+		},
+		{
+			Name:  "valid dependency",
+			Input: []interface{}{activity("a", nil, "web"), activity("b", []string{"a"}, "lookup")},
+			Valid: true,
+		},
+		{
+			Name:  "missing dependency",
+			Input: []interface{}{activity("a", nil, "web"), activity("b", []string{"c"}, "lookup")},
+			Valid: false,
+		},
+		{
+			Name:  "self dependency",
+			Input: []interface{}{activity("a", []string{"a"}, "web")},
+			Valid: false,
+		},
+		{
+			Name:  "unknown name",
+			Input: []interface{}{activity("", nil, "web"), activity("b", []string{""}, "lookup")},
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := validateDataFactoryPipelineActivities(tc.Input)
+			if valid := err == nil; valid != tc.Valid {
+				t.Fatalf("expected valid to be %t but got %t (%+v)", tc.Valid, valid, err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package datafactory
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -82,8 +83,11 @@ func resourceDataFactoryPipeline() *pluginsdk.Resource {
 				Optional:         true,
 				StateFunc:        utils.NormalizeJson,
 				DiffSuppressFunc: suppressJsonOrderingDifference,
+				ConflictsWith:    []string{"activity"},
 			},
 
+			"activity": dataFactoryPipelineActivitySchema(),
+
 			"annotations": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -109,6 +113,17 @@ func resourceDataFactoryPipeline() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				// the activities may reference values which aren't known until apply, in which case they're validated then
+				if !d.NewValueKnown("activity") {
+					return nil
+				}
+
+				return validateDataFactoryPipelineActivities(d.Get("activity").([]interface{}))
+			}),
+		),
 	}
 }
 
@@ -177,6 +192,14 @@ func resourceDataFactoryPipelineCreateUpdate(d *pluginsdk.ResourceData, meta int
 		payload.Properties.Activities = pointer.To(activities)
 	}
 
+	if v, ok := d.GetOk("activity"); ok {
+		activities, err := expandDataFactoryPipelineActivities(v.([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `activity`: %+v", err)
+		}
+		payload.Properties.Activities = activities
+	}
+
 	annotations := make([]interface{}, 0)
 	if v, ok := d.GetOk("annotations"); ok {
 		annotations = v.([]interface{})
@@ -269,16 +292,25 @@ func resourceDataFactoryPipelineRead(d *pluginsdk.ResourceData, meta interface{}
 			return fmt.Errorf("setting `variables`: %+v", err)
 		}
 
+		// activities defined using `activity` blocks are read back into them, unless they've been changed outside of
+		// Terraform in a way which can't be represented by the blocks - in which case they're surfaced in `activities_json`
+		activities := make([]interface{}, 0)
+		useActivityBlocks := false
+		if len(d.Get("activity").([]interface{})) > 0 {
+			activities, useActivityBlocks = flattenDataFactoryPipelineActivities(props.Activities)
+		}
+
 		activitiesJson := ""
-		if activities := props.Activities; activities != nil {
-			acts, err := json.Marshal(activities)
-			if err != nil {
+		if !useActivityBlocks {
+			activities = make([]interface{}, 0)
+			if activitiesJson, err = flattenDataFactoryPipelineActivitiesJson(props.Activities); err != nil {
 				return fmt.Errorf("marshaling `activities_json`: %+v", err)
 			}
-
-			activitiesJson = string(acts)
 		}
 		d.Set("activities_json", activitiesJson)
+		if err := d.Set("activity", activities); err != nil {
+			return fmt.Errorf("setting `activity`: %+v", err)
+		}
 	}
 
 	return nil
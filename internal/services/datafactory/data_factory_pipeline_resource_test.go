@@ -125,6 +125,41 @@ func TestAccDataFactoryPipeline_activities(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryPipeline_activityBlocks(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_pipeline", "test")
+	r := PipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.activityBlocks(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activity.#").HasValue("1"),
+				check.That(data.ResourceName).Key("activities_json").IsEmpty(),
+			),
+		},
+		// activities are imported into `activities_json` since the configuration isn't available during import
+		data.ImportStep("activity", "activities_json"),
+		{
+			Config: r.activityBlocksUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activity.#").HasValue("2"),
+				check.That(data.ResourceName).Key("activities_json").IsEmpty(),
+			),
+		},
+		data.ImportStep("activity", "activities_json"),
+		{
+			Config: r.activityBlocks(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activity.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("activity", "activities_json"),
+	})
+}
+
 func (t PipelineResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := pipelines.ParsePipelineID(state.ID)
 	if err != nil {
@@ -469,3 +504,94 @@ JSON
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
+
+func (PipelineResource) activityBlocksTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfv2%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_pipeline" "child" {
+  name            = "acctestchild%d"
+  data_factory_id = azurerm_data_factory.test.id
+  parameters = {
+    "date" = ""
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (r PipelineResource) activityBlocks(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name            = "acctest%d"
+  data_factory_id = azurerm_data_factory.test.id
+
+  activity {
+    name = "Notify"
+
+    web {
+      method = "GET"
+      url    = "https://www.hashicorp.com"
+    }
+  }
+}
+`, r.activityBlocksTemplate(data), data.RandomInteger)
+}
+
+func (r PipelineResource) activityBlocksUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name            = "acctest%d"
+  data_factory_id = azurerm_data_factory.test.id
+
+  activity {
+    name        = "Run Child"
+    description = "runs the child pipeline"
+
+    execute_pipeline {
+      pipeline_name              = azurerm_data_factory_pipeline.child.name
+      wait_on_completion_enabled = false
+
+      parameters = {
+        "date" = "@pipeline().TriggerTime"
+      }
+    }
+  }
+
+  activity {
+    name = "Notify"
+
+    depends_on {
+      activity   = "Run Child"
+      conditions = ["Succeeded", "Failed"]
+    }
+
+    web {
+      method = "POST"
+      url    = "https://www.hashicorp.com"
+      body   = "{\"status\":\"done\"}"
+
+      headers = {
+        "Content-Type" = "application/json"
+      }
+    }
+  }
+}
+`, r.activityBlocksTemplate(data), data.RandomInteger)
+}
@@ -53,6 +53,26 @@ func dataSourceDataProtectionBackupVault() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"cross_subscription_restore_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"immutability": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"soft_delete": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"retention_duration_in_days": {
+				Type:     pluginsdk.TypeFloat,
+				Computed: true,
+			},
+
 			"identity": commonschema.SystemAssignedIdentityComputed(),
 
 			"tags": commonschema.TagsDataSource(),
@@ -92,6 +112,24 @@ func dataSourceDataProtectionBackupVaultRead(d *pluginsdk.ResourceData, meta int
 			d.Set("redundancy", string(pointer.From((props.StorageSettings)[0].Type)))
 		}
 
+		immutability := backupvaults.ImmutabilityStateDisabled
+		if securitySetting := props.SecuritySettings; securitySetting != nil {
+			if immutabilitySettings := securitySetting.ImmutabilitySettings; immutabilitySettings != nil && immutabilitySettings.State != nil {
+				immutability = *immutabilitySettings.State
+			}
+			if softDelete := securitySetting.SoftDeleteSettings; softDelete != nil {
+				d.Set("soft_delete", string(pointer.From(softDelete.State)))
+				d.Set("retention_duration_in_days", pointer.From(softDelete.RetentionDurationInDays))
+			}
+		}
+		d.Set("immutability", string(immutability))
+
+		crossSubscriptionRestoreState := ""
+		if featureSetting := props.FeatureSettings; featureSetting != nil && featureSetting.CrossSubscriptionRestoreSettings != nil {
+			crossSubscriptionRestoreState = string(pointer.From(featureSetting.CrossSubscriptionRestoreSettings.State))
+		}
+		d.Set("cross_subscription_restore_state", crossSubscriptionRestoreState)
+
 		if err = d.Set("identity", dataSourceFlattenBackupVaultDppIdentityDetails(model.Identity)); err != nil {
 			return fmt.Errorf("setting `identity`: %+v", err)
 		}
@@ -29,6 +29,9 @@ func TestAccDataProtectionBackupVaultDataSource_complete(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("datastore_type").HasValue("VaultStore"),
 				check.That(data.ResourceName).Key("redundancy").HasValue("LocallyRedundant"),
+				check.That(data.ResourceName).Key("immutability").Exists(),
+				check.That(data.ResourceName).Key("soft_delete").Exists(),
+				check.That(data.ResourceName).Key("cross_subscription_restore_state").Exists(),
 				check.That(data.ResourceName).Key("location").Exists(),
 				check.That(data.ResourceName).Key("identity.0.type").HasValue("SystemAssigned"),
 				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
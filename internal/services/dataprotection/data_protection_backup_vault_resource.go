@@ -86,6 +86,13 @@ func resourceDataProtectionBackupVault() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"cross_subscription_restore_state": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(backupvaults.PossibleValuesForCrossSubscriptionRestoreState(), false),
+			},
+
 			"retention_duration_in_days": {
 				Type:         pluginsdk.TypeFloat,
 				Optional:     true,
@@ -128,6 +135,11 @@ func resourceDataProtectionBackupVault() *pluginsdk.Resource {
 				return old.(string) == string(backupvaults.SoftDeleteStateAlwaysOn) && new.(string) != string(backupvaults.SoftDeleteStateAlwaysOn)
 			}),
 
+			// Once `cross_subscription_restore_state` is `PermanentlyDisabled` it cannot be changed.
+			pluginsdk.ForceNewIfChange("cross_subscription_restore_state", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(string) == string(backupvaults.CrossSubscriptionRestoreStatePermanentlyDisabled) && new.(string) != string(backupvaults.CrossSubscriptionRestoreStatePermanentlyDisabled)
+			}),
+
 			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
 				redundancy := d.Get("redundancy").(string)
 				crossRegionRestore := d.GetRawConfig().AsValueMap()["cross_region_restore_enabled"]
@@ -202,6 +214,15 @@ func resourceDataProtectionBackupVaultCreateUpdate(d *pluginsdk.ResourceData, me
 		}
 	}
 
+	if v, ok := d.GetOk("cross_subscription_restore_state"); ok {
+		if parameters.Properties.FeatureSettings == nil {
+			parameters.Properties.FeatureSettings = &backupvaults.FeatureSettings{}
+		}
+		parameters.Properties.FeatureSettings.CrossSubscriptionRestoreSettings = &backupvaults.CrossSubscriptionRestoreSettings{
+			State: pointer.To(backupvaults.CrossSubscriptionRestoreState(v.(string))),
+		}
+	}
+
 	if v, ok := d.GetOk("retention_duration_in_days"); ok {
 		parameters.Properties.SecuritySettings.SoftDeleteSettings.RetentionDurationInDays = pointer.To(v.(float64))
 	}
@@ -261,14 +282,19 @@ func resourceDataProtectionBackupVaultRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("immutability", string(immutability))
 
 		crossRegionStoreEnabled := false
+		crossSubscriptionRestoreState := ""
 		if featureSetting := model.Properties.FeatureSettings; featureSetting != nil {
 			if crossRegionRestore := featureSetting.CrossRegionRestoreSettings; crossRegionRestore != nil {
 				if pointer.From(crossRegionRestore.State) == backupvaults.CrossRegionRestoreStateEnabled {
 					crossRegionStoreEnabled = true
 				}
 			}
+			if crossSubscriptionRestore := featureSetting.CrossSubscriptionRestoreSettings; crossSubscriptionRestore != nil {
+				crossSubscriptionRestoreState = string(pointer.From(crossSubscriptionRestore.State))
+			}
 		}
 		d.Set("cross_region_restore_enabled", crossRegionStoreEnabled)
+		d.Set("cross_subscription_restore_state", crossSubscriptionRestoreState)
 
 		if err = d.Set("identity", flattenBackupVaultDppIdentityDetails(model.Identity)); err != nil {
 			return fmt.Errorf("setting `identity`: %+v", err)
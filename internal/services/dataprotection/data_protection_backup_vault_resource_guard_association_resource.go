@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataprotection
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupvaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/dppresourceguardproxies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/resourceguards"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	// the Backup Vault can only be associated with a single Resource Guard, which the service always names `DppResourceGuardProxy`
+	backupVaultResourceGuardProxyName = "DppResourceGuardProxy"
+	backupVaultResourceGuardProxyType = "Microsoft.DataProtection/backupVaults/backupResourceGuardProxies"
+
+	// this name does not matter, this value comes from Portal.
+	backupVaultResourceGuardProxyDeleteRequestName = "default"
+)
+
+type DataProtectionBackupVaultResourceGuardAssociationResource struct{}
+
+type DataProtectionBackupVaultResourceGuardAssociationModel struct {
+	DataProtectionBackupVaultId string `tfschema:"data_protection_backup_vault_id"`
+	ResourceGuardId             string `tfschema:"resource_guard_id"`
+}
+
+var _ sdk.Resource = DataProtectionBackupVaultResourceGuardAssociationResource{}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) ModelObject() interface{} {
+	return &DataProtectionBackupVaultResourceGuardAssociationModel{}
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) ResourceType() string {
+	return "azurerm_data_protection_backup_vault_resource_guard_association"
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return dppresourceguardproxies.ValidateBackupResourceGuardProxyID
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"data_protection_backup_vault_id": commonschema.ResourceIDReferenceRequiredForceNew(&backupvaults.BackupVaultId{}),
+
+		"resource_guard_id": commonschema.ResourceIDReferenceRequiredForceNew(&resourceguards.ResourceGuardId{}),
+	}
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DataProtection.ResourceGuardProxyClient
+
+			var model DataProtectionBackupVaultResourceGuardAssociationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			vaultId, err := backupvaults.ParseBackupVaultID(model.DataProtectionBackupVaultId)
+			if err != nil {
+				return err
+			}
+
+			id := dppresourceguardproxies.NewBackupResourceGuardProxyID(vaultId.SubscriptionId, vaultId.ResourceGroupName, vaultId.BackupVaultName, backupVaultResourceGuardProxyName)
+
+			existing, err := client.DppResourceGuardProxyGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			payload := dppresourceguardproxies.ResourceGuardProxyBaseResource{
+				Type: pointer.To(backupVaultResourceGuardProxyType),
+				Properties: &dppresourceguardproxies.ResourceGuardProxyBase{
+					ResourceGuardResourceId: pointer.To(model.ResourceGuardId),
+				},
+			}
+
+			if _, err := client.DppResourceGuardProxyCreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DataProtection.ResourceGuardProxyClient
+
+			id, err := dppresourceguardproxies.ParseBackupResourceGuardProxyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.DppResourceGuardProxyGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DataProtectionBackupVaultResourceGuardAssociationModel{
+				DataProtectionBackupVaultId: backupvaults.NewBackupVaultID(id.SubscriptionId, id.ResourceGroupName, id.BackupVaultName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				resourceGuardId, err := resourceguards.ParseResourceGuardIDInsensitively(pointer.From(model.Properties.ResourceGuardResourceId))
+				if err != nil {
+					return err
+				}
+				state.ResourceGuardId = resourceGuardId.ID()
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DataProtectionBackupVaultResourceGuardAssociationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DataProtection.ResourceGuardProxyClient
+
+			id, err := dppresourceguardproxies.ParseBackupResourceGuardProxyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DataProtectionBackupVaultResourceGuardAssociationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resourceGuardId, err := resourceguards.ParseResourceGuardID(model.ResourceGuardId)
+			if err != nil {
+				return err
+			}
+
+			// removing the association is itself a critical operation, so it has to be unlocked first
+			requestId := resourceguards.NewDeleteResourceGuardProxyRequestID(resourceGuardId.SubscriptionId, resourceGuardId.ResourceGroupName, resourceGuardId.ResourceGuardName, backupVaultResourceGuardProxyDeleteRequestName)
+			unlock := dppresourceguardproxies.UnlockDeleteRequest{
+				ResourceGuardOperationRequests: pointer.To([]string{requestId.ID()}),
+				ResourceToBeDeleted:            pointer.To(id.ID()),
+			}
+			if _, err := client.DppResourceGuardProxyUnlockDelete(ctx, *id, unlock, dppresourceguardproxies.DefaultDppResourceGuardProxyUnlockDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("unlocking the deletion of %s: %+v", *id, err)
+			}
+
+			if _, err := client.DppResourceGuardProxyDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -54,6 +54,34 @@ func TestAccDataProtectionBackupVault_crossRegionRestore(t *testing.T) {
 	})
 }
 
+func TestAccDataProtectionBackupVault_crossSubscriptionRestore(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_protection_backup_vault", "test")
+	r := DataProtectionBackupVaultResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.crossSubscriptionRestore(data, "Enabled"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.crossSubscriptionRestore(data, "Disabled"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.crossSubscriptionRestore(data, "PermanentlyDisabled"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccDataProtectionBackupVault_zoneRedundant(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_data_protection_backup_vault", "test")
 	r := DataProtectionBackupVaultResource{}
@@ -215,6 +243,22 @@ resource "azurerm_data_protection_backup_vault" "test" {
 `, template, data.RandomInteger, enabled)
 }
 
+func (r DataProtectionBackupVaultResource) crossSubscriptionRestore(data acceptance.TestData, state string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_protection_backup_vault" "test" {
+  name                             = "acctest-bv-%d"
+  resource_group_name              = azurerm_resource_group.test.name
+  location                         = azurerm_resource_group.test.location
+  datastore_type                   = "VaultStore"
+  redundancy                       = "LocallyRedundant"
+  cross_subscription_restore_state = %q
+}
+`, template, data.RandomInteger, state)
+}
+
 func (r DataProtectionBackupVaultResource) requiresImport(data acceptance.TestData) string {
 	config := r.basic(data)
 	return fmt.Sprintf(`
@@ -246,9 +290,10 @@ resource "azurerm_data_protection_backup_vault" "test" {
     type = "SystemAssigned"
   }
 
-  immutability               = "Disabled"
-  soft_delete                = "Off"
-  retention_duration_in_days = 14
+  immutability                     = "Disabled"
+  soft_delete                      = "Off"
+  retention_duration_in_days       = 14
+  cross_subscription_restore_state = "Disabled"
 
   tags = {
     ENV = "Test"
@@ -273,9 +318,10 @@ resource "azurerm_data_protection_backup_vault" "test" {
     type = "SystemAssigned"
   }
 
-  immutability               = "Locked"
-  soft_delete                = "On"
-  retention_duration_in_days = 15
+  immutability                     = "Locked"
+  soft_delete                      = "On"
+  retention_duration_in_days       = 15
+  cross_subscription_restore_state = "Enabled"
 
   tags = {
     ENV = "Test"
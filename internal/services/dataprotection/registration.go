@@ -67,5 +67,6 @@ func (r Registration) Resources() []sdk.Resource {
 		DataProtectionBackupInstanceMySQLFlexibleServerResource{},
 		DataProtectionBackupInstancePostgreSQLFlexibleServerResource{},
 		DataProtectionBackupVaultCustomerManagedKeyResource{},
+		DataProtectionBackupVaultResourceGuardAssociationResource{},
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/desktop"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/hostpool"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/scalingplan"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/scalingplanpersonalschedule"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/sessionhost"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/workspace"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -24,6 +25,8 @@ type Client struct {
 	SessionHostsClient      *sessionhost.SessionHostClient
 	ScalingPlansClient      *scalingplan.ScalingPlanClient
 	WorkspacesClient        *workspace.WorkspaceClient
+
+	ScalingPlanPersonalSchedulesClient *scalingplanpersonalschedule.ScalingPlanPersonalScheduleClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -63,6 +66,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(scalingPlansClient.Client, o.Authorizers.ResourceManager)
 
+	scalingPlanPersonalSchedulesClient, err := scalingplanpersonalschedule.NewScalingPlanPersonalScheduleClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ScalingPlanPersonalSchedule Client: %+v", err)
+	}
+	o.Configure(scalingPlanPersonalSchedulesClient.Client, o.Authorizers.ResourceManager)
+
 	workspacesClient, err := workspace.NewWorkspaceClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Workspaces Client: %+v", err)
@@ -77,5 +86,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		SessionHostsClient:      sessionHostsClient,
 		ScalingPlansClient:      scalingPlansClient,
 		WorkspacesClient:        workspacesClient,
+
+		ScalingPlanPersonalSchedulesClient: scalingPlanPersonalSchedulesClient,
 	}, nil
 }
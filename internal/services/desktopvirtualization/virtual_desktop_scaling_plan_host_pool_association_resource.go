@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/hostpool"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/scalingplan"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -64,6 +65,7 @@ func resourceVirtualDesktopScalingPlanHostPoolAssociation() *pluginsdk.Resource
 
 func resourceVirtualDesktopScalingPlanHostPoolAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).DesktopVirtualization.ScalingPlansClient
+	hostPoolsClient := meta.(*clients.Client).DesktopVirtualization.HostPoolsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -106,6 +108,21 @@ func resourceVirtualDesktopScalingPlanHostPoolAssociationCreate(d *pluginsdk.Res
 	if scalingPlanHostPoolAssociationExists(model.Properties, hostPoolStr) {
 		return tf.ImportAsExistsError("azurerm_virtual_desktop_scaling_plan_host_pool_association", associationId)
 	}
+
+	// a Scaling Plan can only be assigned to Host Pools of the same type, e.g. Personal Scaling Plans to Personal Host Pools
+	hostPool, err := hostPoolsClient.Get(ctx, hostpool.NewHostPoolID(hostPoolId.SubscriptionId, hostPoolId.ResourceGroupName, hostPoolId.HostPoolName))
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *hostPoolId, err)
+	}
+	if hostPool.Model != nil {
+		scalingPlanHostPoolType := string(scalingplan.ScalingHostPoolTypePooled)
+		if v := model.Properties.HostPoolType; v != nil {
+			scalingPlanHostPoolType = string(*v)
+		}
+		if !strings.EqualFold(string(hostPool.Model.Properties.HostPoolType), scalingPlanHostPoolType) {
+			return fmt.Errorf("%s has the Host Pool Type %q and can't be associated with %s which has the Host Pool Type %q", *hostPoolId, string(hostPool.Model.Properties.HostPoolType), *scalingPlanId, scalingPlanHostPoolType)
+		}
+	}
 	hostPoolAssociations = append(hostPoolAssociations, scalingplan.ScalingHostPoolReference{
 		HostPoolArmPath:    &hostPoolStr,
 		ScalingPlanEnabled: utils.Bool(d.Get("enabled").(bool)),
@@ -4,6 +4,7 @@
 package desktopvirtualization
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,12 +12,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/hostpool"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/scalingplan"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/scalingplanpersonalschedule"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -27,6 +30,9 @@ import (
 
 var scalingPlanResourceType = "azurerm_virtual_desktop_scaling_plan"
 
+// the SDK only defines the `Pooled` Host Pool Type for Scaling Plans, however the API also supports `Personal`
+const scalingHostPoolTypePersonal = scalingplan.ScalingHostPoolType("Personal")
+
 func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVirtualDesktopScalingPlanCreate,
@@ -46,6 +52,21 @@ func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			// Pooled Host Pools are scaled using `schedule` blocks and Personal Host Pools using `personal_schedule` blocks
+			switch diff.Get("host_pool_type").(string) {
+			case string(scalingHostPoolTypePersonal):
+				if len(diff.Get("personal_schedule").([]interface{})) == 0 {
+					return fmt.Errorf("at least one `personal_schedule` block must be specified when `host_pool_type` is `%s`", scalingHostPoolTypePersonal)
+				}
+			default:
+				if len(diff.Get("schedule").([]interface{})) == 0 {
+					return fmt.Errorf("at least one `schedule` block must be specified when `host_pool_type` is `%s`", scalingplan.ScalingHostPoolTypePooled)
+				}
+			}
+			return nil
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -80,10 +101,22 @@ func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"host_pool_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(scalingplan.ScalingHostPoolTypePooled),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(scalingplan.ScalingHostPoolTypePooled),
+					string(scalingHostPoolTypePersonal),
+				}, false),
+			},
+
 			"schedule": {
-				Type:     pluginsdk.TypeList,
-				Required: true,
-				MinItems: 1,
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MinItems:      1,
+				ConflictsWith: []string{"personal_schedule"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -220,6 +253,16 @@ func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 				},
 			},
 
+			"personal_schedule": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MinItems:      1,
+				ConflictsWith: []string{"schedule"},
+				Elem: &pluginsdk.Resource{
+					Schema: personalScheduleSchema(),
+				},
+			},
+
 			"host_pool": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -273,7 +316,7 @@ func resourceVirtualDesktopScalingPlanCreate(d *pluginsdk.ResourceData, meta int
 	location := location.Normalize(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	hostPoolType := scalingplan.ScalingHostPoolTypePooled // Only one possible value for this
+	hostPoolType := scalingplan.ScalingHostPoolType(d.Get("host_pool_type").(string))
 	payload := scalingplan.ScalingPlan{
 		Name:     utils.String(d.Get("name").(string)),
 		Location: location,
@@ -295,6 +338,15 @@ func resourceVirtualDesktopScalingPlanCreate(d *pluginsdk.ResourceData, meta int
 
 	d.SetId(id.ID())
 
+	// the schedules of a Personal Scaling Plan are nested resources which can only be created once the Scaling Plan exists
+	personalSchedulesClient := meta.(*clients.Client).DesktopVirtualization.ScalingPlanPersonalSchedulesClient
+	for _, schedule := range expandScalingPlanPersonalSchedules(d.Get("personal_schedule").([]interface{})) {
+		scheduleId := scalingplanpersonalschedule.NewPersonalScheduleID(id.SubscriptionId, id.ResourceGroupName, id.ScalingPlanName, pointer.From(schedule.Name))
+		if _, err := personalSchedulesClient.Create(ctx, scheduleId, schedule); err != nil {
+			return fmt.Errorf("creating %s: %+v", scheduleId, err)
+		}
+	}
+
 	return resourceVirtualDesktopScalingPlanRead(d, meta)
 }
 
@@ -328,6 +380,32 @@ func resourceVirtualDesktopScalingPlanUpdate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
+	if d.HasChange("personal_schedule") {
+		personalSchedulesClient := meta.(*clients.Client).DesktopVirtualization.ScalingPlanPersonalSchedulesClient
+
+		schedules := expandScalingPlanPersonalSchedules(d.Get("personal_schedule").([]interface{}))
+		configured := make(map[string]struct{}, len(schedules))
+		for _, schedule := range schedules {
+			scheduleId := scalingplanpersonalschedule.NewPersonalScheduleID(id.SubscriptionId, id.ResourceGroupName, id.ScalingPlanName, pointer.From(schedule.Name))
+			if _, err := personalSchedulesClient.Create(ctx, scheduleId, schedule); err != nil {
+				return fmt.Errorf("updating %s: %+v", scheduleId, err)
+			}
+			configured[strings.ToLower(scheduleId.PersonalScheduleName)] = struct{}{}
+		}
+
+		oldRaw, _ := d.GetChange("personal_schedule")
+		for _, schedule := range expandScalingPlanPersonalSchedules(oldRaw.([]interface{})) {
+			if _, ok := configured[strings.ToLower(pointer.From(schedule.Name))]; ok {
+				continue
+			}
+
+			scheduleId := scalingplanpersonalschedule.NewPersonalScheduleID(id.SubscriptionId, id.ResourceGroupName, id.ScalingPlanName, pointer.From(schedule.Name))
+			if resp, err := personalSchedulesClient.Delete(ctx, scheduleId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("deleting %s: %+v", scheduleId, err)
+			}
+		}
+	}
+
 	return resourceVirtualDesktopScalingPlanRead(d, meta)
 }
 
@@ -363,6 +441,29 @@ func resourceVirtualDesktopScalingPlanRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("schedule", flattenScalingPlanSchedule(model.Properties.Schedules))
 		d.Set("host_pool", flattenScalingHostpoolReference(model.Properties.HostPoolReferences))
 
+		hostPoolType := scalingplan.ScalingHostPoolTypePooled
+		if model.Properties.HostPoolType != nil {
+			hostPoolType = *model.Properties.HostPoolType
+		}
+		d.Set("host_pool_type", string(hostPoolType))
+
+		personalSchedules := make([]interface{}, 0)
+		if strings.EqualFold(string(hostPoolType), string(scalingHostPoolTypePersonal)) {
+			personalSchedulesClient := meta.(*clients.Client).DesktopVirtualization.ScalingPlanPersonalSchedulesClient
+			scalingPlanId := scalingplanpersonalschedule.NewScalingPlanID(id.SubscriptionId, id.ResourceGroupName, id.ScalingPlanName)
+			schedulesResp, err := personalSchedulesClient.ListComplete(ctx, scalingPlanId, scalingplanpersonalschedule.DefaultListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing Personal Schedules for %s: %+v", *id, err)
+			}
+			personalSchedules, err = flattenScalingPlanPersonalSchedules(schedulesResp.Items)
+			if err != nil {
+				return fmt.Errorf("flattening Personal Schedules for %s: %+v", *id, err)
+			}
+		}
+		if err := d.Set("personal_schedule", personalSchedules); err != nil {
+			return fmt.Errorf("setting `personal_schedule`: %+v", err)
+		}
+
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return err
 		}
@@ -595,3 +696,217 @@ func flattenScalingHostpoolReference(input *[]scalingplan.ScalingHostPoolReferen
 	}
 	return results
 }
+
+func personalScheduleSchema() map[string]*pluginsdk.Schema {
+	out := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"days_of_week": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(scalingplanpersonalschedule.PossibleValuesForDayOfWeek(), false),
+			},
+		},
+
+		"ramp_up_auto_start_hosts": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(scalingplanpersonalschedule.StartupBehaviorNone),
+			ValidateFunc: validation.StringInSlice(scalingplanpersonalschedule.PossibleValuesForStartupBehavior(), false),
+		},
+	}
+
+	// each of the four phases of a Personal Schedule supports the same settings
+	for _, phase := range []string{"ramp_up", "peak", "ramp_down", "off_peak"} {
+		out[phase+"_start_time"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validateTime(),
+		}
+
+		out[phase+"_start_vm_on_connect"] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		}
+
+		out[phase+"_action_on_disconnect"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(scalingplanpersonalschedule.SessionHandlingOperationNone),
+			ValidateFunc: validation.StringInSlice(scalingplanpersonalschedule.PossibleValuesForSessionHandlingOperation(), false),
+		}
+
+		out[phase+"_minutes_to_wait_on_disconnect"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+
+		out[phase+"_action_on_logoff"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(scalingplanpersonalschedule.SessionHandlingOperationNone),
+			ValidateFunc: validation.StringInSlice(scalingplanpersonalschedule.PossibleValuesForSessionHandlingOperation(), false),
+		}
+
+		out[phase+"_minutes_to_wait_on_logoff"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+	}
+
+	return out
+}
+
+// personalSchedulePhase holds the settings of one of the phases of a Personal Schedule, which the API exposes as
+// separate, prefixed, fields
+type personalSchedulePhase struct {
+	startTime                 **scalingplanpersonalschedule.Time
+	startVMOnConnect          **scalingplanpersonalschedule.SetStartVMOnConnect
+	actionOnDisconnect        **scalingplanpersonalschedule.SessionHandlingOperation
+	minutesToWaitOnDisconnect **int64
+	actionOnLogoff            **scalingplanpersonalschedule.SessionHandlingOperation
+	minutesToWaitOnLogoff     **int64
+}
+
+func personalSchedulePhases(props *scalingplanpersonalschedule.ScalingPlanPersonalScheduleProperties) map[string]personalSchedulePhase {
+	return map[string]personalSchedulePhase{
+		"ramp_up": {
+			startTime:                 &props.RampUpStartTime,
+			startVMOnConnect:          &props.RampUpStartVMOnConnect,
+			actionOnDisconnect:        &props.RampUpActionOnDisconnect,
+			minutesToWaitOnDisconnect: &props.RampUpMinutesToWaitOnDisconnect,
+			actionOnLogoff:            &props.RampUpActionOnLogoff,
+			minutesToWaitOnLogoff:     &props.RampUpMinutesToWaitOnLogoff,
+		},
+		"peak": {
+			startTime:                 &props.PeakStartTime,
+			startVMOnConnect:          &props.PeakStartVMOnConnect,
+			actionOnDisconnect:        &props.PeakActionOnDisconnect,
+			minutesToWaitOnDisconnect: &props.PeakMinutesToWaitOnDisconnect,
+			actionOnLogoff:            &props.PeakActionOnLogoff,
+			minutesToWaitOnLogoff:     &props.PeakMinutesToWaitOnLogoff,
+		},
+		"ramp_down": {
+			startTime:                 &props.RampDownStartTime,
+			startVMOnConnect:          &props.RampDownStartVMOnConnect,
+			actionOnDisconnect:        &props.RampDownActionOnDisconnect,
+			minutesToWaitOnDisconnect: &props.RampDownMinutesToWaitOnDisconnect,
+			actionOnLogoff:            &props.RampDownActionOnLogoff,
+			minutesToWaitOnLogoff:     &props.RampDownMinutesToWaitOnLogoff,
+		},
+		"off_peak": {
+			startTime:                 &props.OffPeakStartTime,
+			startVMOnConnect:          &props.OffPeakStartVMOnConnect,
+			actionOnDisconnect:        &props.OffPeakActionOnDisconnect,
+			minutesToWaitOnDisconnect: &props.OffPeakMinutesToWaitOnDisconnect,
+			actionOnLogoff:            &props.OffPeakActionOnLogoff,
+			minutesToWaitOnLogoff:     &props.OffPeakMinutesToWaitOnLogoff,
+		},
+	}
+}
+
+func expandScalingPlanPersonalSchedules(input []interface{}) []scalingplanpersonalschedule.ScalingPlanPersonalSchedule {
+	results := make([]scalingplanpersonalschedule.ScalingPlanPersonalSchedule, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		v := item.(map[string]interface{})
+
+		daysOfWeek := make([]scalingplanpersonalschedule.DayOfWeek, 0)
+		for _, weekday := range v["days_of_week"].(*pluginsdk.Set).List() {
+			daysOfWeek = append(daysOfWeek, scalingplanpersonalschedule.DayOfWeek(weekday.(string)))
+		}
+
+		props := scalingplanpersonalschedule.ScalingPlanPersonalScheduleProperties{
+			DaysOfWeek:           &daysOfWeek,
+			RampUpAutoStartHosts: pointer.To(scalingplanpersonalschedule.StartupBehavior(v["ramp_up_auto_start_hosts"].(string))),
+		}
+
+		for prefix, phase := range personalSchedulePhases(&props) {
+			startVMOnConnect := scalingplanpersonalschedule.SetStartVMOnConnectDisable
+			if v[prefix+"_start_vm_on_connect"].(bool) {
+				startVMOnConnect = scalingplanpersonalschedule.SetStartVMOnConnectEnable
+			}
+
+			*phase.startTime = expandScalingPlanPersonalScheduleTime(v[prefix+"_start_time"].(string))
+			*phase.startVMOnConnect = pointer.To(startVMOnConnect)
+			*phase.actionOnDisconnect = pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(v[prefix+"_action_on_disconnect"].(string)))
+			*phase.minutesToWaitOnDisconnect = pointer.To(int64(v[prefix+"_minutes_to_wait_on_disconnect"].(int)))
+			*phase.actionOnLogoff = pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(v[prefix+"_action_on_logoff"].(string)))
+			*phase.minutesToWaitOnLogoff = pointer.To(int64(v[prefix+"_minutes_to_wait_on_logoff"].(int)))
+		}
+
+		results = append(results, scalingplanpersonalschedule.ScalingPlanPersonalSchedule{
+			Name:       pointer.To(v["name"].(string)),
+			Properties: props,
+		})
+	}
+
+	return results
+}
+
+func expandScalingPlanPersonalScheduleTime(input string) *scalingplanpersonalschedule.Time {
+	t := expandScalingPlanScheduleTime(input)
+	if t == nil {
+		return nil
+	}
+
+	return &scalingplanpersonalschedule.Time{
+		Hour:   t.Hour,
+		Minute: t.Minute,
+	}
+}
+
+func flattenScalingPlanPersonalSchedules(input []scalingplanpersonalschedule.ScalingPlanPersonalSchedule) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		// the name returned by the API is in the format `{scalingPlanName}/{personalScheduleName}`
+		scheduleId, err := scalingplanpersonalschedule.ParsePersonalScheduleIDInsensitively(pointer.From(item.Id))
+		if err != nil {
+			return nil, err
+		}
+
+		daysOfWeek := make([]string, 0)
+		if item.Properties.DaysOfWeek != nil {
+			for _, weekday := range *item.Properties.DaysOfWeek {
+				daysOfWeek = append(daysOfWeek, string(weekday))
+			}
+		}
+
+		result := map[string]interface{}{
+			"name":                     scheduleId.PersonalScheduleName,
+			"days_of_week":             daysOfWeek,
+			"ramp_up_auto_start_hosts": string(pointer.From(item.Properties.RampUpAutoStartHosts)),
+		}
+
+		for prefix, phase := range personalSchedulePhases(&item.Properties) {
+			startTime := ""
+			if t := *phase.startTime; t != nil {
+				startTime = fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)
+			}
+
+			result[prefix+"_start_time"] = startTime
+			result[prefix+"_start_vm_on_connect"] = pointer.From(*phase.startVMOnConnect) == scalingplanpersonalschedule.SetStartVMOnConnectEnable
+			result[prefix+"_action_on_disconnect"] = string(pointer.From(*phase.actionOnDisconnect))
+			result[prefix+"_minutes_to_wait_on_disconnect"] = pointer.From(*phase.minutesToWaitOnDisconnect)
+			result[prefix+"_action_on_logoff"] = string(pointer.From(*phase.actionOnLogoff))
+			result[prefix+"_minutes_to_wait_on_logoff"] = pointer.From(*phase.minutesToWaitOnLogoff)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
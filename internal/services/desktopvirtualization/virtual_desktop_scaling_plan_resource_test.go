@@ -81,6 +81,39 @@ func TestAccVirtualDesktopScalingPlan_update(t *testing.T) {
 	})
 }
 
+func TestAccVirtualDesktopScalingPlan_personal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_scaling_plan", "test")
+	r := VirtualDesktopScalingPlanResource{}
+	roleAssignmentId := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.personal(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("personal_schedule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.personalUpdated(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("personal_schedule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.personal(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("personal_schedule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccVirtualDesktopScalingPlan_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_scaling_plan", "test")
 	r := VirtualDesktopScalingPlanResource{}
@@ -315,3 +348,123 @@ resource "azurerm_virtual_desktop_scaling_plan" "import" {
 }
 `, r.basic(data, roleAssignmentId))
 }
+
+func (VirtualDesktopScalingPlanResource) personalTemplate(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azuread" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vdesktop-%d"
+  location = "%s"
+}
+
+data "azuread_service_principal" "test" {
+  display_name = "Windows Virtual Desktop"
+}
+
+resource "azurerm_role_assignment" "test" {
+  name                             = "%s"
+  scope                            = azurerm_resource_group.test.id
+  role_definition_name             = "Desktop Virtualization Power On Off Contributor"
+  principal_id                     = data.azuread_service_principal.test.object_id
+  skip_service_principal_aad_check = true
+}
+
+resource "azurerm_virtual_desktop_host_pool" "test" {
+  name                             = "acctestHP%s"
+  location                         = azurerm_resource_group.test.location
+  resource_group_name              = azurerm_resource_group.test.name
+  type                             = "Personal"
+  personal_desktop_assignment_type = "Automatic"
+  load_balancer_type               = "Persistent"
+  start_vm_on_connect              = true
+}
+`, data.RandomInteger, data.Locations.Primary, roleAssignmentId, data.RandomString)
+}
+
+func (r VirtualDesktopScalingPlanResource) personal(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan" "test" {
+  name                = "scalingPlan%x"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  time_zone           = "GMT Standard Time"
+  host_pool_type      = "Personal"
+
+  personal_schedule {
+    name                 = "Weekdays"
+    days_of_week         = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+    ramp_up_start_time   = "06:00"
+    peak_start_time      = "09:00"
+    ramp_down_start_time = "18:00"
+    off_peak_start_time  = "22:00"
+  }
+
+  host_pool {
+    hostpool_id          = azurerm_virtual_desktop_host_pool.test.id
+    scaling_plan_enabled = true
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.personalTemplate(data, roleAssignmentId), data.RandomString)
+}
+
+func (r VirtualDesktopScalingPlanResource) personalUpdated(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan" "test" {
+  name                = "scalingPlan%x"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  time_zone           = "GMT Standard Time"
+  host_pool_type      = "Personal"
+
+  personal_schedule {
+    name                                   = "Weekdays"
+    days_of_week                           = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+    ramp_up_start_time                     = "07:00"
+    ramp_up_auto_start_hosts               = "WithAssignedUser"
+    ramp_up_start_vm_on_connect            = true
+    ramp_up_action_on_disconnect           = "Deallocate"
+    ramp_up_minutes_to_wait_on_disconnect  = 30
+    ramp_up_action_on_logoff               = "Deallocate"
+    ramp_up_minutes_to_wait_on_logoff      = 15
+    peak_start_time                        = "09:00"
+    peak_action_on_disconnect              = "Hibernate"
+    peak_minutes_to_wait_on_disconnect     = 60
+    ramp_down_start_time                   = "18:00"
+    ramp_down_start_vm_on_connect          = false
+    ramp_down_action_on_logoff             = "Deallocate"
+    ramp_down_minutes_to_wait_on_logoff    = 5
+    off_peak_start_time                    = "22:00"
+    off_peak_start_vm_on_connect           = false
+    off_peak_action_on_disconnect          = "Deallocate"
+    off_peak_minutes_to_wait_on_disconnect = 5
+  }
+
+  personal_schedule {
+    name                 = "Weekends"
+    days_of_week         = ["Saturday", "Sunday"]
+    ramp_up_start_time   = "09:00"
+    peak_start_time      = "10:00"
+    ramp_down_start_time = "16:00"
+    off_peak_start_time  = "18:00"
+  }
+
+  host_pool {
+    hostpool_id          = azurerm_virtual_desktop_host_pool.test.id
+    scaling_plan_enabled = true
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.personalTemplate(data, roleAssignmentId), data.RandomString)
+}
@@ -7,7 +7,11 @@ import (
 	"fmt"
 
 	eventgrid_v2022_06_15 "github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/clientgroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/clients"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/permissionbindings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/topicspaces"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -15,16 +19,44 @@ import (
 type Client struct {
 	*eventgrid_v2022_06_15.Client
 
-	NamespacesClient *namespaces.NamespacesClient
+	ClientGroupsClient       *clientgroups.ClientGroupsClient
+	ClientsClient            *clients.ClientsClient
+	NamespacesClient         *namespaces.NamespacesClient
+	PermissionBindingsClient *permissionbindings.PermissionBindingsClient
+	TopicSpacesClient        *topicspaces.TopicSpacesClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	ClientGroupsClient, err := clientgroups.NewClientGroupsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ClientGroups Client: %+v", err)
+	}
+	o.Configure(ClientGroupsClient.Client, o.Authorizers.ResourceManager)
+
+	ClientsClient, err := clients.NewClientsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Clients Client: %+v", err)
+	}
+	o.Configure(ClientsClient.Client, o.Authorizers.ResourceManager)
+
 	NamespacesClient, err := namespaces.NewNamespacesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Namespaces Client: %+v", err)
 	}
 	o.Configure(NamespacesClient.Client, o.Authorizers.ResourceManager)
 
+	PermissionBindingsClient, err := permissionbindings.NewPermissionBindingsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building PermissionBindings Client: %+v", err)
+	}
+	o.Configure(PermissionBindingsClient.Client, o.Authorizers.ResourceManager)
+
+	TopicSpacesClient, err := topicspaces.NewTopicSpacesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building TopicSpaces Client: %+v", err)
+	}
+	o.Configure(TopicSpacesClient.Client, o.Authorizers.ResourceManager)
+
 	client, err := eventgrid_v2022_06_15.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
 		o.Configure(c, o.Authorizers.ResourceManager)
 	})
@@ -32,7 +64,11 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		return nil, fmt.Errorf("building EventGrid client: %+v", err)
 	}
 	return &Client{
-		NamespacesClient: NamespacesClient,
-		Client:           client,
+		ClientGroupsClient:       ClientGroupsClient,
+		ClientsClient:            ClientsClient,
+		NamespacesClient:         NamespacesClient,
+		PermissionBindingsClient: PermissionBindingsClient,
+		TopicSpacesClient:        TopicSpacesClient,
+		Client:                   client,
 	}, nil
 }
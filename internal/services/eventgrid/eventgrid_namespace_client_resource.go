@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	mqttclients "github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceClientResource{}
+
+type EventGridNamespaceClientResource struct{}
+
+type EventGridNamespaceClientResourceModel struct {
+	Name                            string                                 `tfschema:"name"`
+	NamespaceId                     string                                 `tfschema:"namespace_id"`
+	AuthenticationName              string                                 `tfschema:"authentication_name"`
+	Attributes                      map[string]string                      `tfschema:"attributes"`
+	ClientCertificateAuthentication []ClientCertificateAuthenticationModel `tfschema:"client_certificate_authentication"`
+	Description                     string                                 `tfschema:"description"`
+	Enabled                         bool                                   `tfschema:"enabled"`
+}
+
+type ClientCertificateAuthenticationModel struct {
+	ValidationScheme   string   `tfschema:"validation_scheme"`
+	AllowedThumbprints []string `tfschema:"allowed_thumbprints"`
+}
+
+func (r EventGridNamespaceClientResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9:._-]{1,128}$`),
+				"`name` must be between 1 and 128 characters long and can only contain letters, numbers, hyphens, colons, periods and underscores.",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: mqttclients.ValidateNamespaceID,
+		},
+
+		"authentication_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+
+		"attributes": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"client_certificate_authentication": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validation_scheme": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(mqttclients.PossibleValuesForClientCertificateValidationScheme(), false),
+					},
+
+					"allowed_thumbprints": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 2,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 512),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceClientResource) ModelObject() interface{} {
+	return &EventGridNamespaceClientResourceModel{}
+}
+
+func (r EventGridNamespaceClientResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_client"
+}
+
+func (r EventGridNamespaceClientResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return mqttclients.ValidateClientID
+}
+
+func (r EventGridNamespaceClientResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			var model EventGridNamespaceClientResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := mqttclients.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := mqttclients.NewClientID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := mqttclients.Client{
+				Properties: &mqttclients.ClientProperties{
+					Attributes:                      expandEventGridNamespaceClientAttributes(model.Attributes),
+					ClientCertificateAuthentication: expandEventGridNamespaceClientCertificateAuthentication(model.ClientCertificateAuthentication),
+					State:                           expandEventGridNamespaceClientState(model.Enabled),
+				},
+			}
+
+			if model.AuthenticationName != "" {
+				payload.Properties.AuthenticationName = pointer.To(model.AuthenticationName)
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			id, err := mqttclients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceClientResourceModel{
+				Name:        id.ClientName,
+				NamespaceId: mqttclients.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					attributes, err := flattenEventGridNamespaceClientAttributes(props.Attributes)
+					if err != nil {
+						return fmt.Errorf("flattening `attributes`: %+v", err)
+					}
+					state.Attributes = attributes
+					state.AuthenticationName = pointer.From(props.AuthenticationName)
+					state.ClientCertificateAuthentication = flattenEventGridNamespaceClientCertificateAuthentication(props.ClientCertificateAuthentication)
+					state.Description = pointer.From(props.Description)
+					state.Enabled = pointer.From(props.State) == mqttclients.ClientStateEnabled
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			id, err := mqttclients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceClientResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("attributes") {
+				payload.Properties.Attributes = expandEventGridNamespaceClientAttributes(model.Attributes)
+			}
+
+			if metadata.ResourceData.HasChange("client_certificate_authentication") {
+				payload.Properties.ClientCertificateAuthentication = expandEventGridNamespaceClientCertificateAuthentication(model.ClientCertificateAuthentication)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				// the Client is replaced in full, so omitting the description removes it
+				payload.Properties.Description = nil
+				if model.Description != "" {
+					payload.Properties.Description = pointer.To(model.Description)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				payload.Properties.State = expandEventGridNamespaceClientState(model.Enabled)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			id, err := mqttclients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceClientState(enabled bool) *mqttclients.ClientState {
+	if enabled {
+		return pointer.To(mqttclients.ClientStateEnabled)
+	}
+	return pointer.To(mqttclients.ClientStateDisabled)
+}
+
+func expandEventGridNamespaceClientAttributes(input map[string]string) *map[string]interface{} {
+	attributes := make(map[string]interface{})
+	for k, v := range input {
+		attributes[k] = v
+	}
+	return &attributes
+}
+
+func flattenEventGridNamespaceClientAttributes(input *map[string]interface{}) (map[string]string, error) {
+	output := make(map[string]string)
+	if input == nil {
+		return output, nil
+	}
+
+	for k, v := range *input {
+		// attributes set outside of Terraform can also be numbers or arrays of strings, these are surfaced as JSON
+		if s, ok := v.(string); ok {
+			output[k] = s
+			continue
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling the value of %q: %+v", k, err)
+		}
+		output[k] = string(b)
+	}
+
+	return output, nil
+}
+
+func expandEventGridNamespaceClientCertificateAuthentication(input []ClientCertificateAuthenticationModel) *mqttclients.ClientCertificateAuthentication {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := mqttclients.ClientCertificateAuthentication{
+		ValidationScheme: pointer.To(mqttclients.ClientCertificateValidationScheme(input[0].ValidationScheme)),
+	}
+
+	if len(input[0].AllowedThumbprints) > 0 {
+		output.AllowedThumbprints = pointer.To(input[0].AllowedThumbprints)
+	}
+
+	return &output
+}
+
+func flattenEventGridNamespaceClientCertificateAuthentication(input *mqttclients.ClientCertificateAuthentication) []ClientCertificateAuthenticationModel {
+	output := make([]ClientCertificateAuthenticationModel, 0)
+	if input == nil || input.ValidationScheme == nil {
+		return output
+	}
+
+	return append(output, ClientCertificateAuthenticationModel{
+		ValidationScheme:   string(pointer.From(input.ValidationScheme)),
+		AllowedThumbprints: pointer.From(input.AllowedThumbprints),
+	})
+}
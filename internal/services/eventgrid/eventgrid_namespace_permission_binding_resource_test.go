@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/permissionbindings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EventGridNamespacePermissionBindingResource struct{}
+
+func TestAccEventGridNamespacePermissionBindingResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespacePermissionBindingResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventGridNamespacePermissionBindingResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r EventGridNamespacePermissionBindingResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := permissionbindings.ParsePermissionBindingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.PermissionBindingsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r EventGridNamespacePermissionBindingResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eg-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventgrid_namespace" "test" {
+  name                = "acctest-egn-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Standard"
+
+  topic_spaces_configuration {}
+}
+
+resource "azurerm_eventgrid_namespace_client_group" "test" {
+  name         = "acctest-cg-%[1]d"
+  namespace_id = azurerm_eventgrid_namespace.test.id
+  query        = "attributes.type IN ['sensor']"
+}
+
+resource "azurerm_eventgrid_namespace_topic_space" "test" {
+  name            = "acctest-ts-%[1]d"
+  namespace_id    = azurerm_eventgrid_namespace.test.id
+  topic_templates = ["devices/+/telemetry"]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r EventGridNamespacePermissionBindingResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_permission_binding" "test" {
+  name              = "acctest-pb-%d"
+  namespace_id      = azurerm_eventgrid_namespace.test.id
+  client_group_name = azurerm_eventgrid_namespace_client_group.test.name
+  topic_space_name  = azurerm_eventgrid_namespace_topic_space.test.name
+  permission        = "Publisher"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventGridNamespacePermissionBindingResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_permission_binding" "import" {
+  name              = azurerm_eventgrid_namespace_permission_binding.test.name
+  namespace_id      = azurerm_eventgrid_namespace_permission_binding.test.namespace_id
+  client_group_name = azurerm_eventgrid_namespace_permission_binding.test.client_group_name
+  topic_space_name  = azurerm_eventgrid_namespace_permission_binding.test.topic_space_name
+  permission        = azurerm_eventgrid_namespace_permission_binding.test.permission
+}
+`, r.basic(data))
+}
+
+func (r EventGridNamespacePermissionBindingResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_permission_binding" "test" {
+  name              = "acctest-pb-%d"
+  namespace_id      = azurerm_eventgrid_namespace.test.id
+  client_group_name = azurerm_eventgrid_namespace_client_group.test.name
+  topic_space_name  = azurerm_eventgrid_namespace_topic_space.test.name
+  permission        = "Subscriber"
+  description       = "Sensors can subscribe to telemetry"
+}
+`, r.template(data), data.RandomInteger)
+}
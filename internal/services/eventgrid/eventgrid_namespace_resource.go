@@ -21,7 +21,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.ResourceWithUpdate = EventGridNamespaceResource{}
+var (
+	_ sdk.ResourceWithUpdate        = EventGridNamespaceResource{}
+	_ sdk.ResourceWithCustomizeDiff = EventGridNamespaceResource{}
+)
 
 type EventGridNamespaceResource struct{}
 
@@ -122,7 +125,7 @@ func (r EventGridNamespaceResource) Arguments() map[string]*pluginsdk.Schema {
 		"topic_spaces_configuration": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
-			ForceNew: true,
+			MaxItems: 1,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"alternative_authentication_name_source": {
@@ -408,6 +411,23 @@ func (r EventGridNamespaceResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+func (r EventGridNamespaceResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the MQTT broker can be enabled and reconfigured in-place, but can't be disabled once it's been enabled
+			if metadata.ResourceDiff.HasChange("topic_spaces_configuration") {
+				o, n := metadata.ResourceDiff.GetChange("topic_spaces_configuration")
+				if len(o.([]interface{})) > 0 && len(n.([]interface{})) == 0 {
+					return metadata.ResourceDiff.ForceNew("topic_spaces_configuration")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r EventGridNamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return namespaces.ValidateNamespaceID
 }
@@ -464,7 +484,7 @@ func expandTopicSpacesConfiguration(input []TopicSpacesConfigurationModel) *name
 }
 
 func expandTopicSpacesConfigurationUpdate(input []TopicSpacesConfigurationModel) *namespaces.UpdateTopicSpacesConfigurationInfo {
-	if input == nil {
+	if len(input) == 0 {
 		return nil
 	}
 
@@ -35,6 +35,10 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		EventGridNamespaceResource{},
+		EventGridNamespaceClientResource{},
+		EventGridNamespaceClientGroupResource{},
+		EventGridNamespacePermissionBindingResource{},
+		EventGridNamespaceTopicSpaceResource{},
 		EventGridPartnerConfigurationResource{},
 	}
 }
@@ -6,6 +6,7 @@ package client
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/applicationgroup"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/authorizationruleseventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/authorizationrulesnamespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/checknameavailabilitydisasterrecoveryconfigs"
@@ -20,6 +21,7 @@ import (
 )
 
 type Client struct {
+	ApplicationGroupClient                 *applicationgroup.ApplicationGroupClient
 	ClusterClient                          *eventhubsclusters.EventHubsClustersClient
 	ConsumerGroupClient                    *consumergroups.ConsumerGroupsClient
 	DisasterRecoveryConfigsClient          *disasterrecoveryconfigs.DisasterRecoveryConfigsClient
@@ -33,6 +35,12 @@ type Client struct {
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	applicationGroupClient, err := applicationgroup.NewApplicationGroupClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ApplicationGroup Client: %+v", err)
+	}
+	o.Configure(applicationGroupClient.Client, o.Authorizers.ResourceManager)
+
 	clustersClient, err := eventhubsclusters.NewEventHubsClustersClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Clusters Client: %+v", err)
@@ -94,6 +102,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(schemaRegistryClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
+		ApplicationGroupClient:                 applicationGroupClient,
 		ClusterClient:                          clustersClient,
 		ConsumerGroupClient:                    consumerGroupsClient,
 		DisasterRecoveryConfigsClient:          disasterRecoveryConfigsClient,
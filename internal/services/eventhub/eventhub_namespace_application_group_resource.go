@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/applicationgroup"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NamespaceApplicationGroupModel struct {
+	Name                     string                  `tfschema:"name"`
+	NamespaceId              string                  `tfschema:"namespace_id"`
+	ClientAppGroupIdentifier string                  `tfschema:"client_app_group_identifier"`
+	Enabled                  bool                    `tfschema:"enabled"`
+	ThrottlingPolicy         []ThrottlingPolicyModel `tfschema:"throttling_policy"`
+}
+
+type ThrottlingPolicyModel struct {
+	Name               string `tfschema:"name"`
+	MetricId           string `tfschema:"metric_id"`
+	RateLimitThreshold int64  `tfschema:"rate_limit_threshold"`
+}
+
+var _ sdk.ResourceWithUpdate = NamespaceApplicationGroupResource{}
+
+type NamespaceApplicationGroupResource struct{}
+
+func (r NamespaceApplicationGroupResource) ResourceType() string {
+	return "azurerm_eventhub_namespace_application_group"
+}
+
+func (r NamespaceApplicationGroupResource) ModelObject() interface{} {
+	return &NamespaceApplicationGroupModel{}
+}
+
+func (r NamespaceApplicationGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return applicationgroup.ValidateApplicationGroupID
+}
+
+func (r NamespaceApplicationGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,254}$`),
+				"The name can contain only letters, numbers, periods, hyphens and underscores, must start with a letter or number and be at most 255 characters long.",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: applicationgroup.ValidateNamespaceID,
+		},
+
+		"client_app_group_identifier": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(SASKeyName|AADAppID)=.+$`),
+				"The client application group identifier must be in the format `SASKeyName=<name>` or `AADAppID=<application id>`.",
+			),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"throttling_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"metric_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(applicationgroup.PossibleValuesForMetricId(), false),
+					},
+
+					"rate_limit_threshold": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r NamespaceApplicationGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NamespaceApplicationGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ApplicationGroupClient
+
+			var model NamespaceApplicationGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := applicationgroup.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := applicationgroup.NewApplicationGroupID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := applicationgroup.ApplicationGroup{
+				Properties: &applicationgroup.ApplicationGroupProperties{
+					ClientAppGroupIdentifier: model.ClientAppGroupIdentifier,
+					IsEnabled:                pointer.To(model.Enabled),
+					Policies:                 expandApplicationGroupThrottlingPolicies(model.ThrottlingPolicy),
+				},
+			}
+
+			if _, err := client.CreateOrUpdateApplicationGroup(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NamespaceApplicationGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ApplicationGroupClient
+
+			id, err := applicationgroup.ParseApplicationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := NamespaceApplicationGroupModel{
+				Name:        id.ApplicationGroupName,
+				NamespaceId: applicationgroup.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ClientAppGroupIdentifier = props.ClientAppGroupIdentifier
+					state.Enabled = pointer.From(props.IsEnabled)
+					state.ThrottlingPolicy = flattenApplicationGroupThrottlingPolicies(props.Policies)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NamespaceApplicationGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ApplicationGroupClient
+
+			id, err := applicationgroup.ParseApplicationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model NamespaceApplicationGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if resp.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			parameters := *resp.Model
+
+			if metadata.ResourceData.HasChange("enabled") {
+				parameters.Properties.IsEnabled = pointer.To(model.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("throttling_policy") {
+				parameters.Properties.Policies = expandApplicationGroupThrottlingPolicies(model.ThrottlingPolicy)
+			}
+
+			if _, err := client.CreateOrUpdateApplicationGroup(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NamespaceApplicationGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ApplicationGroupClient
+
+			id, err := applicationgroup.ParseApplicationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplicationGroupThrottlingPolicies(input []ThrottlingPolicyModel) *[]applicationgroup.ApplicationGroupPolicy {
+	policies := make([]applicationgroup.ApplicationGroupPolicy, 0)
+	for _, v := range input {
+		policies = append(policies, applicationgroup.ThrottlingPolicy{
+			Name:               v.Name,
+			MetricId:           applicationgroup.MetricId(v.MetricId),
+			RateLimitThreshold: v.RateLimitThreshold,
+			Type:               applicationgroup.ApplicationGroupPolicyTypeThrottlingPolicy,
+		})
+	}
+
+	return &policies
+}
+
+func flattenApplicationGroupThrottlingPolicies(input *[]applicationgroup.ApplicationGroupPolicy) []ThrottlingPolicyModel {
+	output := make([]ThrottlingPolicyModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if policy, ok := v.(applicationgroup.ThrottlingPolicy); ok {
+			output = append(output, ThrottlingPolicyModel{
+				Name:               policy.Name,
+				MetricId:           string(policy.MetricId),
+				RateLimitThreshold: policy.RateLimitThreshold,
+			})
+		}
+	}
+
+	return output
+}
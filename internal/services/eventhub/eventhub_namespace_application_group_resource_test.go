@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/applicationgroup"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EventHubNamespaceApplicationGroupResource struct{}
+
+func TestAccEventHubNamespaceApplicationGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_application_group", "test")
+	r := EventHubNamespaceApplicationGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubNamespaceApplicationGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_application_group", "test")
+	r := EventHubNamespaceApplicationGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventHubNamespaceApplicationGroup_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_application_group", "test")
+	r := EventHubNamespaceApplicationGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubNamespaceApplicationGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_application_group", "test")
+	r := EventHubNamespaceApplicationGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventHubNamespaceApplicationGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := applicationgroup.ParseApplicationGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Eventhub.ApplicationGroupClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (EventHubNamespaceApplicationGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eh-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Premium"
+  capacity            = 1
+}
+
+resource "azurerm_eventhub_namespace_authorization_rule" "test" {
+  name                = "acctest-%[1]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  listen              = true
+  send                = true
+  manage              = false
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r EventHubNamespaceApplicationGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_application_group" "test" {
+  name                        = "acctest-ag-%d"
+  namespace_id                = azurerm_eventhub_namespace.test.id
+  client_app_group_identifier = "SASKeyName=${azurerm_eventhub_namespace_authorization_rule.test.name}"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventHubNamespaceApplicationGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_application_group" "import" {
+  name                        = azurerm_eventhub_namespace_application_group.test.name
+  namespace_id                = azurerm_eventhub_namespace_application_group.test.namespace_id
+  client_app_group_identifier = azurerm_eventhub_namespace_application_group.test.client_app_group_identifier
+}
+`, r.basic(data))
+}
+
+func (r EventHubNamespaceApplicationGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_application_group" "test" {
+  name                        = "acctest-ag-%d"
+  namespace_id                = azurerm_eventhub_namespace.test.id
+  client_app_group_identifier = "SASKeyName=${azurerm_eventhub_namespace_authorization_rule.test.name}"
+  enabled                     = false
+
+  throttling_policy {
+    name                 = "incoming-bytes"
+    metric_id            = "IncomingBytes"
+    rate_limit_threshold = 10000
+  }
+
+  throttling_policy {
+    name                 = "outgoing-messages"
+    metric_id            = "OutgoingMessages"
+    rate_limit_threshold = 500
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/checknameavailabilitydisasterrecoveryconfigs"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -65,7 +67,25 @@ func resourceEventHubNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 				Required:     true,
 				ValidateFunc: azure.ValidateResourceID,
 			},
+
+			"failover_trigger": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"primary_namespace_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			if diff.Id() != "" && diff.HasChange("failover_trigger") && diff.HasChange("partner_namespace_id") {
+				return fmt.Errorf("`partner_namespace_id` cannot be changed at the same time as `failover_trigger`")
+			}
+			return nil
+		}),
 	}
 }
 
@@ -87,7 +107,9 @@ func resourceEventHubNamespaceDisasterRecoveryConfigCreate(d *pluginsdk.Resource
 			}
 		}
 
-		if !response.WasNotFound(existing.HttpResponse) {
+		// following a failover the alias remains on the new primary namespace without a partner, which is re-paired
+		// by swapping `namespace_name` and `partner_namespace_id`
+		if !response.WasNotFound(existing.HttpResponse) && !isUnpairedDisasterRecoveryConfig(existing.Model) {
 			return tf.ImportAsExistsError("azurerm_eventhub_namespace_disaster_recovery_config", id.ID())
 		}
 	}
@@ -128,9 +150,35 @@ func resourceEventHubNamespaceDisasterRecoveryConfigUpdate(d *pluginsdk.Resource
 
 	pairingStatus, err := client.Get(ctx, *id)
 	if err != nil {
+		if response.WasNotFound(pairingStatus.HttpResponse) {
+			return fmt.Errorf("%s has been failed over to the partner namespace %q, swap `namespace_name` and `partner_namespace_id` to pair the namespaces again", *id, d.Get("partner_namespace_id").(string))
+		}
 		return fmt.Errorf("checking the status of eventhub disaster recovery error: %+v", err)
 	}
 
+	if d.HasChange("failover_trigger") {
+		partnerNamespaceId, err := disasterrecoveryconfigs.ParseNamespaceIDInsensitively(d.Get("partner_namespace_id").(string))
+		if err != nil {
+			return fmt.Errorf("parsing `partner_namespace_id`: %+v", err)
+		}
+		secondaryId := disasterrecoveryconfigs.NewDisasterRecoveryConfigID(partnerNamespaceId.SubscriptionId, partnerNamespaceId.ResourceGroupName, partnerNamespaceId.NamespaceName, id.DisasterRecoveryConfigName)
+
+		locks.ByName(secondaryId.NamespaceName, eventHubNamespaceResourceName)
+		defer locks.UnlockByName(secondaryId.NamespaceName, eventHubNamespaceResourceName)
+
+		// the failover is initiated against the alias on the secondary namespace, which then becomes the primary
+		log.Printf("[DEBUG] Failing over %s to %s..", *id, secondaryId)
+		if _, err := client.FailOver(ctx, secondaryId); err != nil {
+			return fmt.Errorf("failing over %s: %+v", secondaryId, err)
+		}
+
+		if err := resourceEventHubNamespaceDisasterRecoveryConfigWaitForState(ctx, client, secondaryId); err != nil {
+			return fmt.Errorf("waiting for the failover of %s: %+v", secondaryId, err)
+		}
+
+		return resourceEventHubNamespaceDisasterRecoveryConfigRead(d, meta)
+	}
+
 	// need to check if DCR needs pair-breaking first
 	breakPairFirst := false
 	if model := pairingStatus.Model; model != nil {
@@ -182,7 +230,23 @@ func resourceEventHubNamespaceDisasterRecoveryConfigRead(d *pluginsdk.ResourceDa
 	resp, err := client.Get(ctx, *id)
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
-			d.SetId("")
+			failedOverNamespaceId, err := eventHubNamespaceDisasterRecoveryConfigFailedOverNamespaceId(ctx, client, *id, d.Get("partner_namespace_id").(string))
+			if err != nil {
+				return err
+			}
+
+			if failedOverNamespaceId == nil {
+				d.SetId("")
+				return nil
+			}
+
+			// the alias now points at the former secondary namespace, the pairing is retained in the state so that it
+			// can be re-established by swapping `namespace_name` and `partner_namespace_id`
+			log.Printf("[WARN] %s has been failed over to %s", *id, *failedOverNamespaceId)
+			d.Set("name", id.DisasterRecoveryConfigName)
+			d.Set("namespace_name", id.NamespaceName)
+			d.Set("resource_group_name", id.ResourceGroupName)
+			d.Set("primary_namespace_id", failedOverNamespaceId.ID())
 			return nil
 		}
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -192,9 +256,15 @@ func resourceEventHubNamespaceDisasterRecoveryConfigRead(d *pluginsdk.ResourceDa
 	d.Set("namespace_name", id.NamespaceName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
+	primaryNamespaceId := disasterrecoveryconfigs.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID()
 	if model := resp.Model; model != nil && model.Properties != nil {
 		d.Set("partner_namespace_id", model.Properties.PartnerNamespace)
+
+		if pointer.From(model.Properties.Role) == disasterrecoveryconfigs.RoleDisasterRecoverySecondary {
+			primaryNamespaceId = pointer.From(model.Properties.PartnerNamespace)
+		}
 	}
+	d.Set("primary_namespace_id", primaryNamespaceId)
 
 	return nil
 }
@@ -214,6 +284,11 @@ func resourceEventHubNamespaceDisasterRecoveryConfigDelete(d *pluginsdk.Resource
 
 	pairingStatus, err := client.Get(ctx, *id)
 	if err != nil {
+		// following a failover the alias no longer exists on this namespace and the alias on the new primary
+		// namespace must be left intact
+		if response.WasNotFound(pairingStatus.HttpResponse) {
+			return nil
+		}
 		return fmt.Errorf("checking the status of eventhub disaster recovery error: %+v", err)
 	}
 
@@ -309,6 +384,44 @@ func resourceEventHubNamespaceDisasterRecoveryConfigDelete(d *pluginsdk.Resource
 	return nil
 }
 
+// eventHubNamespaceDisasterRecoveryConfigFailedOverNamespaceId returns the ID of the partner namespace when the alias
+// has been failed over to it, or nil when the alias doesn't exist on the partner namespace as an unpaired primary
+func eventHubNamespaceDisasterRecoveryConfigFailedOverNamespaceId(ctx context.Context, client *disasterrecoveryconfigs.DisasterRecoveryConfigsClient, id disasterrecoveryconfigs.DisasterRecoveryConfigId, partnerNamespace string) (*disasterrecoveryconfigs.NamespaceId, error) {
+	if partnerNamespace == "" {
+		return nil, nil
+	}
+
+	partnerNamespaceId, err := disasterrecoveryconfigs.ParseNamespaceIDInsensitively(partnerNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `partner_namespace_id`: %+v", err)
+	}
+
+	partnerId := disasterrecoveryconfigs.NewDisasterRecoveryConfigID(partnerNamespaceId.SubscriptionId, partnerNamespaceId.ResourceGroupName, partnerNamespaceId.NamespaceName, id.DisasterRecoveryConfigName)
+	resp, err := client.Get(ctx, partnerId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", partnerId, err)
+	}
+
+	if !isUnpairedDisasterRecoveryConfig(resp.Model) {
+		return nil, nil
+	}
+
+	return partnerNamespaceId, nil
+}
+
+// isUnpairedDisasterRecoveryConfig returns whether the alias is a primary without a partner namespace, which is the
+// case for the alias on the new primary namespace following a failover
+func isUnpairedDisasterRecoveryConfig(input *disasterrecoveryconfigs.ArmDisasterRecovery) bool {
+	if input == nil || input.Properties == nil {
+		return false
+	}
+
+	return pointer.From(input.Properties.Role) == disasterrecoveryconfigs.RoleDisasterRecoveryPrimaryNotReplicating && pointer.From(input.Properties.PartnerNamespace) == ""
+}
+
 func resourceEventHubNamespaceDisasterRecoveryConfigWaitForState(ctx context.Context, client *disasterrecoveryconfigs.DisasterRecoveryConfigsClient, id disasterrecoveryconfigs.DisasterRecoveryConfigId) error {
 	deadline, ok := ctx.Deadline()
 	if !ok {
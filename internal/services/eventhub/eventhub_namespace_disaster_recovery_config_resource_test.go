@@ -57,6 +57,35 @@ func TestAccEventHubNamespaceDisasterRecoveryConfig_update(t *testing.T) {
 	})
 }
 
+func TestAccEventHubNamespaceDisasterRecoveryConfig_failoverAndRepair(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_disaster_recovery_config", "test")
+	r := EventHubNamespaceDisasterRecoveryConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_namespace_id").MatchesOtherKey(check.That("azurerm_eventhub_namespace.testa").Key("id")),
+			),
+		},
+		{
+			Config: r.failover(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("primary_namespace_id").MatchesOtherKey(check.That("azurerm_eventhub_namespace.testb").Key("id")),
+			),
+		},
+		{
+			Config: r.repaired(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_namespace_id").MatchesOtherKey(check.That("azurerm_eventhub_namespace.testb").Key("id")),
+			),
+		},
+		data.ImportStep("failover_trigger"),
+	})
+}
+
 func (EventHubNamespaceDisasterRecoveryConfigResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigID(state.ID)
 	if err != nil {
@@ -179,3 +208,58 @@ resource "azurerm_eventhub_namespace" "testc" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
 }
+
+func (r EventHubNamespaceDisasterRecoveryConfigResource) failover(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_disaster_recovery_config" "test" {
+  name                 = "acctest-EHN-DRC-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  namespace_name       = azurerm_eventhub_namespace.testa.name
+  partner_namespace_id = azurerm_eventhub_namespace.testb.id
+  failover_trigger     = "1"
+}
+`, r.namespaces(data), data.RandomInteger)
+}
+
+func (r EventHubNamespaceDisasterRecoveryConfigResource) repaired(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_disaster_recovery_config" "test" {
+  name                 = "acctest-EHN-DRC-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  namespace_name       = azurerm_eventhub_namespace.testb.name
+  partner_namespace_id = azurerm_eventhub_namespace.testa.id
+  failover_trigger     = "1"
+}
+`, r.namespaces(data), data.RandomInteger)
+}
+
+func (EventHubNamespaceDisasterRecoveryConfigResource) namespaces(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eventhub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventhub_namespace" "testa" {
+  name                = "acctest-EHN-%[1]d-a"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub_namespace" "testb" {
+  name                = "acctest-EHN-%[1]d-b"
+  location            = "%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+`, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
+}
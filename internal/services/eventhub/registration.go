@@ -65,5 +65,6 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ConsumerGroupResource{},
+		NamespaceApplicationGroupResource{},
 	}
 }
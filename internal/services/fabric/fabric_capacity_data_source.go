@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fabric
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/fabric/2023-11-01/fabriccapacities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type FabricCapacityDataSource struct{}
+
+var _ sdk.DataSource = FabricCapacityDataSource{}
+
+type FabricCapacityDataSourceModel struct {
+	Name                  string            `tfschema:"name"`
+	ResourceGroupName     string            `tfschema:"resource_group_name"`
+	AdministrationMembers []string          `tfschema:"administration_members"`
+	AvailableSkus         []SkuModel        `tfschema:"available_skus"`
+	Location              string            `tfschema:"location"`
+	Sku                   []SkuModel        `tfschema:"sku"`
+	State                 string            `tfschema:"state"`
+	Tags                  map[string]string `tfschema:"tags"`
+}
+
+func (r FabricCapacityDataSource) ResourceType() string {
+	return "azurerm_fabric_capacity"
+}
+
+func (r FabricCapacityDataSource) ModelObject() interface{} {
+	return &FabricCapacityDataSourceModel{}
+}
+
+func (r FabricCapacityDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+	}
+}
+
+func (r FabricCapacityDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"administration_members": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"available_skus": fabricCapacitySkuSchemaComputed(),
+
+		"sku": fabricCapacitySkuSchemaComputed(),
+
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"tags": commonschema.TagsDataSource(),
+	}
+}
+
+func (r FabricCapacityDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fabric.FabricCapacitiesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state FabricCapacityDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := fabriccapacities.NewCapacityID(subscriptionId, state.ResourceGroupName, state.Name)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.AdministrationMembers = model.Properties.Administration.Members
+				state.Sku = flattenSkuModel(model.Sku)
+				state.State = string(pointer.From(model.Properties.State))
+				state.Tags = pointer.From(model.Tags)
+			}
+
+			skus, err := client.ListSkusForCapacityComplete(ctx, id)
+			if err != nil {
+				return fmt.Errorf("listing available SKUs for %s: %+v", id, err)
+			}
+
+			state.AvailableSkus = make([]SkuModel, 0)
+			for _, item := range skus.Items {
+				state.AvailableSkus = append(state.AvailableSkus, flattenSkuModel(item.Sku)...)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func fabricCapacitySkuSchemaComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"tier": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fabric_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type FabricCapacityDataSource struct{}
+
+func TestAccFabricCapacityDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_fabric_capacity", "test")
+	d := FabricCapacityDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("administration_members.#").HasValue("1"),
+				check.That(data.ResourceName).Key("sku.0.name").HasValue("F2"),
+				check.That(data.ResourceName).Key("state").HasValue("Active"),
+				check.That(data.ResourceName).Key("available_skus.#").Exists(),
+			),
+		},
+	})
+}
+
+func (d FabricCapacityDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_fabric_capacity" "test" {
+  name                = azurerm_fabric_capacity.test.name
+  resource_group_name = azurerm_fabric_capacity.test.resource_group_name
+}
+`, FabricCapacityResource{}.basic(data))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -21,7 +22,10 @@ import (
 
 type FabricCapacityResource struct{}
 
-var _ sdk.ResourceWithUpdate = FabricCapacityResource{}
+var (
+	_ sdk.ResourceWithUpdate        = FabricCapacityResource{}
+	_ sdk.ResourceWithCustomizeDiff = FabricCapacityResource{}
+)
 
 type FabricCapacityResourceModel struct {
 	Name                  string            `tfschema:"name"`
@@ -29,6 +33,7 @@ type FabricCapacityResourceModel struct {
 	AdministrationMembers []string          `tfschema:"administration_members"`
 	Location              string            `tfschema:"location"`
 	Sku                   []SkuModel        `tfschema:"sku"`
+	State                 string            `tfschema:"state"`
 	Tags                  map[string]string `tfschema:"tags"`
 }
 
@@ -38,7 +43,7 @@ type SkuModel struct {
 }
 
 func (r FabricCapacityResource) ModelObject() interface{} {
-	return &FabricCapacityResource{}
+	return &FabricCapacityResourceModel{}
 }
 
 func (r FabricCapacityResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
@@ -108,6 +113,16 @@ func (r FabricCapacityResource) Arguments() map[string]*pluginsdk.Schema {
 			},
 		},
 
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(fabriccapacities.ResourceStateActive),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(fabriccapacities.ResourceStateActive),
+				string(fabriccapacities.ResourceStatePaused),
+			}, false),
+		},
+
 		"tags": commonschema.Tags(),
 	}
 }
@@ -116,6 +131,48 @@ func (r FabricCapacityResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
 
+func (r FabricCapacityResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fabric.FabricCapacitiesClient
+
+			if metadata.ResourceDiff.Id() == "" || !metadata.ResourceDiff.HasChange("sku") {
+				return nil
+			}
+
+			id, err := fabriccapacities.ParseCapacityID(metadata.ResourceDiff.Id())
+			if err != nil {
+				return err
+			}
+
+			var config FabricCapacityResourceModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			if len(config.Sku) == 0 {
+				return nil
+			}
+
+			// an existing Capacity can only be scaled to the SKUs which are available for it
+			resp, err := client.ListSkusForCapacityComplete(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing available SKUs for %s: %+v", *id, err)
+			}
+
+			available := make([]string, 0)
+			for _, item := range resp.Items {
+				if strings.EqualFold(item.Sku.Name, config.Sku[0].Name) && strings.EqualFold(string(item.Sku.Tier), config.Sku[0].Tier) {
+					return nil
+				}
+				available = append(available, item.Sku.Name)
+			}
+
+			return fmt.Errorf("%s can't be scaled to the SKU %q, the available SKUs are %s", *id, config.Sku[0].Name, strings.Join(available, ", "))
+		},
+	}
+}
+
 func (r FabricCapacityResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -160,6 +217,13 @@ func (r FabricCapacityResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
+			// the Capacity is always created in an Active state, so it has to be paused afterwards
+			if model.State == string(fabriccapacities.ResourceStatePaused) {
+				if err := client.SuspendThenPoll(ctx, id); err != nil {
+					return fmt.Errorf("pausing %s: %+v", id, err)
+				}
+			}
+
 			metadata.SetID(id)
 			return nil
 		},
@@ -182,32 +246,41 @@ func (r FabricCapacityResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
-			existing, err := client.Get(ctx, *id)
-			if err != nil {
-				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			if metadata.ResourceData.HasChange("state") && model.State == string(fabriccapacities.ResourceStateActive) {
+				if err := client.ResumeThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("resuming %s: %+v", *id, err)
+				}
 			}
 
-			if existing.Model == nil {
-				return fmt.Errorf("retrieving %s: `model` was nil", *id)
-			}
+			if metadata.ResourceData.HasChanges("administration_members", "sku", "tags") {
+				payload := fabriccapacities.FabricCapacityUpdate{}
 
-			payload := existing.Model
-			if metadata.ResourceData.HasChange("administration_members") {
-				payload.Properties.Administration = fabriccapacities.CapacityAdministration{
-					Members: model.AdministrationMembers,
+				if metadata.ResourceData.HasChange("administration_members") {
+					payload.Properties = &fabriccapacities.FabricCapacityUpdateProperties{
+						Administration: &fabriccapacities.CapacityAdministration{
+							Members: model.AdministrationMembers,
+						},
+					}
 				}
-			}
 
-			if metadata.ResourceData.HasChange("sku") {
-				payload.Sku = expandSkuModel(model.Sku)
-			}
+				// the SKU is scaled in place, which doesn't interrupt the workloads running on the Capacity
+				if metadata.ResourceData.HasChange("sku") {
+					payload.Sku = pointer.To(expandSkuModel(model.Sku))
+				}
+
+				if metadata.ResourceData.HasChange("tags") {
+					payload.Tags = pointer.To(model.Tags)
+				}
 
-			if metadata.ResourceData.HasChange("tags") {
-				payload.Tags = pointer.To(model.Tags)
+				if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
 			}
 
-			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
-				return fmt.Errorf("updating %s: %+v", *id, err)
+			if metadata.ResourceData.HasChange("state") && model.State == string(fabriccapacities.ResourceStatePaused) {
+				if err := client.SuspendThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("pausing %s: %+v", *id, err)
+				}
 			}
 
 			return nil
@@ -244,6 +317,7 @@ func (r FabricCapacityResource) Read() sdk.ResourceFunc {
 				state.Location = location.Normalize(model.Location)
 				state.AdministrationMembers = model.Properties.Administration.Members
 				state.Sku = flattenSkuModel(model.Sku)
+				state.State = flattenFabricCapacityState(model.Properties.State)
 				state.Tags = pointer.From(model.Tags)
 			}
 
@@ -289,3 +363,20 @@ func flattenSkuModel(input fabriccapacities.RpSku) []SkuModel {
 
 	return append(outputList, output)
 }
+
+// flattenFabricCapacityState maps the transitional states of the Capacity to the state it's transitioning to, since
+// only `Active` and `Paused` can be configured
+func flattenFabricCapacityState(input *fabriccapacities.ResourceState) string {
+	if input == nil {
+		return string(fabriccapacities.ResourceStateActive)
+	}
+
+	switch *input {
+	case fabriccapacities.ResourceStatePausing, fabriccapacities.ResourceStateSuspended, fabriccapacities.ResourceStateSuspending:
+		return string(fabriccapacities.ResourceStatePaused)
+	case fabriccapacities.ResourceStateResuming, fabriccapacities.ResourceStateScaling, fabriccapacities.ResourceStateUpdating:
+		return string(fabriccapacities.ResourceStateActive)
+	}
+
+	return string(*input)
+}
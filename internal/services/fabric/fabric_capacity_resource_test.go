@@ -95,6 +95,45 @@ func TestAccFabricCapacity_update(t *testing.T) {
 	})
 }
 
+func TestAccFabricCapacity_state(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_fabric_capacity", "test")
+	r := FabricCapacityResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.state(data, "F2", "Paused"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Paused"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.state(data, "F4", "Paused"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Paused"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.state(data, "F8", "Active"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Active"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.state(data, "F2", "Paused"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Paused"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r FabricCapacityResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := fabriccapacities.ParseCapacityID(state.ID)
 	if err != nil {
@@ -208,3 +247,23 @@ resource "azurerm_fabric_capacity" "test" {
 }
 `, template, data.RandomInteger, data.Locations.Primary)
 }
+
+func (r FabricCapacityResource) state(data acceptance.TestData, sku string, state string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_fabric_capacity" "test" {
+  name                   = "acctestffc%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = "%s"
+  administration_members = [data.azurerm_client_config.current.object_id]
+  state                  = "%s"
+
+  sku {
+    name = "%s"
+    tier = "Fabric"
+  }
+}
+`, template, data.RandomInteger, data.Locations.Primary, state, sku)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 )
 
 var (
@@ -127,6 +128,41 @@ func (c *Client) KeyVaultIDFromBaseUrl(ctx context.Context, subscriptionId commo
 	return nil, nil
 }
 
+// LatestVersionOfNestedItem returns the current version of the Certificate or Secret referenced by the Nested Item ID,
+// which allows resources referencing a versionless ID to detect when the item has been rotated.
+func (c *Client) LatestVersionOfNestedItem(ctx context.Context, id parse.NestedItemId) (*string, error) {
+	var latestId *string
+	switch id.NestedItemType {
+	case parse.NestedItemTypeCertificate:
+		resp, err := c.ManagementClient.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, "")
+		if err != nil {
+			return nil, fmt.Errorf("retrieving the latest version of Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+		latestId = resp.ID
+
+	case parse.NestedItemTypeSecret:
+		resp, err := c.ManagementClient.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, "")
+		if err != nil {
+			return nil, fmt.Errorf("retrieving the latest version of Secret %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+		latestId = resp.ID
+
+	default:
+		return nil, fmt.Errorf("internal-error: retrieving the latest version of a %q Nested Item is not supported", string(id.NestedItemType))
+	}
+
+	if latestId == nil {
+		return nil, fmt.Errorf("retrieving the latest version of %q (Key Vault %q): `id` was nil", id.Name, id.KeyVaultBaseUrl)
+	}
+
+	latest, err := parse.ParseNestedItemID(*latestId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &latest.Version, nil
+}
+
 func (c *Client) Purge(keyVaultId commonids.KeyVaultId) {
 	cacheKey := c.cacheKeyForKeyVault(keyVaultId.VaultName)
 	keysmith.Lock()
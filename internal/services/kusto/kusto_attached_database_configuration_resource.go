@@ -4,9 +4,11 @@
 package kusto
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
@@ -41,6 +43,14 @@ func resourceKustoAttachedDatabaseConfiguration() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+			// the name of the follower database can only be overridden when following a specific database
+			if d.Get("database_name").(string) == "*" && d.Get("database_name_override").(string) != "" {
+				return fmt.Errorf("`database_name_override` cannot be specified when `database_name` is `*`, `database_name_prefix` can be used instead")
+			}
+			return nil
+		}),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -81,6 +91,22 @@ func resourceKustoAttachedDatabaseConfiguration() *pluginsdk.Resource {
 				ValidateFunc: commonids.ValidateKustoClusterID,
 			},
 
+			"database_name_override": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validate.DatabaseName,
+				ConflictsWith: []string{"database_name_prefix"},
+			},
+
+			"database_name_prefix": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"database_name_override"},
+			},
+
 			"attached_database_names": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -110,6 +136,14 @@ func resourceKustoAttachedDatabaseConfiguration() *pluginsdk.Resource {
 							},
 						},
 
+						"functions_to_exclude": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
 						"external_tables_to_include": {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
@@ -118,6 +152,14 @@ func resourceKustoAttachedDatabaseConfiguration() *pluginsdk.Resource {
 							},
 						},
 
+						"functions_to_include": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
 						"materialized_views_to_exclude": {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
@@ -245,6 +287,8 @@ func resourceKustoAttachedDatabaseConfigurationRead(d *pluginsdk.ResourceData, m
 			}
 			d.Set("cluster_id", clusterResourceId.ID())
 			d.Set("database_name", props.DatabaseName)
+			d.Set("database_name_override", pointer.From(props.DatabaseNameOverride))
+			d.Set("database_name_prefix", pointer.From(props.DatabaseNamePrefix))
 			d.Set("default_principal_modification_kind", props.DefaultPrincipalsModificationKind)
 			d.Set("attached_database_names", props.AttachedDatabaseNames)
 			d.Set("sharing", flattenAttachedDatabaseConfigurationTableLevelSharingProperties(props.TableLevelSharingProperties))
@@ -296,6 +340,14 @@ func expandKustoAttachedDatabaseConfigurationProperties(d *pluginsdk.ResourceDat
 		AttachedDatabaseConfigurationProperties.DatabaseName = databaseName.(string)
 	}
 
+	if databaseNameOverride, ok := d.GetOk("database_name_override"); ok {
+		AttachedDatabaseConfigurationProperties.DatabaseNameOverride = pointer.To(databaseNameOverride.(string))
+	}
+
+	if databaseNamePrefix, ok := d.GetOk("database_name_prefix"); ok {
+		AttachedDatabaseConfigurationProperties.DatabaseNamePrefix = pointer.To(databaseNamePrefix.(string))
+	}
+
 	if defaultPrincipalModificationKind, ok := d.GetOk("default_principal_modification_kind"); ok {
 		AttachedDatabaseConfigurationProperties.DefaultPrincipalsModificationKind = attacheddatabaseconfigurations.DefaultPrincipalsModificationKind(defaultPrincipalModificationKind.(string))
 	}
@@ -315,6 +367,8 @@ func expandAttachedDatabaseConfigurationTableLevelSharingProperties(input []inte
 		TablesToExclude:            utils.ExpandStringSlice(v["tables_to_exclude"].(*pluginsdk.Set).List()),
 		ExternalTablesToInclude:    utils.ExpandStringSlice(v["external_tables_to_include"].(*pluginsdk.Set).List()),
 		ExternalTablesToExclude:    utils.ExpandStringSlice(v["external_tables_to_exclude"].(*pluginsdk.Set).List()),
+		FunctionsToInclude:         utils.ExpandStringSlice(v["functions_to_include"].(*pluginsdk.Set).List()),
+		FunctionsToExclude:         utils.ExpandStringSlice(v["functions_to_exclude"].(*pluginsdk.Set).List()),
 		MaterializedViewsToInclude: utils.ExpandStringSlice(v["materialized_views_to_include"].(*pluginsdk.Set).List()),
 		MaterializedViewsToExclude: utils.ExpandStringSlice(v["materialized_views_to_exclude"].(*pluginsdk.Set).List()),
 	}
@@ -329,6 +383,8 @@ func flattenAttachedDatabaseConfigurationTableLevelSharingProperties(input *atta
 		map[string]interface{}{
 			"external_tables_to_exclude":    utils.FlattenStringSlice(input.ExternalTablesToExclude),
 			"external_tables_to_include":    utils.FlattenStringSlice(input.ExternalTablesToInclude),
+			"functions_to_exclude":          utils.FlattenStringSlice(input.FunctionsToExclude),
+			"functions_to_include":          utils.FlattenStringSlice(input.FunctionsToInclude),
 			"materialized_views_to_exclude": utils.FlattenStringSlice(input.MaterializedViewsToExclude),
 			"materialized_views_to_include": utils.FlattenStringSlice(input.MaterializedViewsToInclude),
 			"tables_to_exclude":             utils.FlattenStringSlice(input.TablesToExclude),
@@ -51,6 +51,37 @@ func TestAccKustoAttachedDatabaseConfiguration_clusterResourceId(t *testing.T) {
 	})
 }
 
+func TestAccKustoAttachedDatabaseConfiguration_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_attached_database_configuration", "test")
+	r := KustoAttachedDatabaseConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("attached_database_names.0").HasValue(fmt.Sprintf("acctestfollower-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKustoAttachedDatabaseConfiguration_allDatabasesWithPrefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_attached_database_configuration", "test")
+	r := KustoAttachedDatabaseConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.allDatabasesWithPrefix(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (KustoAttachedDatabaseConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := attacheddatabaseconfigurations.ParseAttachedDatabaseConfigurationID(state.ID)
 	if err != nil {
@@ -204,3 +235,90 @@ resource "azurerm_kusto_attached_database_configuration" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
+
+func (KustoAttachedDatabaseConfigurationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "rg" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_kusto_cluster" "cluster1" {
+  name                = "acctestkc1%[3]s"
+  location            = azurerm_resource_group.rg.location
+  resource_group_name = azurerm_resource_group.rg.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+}
+
+resource "azurerm_kusto_cluster" "cluster2" {
+  name                = "acctestkc2%[3]s"
+  location            = azurerm_resource_group.rg.location
+  resource_group_name = azurerm_resource_group.rg.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+}
+
+resource "azurerm_kusto_database" "test" {
+  name                = "acctestkd2-%[1]d"
+  resource_group_name = azurerm_resource_group.rg.name
+  location            = azurerm_resource_group.rg.location
+  cluster_name        = azurerm_kusto_cluster.cluster2.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r KustoAttachedDatabaseConfigurationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_attached_database_configuration" "test" {
+  name                   = "acctestka-%d"
+  resource_group_name    = azurerm_resource_group.rg.name
+  location               = azurerm_resource_group.rg.location
+  cluster_name           = azurerm_kusto_cluster.cluster1.name
+  cluster_id             = azurerm_kusto_cluster.cluster2.id
+  database_name          = azurerm_kusto_database.test.name
+  database_name_override = "acctestfollower-%d"
+
+  sharing {
+    external_tables_to_exclude    = ["ExternalTable2"]
+    external_tables_to_include    = ["ExternalTable1"]
+    functions_to_exclude          = ["Function2"]
+    functions_to_include          = ["Function1"]
+    materialized_views_to_exclude = ["MaterializedViewTable2"]
+    materialized_views_to_include = ["MaterializedViewTable1"]
+    tables_to_exclude             = ["Table2"]
+    tables_to_include             = ["Table1"]
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r KustoAttachedDatabaseConfigurationResource) allDatabasesWithPrefix(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_attached_database_configuration" "test" {
+  name                 = "acctestka-%d"
+  resource_group_name  = azurerm_resource_group.rg.name
+  location             = azurerm_resource_group.rg.location
+  cluster_name         = azurerm_kusto_cluster.cluster1.name
+  cluster_id           = azurerm_kusto_cluster.cluster2.id
+  database_name        = "*"
+  database_name_prefix = "follower_"
+
+  depends_on = [azurerm_kusto_database.test]
+}
+`, r.template(data), data.RandomInteger)
+}
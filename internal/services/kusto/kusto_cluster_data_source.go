@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
@@ -50,6 +51,16 @@ func dataSourceKustoCluster() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"auto_stop_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": commonschema.TagsDataSource(),
 		},
 	}
@@ -90,6 +101,12 @@ func dataSourceKustoClusterRead(d *pluginsdk.ResourceData, meta interface{}) err
 		if clusterProperties := model.Properties; clusterProperties != nil {
 			d.Set("uri", clusterProperties.Uri)
 			d.Set("data_ingestion_uri", clusterProperties.DataIngestionUri)
+			autoStopEnabled := true
+			if clusterProperties.EnableAutoStop != nil {
+				autoStopEnabled = *clusterProperties.EnableAutoStop
+			}
+			d.Set("auto_stop_enabled", autoStopEnabled)
+			d.Set("state", string(pointer.From(clusterProperties.State)))
 		}
 		if err := tags.FlattenAndSet(d, resp.Model.Tags); err != nil {
 			return fmt.Errorf("setting `tags`: %s", err)
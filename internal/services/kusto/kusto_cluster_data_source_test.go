@@ -21,6 +21,7 @@ func TestAccKustoClusterDataSource_basic(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(KustoClusterResource{}),
 				check.That(data.ResourceName).Key("uri").IsSet(),
 				check.That(data.ResourceName).Key("data_ingestion_uri").IsSet(),
+				check.That(data.ResourceName).Key("state").HasValue("Running"),
 				check.That(data.ResourceName).Key("identity.0.principal_id").IsSet(),
 			),
 		},
@@ -178,6 +178,11 @@ func resourceKustoCluster() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"language_extensions": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -394,6 +399,14 @@ func resourceKustoClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 	model := existing.Model
 	props := model.Properties
 
+	// a Stopped Cluster, such as one which has been stopped automatically after being idle, rejects any changes
+	if pointer.From(props.State) == clusters.StateStopped {
+		log.Printf("[DEBUG] Starting %s before updating it..", *id)
+		if err := client.StartThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("starting %s: %+v", *id, err)
+		}
+	}
+
 	if d.HasChange("sku") || d.HasChange("optimized_auto_scale") {
 		sku, err := expandKustoClusterSku(d.Get("sku").([]interface{}))
 		if err != nil {
@@ -589,13 +602,19 @@ func resourceKustoClusterRead(d *pluginsdk.ResourceData, meta interface{}) error
 			d.Set("allowed_ip_ranges", props.AllowedIPRangeList)
 			d.Set("double_encryption_enabled", props.EnableDoubleEncryption)
 			d.Set("trusted_external_tenants", flattenTrustedExternalTenants(props.TrustedExternalTenants))
-			d.Set("auto_stop_enabled", props.EnableAutoStop)
+			// the API defaults to stopping idle Clusters automatically when this isn't returned
+			autoStopEnabled := true
+			if props.EnableAutoStop != nil {
+				autoStopEnabled = *props.EnableAutoStop
+			}
+			d.Set("auto_stop_enabled", autoStopEnabled)
 			d.Set("disk_encryption_enabled", props.EnableDiskEncryption)
 			d.Set("streaming_ingestion_enabled", props.EnableStreamingIngest)
 			d.Set("purge_enabled", props.EnablePurge)
 			d.Set("virtual_network_configuration", flattenKustoClusterVNET(props.VirtualNetworkConfiguration))
 			d.Set("uri", props.Uri)
 			d.Set("data_ingestion_uri", props.DataIngestionUri)
+			d.Set("state", string(pointer.From(props.State)))
 			d.Set("public_ip_type", string(pointer.From(props.PublicIPType)))
 
 			d.Set("language_extensions", flattenKustoClusterLanguageExtensionList(props.LanguageExtensions))
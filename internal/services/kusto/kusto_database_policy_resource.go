@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kusto
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/databases"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KustoDatabasePolicyModel struct {
+	DatabaseId       string `tfschema:"database_id"`
+	HotCachePeriod   string `tfschema:"hot_cache_period"`
+	SoftDeletePeriod string `tfschema:"soft_delete_period"`
+	Follower         bool   `tfschema:"follower"`
+}
+
+var (
+	_ sdk.Resource           = KustoDatabasePolicyResource{}
+	_ sdk.ResourceWithUpdate = KustoDatabasePolicyResource{}
+)
+
+type KustoDatabasePolicyResource struct{}
+
+func (r KustoDatabasePolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateKustoDatabaseID,
+		},
+
+		"hot_cache_period": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"hot_cache_period", "soft_delete_period"},
+			ValidateFunc: validate.ISO8601Duration,
+		},
+
+		"soft_delete_period": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"hot_cache_period", "soft_delete_period"},
+			ValidateFunc: validate.ISO8601Duration,
+		},
+	}
+}
+
+func (r KustoDatabasePolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"follower": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func (r KustoDatabasePolicyResource) ModelObject() interface{} {
+	return &KustoDatabasePolicyModel{}
+}
+
+func (r KustoDatabasePolicyResource) ResourceType() string {
+	return "azurerm_kusto_database_policy"
+}
+
+func (r KustoDatabasePolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateKustoDatabaseID
+}
+
+func (r KustoDatabasePolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Kusto.DatabasesClient
+
+			var model KustoDatabasePolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseKustoDatabaseID(model.DatabaseId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			if err := updateKustoDatabasePolicy(ctx, client, *id, model); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KustoDatabasePolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Kusto.DatabasesClient
+
+			id, err := commonids.ParseKustoDatabaseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KustoDatabasePolicyModel{
+				DatabaseId: id.ID(),
+			}
+
+			switch database := resp.Model.(type) {
+			case databases.ReadWriteDatabase:
+				if props := database.Properties; props != nil {
+					state.HotCachePeriod = pointer.From(props.HotCachePeriod)
+					state.SoftDeletePeriod = pointer.From(props.SoftDeletePeriod)
+				}
+			case databases.ReadOnlyFollowingDatabase:
+				state.Follower = true
+				if props := database.Properties; props != nil {
+					state.HotCachePeriod = pointer.From(props.HotCachePeriod)
+					state.SoftDeletePeriod = pointer.From(props.SoftDeletePeriod)
+				}
+			default:
+				return fmt.Errorf("retrieving %s: unexpected database kind %T", *id, resp.Model)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KustoDatabasePolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Kusto.DatabasesClient
+
+			id, err := commonids.ParseKustoDatabaseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KustoDatabasePolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			return updateKustoDatabasePolicy(ctx, client, *id, model)
+		},
+	}
+}
+
+func (r KustoDatabasePolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the caching and retention policies can't be removed from a database, so they're left as-is and the
+			// resource is only removed from the state
+			return nil
+		},
+	}
+}
+
+// updateKustoDatabasePolicy applies the caching and retention policies to either a read-write or a follower
+// database, leaving any policy which isn't specified unchanged.
+func updateKustoDatabasePolicy(ctx context.Context, client *databases.DatabasesClient, id commonids.KustoDatabaseId, model KustoDatabasePolicyModel) error {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	var payload databases.Database
+	switch database := resp.Model.(type) {
+	case databases.ReadWriteDatabase:
+		props := pointer.From(database.Properties)
+		if model.HotCachePeriod != "" {
+			props.HotCachePeriod = pointer.To(model.HotCachePeriod)
+		}
+		if model.SoftDeletePeriod != "" {
+			props.SoftDeletePeriod = pointer.To(model.SoftDeletePeriod)
+		}
+
+		payload = databases.ReadWriteDatabase{
+			Location: database.Location,
+			Properties: &databases.ReadWriteDatabaseProperties{
+				HotCachePeriod:   props.HotCachePeriod,
+				SoftDeletePeriod: props.SoftDeletePeriod,
+			},
+		}
+	case databases.ReadOnlyFollowingDatabase:
+		props := pointer.From(database.Properties)
+		if model.SoftDeletePeriod != "" && model.SoftDeletePeriod != pointer.From(props.SoftDeletePeriod) {
+			return fmt.Errorf("`soft_delete_period` cannot be changed for %s since it's a follower database which inherits it from the leader database", id)
+		}
+		if model.HotCachePeriod != "" {
+			props.HotCachePeriod = pointer.To(model.HotCachePeriod)
+		}
+
+		payload = databases.ReadOnlyFollowingDatabase{
+			Location: database.Location,
+			Properties: &databases.ReadOnlyFollowingDatabaseProperties{
+				HotCachePeriod: props.HotCachePeriod,
+			},
+		}
+	default:
+		return fmt.Errorf("updating %s: unexpected database kind %T", id, resp.Model)
+	}
+
+	if err := client.UpdateThenPoll(ctx, id, payload, databases.DefaultUpdateOperationOptions()); err != nil {
+		return fmt.Errorf("updating the policies for %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kusto_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KustoDatabasePolicyResource struct{}
+
+func TestAccKustoDatabasePolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_database_policy", "test")
+	r := KustoDatabasePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "P7D"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hot_cache_period").HasValue("P7D"),
+				check.That(data.ResourceName).Key("follower").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "P14D"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hot_cache_period").HasValue("P14D"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKustoDatabasePolicy_follower(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_database_policy", "test")
+	r := KustoDatabasePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.follower(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hot_cache_period").HasValue("P1D"),
+				check.That(data.ResourceName).Key("follower").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KustoDatabasePolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseKustoDatabaseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Kusto.DatabasesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (KustoDatabasePolicyResource) basic(data acceptance.TestData, hotCachePeriod string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kusto_cluster" "test" {
+  name                = "acctestkc%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+}
+
+resource "azurerm_kusto_database" "test" {
+  name                = "acctestkd-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  cluster_name        = azurerm_kusto_cluster.test.name
+}
+
+resource "azurerm_kusto_database_policy" "test" {
+  database_id        = azurerm_kusto_database.test.id
+  hot_cache_period   = "%s"
+  soft_delete_period = "P31D"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger, hotCachePeriod)
+}
+
+func (KustoDatabasePolicyResource) follower(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kusto_cluster" "follower" {
+  name                = "acctestkc1%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+}
+
+resource "azurerm_kusto_cluster" "leader" {
+  name                = "acctestkc2%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+}
+
+resource "azurerm_kusto_database" "leader" {
+  name                = "acctestkd-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  cluster_name        = azurerm_kusto_cluster.leader.name
+  hot_cache_period    = "P7D"
+}
+
+resource "azurerm_kusto_attached_database_configuration" "test" {
+  name                = "acctestka-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  cluster_name        = azurerm_kusto_cluster.follower.name
+  cluster_id          = azurerm_kusto_cluster.leader.id
+  database_name       = azurerm_kusto_database.leader.name
+}
+
+resource "azurerm_kusto_database_policy" "test" {
+  database_id      = "${azurerm_kusto_cluster.follower.id}/databases/${azurerm_kusto_database.leader.name}"
+  hot_cache_period = "P1D"
+
+  depends_on = [azurerm_kusto_attached_database_configuration.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString, data.RandomInteger, data.RandomInteger)
+}
@@ -4,6 +4,9 @@
 package kusto
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"time"
@@ -11,7 +14,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/scripts"
-	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -41,6 +43,14 @@ func resourceKustoDatabaseScript() *pluginsdk.Resource {
 			0: migration.KustoDatabaseScriptV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+			// the tag derived from the script changes along with it, unless a tag has been specified
+			if d.Id() != "" && d.GetRawConfig().AsValueMap()["force_an_update_when_value_changed"].IsNull() && d.HasChanges("url", "script_content") {
+				return d.SetNewComputed("force_an_update_when_value_changed")
+			}
+			return nil
+		}),
+
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := scripts.ParseScriptID(id)
 			return err
@@ -94,7 +104,6 @@ func resourceKustoDatabaseScript() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				ExactlyOneOf: []string{"url", "script_content"},
 				Optional:     true,
-				ForceNew:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
@@ -128,9 +137,10 @@ func resourceKustoDatabaseScriptCreateUpdate(d *pluginsdk.ResourceData, meta int
 	locks.ByID(clusterId.ID())
 	defer locks.UnlockByID(clusterId.ID())
 
+	// when no tag is specified it's derived from the script, so that the script is only run again when it changes
 	forceUpdateTag := d.Get("force_an_update_when_value_changed").(string)
-	if len(forceUpdateTag) == 0 {
-		forceUpdateTag, _ = uuid.GenerateUUID()
+	if d.GetRawConfig().AsValueMap()["force_an_update_when_value_changed"].IsNull() {
+		forceUpdateTag = kustoDatabaseScriptContentHash(d.Get("url").(string), d.Get("script_content").(string))
 	}
 
 	parameters := scripts.Script{
@@ -213,3 +223,8 @@ func resourceKustoDatabaseScriptDelete(d *pluginsdk.ResourceData, meta interface
 
 	return nil
 }
+
+func kustoDatabaseScriptContentHash(scriptURL, scriptContent string) string {
+	hash := sha256.Sum256([]byte(scriptURL + scriptContent))
+	return hex.EncodeToString(hash[:])
+}
@@ -119,6 +119,29 @@ func TestAccKustoScript_scriptContent(t *testing.T) {
 	})
 }
 
+func TestAccKustoScript_scriptContentHash(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_script", "test")
+	r := KustoScriptResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.scriptContentHash(data, "MyTable"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("force_an_update_when_value_changed").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("sas_token", "script_content"),
+		{
+			Config: r.scriptContentHash(data, "MyOtherTable"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("force_an_update_when_value_changed").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("sas_token", "script_content"),
+	})
+}
+
 func (r KustoScriptResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := scripts.ParseScriptID(state.ID)
 	if err != nil {
@@ -324,3 +347,16 @@ resource "azurerm_kusto_script" "test" {
 }
 `, template, data.RandomInteger)
 }
+
+func (r KustoScriptResource) scriptContentHash(data acceptance.TestData, tableName string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_kusto_script" "test" {
+  name                       = "acctest-ks-%d"
+  database_id                = azurerm_kusto_database.test.id
+  continue_on_errors_enabled = true
+  script_content             = ".create-merge table %s (Level:string, Timestamp:datetime, Message:string)"
+}
+`, template, data.RandomInteger, tableName)
+}
@@ -21,6 +21,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		CosmosDBDataConnectionResource{},
+		KustoDatabasePolicyResource{},
 	}
 }
 
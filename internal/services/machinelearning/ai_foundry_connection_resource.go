@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/v2workspaceconnectionresource"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// the metadata key the Azure AI Foundry portal uses to link a Connection to the connected Azure resource
+const aiFoundryConnectionResourceIdMetadataKey = "ResourceId"
+
+type AIFoundryConnection struct{}
+
+type AIFoundryConnectionModel struct {
+	Name               string            `tfschema:"name"`
+	ParentId           string            `tfschema:"parent_id"`
+	Category           string            `tfschema:"category"`
+	Target             string            `tfschema:"target"`
+	AuthenticationType string            `tfschema:"authentication_type"`
+	Key                string            `tfschema:"key"`
+	ResourceId         string            `tfschema:"resource_id"`
+	Metadata           map[string]string `tfschema:"metadata"`
+	SharedToAllEnabled bool              `tfschema:"shared_to_all_enabled"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate        = AIFoundryConnection{}
+	_ sdk.ResourceWithCustomizeDiff = AIFoundryConnection{}
+)
+
+func (r AIFoundryConnection) ModelObject() interface{} {
+	return &AIFoundryConnectionModel{}
+}
+
+func (r AIFoundryConnection) ResourceType() string {
+	return "azurerm_ai_foundry_connection"
+}
+
+func (r AIFoundryConnection) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return v2workspaceconnectionresource.ValidateConnectionID
+}
+
+func (r AIFoundryConnection) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z0-9][a-zA-Z0-9_-]{2,32}$"),
+				"AI Foundry Connection name must be 3 - 33 characters long, start with a letter or number and contain only letters, numbers, underscores and hyphens.",
+			),
+		},
+
+		"parent_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: v2workspaceconnectionresource.ValidateWorkspaceID,
+		},
+
+		"category": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(v2workspaceconnectionresource.ConnectionCategoryADLSGenTwo),
+				string(v2workspaceconnectionresource.ConnectionCategoryAIServices),
+				string(v2workspaceconnectionresource.ConnectionCategoryAzureBlob),
+				string(v2workspaceconnectionresource.ConnectionCategoryAzureOpenAI),
+				string(v2workspaceconnectionresource.ConnectionCategoryCognitiveSearch),
+			}, false),
+		},
+
+		"target": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"authentication_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(v2workspaceconnectionresource.ConnectionAuthTypeAAD),
+				string(v2workspaceconnectionresource.ConnectionAuthTypeAccountKey),
+				string(v2workspaceconnectionresource.ConnectionAuthTypeApiKey),
+			}, false),
+		},
+
+		"key": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"shared_to_all_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r AIFoundryConnection) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AIFoundryConnection) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model AIFoundryConnectionModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if model.AuthenticationType == string(v2workspaceconnectionresource.ConnectionAuthTypeAAD) {
+				if model.Key != "" {
+					return fmt.Errorf("`key` cannot be specified when `authentication_type` is `AAD`")
+				}
+			} else if model.Key == "" && metadata.ResourceDiff.NewValueKnown("key") {
+				return fmt.Errorf("`key` must be specified when `authentication_type` is `%s`", model.AuthenticationType)
+			}
+
+			if _, ok := model.Metadata[aiFoundryConnectionResourceIdMetadataKey]; ok {
+				return fmt.Errorf("the `%s` key cannot be specified in `metadata`, use the `resource_id` property instead", aiFoundryConnectionResourceIdMetadataKey)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AIFoundryConnection) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.WorkspaceConnections
+
+			var model AIFoundryConnectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parentId, err := v2workspaceconnectionresource.ParseWorkspaceID(model.ParentId)
+			if err != nil {
+				return err
+			}
+
+			id := v2workspaceconnectionresource.NewConnectionID(parentId.SubscriptionId, parentId.ResourceGroupName, parentId.WorkspaceName, model.Name)
+
+			existing, err := client.WorkspaceConnectionsGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			payload := v2workspaceconnectionresource.WorkspaceConnectionPropertiesV2BasicResource{
+				Properties: expandAIFoundryConnectionProperties(model),
+			}
+
+			if _, err := client.WorkspaceConnectionsCreate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AIFoundryConnection) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.WorkspaceConnections
+
+			id, err := v2workspaceconnectionresource.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.WorkspaceConnectionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := AIFoundryConnectionModel{
+				Name:     id.ConnectionName,
+				ParentId: v2workspaceconnectionresource.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+				// the key isn't returned by the API
+				Key: metadata.ResourceData.Get("key").(string),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties.WorkspaceConnectionPropertiesV2()
+				state.AuthenticationType = string(props.AuthType)
+				state.Category = string(pointer.From(props.Category))
+				state.Target = pointer.From(props.Target)
+				state.SharedToAllEnabled = pointer.From(props.IsSharedToAll)
+
+				connectionMetadata := make(map[string]string)
+				for k, v := range pointer.From(props.Metadata) {
+					if k == aiFoundryConnectionResourceIdMetadataKey {
+						state.ResourceId = v
+						continue
+					}
+					connectionMetadata[k] = v
+				}
+				state.Metadata = connectionMetadata
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AIFoundryConnection) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.WorkspaceConnections
+
+			id, err := v2workspaceconnectionresource.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AIFoundryConnectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the credentials aren't returned by the API, so the whole Connection is sent from the configuration
+			payload := v2workspaceconnectionresource.WorkspaceConnectionPropertiesV2BasicResource{
+				Properties: expandAIFoundryConnectionProperties(model),
+			}
+
+			if _, err := client.WorkspaceConnectionsCreate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AIFoundryConnection) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.WorkspaceConnections
+
+			id, err := v2workspaceconnectionresource.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.WorkspaceConnectionsDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandAIFoundryConnectionProperties(model AIFoundryConnectionModel) v2workspaceconnectionresource.WorkspaceConnectionPropertiesV2 {
+	category := v2workspaceconnectionresource.ConnectionCategory(model.Category)
+
+	connectionMetadata := make(map[string]string)
+	for k, v := range model.Metadata {
+		connectionMetadata[k] = v
+	}
+	if model.ResourceId != "" {
+		connectionMetadata[aiFoundryConnectionResourceIdMetadataKey] = model.ResourceId
+	}
+
+	switch v2workspaceconnectionresource.ConnectionAuthType(model.AuthenticationType) {
+	case v2workspaceconnectionresource.ConnectionAuthTypeApiKey:
+		return v2workspaceconnectionresource.ApiKeyAuthWorkspaceConnectionProperties{
+			AuthType:      v2workspaceconnectionresource.ConnectionAuthTypeApiKey,
+			Category:      pointer.To(category),
+			Target:        pointer.To(model.Target),
+			IsSharedToAll: pointer.To(model.SharedToAllEnabled),
+			Metadata:      pointer.To(connectionMetadata),
+			Credentials: &v2workspaceconnectionresource.WorkspaceConnectionApiKey{
+				Key: pointer.To(model.Key),
+			},
+		}
+	case v2workspaceconnectionresource.ConnectionAuthTypeAccountKey:
+		return v2workspaceconnectionresource.AccountKeyAuthTypeWorkspaceConnectionProperties{
+			AuthType:      v2workspaceconnectionresource.ConnectionAuthTypeAccountKey,
+			Category:      pointer.To(category),
+			Target:        pointer.To(model.Target),
+			IsSharedToAll: pointer.To(model.SharedToAllEnabled),
+			Metadata:      pointer.To(connectionMetadata),
+			Credentials: &v2workspaceconnectionresource.WorkspaceConnectionAccountKey{
+				Key: pointer.To(model.Key),
+			},
+		}
+	}
+
+	return v2workspaceconnectionresource.AADAuthTypeWorkspaceConnectionProperties{
+		AuthType:      v2workspaceconnectionresource.ConnectionAuthTypeAAD,
+		Category:      pointer.To(category),
+		Target:        pointer.To(model.Target),
+		IsSharedToAll: pointer.To(model.SharedToAllEnabled),
+		Metadata:      pointer.To(connectionMetadata),
+	}
+}
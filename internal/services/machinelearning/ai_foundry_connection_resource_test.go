@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/v2workspaceconnectionresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AIFoundryConnection struct{}
+
+func TestAccAIFoundryConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnection{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAIFoundryConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnection{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAIFoundryConnection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnection{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.apiKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAIFoundryConnection_storageOnProject(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnection{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.storageOnProject(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key"),
+	})
+}
+
+func (AIFoundryConnection) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := v2workspaceconnectionresource.ParseConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MachineLearning.WorkspaceConnections.WorkspaceConnectionsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r AIFoundryConnection) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "test" {
+  name                = "acctestconn-%[2]d"
+  parent_id           = azurerm_ai_foundry.test.id
+  category            = "AIServices"
+  target              = azurerm_ai_services.test.endpoint
+  authentication_type = "AAD"
+  resource_id         = azurerm_ai_services.test.id
+
+  metadata = {
+    ApiType = "Azure"
+  }
+}
+`, AIFoundry{}.basic(data), data.RandomIntOfLength(8))
+}
+
+func (r AIFoundryConnection) apiKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "test" {
+  name                  = "acctestconn-%[2]d"
+  parent_id             = azurerm_ai_foundry.test.id
+  category              = "AIServices"
+  target                = azurerm_ai_services.test.endpoint
+  authentication_type   = "ApiKey"
+  key                   = azurerm_ai_services.test.primary_access_key
+  resource_id           = azurerm_ai_services.test.id
+  shared_to_all_enabled = false
+
+  metadata = {
+    ApiType = "Azure"
+  }
+}
+`, AIFoundry{}.basic(data), data.RandomIntOfLength(8))
+}
+
+func (r AIFoundryConnection) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "import" {
+  name                = azurerm_ai_foundry_connection.test.name
+  parent_id           = azurerm_ai_foundry_connection.test.parent_id
+  category            = azurerm_ai_foundry_connection.test.category
+  target              = azurerm_ai_foundry_connection.test.target
+  authentication_type = azurerm_ai_foundry_connection.test.authentication_type
+}
+`, r.basic(data))
+}
+
+func (r AIFoundryConnection) storageOnProject(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container" "test" {
+  name               = "acctestcontainer"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_ai_foundry_connection" "test" {
+  name                = "acctestconn-%[2]d"
+  parent_id           = azurerm_ai_foundry_project.test.id
+  category            = "AzureBlob"
+  target              = azurerm_storage_account.test.primary_blob_endpoint
+  authentication_type = "AccountKey"
+  key                 = azurerm_storage_account.test.primary_access_key
+  resource_id         = azurerm_storage_account.test.id
+
+  metadata = {
+    AccountName   = azurerm_storage_account.test.name
+    ContainerName = azurerm_storage_container.test.name
+  }
+}
+`, AIFoundryProject{}.basic(data), data.RandomIntOfLength(8))
+}
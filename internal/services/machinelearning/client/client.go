@@ -9,6 +9,10 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/datastore"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/machinelearningcomputes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/managednetwork"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlinedeployment"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlineendpoint"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/serverlessendpoint"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/v2workspaceconnectionresource"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -18,6 +22,10 @@ type Client struct {
 	MachineLearningComputes *machinelearningcomputes.MachineLearningComputesClient
 	Workspaces              *workspaces.WorkspacesClient
 	ManagedNetwork          *managednetwork.ManagedNetworkClient
+	OnlineDeployment        *onlinedeployment.OnlineDeploymentClient
+	OnlineEndpoint          *onlineendpoint.OnlineEndpointClient
+	ServerlessEndpoint      *serverlessendpoint.ServerlessEndpointClient
+	WorkspaceConnections    *v2workspaceconnectionresource.V2WorkspaceConnectionResourceClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -45,10 +53,38 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(managedNetworkClient.Client, o.Authorizers.ResourceManager)
 
+	onlineDeploymentClient, err := onlinedeployment.NewOnlineDeploymentClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building OnlineDeployment client: %+v", err)
+	}
+	o.Configure(onlineDeploymentClient.Client, o.Authorizers.ResourceManager)
+
+	onlineEndpointClient, err := onlineendpoint.NewOnlineEndpointClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building OnlineEndpoint client: %+v", err)
+	}
+	o.Configure(onlineEndpointClient.Client, o.Authorizers.ResourceManager)
+
+	serverlessEndpointClient, err := serverlessendpoint.NewServerlessEndpointClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ServerlessEndpoint client: %+v", err)
+	}
+	o.Configure(serverlessEndpointClient.Client, o.Authorizers.ResourceManager)
+
+	workspaceConnectionsClient, err := v2workspaceconnectionresource.NewV2WorkspaceConnectionResourceClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building WorkspaceConnections client: %+v", err)
+	}
+	o.Configure(workspaceConnectionsClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		MachineLearningComputes: computesClient,
 		Datastore:               datastoreClient,
 		Workspaces:              workspacesClient,
 		ManagedNetwork:          managedNetworkClient,
+		OnlineDeployment:        onlineDeploymentClient,
+		OnlineEndpoint:          onlineEndpointClient,
+		ServerlessEndpoint:      serverlessEndpointClient,
+		WorkspaceConnections:    workspaceConnectionsClient,
 	}, nil
 }
@@ -0,0 +1,514 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlinedeployment"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlineendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// the SKU name used by Managed Online Deployments, where the capacity is the number of instances
+const machineLearningOnlineDeploymentSkuName = "Default"
+
+type MachineLearningOnlineDeployment struct{}
+
+type MachineLearningOnlineDeploymentModel struct {
+	Name                             string                                          `tfschema:"name"`
+	OnlineEndpointId                 string                                          `tfschema:"online_endpoint_id"`
+	InstanceType                     string                                          `tfschema:"instance_type"`
+	InstanceCount                    int64                                           `tfschema:"instance_count"`
+	ModelId                          string                                          `tfschema:"model_id"`
+	EnvironmentId                    string                                          `tfschema:"environment_id"`
+	CodeConfiguration                []MachineLearningOnlineDeploymentCodeConfig     `tfschema:"code_configuration"`
+	EnvironmentVariables             map[string]string                               `tfschema:"environment_variables"`
+	ApplicationInsightsEnabled       bool                                            `tfschema:"application_insights_enabled"`
+	EgressPublicNetworkAccessEnabled bool                                            `tfschema:"egress_public_network_access_enabled"`
+	Description                      string                                          `tfschema:"description"`
+	RequestSettings                  []MachineLearningOnlineDeploymentRequestSetting `tfschema:"request_settings"`
+	TargetUtilizationScale           []MachineLearningOnlineDeploymentScaleSetting   `tfschema:"target_utilization_scale"`
+	Tags                             map[string]interface{}                          `tfschema:"tags"`
+}
+
+type MachineLearningOnlineDeploymentCodeConfig struct {
+	CodeId        string `tfschema:"code_id"`
+	ScoringScript string `tfschema:"scoring_script"`
+}
+
+type MachineLearningOnlineDeploymentRequestSetting struct {
+	MaxConcurrentRequestsPerInstance int64  `tfschema:"max_concurrent_requests_per_instance"`
+	MaxQueueWait                     string `tfschema:"max_queue_wait"`
+	RequestTimeout                   string `tfschema:"request_timeout"`
+}
+
+type MachineLearningOnlineDeploymentScaleSetting struct {
+	MinInstances                int64  `tfschema:"min_instances"`
+	MaxInstances                int64  `tfschema:"max_instances"`
+	PollingInterval             string `tfschema:"polling_interval"`
+	TargetUtilizationPercentage int64  `tfschema:"target_utilization_percentage"`
+}
+
+var _ sdk.ResourceWithUpdate = MachineLearningOnlineDeployment{}
+
+func (r MachineLearningOnlineDeployment) ModelObject() interface{} {
+	return &MachineLearningOnlineDeploymentModel{}
+}
+
+func (r MachineLearningOnlineDeployment) ResourceType() string {
+	return "azurerm_machine_learning_online_deployment"
+}
+
+func (r MachineLearningOnlineDeployment) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return onlinedeployment.ValidateOnlineEndpointDeploymentID
+}
+
+func (r MachineLearningOnlineDeployment) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.OnlineDeploymentName,
+		},
+
+		"online_endpoint_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: onlinedeployment.ValidateOnlineEndpointID,
+		},
+
+		"instance_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"instance_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"model_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"environment_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"code_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"scoring_script": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"code_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"environment_variables": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"application_insights_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"egress_public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"request_settings": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"max_concurrent_requests_per_instance": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"max_queue_wait": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "PT0.5S",
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+
+					"request_timeout": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "PT5S",
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+				},
+			},
+		},
+
+		"target_utilization_scale": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"min_instances": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"max_instances": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"polling_interval": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "PT1S",
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+
+					"target_utilization_percentage": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      70,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MachineLearningOnlineDeployment) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployment
+			endpointClient := metadata.Client.MachineLearning.OnlineEndpoint
+
+			var model MachineLearningOnlineDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			endpointId, err := onlineendpoint.ParseOnlineEndpointID(model.OnlineEndpointId)
+			if err != nil {
+				return err
+			}
+
+			id := onlinedeployment.NewOnlineEndpointDeploymentID(endpointId.SubscriptionId, endpointId.ResourceGroupName, endpointId.WorkspaceName, endpointId.OnlineEndpointName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			// Online Deployments must be in the same location as the Online Endpoint
+			endpoint, err := endpointClient.Get(ctx, *endpointId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *endpointId, err)
+			}
+			if endpoint.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *endpointId)
+			}
+
+			payload := expandMachineLearningOnlineDeployment(model, location.Normalize(endpoint.Model.Location))
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployment
+
+			id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MachineLearningOnlineDeploymentModel{
+				Name:             id.DeploymentName,
+				OnlineEndpointId: onlinedeployment.NewOnlineEndpointID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.OnlineEndpointName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = tags.Flatten(model.Tags)
+
+				if sku := model.Sku; sku != nil {
+					state.InstanceCount = pointer.From(sku.Capacity)
+				}
+
+				if props, ok := model.Properties.(onlinedeployment.ManagedOnlineDeployment); ok {
+					state.InstanceType = pointer.From(props.InstanceType)
+					state.ModelId = pointer.From(props.Model)
+					state.EnvironmentId = pointer.From(props.EnvironmentId)
+					state.EnvironmentVariables = pointer.From(props.EnvironmentVariables)
+					state.ApplicationInsightsEnabled = pointer.From(props.AppInsightsEnabled)
+					state.EgressPublicNetworkAccessEnabled = pointer.From(props.EgressPublicNetworkAccess) != onlinedeployment.EgressPublicNetworkAccessTypeDisabled
+					state.Description = pointer.From(props.Description)
+					state.CodeConfiguration = flattenMachineLearningOnlineDeploymentCodeConfiguration(props.CodeConfiguration)
+					state.RequestSettings = flattenMachineLearningOnlineDeploymentRequestSettings(props.RequestSettings)
+					state.TargetUtilizationScale = flattenMachineLearningOnlineDeploymentScaleSettings(props.ScaleSettings)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployment
+
+			id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MachineLearningOnlineDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := expandMachineLearningOnlineDeployment(model, existing.Model.Location)
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployment
+
+			id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMachineLearningOnlineDeployment(model MachineLearningOnlineDeploymentModel, location string) onlinedeployment.OnlineDeploymentTrackedResource {
+	egressPublicNetworkAccess := onlinedeployment.EgressPublicNetworkAccessTypeDisabled
+	if model.EgressPublicNetworkAccessEnabled {
+		egressPublicNetworkAccess = onlinedeployment.EgressPublicNetworkAccessTypeEnabled
+	}
+
+	props := onlinedeployment.ManagedOnlineDeployment{
+		AppInsightsEnabled:        pointer.To(model.ApplicationInsightsEnabled),
+		EgressPublicNetworkAccess: pointer.To(egressPublicNetworkAccess),
+		EndpointComputeType:       onlinedeployment.EndpointComputeTypeManaged,
+		EnvironmentVariables:      pointer.To(model.EnvironmentVariables),
+		InstanceType:              pointer.To(model.InstanceType),
+		ScaleSettings:             expandMachineLearningOnlineDeploymentScaleSettings(model.TargetUtilizationScale),
+	}
+
+	if model.ModelId != "" {
+		props.Model = pointer.To(model.ModelId)
+	}
+
+	if model.EnvironmentId != "" {
+		props.EnvironmentId = pointer.To(model.EnvironmentId)
+	}
+
+	if model.Description != "" {
+		props.Description = pointer.To(model.Description)
+	}
+
+	if len(model.CodeConfiguration) > 0 {
+		codeConfiguration := model.CodeConfiguration[0]
+		props.CodeConfiguration = &onlinedeployment.CodeConfiguration{
+			ScoringScript: codeConfiguration.ScoringScript,
+		}
+		if codeConfiguration.CodeId != "" {
+			props.CodeConfiguration.CodeId = pointer.To(codeConfiguration.CodeId)
+		}
+	}
+
+	if len(model.RequestSettings) > 0 {
+		requestSettings := model.RequestSettings[0]
+		props.RequestSettings = &onlinedeployment.OnlineRequestSettings{
+			MaxConcurrentRequestsPerInstance: pointer.To(requestSettings.MaxConcurrentRequestsPerInstance),
+			MaxQueueWait:                     pointer.To(requestSettings.MaxQueueWait),
+			RequestTimeout:                   pointer.To(requestSettings.RequestTimeout),
+		}
+	}
+
+	return onlinedeployment.OnlineDeploymentTrackedResource{
+		Location:   location,
+		Properties: props,
+		Sku: &onlinedeployment.Sku{
+			Name:     machineLearningOnlineDeploymentSkuName,
+			Capacity: pointer.To(model.InstanceCount),
+		},
+		Tags: tags.Expand(model.Tags),
+	}
+}
+
+func expandMachineLearningOnlineDeploymentScaleSettings(input []MachineLearningOnlineDeploymentScaleSetting) onlinedeployment.OnlineScaleSettings {
+	if len(input) == 0 {
+		return onlinedeployment.DefaultScaleSettings{}
+	}
+
+	v := input[0]
+	return onlinedeployment.TargetUtilizationScaleSettings{
+		MaxInstances:                pointer.To(v.MaxInstances),
+		MinInstances:                pointer.To(v.MinInstances),
+		PollingInterval:             pointer.To(v.PollingInterval),
+		TargetUtilizationPercentage: pointer.To(v.TargetUtilizationPercentage),
+	}
+}
+
+func flattenMachineLearningOnlineDeploymentCodeConfiguration(input *onlinedeployment.CodeConfiguration) []MachineLearningOnlineDeploymentCodeConfig {
+	if input == nil {
+		return []MachineLearningOnlineDeploymentCodeConfig{}
+	}
+
+	return []MachineLearningOnlineDeploymentCodeConfig{
+		{
+			CodeId:        pointer.From(input.CodeId),
+			ScoringScript: input.ScoringScript,
+		},
+	}
+}
+
+func flattenMachineLearningOnlineDeploymentRequestSettings(input *onlinedeployment.OnlineRequestSettings) []MachineLearningOnlineDeploymentRequestSetting {
+	if input == nil {
+		return []MachineLearningOnlineDeploymentRequestSetting{}
+	}
+
+	return []MachineLearningOnlineDeploymentRequestSetting{
+		{
+			MaxConcurrentRequestsPerInstance: pointer.From(input.MaxConcurrentRequestsPerInstance),
+			MaxQueueWait:                     pointer.From(input.MaxQueueWait),
+			RequestTimeout:                   pointer.From(input.RequestTimeout),
+		},
+	}
+}
+
+func flattenMachineLearningOnlineDeploymentScaleSettings(input onlinedeployment.OnlineScaleSettings) []MachineLearningOnlineDeploymentScaleSetting {
+	settings, ok := input.(onlinedeployment.TargetUtilizationScaleSettings)
+	if !ok {
+		return []MachineLearningOnlineDeploymentScaleSetting{}
+	}
+
+	return []MachineLearningOnlineDeploymentScaleSetting{
+		{
+			MinInstances:                pointer.From(settings.MinInstances),
+			MaxInstances:                pointer.From(settings.MaxInstances),
+			PollingInterval:             pointer.From(settings.PollingInterval),
+			TargetUtilizationPercentage: pointer.From(settings.TargetUtilizationPercentage),
+		},
+	}
+}
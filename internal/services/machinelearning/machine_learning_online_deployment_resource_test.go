@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlinedeployment"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MachineLearningOnlineDeploymentResource struct{}
+
+func TestAccMachineLearningOnlineDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_deployment", "test")
+	r := MachineLearningOnlineDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningOnlineDeployment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_deployment", "test")
+	r := MachineLearningOnlineDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMachineLearningOnlineDeployment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_deployment", "test")
+	r := MachineLearningOnlineDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MachineLearningOnlineDeploymentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.OnlineDeployment.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MachineLearningOnlineDeploymentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_deployment" "test" {
+  name               = "blue"
+  online_endpoint_id = azurerm_machine_learning_online_endpoint.test.id
+  instance_type      = "Standard_DS3_v2"
+  model_id           = "azureml://registries/azureml/models/distilbert-base-uncased-finetuned-sst-2-english/versions/17"
+}
+`, MachineLearningOnlineEndpointResource{}.basic(data))
+}
+
+func (r MachineLearningOnlineDeploymentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_deployment" "import" {
+  name               = azurerm_machine_learning_online_deployment.test.name
+  online_endpoint_id = azurerm_machine_learning_online_deployment.test.online_endpoint_id
+  instance_type      = azurerm_machine_learning_online_deployment.test.instance_type
+  model_id           = azurerm_machine_learning_online_deployment.test.model_id
+}
+`, r.basic(data))
+}
+
+func (r MachineLearningOnlineDeploymentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_deployment" "test" {
+  name                         = "blue"
+  online_endpoint_id           = azurerm_machine_learning_online_endpoint.test.id
+  instance_type                = "Standard_DS3_v2"
+  instance_count               = 2
+  model_id                     = "azureml://registries/azureml/models/distilbert-base-uncased-finetuned-sst-2-english/versions/17"
+  application_insights_enabled = true
+
+  environment_variables = {
+    WORKER_COUNT = "2"
+  }
+
+  request_settings {
+    max_concurrent_requests_per_instance = 2
+    request_timeout                      = "PT10S"
+    max_queue_wait                       = "PT1S"
+  }
+
+  target_utilization_scale {
+    min_instances                 = 2
+    max_instances                 = 3
+    target_utilization_percentage = 60
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, MachineLearningOnlineEndpointResource{}.basic(data))
+}
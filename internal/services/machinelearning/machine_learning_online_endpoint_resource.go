@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlineendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MachineLearningOnlineEndpoint struct{}
+
+type MachineLearningOnlineEndpointModel struct {
+	Name                       string                                     `tfschema:"name"`
+	WorkspaceId                string                                     `tfschema:"workspace_id"`
+	Location                   string                                     `tfschema:"location"`
+	AuthenticationMode         string                                     `tfschema:"authentication_mode"`
+	Identity                   []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Description                string                                     `tfschema:"description"`
+	PublicNetworkAccessEnabled bool                                       `tfschema:"public_network_access_enabled"`
+	ScoringUri                 string                                     `tfschema:"scoring_uri"`
+	SwaggerUri                 string                                     `tfschema:"swagger_uri"`
+	PrimaryKey                 string                                     `tfschema:"primary_key"`
+	SecondaryKey               string                                     `tfschema:"secondary_key"`
+	Tags                       map[string]interface{}                     `tfschema:"tags"`
+}
+
+var _ sdk.ResourceWithUpdate = MachineLearningOnlineEndpoint{}
+
+func (r MachineLearningOnlineEndpoint) ModelObject() interface{} {
+	return &MachineLearningOnlineEndpointModel{}
+}
+
+func (r MachineLearningOnlineEndpoint) ResourceType() string {
+	return "azurerm_machine_learning_online_endpoint"
+}
+
+func (r MachineLearningOnlineEndpoint) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return onlineendpoint.ValidateOnlineEndpointID
+}
+
+func (r MachineLearningOnlineEndpoint) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.EndpointName,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: onlineendpoint.ValidateWorkspaceID,
+		},
+
+		"location": commonschema.Location(),
+
+		"authentication_mode": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(onlineendpoint.PossibleValuesForEndpointAuthMode(), false),
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityRequired(),
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scoring_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"swagger_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"primary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoint
+
+			var model MachineLearningOnlineEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := onlineendpoint.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := onlineendpoint.NewOnlineEndpointID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			publicNetworkAccess := onlineendpoint.PublicNetworkAccessTypeDisabled
+			if model.PublicNetworkAccessEnabled {
+				publicNetworkAccess = onlineendpoint.PublicNetworkAccessTypeEnabled
+			}
+
+			payload := onlineendpoint.OnlineEndpointTrackedResource{
+				Identity: expandedIdentity,
+				Location: location.Normalize(model.Location),
+				Properties: onlineendpoint.OnlineEndpoint{
+					AuthMode:            onlineendpoint.EndpointAuthMode(model.AuthenticationMode),
+					PublicNetworkAccess: pointer.To(publicNetworkAccess),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoint
+
+			id, err := onlineendpoint.ParseOnlineEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MachineLearningOnlineEndpointModel{
+				Name:        id.OnlineEndpointName,
+				WorkspaceId: onlineendpoint.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				flattenedIdentity, err := identity.FlattenLegacySystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = flattenedIdentity
+
+				props := model.Properties
+				state.AuthenticationMode = string(props.AuthMode)
+				state.Description = pointer.From(props.Description)
+				state.PublicNetworkAccessEnabled = pointer.From(props.PublicNetworkAccess) != onlineendpoint.PublicNetworkAccessTypeDisabled
+				state.ScoringUri = pointer.From(props.ScoringUri)
+				state.SwaggerUri = pointer.From(props.SwaggerUri)
+
+				// the keys can only be retrieved when key-based authentication is used
+				if props.AuthMode == onlineendpoint.EndpointAuthModeKey {
+					keys, err := client.ListKeys(ctx, *id)
+					if err != nil {
+						return fmt.Errorf("listing keys for %s: %+v", *id, err)
+					}
+					if keysModel := keys.Model; keysModel != nil {
+						state.PrimaryKey = pointer.From(keysModel.PrimaryKey)
+						state.SecondaryKey = pointer.From(keysModel.SecondaryKey)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoint
+
+			id, err := onlineendpoint.ParseOnlineEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MachineLearningOnlineEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// the `traffic` and `mirrorTraffic` are managed by the `azurerm_machine_learning_online_endpoint_traffic`
+			// resource, as such these are retained from the existing Online Endpoint
+			payload := existing.Model
+
+			if metadata.ResourceData.HasChange("authentication_mode") {
+				payload.Properties.AuthMode = onlineendpoint.EndpointAuthMode(model.AuthenticationMode)
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access_enabled") {
+				publicNetworkAccess := onlineendpoint.PublicNetworkAccessTypeDisabled
+				if model.PublicNetworkAccessEnabled {
+					publicNetworkAccess = onlineendpoint.PublicNetworkAccessTypeEnabled
+				}
+				payload.Properties.PublicNetworkAccess = pointer.To(publicNetworkAccess)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.Expand(model.Tags)
+			}
+
+			// the keys are returned as part of the Online Endpoint but can't be sent back
+			payload.Properties.Keys = nil
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoint
+
+			id, err := onlineendpoint.ParseOnlineEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
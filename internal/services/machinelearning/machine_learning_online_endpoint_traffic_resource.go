@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlineendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// MachineLearningOnlineEndpointTraffic manages the traffic split of an Online Endpoint across its Online Deployments.
+// This is a separate resource since the Online Deployments can only be created once the Online Endpoint exists.
+type MachineLearningOnlineEndpointTraffic struct{}
+
+type MachineLearningOnlineEndpointTrafficModel struct {
+	OnlineEndpointId string           `tfschema:"online_endpoint_id"`
+	Traffic          map[string]int64 `tfschema:"traffic"`
+	MirrorTraffic    map[string]int64 `tfschema:"mirror_traffic"`
+}
+
+var _ sdk.ResourceWithUpdate = MachineLearningOnlineEndpointTraffic{}
+
+func (r MachineLearningOnlineEndpointTraffic) ModelObject() interface{} {
+	return &MachineLearningOnlineEndpointTrafficModel{}
+}
+
+func (r MachineLearningOnlineEndpointTraffic) ResourceType() string {
+	return "azurerm_machine_learning_online_endpoint_traffic"
+}
+
+func (r MachineLearningOnlineEndpointTraffic) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return onlineendpoint.ValidateOnlineEndpointID
+}
+
+func (r MachineLearningOnlineEndpointTraffic) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"online_endpoint_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: onlineendpoint.ValidateOnlineEndpointID,
+		},
+
+		"traffic": {
+			Type:     pluginsdk.TypeMap,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+		},
+
+		"mirror_traffic": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 50),
+			},
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpointTraffic) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MachineLearningOnlineEndpointTraffic) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MachineLearningOnlineEndpointTrafficModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			total := int64(0)
+			for _, v := range model.Traffic {
+				total += v
+			}
+			if total != 0 && total != 100 {
+				return fmt.Errorf("the values of `traffic` must add up to either `0` or `100`, got `%d`", total)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpointTraffic) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoint
+
+			var model MachineLearningOnlineEndpointTrafficModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := onlineendpoint.ParseOnlineEndpointID(model.OnlineEndpointId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// the Online Endpoint always exists, so the presence of any traffic is used to determine whether this already exists
+			if len(pointer.From(existing.Model.Properties.Traffic)) > 0 || len(pointer.From(existing.Model.Properties.MirrorTraffic)) > 0 {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			if err := updateMachineLearningOnlineEndpointTraffic(ctx, client, *id, *existing.Model, model.Traffic, model.MirrorTraffic); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpointTraffic) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoint
+
+			id, err := onlineendpoint.ParseOnlineEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MachineLearningOnlineEndpointTrafficModel{
+				OnlineEndpointId: id.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Traffic = pointer.From(model.Properties.Traffic)
+				state.MirrorTraffic = pointer.From(model.Properties.MirrorTraffic)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpointTraffic) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoint
+
+			id, err := onlineendpoint.ParseOnlineEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MachineLearningOnlineEndpointTrafficModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			return updateMachineLearningOnlineEndpointTraffic(ctx, client, *id, *existing.Model, model.Traffic, model.MirrorTraffic)
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpointTraffic) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoint
+
+			id, err := onlineendpoint.ParseOnlineEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// removing the traffic allows the Online Deployments to be deleted
+			return updateMachineLearningOnlineEndpointTraffic(ctx, client, *id, *existing.Model, map[string]int64{}, map[string]int64{})
+		},
+	}
+}
+
+func updateMachineLearningOnlineEndpointTraffic(ctx context.Context, client *onlineendpoint.OnlineEndpointClient, id onlineendpoint.OnlineEndpointId, payload onlineendpoint.OnlineEndpointTrackedResource, traffic, mirrorTraffic map[string]int64) error {
+	payload.Properties.Traffic = pointer.To(traffic)
+	payload.Properties.MirrorTraffic = pointer.To(mirrorTraffic)
+
+	// the keys are returned as part of the Online Endpoint but can't be sent back
+	payload.Properties.Keys = nil
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("updating the traffic for %s: %+v", id, err)
+	}
+
+	return nil
+}
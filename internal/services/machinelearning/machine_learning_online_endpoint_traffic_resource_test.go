@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/onlineendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MachineLearningOnlineEndpointTrafficResource struct{}
+
+func TestAccMachineLearningOnlineEndpointTraffic_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_endpoint_traffic", "test")
+	r := MachineLearningOnlineEndpointTrafficResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic.blue").HasValue("100"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningOnlineEndpointTraffic_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_endpoint_traffic", "test")
+	r := MachineLearningOnlineEndpointTrafficResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.split(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic.blue").HasValue("80"),
+				check.That(data.ResourceName).Key("traffic.green").HasValue("20"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MachineLearningOnlineEndpointTrafficResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := onlineendpoint.ParseOnlineEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.OnlineEndpoint.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil && len(pointer.From(resp.Model.Properties.Traffic)) > 0), nil
+}
+
+func (r MachineLearningOnlineEndpointTrafficResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_endpoint_traffic" "test" {
+  online_endpoint_id = azurerm_machine_learning_online_endpoint.test.id
+
+  traffic = {
+    (azurerm_machine_learning_online_deployment.test.name) = 100
+  }
+}
+`, MachineLearningOnlineDeploymentResource{}.basic(data))
+}
+
+func (r MachineLearningOnlineEndpointTrafficResource) split(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_deployment" "green" {
+  name               = "green"
+  online_endpoint_id = azurerm_machine_learning_online_endpoint.test.id
+  instance_type      = "Standard_DS3_v2"
+  model_id           = "azureml://registries/azureml/models/distilbert-base-uncased-finetuned-sst-2-english/versions/17"
+}
+
+resource "azurerm_machine_learning_online_endpoint_traffic" "test" {
+  online_endpoint_id = azurerm_machine_learning_online_endpoint.test.id
+
+  traffic = {
+    (azurerm_machine_learning_online_deployment.test.name)  = 80
+    (azurerm_machine_learning_online_deployment.green.name) = 20
+  }
+}
+`, MachineLearningOnlineDeploymentResource{}.basic(data))
+}
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/serverlessendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// the only SKU supported for Serverless Endpoints, which are billed per-token
+const machineLearningServerlessEndpointSkuName = "Consumption"
+
+type MachineLearningServerlessEndpoint struct{}
+
+type MachineLearningServerlessEndpointModel struct {
+	Name                      string                 `tfschema:"name"`
+	WorkspaceId               string                 `tfschema:"workspace_id"`
+	Location                  string                 `tfschema:"location"`
+	ModelId                   string                 `tfschema:"model_id"`
+	ContentSafetyEnabled      bool                   `tfschema:"content_safety_enabled"`
+	InferenceEndpointUri      string                 `tfschema:"inference_endpoint_uri"`
+	MarketplaceSubscriptionId string                 `tfschema:"marketplace_subscription_id"`
+	PrimaryKey                string                 `tfschema:"primary_key"`
+	SecondaryKey              string                 `tfschema:"secondary_key"`
+	Tags                      map[string]interface{} `tfschema:"tags"`
+}
+
+var _ sdk.ResourceWithUpdate = MachineLearningServerlessEndpoint{}
+
+func (r MachineLearningServerlessEndpoint) ModelObject() interface{} {
+	return &MachineLearningServerlessEndpointModel{}
+}
+
+func (r MachineLearningServerlessEndpoint) ResourceType() string {
+	return "azurerm_machine_learning_serverless_endpoint"
+}
+
+func (r MachineLearningServerlessEndpoint) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return serverlessendpoint.ValidateServerlessEndpointID
+}
+
+func (r MachineLearningServerlessEndpoint) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.EndpointName,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: serverlessendpoint.ValidateWorkspaceID,
+		},
+
+		"location": commonschema.Location(),
+
+		"model_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"content_safety_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MachineLearningServerlessEndpoint) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"inference_endpoint_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"marketplace_subscription_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"primary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r MachineLearningServerlessEndpoint) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.ServerlessEndpoint
+
+			var model MachineLearningServerlessEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := serverlessendpoint.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := serverlessendpoint.NewServerlessEndpointID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			contentSafetyStatus := serverlessendpoint.ContentSafetyStatusDisabled
+			if model.ContentSafetyEnabled {
+				contentSafetyStatus = serverlessendpoint.ContentSafetyStatusEnabled
+			}
+
+			payload := serverlessendpoint.ServerlessEndpointTrackedResource{
+				Location: location.Normalize(model.Location),
+				Properties: serverlessendpoint.ServerlessEndpoint{
+					AuthMode: serverlessendpoint.ServerlessInferenceEndpointAuthModeKey,
+					ContentSafety: &serverlessendpoint.ContentSafety{
+						ContentSafetyStatus: contentSafetyStatus,
+					},
+					ModelSettings: &serverlessendpoint.ModelSettings{
+						ModelId: pointer.To(model.ModelId),
+					},
+				},
+				Sku: &serverlessendpoint.Sku{
+					Name: machineLearningServerlessEndpointSkuName,
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningServerlessEndpoint) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.ServerlessEndpoint
+
+			id, err := serverlessendpoint.ParseServerlessEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MachineLearningServerlessEndpointModel{
+				Name:        id.ServerlessEndpointName,
+				WorkspaceId: serverlessendpoint.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				props := model.Properties
+				if props.ContentSafety != nil {
+					state.ContentSafetyEnabled = props.ContentSafety.ContentSafetyStatus == serverlessendpoint.ContentSafetyStatusEnabled
+				}
+				if props.ModelSettings != nil {
+					state.ModelId = pointer.From(props.ModelSettings.ModelId)
+				}
+				if props.InferenceEndpoint != nil {
+					state.InferenceEndpointUri = props.InferenceEndpoint.Uri
+				}
+				state.MarketplaceSubscriptionId = pointer.From(props.MarketplaceSubscriptionId)
+			}
+
+			keys, err := client.ListKeys(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing keys for %s: %+v", *id, err)
+			}
+			if model := keys.Model; model != nil {
+				state.PrimaryKey = pointer.From(model.PrimaryKey)
+				state.SecondaryKey = pointer.From(model.SecondaryKey)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningServerlessEndpoint) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.ServerlessEndpoint
+
+			id, err := serverlessendpoint.ParseServerlessEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MachineLearningServerlessEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := serverlessendpoint.PartialMinimalTrackedResourceWithSkuAndIdentity{}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.Expand(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningServerlessEndpoint) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.ServerlessEndpoint
+
+			id, err := serverlessendpoint.ParseServerlessEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
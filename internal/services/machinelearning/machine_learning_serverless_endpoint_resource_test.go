@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/serverlessendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MachineLearningServerlessEndpointResource struct{}
+
+func TestAccMachineLearningServerlessEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_serverless_endpoint", "test")
+	r := MachineLearningServerlessEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("inference_endpoint_uri").IsSet(),
+				check.That(data.ResourceName).Key("primary_key").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningServerlessEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_serverless_endpoint", "test")
+	r := MachineLearningServerlessEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMachineLearningServerlessEndpoint_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_serverless_endpoint", "test")
+	r := MachineLearningServerlessEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MachineLearningServerlessEndpointResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := serverlessendpoint.ParseServerlessEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.ServerlessEndpoint.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MachineLearningServerlessEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_serverless_endpoint" "test" {
+  name         = "acctest-se-%s"
+  workspace_id = azurerm_machine_learning_workspace.test.id
+  location     = azurerm_resource_group.test.location
+  model_id     = "azureml://registries/azureml/models/Phi-3-mini-4k-instruct"
+}
+`, MachineLearningOnlineEndpointResource{}.template(data), data.RandomString)
+}
+
+func (r MachineLearningServerlessEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_serverless_endpoint" "import" {
+  name         = azurerm_machine_learning_serverless_endpoint.test.name
+  workspace_id = azurerm_machine_learning_serverless_endpoint.test.workspace_id
+  location     = azurerm_machine_learning_serverless_endpoint.test.location
+  model_id     = azurerm_machine_learning_serverless_endpoint.test.model_id
+}
+`, r.basic(data))
+}
+
+func (r MachineLearningServerlessEndpointResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_serverless_endpoint" "test" {
+  name                   = "acctest-se-%s"
+  workspace_id           = azurerm_machine_learning_workspace.test.id
+  location               = azurerm_resource_group.test.location
+  model_id               = "azureml://registries/azureml/models/Phi-3-mini-4k-instruct"
+  content_safety_enabled = false
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, MachineLearningOnlineEndpointResource{}.template(data), data.RandomString)
+}
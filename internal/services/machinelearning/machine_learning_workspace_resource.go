@@ -4,6 +4,7 @@
 package machinelearning
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/managednetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -195,6 +197,12 @@ func resourceMachineLearningWorkspace() *pluginsdk.Resource {
 							Computed:     true,
 							ValidateFunc: validation.StringInSlice(workspaces.PossibleValuesForIsolationMode(), false),
 						},
+
+						"provision_network_now": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -361,6 +369,13 @@ func resourceMachineLearningWorkspaceCreate(d *pluginsdk.ResourceData, meta inte
 	}
 
 	d.SetId(id.ID())
+
+	if d.Get("managed_network.0.provision_network_now").(bool) {
+		if err := provisionMachineLearningWorkspaceManagedNetwork(ctx, meta.(*clients.Client).MachineLearning.ManagedNetwork, id, d.Get("managed_network.0.isolation_mode").(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceMachineLearningWorkspaceRead(d, meta)
 }
 
@@ -439,8 +454,14 @@ func resourceMachineLearningWorkspaceUpdate(d *pluginsdk.ResourceData, meta inte
 		payload.Properties.FriendlyName = pointer.To(d.Get("friendly_name").(string))
 	}
 
-	if d.HasChange("managed_network") {
-		payload.Properties.ManagedNetwork = expandMachineLearningWorkspaceManagedNetwork(d.Get("managed_network").([]interface{}))
+	if d.HasChange("managed_network.0.isolation_mode") {
+		managedNetwork := expandMachineLearningWorkspaceManagedNetwork(d.Get("managed_network").([]interface{}))
+		if managedNetwork != nil && payload.Properties.ManagedNetwork != nil {
+			// the Outbound Rules are managed by the `azurerm_machine_learning_workspace_network_outbound_rule_*`
+			// resources, so these need to be retained when only the Isolation Mode is changed
+			managedNetwork.OutboundRules = payload.Properties.ManagedNetwork.OutboundRules
+		}
+		payload.Properties.ManagedNetwork = managedNetwork
 	}
 
 	if d.HasChange("sku_name") {
@@ -483,6 +504,12 @@ func resourceMachineLearningWorkspaceUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
+	if d.HasChanges("managed_network.0.isolation_mode", "managed_network.0.provision_network_now") && d.Get("managed_network.0.provision_network_now").(bool) {
+		if err := provisionMachineLearningWorkspaceManagedNetwork(ctx, meta.(*clients.Client).MachineLearning.ManagedNetwork, *id, d.Get("managed_network.0.isolation_mode").(string)); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceMachineLearningWorkspaceRead(d, meta)
 }
@@ -546,7 +573,7 @@ func resourceMachineLearningWorkspaceRead(d *pluginsdk.ResourceData, meta interf
 			d.Set("public_network_access_enabled", *props.PublicNetworkAccess == workspaces.PublicNetworkAccessEnabled)
 			d.Set("v1_legacy_mode_enabled", props.V1LegacyMode)
 			d.Set("workspace_id", props.WorkspaceId)
-			d.Set("managed_network", flattenMachineLearningWorkspaceManagedNetwork(props.ManagedNetwork))
+			d.Set("managed_network", flattenMachineLearningWorkspaceManagedNetwork(props.ManagedNetwork, d.Get("managed_network.0.provision_network_now").(bool)))
 			d.Set("serverless_compute", flattenMachineLearningWorkspaceServerlessCompute(props.ServerlessComputeSettings))
 
 			kvId, err := commonids.ParseKeyVaultIDInsensitively(*props.KeyVault)
@@ -779,12 +806,15 @@ func expandMachineLearningWorkspaceManagedNetwork(i []interface{}) *workspaces.M
 	}
 }
 
-func flattenMachineLearningWorkspaceManagedNetwork(i *workspaces.ManagedNetworkSettings) *[]interface{} {
+func flattenMachineLearningWorkspaceManagedNetwork(i *workspaces.ManagedNetworkSettings, provisionNetworkNow bool) *[]interface{} {
 	if i == nil {
 		return &[]interface{}{}
 	}
 
-	out := map[string]interface{}{}
+	out := map[string]interface{}{
+		// this isn't returned by the API, so we pull it from the config
+		"provision_network_now": provisionNetworkNow,
+	}
 
 	if i.IsolationMode != nil {
 		out["isolation_mode"] = *i.IsolationMode
@@ -793,6 +823,24 @@ func flattenMachineLearningWorkspaceManagedNetwork(i *workspaces.ManagedNetworkS
 	return &[]interface{}{out}
 }
 
+// provisionMachineLearningWorkspaceManagedNetwork provisions the Managed Virtual Network of the Workspace, which
+// otherwise is only provisioned when the first Compute resource is created within the Workspace
+func provisionMachineLearningWorkspaceManagedNetwork(ctx context.Context, client *managednetwork.ManagedNetworkClient, id workspaces.WorkspaceId, isolationMode string) error {
+	if isolationMode == "" || isolationMode == string(workspaces.IsolationModeDisabled) {
+		return fmt.Errorf("`provision_network_now` can only be set to `true` when `isolation_mode` is `%s` or `%s`", workspaces.IsolationModeAllowInternetOutbound, workspaces.IsolationModeAllowOnlyApprovedOutbound)
+	}
+
+	workspaceId := managednetwork.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+	input := managednetwork.ManagedNetworkProvisionOptions{
+		IncludeSpark: pointer.To(false),
+	}
+	if err := client.ProvisionsProvisionManagedNetworkThenPoll(ctx, workspaceId, input); err != nil {
+		return fmt.Errorf("provisioning the Managed Network for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
 func expandMachineLearningWorkspaceServerlessCompute(i []interface{}) *workspaces.ServerlessComputeSettings {
 	if len(i) == 0 || i[0] == nil {
 		return nil
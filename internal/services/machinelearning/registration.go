@@ -58,9 +58,14 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AIFoundry{},
 		AIFoundryProject{},
+		AIFoundryConnection{},
 		MachineLearningDataStoreBlobStorage{},
 		MachineLearningDataStoreDataLakeGen2{},
 		MachineLearningDataStoreFileShare{},
+		MachineLearningOnlineDeployment{},
+		MachineLearningOnlineEndpoint{},
+		MachineLearningOnlineEndpointTraffic{},
+		MachineLearningServerlessEndpoint{},
 		WorkspaceNetworkOutboundRuleFqdn{},
 		WorkspaceNetworkOutboundRulePrivateEndpoint{},
 		WorkspaceNetworkOutboundRuleServiceTag{},
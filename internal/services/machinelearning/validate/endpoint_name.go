@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// EndpointName validates the name of an Online or Serverless Endpoint
+func EndpointName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if matched := regexp.MustCompile(`^[a-zA-Z]([a-zA-Z0-9-]{1,30})[a-zA-Z0-9]$`).Match([]byte(v)); !matched {
+		errors = append(errors, fmt.Errorf("%s must be between 3 and 32 characters, must start with a letter, end with a letter or number and may only include alphanumeric characters and '-'.", k))
+	}
+	return
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestOnlineDeploymentName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "blue",
+			expected: true,
+		},
+		{
+			// can start with a number
+			input:    "1blue",
+			expected: true,
+		},
+		{
+			// cannot start with a hyphen
+			input:    "-blue",
+			expected: false,
+		},
+		{
+			// cannot end with a hyphen
+			input:    "blue-",
+			expected: false,
+		},
+		{
+			// cannot contain other special symbols other than hyphens
+			input:    "blue.green",
+			expected: false,
+		},
+		{
+			// 2 chars
+			input:    "ab",
+			expected: false,
+		},
+		{
+			// 32 chars
+			input:    "abcdefghijklmnopqrstuvwxyzabcdef",
+			expected: true,
+		},
+		{
+			// 33 chars
+			input:    "abcdefghijklmnopqrstuvwxyzabcdefg",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		_, errors := OnlineDeploymentName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -4,18 +4,24 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+// alertProcessingRuleScheduleTimeFormat is the format of the `effective_from` and `effective_until` times of a `schedule`
+const alertProcessingRuleScheduleTimeFormat = "2006-01-02T15:04:05"
+
 type AlertProcessingRuleConditionModel struct {
 	AlertContext        []AlertProcessingRuleSingleConditionModel `tfschema:"alert_context"`
 	AlertRuleId         []AlertProcessingRuleSingleConditionModel `tfschema:"alert_rule_id"`
@@ -374,6 +380,41 @@ func schemaAlertProcessingRule() map[string]*pluginsdk.Schema {
 	}
 }
 
+// alertProcessingRuleCustomizeDiff validates that the `schedule` of an Alert Processing Rule ends after it starts, since
+// a window (such as a one-off maintenance window) which ends before it starts is accepted by the API but never applies
+func alertProcessingRuleCustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+			if !rd.NewValueKnown("schedule.0.effective_from") || !rd.NewValueKnown("schedule.0.effective_until") {
+				return nil
+			}
+
+			effectiveFrom := rd.Get("schedule.0.effective_from").(string)
+			effectiveUntil := rd.Get("schedule.0.effective_until").(string)
+			if effectiveFrom == "" || effectiveUntil == "" {
+				return nil
+			}
+
+			from, err := time.Parse(alertProcessingRuleScheduleTimeFormat, effectiveFrom)
+			if err != nil {
+				return fmt.Errorf("parsing `schedule.0.effective_from`: %+v", err)
+			}
+			until, err := time.Parse(alertProcessingRuleScheduleTimeFormat, effectiveUntil)
+			if err != nil {
+				return fmt.Errorf("parsing `schedule.0.effective_until`: %+v", err)
+			}
+
+			if !until.After(from) {
+				return fmt.Errorf("`schedule.0.effective_until` (%q) must be after `schedule.0.effective_from` (%q)", effectiveUntil, effectiveFrom)
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
 func schemaAlertProcessingRuleCondition(operatorValidateItems, valuesValidateItems []string, atLeastOneOf []string) *pluginsdk.Schema {
 	operatorValidateFunc := validation.StringIsNotEmpty
 	valuesValidateFunc := validation.StringIsNotEmpty
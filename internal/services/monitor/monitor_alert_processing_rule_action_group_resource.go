@@ -30,7 +30,10 @@ type AlertProcessingRuleActionGroupModel struct {
 
 type AlertProcessingRuleActionGroupResource struct{}
 
-var _ sdk.ResourceWithUpdate = AlertProcessingRuleActionGroupResource{}
+var (
+	_ sdk.ResourceWithUpdate        = AlertProcessingRuleActionGroupResource{}
+	_ sdk.ResourceWithCustomizeDiff = AlertProcessingRuleActionGroupResource{}
+)
 
 func (r AlertProcessingRuleActionGroupResource) ResourceType() string {
 	return "azurerm_monitor_alert_processing_rule_action_group"
@@ -40,6 +43,10 @@ func (r AlertProcessingRuleActionGroupResource) ModelObject() interface{} {
 	return &AlertProcessingRuleActionGroupModel{}
 }
 
+func (r AlertProcessingRuleActionGroupResource) CustomizeDiff() sdk.ResourceFunc {
+	return alertProcessingRuleCustomizeDiff()
+}
+
 func (r AlertProcessingRuleActionGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return alertprocessingrules.ValidateActionRuleID
 }
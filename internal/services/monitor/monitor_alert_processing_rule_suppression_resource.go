@@ -28,7 +28,10 @@ type AlertProcessingRuleSuppressionModel struct {
 
 type AlertProcessingRuleSuppressionResource struct{}
 
-var _ sdk.ResourceWithUpdate = AlertProcessingRuleSuppressionResource{}
+var (
+	_ sdk.ResourceWithUpdate        = AlertProcessingRuleSuppressionResource{}
+	_ sdk.ResourceWithCustomizeDiff = AlertProcessingRuleSuppressionResource{}
+)
 
 func (r AlertProcessingRuleSuppressionResource) ResourceType() string {
 	return "azurerm_monitor_alert_processing_rule_suppression"
@@ -38,6 +41,10 @@ func (r AlertProcessingRuleSuppressionResource) ModelObject() interface{} {
 	return &AlertProcessingRuleSuppressionModel{}
 }
 
+func (r AlertProcessingRuleSuppressionResource) CustomizeDiff() sdk.ResourceFunc {
+	return alertProcessingRuleCustomizeDiff()
+}
+
 func (r AlertProcessingRuleSuppressionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return alertprocessingrules.ValidateActionRuleID
 }
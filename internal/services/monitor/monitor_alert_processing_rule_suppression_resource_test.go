@@ -6,6 +6,7 @@ package monitor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
@@ -98,6 +99,25 @@ func TestAccMonitorAlertProcessingRuleSuppression_update(t *testing.T) {
 	})
 }
 
+func TestAccMonitorAlertProcessingRuleSuppression_oneOffWindow(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_processing_rule_suppression", "test")
+	r := MonitorAlertProcessingRuleSuppressionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.oneOffWindow(data, "2030-01-01T00:00:00", "2030-01-02T00:00:00"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.oneOffWindow(data, "2030-01-02T00:00:00", "2030-01-01T00:00:00"),
+			ExpectError: regexp.MustCompile("`schedule.0.effective_until` .* must be after `schedule.0.effective_from`"),
+		},
+	})
+}
+
 func (r MonitorAlertProcessingRuleSuppressionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := alertprocessingrules.ParseActionRuleIDInsensitively(state.ID)
 	if err != nil {
@@ -124,6 +144,24 @@ resource "azurerm_monitor_alert_processing_rule_suppression" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r MonitorAlertProcessingRuleSuppressionResource) oneOffWindow(data acceptance.TestData, effectiveFrom, effectiveUntil string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_alert_processing_rule_suppression" "test" {
+  name                = "acctest-moniter-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+
+  schedule {
+    effective_from  = "%s"
+    effective_until = "%s"
+    time_zone       = "UTC"
+  }
+}
+`, r.template(data), data.RandomInteger, effectiveFrom, effectiveUntil)
+}
+
 func (r MonitorAlertProcessingRuleSuppressionResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
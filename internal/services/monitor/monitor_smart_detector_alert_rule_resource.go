@@ -4,6 +4,7 @@
 package monitor
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -63,16 +64,16 @@ func resourceMonitorSmartDetectorAlertRule() *pluginsdk.Resource {
 			"resource_group_name": commonschema.ResourceGroupName(),
 
 			"detector_type": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"FailureAnomaliesDetector",
-					"RequestPerformanceDegradationDetector",
-					"DependencyPerformanceDegradationDetector",
-					"ExceptionVolumeChangedDetector",
-					"TraceSeverityDetector",
-					"MemoryLeakDetector",
-				}, false),
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(monitorSmartDetectorIds(), false),
+			},
+
+			"detector_parameters": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 			},
 
 			"scope_resource_ids": {
@@ -200,6 +201,14 @@ func resourceMonitorSmartDetectorAlertRuleCreateUpdate(d *pluginsdk.ResourceData
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if v, ok := d.GetOk("detector_parameters"); ok {
+		parameters := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(v.(string)), &parameters); err != nil {
+			return fmt.Errorf("unmarshaling `detector_parameters`: %+v", err)
+		}
+		actionRule.Properties.Detector.Parameters = &parameters
+	}
+
 	if v, ok := d.GetOk("throttling_duration"); ok {
 		actionRule.Properties.Throttling = &smartdetectoralertrules.ThrottlingInformation{
 			Duration: pointer.To(v.(string)),
@@ -246,6 +255,16 @@ func resourceMonitorSmartDetectorAlertRuleRead(d *pluginsdk.ResourceData, meta i
 			d.Set("scope_resource_ids", props.Scope)
 			d.Set("detector_type", props.Detector.Id)
 
+			detectorParameters := ""
+			if props.Detector.Parameters != nil && len(*props.Detector.Parameters) > 0 {
+				parameters, err := json.Marshal(*props.Detector.Parameters)
+				if err != nil {
+					return fmt.Errorf("marshaling `detector_parameters`: %+v", err)
+				}
+				detectorParameters = string(parameters)
+			}
+			d.Set("detector_parameters", detectorParameters)
+
 			throttlingDuration := ""
 			if props.Throttling != nil && props.Throttling.Duration != nil {
 				throttlingDuration = *props.Throttling.Duration
@@ -91,6 +91,21 @@ func TestAccMonitorSmartDetectorAlertRule_update(t *testing.T) {
 	})
 }
 
+func TestAccMonitorSmartDetectorAlertRule_dependencyPerformanceDegradation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_smart_detector_alert_rule", "test")
+	r := MonitorSmartDetectorAlertRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dependencyPerformanceDegradation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t MonitorSmartDetectorAlertRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := smartdetectoralertrules.ParseSmartDetectorAlertRuleID(state.ID)
 	if err != nil {
@@ -177,6 +192,25 @@ BODY
 `, r.template(data), data.RandomInteger)
 }
 
+func (r MonitorSmartDetectorAlertRuleResource) dependencyPerformanceDegradation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_smart_detector_alert_rule" "test" {
+  name                = "acctestSDAR-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  severity            = "Sev3"
+  scope_resource_ids  = [azurerm_application_insights.test.id]
+  frequency           = "P1D"
+  detector_type       = "DependencyPerformanceDegradationDetector"
+
+  action_group {
+    ids = [azurerm_monitor_action_group.test.id]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (MonitorSmartDetectorAlertRuleResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
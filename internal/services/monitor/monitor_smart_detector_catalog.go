@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import "strings"
+
+// there's no API available to list the Smart Detectors which can be used in a Smart Detector Alert Rule, so the
+// catalog of detectors (and the resource types which they support) is maintained here
+type monitorSmartDetector struct {
+	Id                     string
+	Name                   string
+	Description            string
+	SupportedResourceTypes []string
+}
+
+const monitorSmartDetectorResourceTypeApplicationInsights = "microsoft.insights/components"
+
+var monitorSmartDetectors = []monitorSmartDetector{
+	{
+		Id:                     "FailureAnomaliesDetector",
+		Name:                   "Failure Anomalies",
+		Description:            "Detects an abnormal rise in the rate of failed requests and dependency calls.",
+		SupportedResourceTypes: []string{monitorSmartDetectorResourceTypeApplicationInsights},
+	},
+	{
+		Id:                     "RequestPerformanceDegradationDetector",
+		Name:                   "Response Latency Degradation",
+		Description:            "Detects a degradation in the response time of requests.",
+		SupportedResourceTypes: []string{monitorSmartDetectorResourceTypeApplicationInsights},
+	},
+	{
+		Id:                     "DependencyPerformanceDegradationDetector",
+		Name:                   "Dependency Latency Degradation",
+		Description:            "Detects a degradation in the duration of dependency calls.",
+		SupportedResourceTypes: []string{monitorSmartDetectorResourceTypeApplicationInsights},
+	},
+	{
+		Id:                     "ExceptionVolumeChangedDetector",
+		Name:                   "Exception Anomalies",
+		Description:            "Detects an abnormal rise in the volume of exceptions.",
+		SupportedResourceTypes: []string{monitorSmartDetectorResourceTypeApplicationInsights},
+	},
+	{
+		Id:                     "TraceSeverityDetector",
+		Name:                   "Trace Severity Degradation",
+		Description:            "Detects a degradation in the ratio between good traces and bad traces.",
+		SupportedResourceTypes: []string{monitorSmartDetectorResourceTypeApplicationInsights},
+	},
+	{
+		Id:                     "MemoryLeakDetector",
+		Name:                   "Potential Memory Leak",
+		Description:            "Detects a potential memory leak from the memory consumption of processes.",
+		SupportedResourceTypes: []string{monitorSmartDetectorResourceTypeApplicationInsights},
+	},
+}
+
+func monitorSmartDetectorIds() []string {
+	output := make([]string, 0, len(monitorSmartDetectors))
+	for _, detector := range monitorSmartDetectors {
+		output = append(output, detector.Id)
+	}
+	return output
+}
+
+// monitorSmartDetectorsForResourceType returns the Smart Detectors which support the resource type, or all of them
+// when no resource type is specified.
+func monitorSmartDetectorsForResourceType(resourceType string) []monitorSmartDetector {
+	if resourceType == "" {
+		return monitorSmartDetectors
+	}
+
+	output := make([]monitorSmartDetector, 0)
+	for _, detector := range monitorSmartDetectors {
+		for _, supported := range detector.SupportedResourceTypes {
+			if strings.EqualFold(supported, resourceType) {
+				output = append(output, detector)
+				break
+			}
+		}
+	}
+	return output
+}
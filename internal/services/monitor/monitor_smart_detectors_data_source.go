@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func dataSourceMonitorSmartDetectors() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceMonitorSmartDetectorsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_type": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"detectors": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"supported_resource_types": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMonitorSmartDetectorsRead(d *pluginsdk.ResourceData, _ interface{}) error {
+	resourceType := d.Get("resource_type").(string)
+
+	detectors := make([]interface{}, 0)
+	for _, detector := range monitorSmartDetectorsForResourceType(resourceType) {
+		detectors = append(detectors, map[string]interface{}{
+			"id":                       detector.Id,
+			"name":                     detector.Name,
+			"description":              detector.Description,
+			"supported_resource_types": detector.SupportedResourceTypes,
+		})
+	}
+
+	if err := d.Set("detectors", detectors); err != nil {
+		return fmt.Errorf("setting `detectors`: %+v", err)
+	}
+
+	d.SetId(fmt.Sprintf("smartDetectors/%s", strings.ToLower(resourceType)))
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MonitorSmartDetectorsDataSource struct{}
+
+func TestAccDataSourceMonitorSmartDetectors_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_smart_detectors", "test")
+	r := MonitorSmartDetectorsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("detectors.#").HasValue("6"),
+				check.That(data.ResourceName).Key("detectors.0.id").HasValue("FailureAnomaliesDetector"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceMonitorSmartDetectors_unsupportedResourceType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_smart_detectors", "test")
+	r := MonitorSmartDetectorsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.unsupportedResourceType(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("detectors.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (MonitorSmartDetectorsDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_monitor_smart_detectors" "test" {
+  resource_type = "Microsoft.Insights/components"
+}
+`
+}
+
+func (MonitorSmartDetectorsDataSource) unsupportedResourceType() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_monitor_smart_detectors" "test" {
+  resource_type = "Microsoft.Storage/storageAccounts"
+}
+`
+}
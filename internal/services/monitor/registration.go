@@ -59,6 +59,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_monitor_diagnostic_categories":       dataSourceMonitorDiagnosticCategories(),
 		"azurerm_monitor_scheduled_query_rules_alert": dataSourceMonitorScheduledQueryRulesAlert(),
 		"azurerm_monitor_scheduled_query_rules_log":   dataSourceMonitorScheduledQueryRulesLog(),
+		"azurerm_monitor_smart_detectors":             dataSourceMonitorSmartDetectors(),
 	}
 
 	return dataSources
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/distributedavailabilitygroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/instancefailovergroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/managedbackupshorttermretentionpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/manageddatabases"
@@ -18,21 +19,24 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/managedinstances"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/managedinstancevulnerabilityassessments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/managedserversecurityalertpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/servertrustcertificates"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	ManagedDatabasesClient                           *manageddatabases.ManagedDatabasesClient
-	ManagedInstancesClient                           *managedinstances.ManagedInstancesClient
-	ManagedInstancesLongTermRetentionPoliciesClient  *managedinstancelongtermretentionpolicies.ManagedInstanceLongTermRetentionPoliciesClient
-	ManagedInstancesShortTermRetentionPoliciesClient *managedbackupshorttermretentionpolicies.ManagedBackupShortTermRetentionPoliciesClient
-	ManagedInstanceVulnerabilityAssessmentsClient    *managedinstancevulnerabilityassessments.ManagedInstanceVulnerabilityAssessmentsClient
-	ManagedInstanceServerSecurityAlertPoliciesClient *managedserversecurityalertpolicies.ManagedServerSecurityAlertPoliciesClient
-	ManagedInstanceAdministratorsClient              *managedinstanceadministrators.ManagedInstanceAdministratorsClient
-	ManagedInstanceAzureADOnlyAuthenticationsClient  *managedinstanceazureadonlyauthentications.ManagedInstanceAzureADOnlyAuthenticationsClient
-	ManagedInstanceEncryptionProtectorClient         *managedinstanceencryptionprotectors.ManagedInstanceEncryptionProtectorsClient
-	ManagedInstanceFailoverGroupsClient              *instancefailovergroups.InstanceFailoverGroupsClient
-	ManagedInstanceKeysClient                        *managedinstancekeys.ManagedInstanceKeysClient
+	ManagedDatabasesClient                             *manageddatabases.ManagedDatabasesClient
+	ManagedInstancesClient                             *managedinstances.ManagedInstancesClient
+	ManagedInstancesLongTermRetentionPoliciesClient    *managedinstancelongtermretentionpolicies.ManagedInstanceLongTermRetentionPoliciesClient
+	ManagedInstancesShortTermRetentionPoliciesClient   *managedbackupshorttermretentionpolicies.ManagedBackupShortTermRetentionPoliciesClient
+	ManagedInstanceVulnerabilityAssessmentsClient      *managedinstancevulnerabilityassessments.ManagedInstanceVulnerabilityAssessmentsClient
+	ManagedInstanceServerSecurityAlertPoliciesClient   *managedserversecurityalertpolicies.ManagedServerSecurityAlertPoliciesClient
+	ManagedInstanceAdministratorsClient                *managedinstanceadministrators.ManagedInstanceAdministratorsClient
+	ManagedInstanceAzureADOnlyAuthenticationsClient    *managedinstanceazureadonlyauthentications.ManagedInstanceAzureADOnlyAuthenticationsClient
+	ManagedInstanceDistributedAvailabilityGroupsClient *distributedavailabilitygroups.DistributedAvailabilityGroupsClient
+	ManagedInstanceEncryptionProtectorClient           *managedinstanceencryptionprotectors.ManagedInstanceEncryptionProtectorsClient
+	ManagedInstanceFailoverGroupsClient                *instancefailovergroups.InstanceFailoverGroupsClient
+	ManagedInstanceKeysClient                          *managedinstancekeys.ManagedInstanceKeysClient
+	ManagedInstanceServerTrustCertificatesClient       *servertrustcertificates.ServerTrustCertificatesClient
 
 	options *common.ClientOptions
 }
@@ -74,6 +78,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(managedInstanceAzureADOnlyAuthenticationsClient.Client, o.Authorizers.ResourceManager)
 
+	managedInstanceDistributedAvailabilityGroupsClient, err := distributedavailabilitygroups.NewDistributedAvailabilityGroupsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Managed Instance Distributed Availability Groups Client: %+v", err)
+	}
+	o.Configure(managedInstanceDistributedAvailabilityGroupsClient.Client, o.Authorizers.ResourceManager)
+
 	managedInstanceEncryptionProtectorsClient, err := managedinstanceencryptionprotectors.NewManagedInstanceEncryptionProtectorsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Managed Instance Encryption Protectors Client: %+v", err)
@@ -92,6 +102,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(managedInstanceKeysClient.Client, o.Authorizers.ResourceManager)
 
+	managedInstanceServerTrustCertificatesClient, err := servertrustcertificates.NewServerTrustCertificatesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Managed Instance Server Trust Certificates Client: %+v", err)
+	}
+	o.Configure(managedInstanceServerTrustCertificatesClient.Client, o.Authorizers.ResourceManager)
+
 	managedInstanceVulnerabilityAssessmentsClient, err := managedinstancevulnerabilityassessments.NewManagedInstanceVulnerabilityAssessmentsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Managed Instance Vulnerability Assessments Client: %+v", err)
@@ -105,17 +121,19 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(managedInstanceServerSecurityAlertPoliciesClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
-		ManagedDatabasesClient:                           managedDatabasesClient,
-		ManagedInstanceAdministratorsClient:              managedInstancesAdministratorsClient,
-		ManagedInstanceAzureADOnlyAuthenticationsClient:  managedInstanceAzureADOnlyAuthenticationsClient,
-		ManagedInstanceEncryptionProtectorClient:         managedInstanceEncryptionProtectorsClient,
-		ManagedInstanceFailoverGroupsClient:              managedInstanceFailoverGroupsClient,
-		ManagedInstanceKeysClient:                        managedInstanceKeysClient,
-		ManagedInstancesLongTermRetentionPoliciesClient:  managedInstancesLongTermRetentionPoliciesClient,
-		ManagedInstanceServerSecurityAlertPoliciesClient: managedInstanceServerSecurityAlertPoliciesClient,
-		ManagedInstancesShortTermRetentionPoliciesClient: managedInstancesShortTermRetentionPoliciesClient,
-		ManagedInstanceVulnerabilityAssessmentsClient:    managedInstanceVulnerabilityAssessmentsClient,
-		ManagedInstancesClient:                           managedInstancesClient,
+		ManagedDatabasesClient:                             managedDatabasesClient,
+		ManagedInstanceAdministratorsClient:                managedInstancesAdministratorsClient,
+		ManagedInstanceAzureADOnlyAuthenticationsClient:    managedInstanceAzureADOnlyAuthenticationsClient,
+		ManagedInstanceDistributedAvailabilityGroupsClient: managedInstanceDistributedAvailabilityGroupsClient,
+		ManagedInstanceEncryptionProtectorClient:           managedInstanceEncryptionProtectorsClient,
+		ManagedInstanceFailoverGroupsClient:                managedInstanceFailoverGroupsClient,
+		ManagedInstanceKeysClient:                          managedInstanceKeysClient,
+		ManagedInstancesLongTermRetentionPoliciesClient:    managedInstancesLongTermRetentionPoliciesClient,
+		ManagedInstanceServerSecurityAlertPoliciesClient:   managedInstanceServerSecurityAlertPoliciesClient,
+		ManagedInstanceServerTrustCertificatesClient:       managedInstanceServerTrustCertificatesClient,
+		ManagedInstancesShortTermRetentionPoliciesClient:   managedInstancesShortTermRetentionPoliciesClient,
+		ManagedInstanceVulnerabilityAssessmentsClient:      managedInstanceVulnerabilityAssessmentsClient,
+		ManagedInstancesClient:                             managedInstancesClient,
 
 		options: o,
 	}, nil
@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssqlmanagedinstance
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/distributedavailabilitygroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlManagedInstanceLinkModel struct {
+	Name                          string   `tfschema:"name"`
+	ManagedInstanceId             string   `tfschema:"managed_instance_id"`
+	Databases                     []string `tfschema:"databases"`
+	InstanceAvailabilityGroupName string   `tfschema:"instance_availability_group_name"`
+	PartnerAvailabilityGroupName  string   `tfschema:"partner_availability_group_name"`
+	PartnerEndpoint               string   `tfschema:"partner_endpoint"`
+	FailoverMode                  string   `tfschema:"failover_mode"`
+	ReplicationMode               string   `tfschema:"replication_mode"`
+	SeedingMode                   string   `tfschema:"seeding_mode"`
+	InstanceLinkRole              string   `tfschema:"instance_link_role"`
+	FailoverType                  string   `tfschema:"failover_type"`
+
+	DistributedAvailabilityGroupId string `tfschema:"distributed_availability_group_id"`
+	PartnerLinkRole                string `tfschema:"partner_link_role"`
+}
+
+var (
+	_ sdk.Resource           = MsSqlManagedInstanceLinkResource{}
+	_ sdk.ResourceWithUpdate = MsSqlManagedInstanceLinkResource{}
+)
+
+type MsSqlManagedInstanceLinkResource struct{}
+
+func (r MsSqlManagedInstanceLinkResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_link"
+}
+
+func (r MsSqlManagedInstanceLinkResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceLinkModel{}
+}
+
+func (r MsSqlManagedInstanceLinkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return distributedavailabilitygroups.ValidateDistributedAvailabilityGroupID
+}
+
+func (r MsSqlManagedInstanceLinkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"databases": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"instance_availability_group_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"partner_availability_group_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"partner_endpoint": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(?i)tcp://[^:]+:\d+$`),
+				"`partner_endpoint` must be in the format `TCP://<address>:<port>`",
+			),
+		},
+
+		"failover_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(distributedavailabilitygroups.FailoverModeTypeNone),
+			ValidateFunc: validation.StringInSlice(distributedavailabilitygroups.PossibleValuesForFailoverModeType(), false),
+		},
+
+		"replication_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(distributedavailabilitygroups.ReplicationModeTypeAsync),
+			ValidateFunc: validation.StringInSlice(distributedavailabilitygroups.PossibleValuesForReplicationModeType(), false),
+		},
+
+		"seeding_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(distributedavailabilitygroups.SeedingModeTypeAutomatic),
+			ValidateFunc: validation.StringInSlice(distributedavailabilitygroups.PossibleValuesForSeedingModeType(), false),
+		},
+
+		"instance_link_role": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(distributedavailabilitygroups.LinkRoleSecondary),
+			ValidateFunc: validation.StringInSlice(distributedavailabilitygroups.PossibleValuesForLinkRole(), false),
+		},
+
+		"failover_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(distributedavailabilitygroups.FailoverTypePlanned),
+			ValidateFunc: validation.StringInSlice(distributedavailabilitygroups.PossibleValuesForFailoverType(), false),
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceLinkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"distributed_availability_group_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"partner_link_role": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceLinkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQLManagedInstance.ManagedInstanceDistributedAvailabilityGroupsClient
+
+			var model MsSqlManagedInstanceLinkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := commonids.ParseSqlManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return err
+			}
+
+			id := distributedavailabilitygroups.NewDistributedAvailabilityGroupID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroupName, managedInstanceId.ManagedInstanceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			databases := make([]distributedavailabilitygroups.DistributedAvailabilityGroupDatabase, 0)
+			for _, v := range model.Databases {
+				databases = append(databases, distributedavailabilitygroups.DistributedAvailabilityGroupDatabase{
+					DatabaseName: pointer.To(v),
+				})
+			}
+
+			parameters := distributedavailabilitygroups.DistributedAvailabilityGroup{
+				Properties: &distributedavailabilitygroups.DistributedAvailabilityGroupProperties{
+					Databases:                     pointer.To(databases),
+					FailoverMode:                  pointer.To(distributedavailabilitygroups.FailoverModeType(model.FailoverMode)),
+					InstanceAvailabilityGroupName: pointer.To(model.InstanceAvailabilityGroupName),
+					InstanceLinkRole:              pointer.To(distributedavailabilitygroups.LinkRole(model.InstanceLinkRole)),
+					PartnerAvailabilityGroupName:  pointer.To(model.PartnerAvailabilityGroupName),
+					PartnerEndpoint:               pointer.To(model.PartnerEndpoint),
+					ReplicationMode:               pointer.To(distributedavailabilitygroups.ReplicationModeType(model.ReplicationMode)),
+					SeedingMode:                   pointer.To(distributedavailabilitygroups.SeedingModeType(model.SeedingMode)),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceLinkResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQLManagedInstance.ManagedInstanceDistributedAvailabilityGroupsClient
+
+			id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlManagedInstanceLinkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// a change of role is performed as a failover of the link, which has to complete before any other changes can be made
+			if metadata.ResourceData.HasChange("instance_link_role") {
+				input := distributedavailabilitygroups.DistributedAvailabilityGroupsFailoverRequest{
+					FailoverType: distributedavailabilitygroups.FailoverType(model.FailoverType),
+				}
+				if err := client.FailoverThenPoll(ctx, *id, input); err != nil {
+					return fmt.Errorf("failing over %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("replication_mode") {
+				parameters := distributedavailabilitygroups.DistributedAvailabilityGroup{
+					Properties: &distributedavailabilitygroups.DistributedAvailabilityGroupProperties{
+						ReplicationMode: pointer.To(distributedavailabilitygroups.ReplicationModeType(model.ReplicationMode)),
+					},
+				}
+				if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceLinkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQLManagedInstance.ManagedInstanceDistributedAvailabilityGroupsClient
+
+			id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MsSqlManagedInstanceLinkModel{
+				Name:              id.DistributedAvailabilityGroupName,
+				ManagedInstanceId: commonids.NewSqlManagedInstanceID(id.SubscriptionId, id.ResourceGroupName, id.ManagedInstanceName).ID(),
+				// the failover type is only used when changing the role and isn't returned by the API
+				FailoverType: metadata.ResourceData.Get("failover_type").(string),
+			}
+
+			if state.FailoverType == "" {
+				state.FailoverType = string(distributedavailabilitygroups.FailoverTypePlanned)
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					databases := make([]string, 0)
+					for _, v := range pointer.From(props.Databases) {
+						databases = append(databases, pointer.From(v.DatabaseName))
+					}
+					state.Databases = databases
+
+					state.DistributedAvailabilityGroupId = pointer.From(props.DistributedAvailabilityGroupId)
+					state.FailoverMode = string(pointer.From(props.FailoverMode))
+					state.InstanceAvailabilityGroupName = pointer.From(props.InstanceAvailabilityGroupName)
+					state.InstanceLinkRole = string(pointer.From(props.InstanceLinkRole))
+					state.PartnerAvailabilityGroupName = pointer.From(props.PartnerAvailabilityGroupName)
+					state.PartnerEndpoint = pointer.From(props.PartnerEndpoint)
+					state.PartnerLinkRole = string(pointer.From(props.PartnerLinkRole))
+					state.ReplicationMode = string(pointer.From(props.ReplicationMode))
+					state.SeedingMode = string(pointer.From(props.SeedingMode))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceLinkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQLManagedInstance.ManagedInstanceDistributedAvailabilityGroupsClient
+
+			id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
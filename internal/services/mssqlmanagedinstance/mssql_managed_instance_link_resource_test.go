@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssqlmanagedinstance_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/distributedavailabilitygroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MsSqlManagedInstanceLinkResource struct{}
+
+// The link requires a SQL Server instance which has been prepared with an availability group containing the database,
+// with its database mirroring endpoint exposed at ARM_TEST_MI_LINK_PARTNER_ENDPOINT (e.g. `TCP://10.0.0.4:5022`) and
+// the hex encoded public key of its endpoint certificate available in ARM_TEST_MI_LINK_PARTNER_CERTIFICATE
+func TestAccMsSqlManagedInstanceLink_basic(t *testing.T) {
+	for _, v := range []string{"ARM_TEST_MI_LINK_PARTNER_ENDPOINT", "ARM_TEST_MI_LINK_PARTNER_CERTIFICATE", "ARM_TEST_MI_LINK_PARTNER_AVAILABILITY_GROUP_NAME", "ARM_TEST_MI_LINK_DATABASE_NAME"} {
+		if os.Getenv(v) == "" {
+			t.Skipf("Skipping as %q is not set", v)
+		}
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_link", "test")
+	r := MsSqlManagedInstanceLinkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Async"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("distributed_availability_group_id").Exists(),
+				check.That(data.ResourceName).Key("partner_link_role").HasValue("Primary"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "Sync"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MsSqlManagedInstanceLinkResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQLManagedInstance.ManagedInstanceDistributedAvailabilityGroupsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MsSqlManagedInstanceLinkResource) basic(data acceptance.TestData, replicationMode string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_managed_instance_server_trust_certificate" "test" {
+  name                = "acctest-cert-%[2]d"
+  managed_instance_id = azurerm_mssql_managed_instance.test.id
+  public_blob         = "%[3]s"
+}
+
+resource "azurerm_mssql_managed_instance_link" "test" {
+  name                             = "acctest-link-%[2]d"
+  managed_instance_id              = azurerm_mssql_managed_instance.test.id
+  databases                        = ["%[4]s"]
+  instance_availability_group_name = "acctest-mi-ag-%[2]d"
+  partner_availability_group_name  = "%[5]s"
+  partner_endpoint                 = "%[6]s"
+  replication_mode                 = "%[7]s"
+
+  depends_on = [azurerm_mssql_managed_instance_server_trust_certificate.test]
+}
+`, MsSqlManagedInstanceResource{}.basic(data), data.RandomInteger, os.Getenv("ARM_TEST_MI_LINK_PARTNER_CERTIFICATE"), os.Getenv("ARM_TEST_MI_LINK_DATABASE_NAME"), os.Getenv("ARM_TEST_MI_LINK_PARTNER_AVAILABILITY_GROUP_NAME"), os.Getenv("ARM_TEST_MI_LINK_PARTNER_ENDPOINT"), replicationMode)
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssqlmanagedinstance
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/servertrustcertificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlManagedInstanceServerTrustCertificateModel struct {
+	Name              string `tfschema:"name"`
+	ManagedInstanceId string `tfschema:"managed_instance_id"`
+	PublicBlob        string `tfschema:"public_blob"`
+	Thumbprint        string `tfschema:"thumbprint"`
+}
+
+var _ sdk.Resource = MsSqlManagedInstanceServerTrustCertificateResource{}
+
+type MsSqlManagedInstanceServerTrustCertificateResource struct{}
+
+func (r MsSqlManagedInstanceServerTrustCertificateResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_server_trust_certificate"
+}
+
+func (r MsSqlManagedInstanceServerTrustCertificateResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceServerTrustCertificateModel{}
+}
+
+func (r MsSqlManagedInstanceServerTrustCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return servertrustcertificates.ValidateServerTrustCertificateID
+}
+
+func (r MsSqlManagedInstanceServerTrustCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"public_blob": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(0x)?[0-9a-fA-F]+$`),
+				"`public_blob` must be the hex encoded public key of the certificate",
+			),
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceServerTrustCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"thumbprint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceServerTrustCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQLManagedInstance.ManagedInstanceServerTrustCertificatesClient
+
+			var model MsSqlManagedInstanceServerTrustCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := commonids.ParseSqlManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return err
+			}
+
+			id := servertrustcertificates.NewServerTrustCertificateID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroupName, managedInstanceId.ManagedInstanceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := servertrustcertificates.ServerTrustCertificate{
+				Properties: &servertrustcertificates.ServerTrustCertificateProperties{
+					PublicBlob: pointer.To(model.PublicBlob),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceServerTrustCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQLManagedInstance.ManagedInstanceServerTrustCertificatesClient
+
+			id, err := servertrustcertificates.ParseServerTrustCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MsSqlManagedInstanceServerTrustCertificateModel{
+				Name:              id.ServerTrustCertificateName,
+				ManagedInstanceId: commonids.NewSqlManagedInstanceID(id.SubscriptionId, id.ResourceGroupName, id.ManagedInstanceName).ID(),
+			}
+
+			// the API normalises the casing and prefix of the public blob, so the configured value is retained where set
+			var config MsSqlManagedInstanceServerTrustCertificateModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.PublicBlob = config.PublicBlob
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if state.PublicBlob == "" {
+						state.PublicBlob = pointer.From(props.PublicBlob)
+					}
+					state.Thumbprint = pointer.From(props.Thumbprint)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceServerTrustCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQLManagedInstance.ManagedInstanceServerTrustCertificatesClient
+
+			id, err := servertrustcertificates.ParseServerTrustCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssqlmanagedinstance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/servertrustcertificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// a self-signed certificate generated for these tests, encoded as the hex of its DER representation
+const msSqlManagedInstanceServerTrustCertificatePublicBlob = "0x308201893082012FA003020102021440DE1D050700BE755133C0EE691BE3D9BA2DDF64300A06082A8648CE3D040302301A3118301606035504030C0F616363746573742D6D692D6C696E6B301E170D3236313031373031323533355A170D3336313031343031323533355A301A3118301606035504030C0F616363746573742D6D692D6C696E6B3059301306072A8648CE3D020106082A8648CE3D030107034200044B11470724876250C33D241EA1597C6D38DB2F62B5BAC090FF4941CDA203D4B16066A4826D2DD4F129A5848E78B2394D93ED442C1D2F7ED156CE4050111DB832A3533051301D0603551D0E041604142F0DF1670B59C49848A4579611F65181EAC46FDA301F0603551D230418301680142F0DF1670B59C49848A4579611F65181EAC46FDA300F0603551D130101FF040530030101FF300A06082A8648CE3D040302034800304502205F92049FC5922BF7BE385A3997E0C54E6F90FFEAA60A352A112F26B98EEAD0570221008884F57F0682CA63A08DF59807AD6712620063C9921EF64519D114326735BCD9"
+
+type MsSqlManagedInstanceServerTrustCertificateResource struct{}
+
+func TestAccMsSqlManagedInstanceServerTrustCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_server_trust_certificate", "test")
+	r := MsSqlManagedInstanceServerTrustCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+			),
+		},
+		data.ImportStep("public_blob"),
+	})
+}
+
+func TestAccMsSqlManagedInstanceServerTrustCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_server_trust_certificate", "test")
+	r := MsSqlManagedInstanceServerTrustCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r MsSqlManagedInstanceServerTrustCertificateResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := servertrustcertificates.ParseServerTrustCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQLManagedInstance.ManagedInstanceServerTrustCertificatesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MsSqlManagedInstanceServerTrustCertificateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_server_trust_certificate" "test" {
+  name                = "acctest-cert-%d"
+  managed_instance_id = azurerm_mssql_managed_instance.test.id
+  public_blob         = "%s"
+}
+`, MsSqlManagedInstanceResource{}.basic(data), data.RandomInteger, msSqlManagedInstanceServerTrustCertificatePublicBlob)
+}
+
+func (r MsSqlManagedInstanceServerTrustCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_server_trust_certificate" "import" {
+  name                = azurerm_mssql_managed_instance_server_trust_certificate.test.name
+  managed_instance_id = azurerm_mssql_managed_instance_server_trust_certificate.test.managed_instance_id
+  public_blob         = azurerm_mssql_managed_instance_server_trust_certificate.test.public_blob
+}
+`, r.basic(data))
+}
@@ -59,6 +59,8 @@ func (r Registration) Resources() []sdk.Resource {
 		MsSqlManagedDatabaseResource{},
 		MsSqlManagedInstanceActiveDirectoryAdministratorResource{},
 		MsSqlManagedInstanceFailoverGroupResource{},
+		MsSqlManagedInstanceLinkResource{},
 		MsSqlManagedInstanceResource{},
+		MsSqlManagedInstanceServerTrustCertificateResource{},
 	}
 }
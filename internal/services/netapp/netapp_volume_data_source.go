@@ -117,6 +117,26 @@ func dataSourceNetAppVolume() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
 						},
+
+						"healthy": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"mirror_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"relationship_status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"total_progress": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -168,6 +188,29 @@ func dataSourceNetAppVolume() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
 			},
+
+			"cool_access": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"retrieval_policy": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"tiering_policy": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"coolness_period_in_days": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -214,6 +257,17 @@ func dataSourceNetAppVolumeRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("key_vault_private_endpoint_id", props.KeyVaultPrivateEndpointResourceId)
 		d.Set("large_volume_enabled", props.IsLargeVolume)
 
+		if pointer.From(props.CoolAccess) {
+			coolAccess := map[string]interface{}{
+				"retrieval_policy":        normalizeCoolAccessRetrievalPolicy(pointer.From(props.CoolAccessRetrievalPolicy)),
+				"tiering_policy":          normalizeCoolAccessTieringPolicy(pointer.From(props.CoolAccessTieringPolicy)),
+				"coolness_period_in_days": pointer.From(props.CoolnessPeriod),
+			}
+			d.Set("cool_access", []interface{}{coolAccess})
+		} else {
+			d.Set("cool_access", []interface{}{})
+		}
+
 		smbNonBrowsable := false
 		if props.SmbNonBrowsable != nil {
 			smbNonBrowsable = strings.EqualFold(string(*props.SmbNonBrowsable), string(volumes.SmbNonBrowsableEnabled))
@@ -238,7 +292,11 @@ func dataSourceNetAppVolumeRead(d *pluginsdk.ResourceData, meta interface{}) err
 		if err := d.Set("mount_ip_addresses", flattenNetAppVolumeMountIPAddresses(props.MountTargets)); err != nil {
 			return fmt.Errorf("setting `mount_ip_addresses`: %+v", err)
 		}
-		if err := d.Set("data_protection_replication", flattenNetAppVolumeDataProtectionReplication(props.DataProtection)); err != nil {
+		replicationStatus, err := getNetAppVolumeReplicationStatus(ctx, meta.(*clients.Client).NetApp.VolumeReplicationClient, id, props.DataProtection)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("data_protection_replication", flattenNetAppVolumeDataProtectionReplication(props.DataProtection, replicationStatus)); err != nil {
 			return fmt.Errorf("setting `data_protection_replication`: %+v", err)
 		}
 		if err := d.Set("data_protection_backup_policy", flattenNetAppVolumeDataProtectionBackupPolicy(props.DataProtection)); err != nil {
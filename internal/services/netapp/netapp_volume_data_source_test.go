@@ -30,6 +30,7 @@ func TestAccDataSourceNetAppVolume_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("mount_ip_addresses.#").HasValue("1"),
 				check.That(data.ResourceName).Key("encryption_key_source").HasValue("Microsoft.NetApp"),
 				check.That(data.ResourceName).Key("large_volume_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("cool_access.#").HasValue("0"),
 			),
 		},
 	})
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -758,6 +759,22 @@ func deleteVolume(ctx context.Context, metadata sdk.ResourceMetaData, volumeId s
 	return nil
 }
 
+// checkNetAppPoolCoolAccessEnabled returns an error when the Capacity Pool containing the volume doesn't support cool access,
+// since this otherwise surfaces as a generic error from the API
+func checkNetAppPoolCoolAccessEnabled(ctx context.Context, client *capacitypools.CapacityPoolsClient, id volumes.VolumeId) error {
+	poolId := capacitypools.NewCapacityPoolID(id.SubscriptionId, id.ResourceGroupName, id.NetAppAccountName, id.CapacityPoolName)
+	resp, err := client.PoolsGet(ctx, poolId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", poolId, err)
+	}
+
+	if model := resp.Model; model != nil && !pointer.From(model.Properties.CoolAccess) {
+		return fmt.Errorf("`cool_access` can only be configured for %s when `cool_access_enabled` is `true` for %s", id, poolId)
+	}
+
+	return nil
+}
+
 func waitForVolumeCreateOrUpdate(ctx context.Context, client *volumes.VolumesClient, id volumes.VolumeId) error {
 	deadline, ok := ctx.Deadline()
 	if !ok {
@@ -846,6 +863,25 @@ func waitForReplAuthorization(ctx context.Context, client *volumesreplication.Vo
 	return nil
 }
 
+// getNetAppVolumeReplicationStatus returns the status of the replication for a data protection (destination) volume,
+// or nil when the volume isn't a destination volume or the replication hasn't been established
+func getNetAppVolumeReplicationStatus(ctx context.Context, client *volumesreplication.VolumesReplicationClient, id volumes.VolumeId, input *volumes.VolumePropertiesDataProtection) (*volumesreplication.ReplicationStatus, error) {
+	if input == nil || input.Replication == nil || !strings.EqualFold(string(pointer.From(input.Replication.EndpointType)), "dst") {
+		return nil, nil
+	}
+
+	replicationId := volumesreplication.NewVolumeID(id.SubscriptionId, id.ResourceGroupName, id.NetAppAccountName, id.CapacityPoolName, id.VolumeName)
+	resp, err := client.VolumesReplicationStatus(ctx, replicationId)
+	if err != nil {
+		if resp.HttpResponse != nil && resp.HttpResponse.StatusCode == http.StatusConflict && strings.Contains(err.Error(), "VolumeReplicationMissingFor") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving replication status for %s: %+v", id, err)
+	}
+
+	return resp.Model, nil
+}
+
 func waitForReplMirrorState(ctx context.Context, client *volumesreplication.VolumesReplicationClient, id volumesreplication.VolumeId, desiredState string) error {
 	deadline, ok := ctx.Deadline()
 	if !ok {
@@ -306,6 +306,32 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 								"hourly",
 							}, false),
 						},
+
+						"replication_broken": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"healthy": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"mirror_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"relationship_status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"total_progress": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -396,6 +422,7 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 			"large_volume_enabled": {
 				Type:        pluginsdk.TypeBool,
 				Optional:    true,
+				ForceNew:    true,
 				Default:     false,
 				Description: "Indicates whether the volume is a large volume.",
 			},
@@ -446,6 +473,11 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 				// All validations passed - no action needed
 			}
 
+			// replication can only be broken from the destination volume
+			if d.Get("data_protection_replication.0.replication_broken").(bool) && d.Get("data_protection_replication.0.endpoint_type").(string) != "dst" {
+				return fmt.Errorf("`replication_broken` can only be set to `true` when `endpoint_type` is `dst`")
+			}
+
 			if d.HasChanges("service_level", "pool_name") {
 				serviceLevelChange := d.HasChange("service_level")
 				poolNameChange := d.HasChange("pool_name")
@@ -596,6 +628,10 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 			endpointType = string(*dataProtectionReplication.Replication.EndpointType)
 		}
 		if strings.EqualFold(endpointType, "dst") {
+			if d.Get("data_protection_replication.0.replication_broken").(bool) {
+				return fmt.Errorf("`replication_broken` cannot be set to `true` when creating %s, since the replication must first be established", id)
+			}
+
 			authorizeReplication = true
 			volumeType = "DataProtection"
 		}
@@ -712,6 +748,10 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 
 	if len(d.Get("cool_access").([]interface{})) > 0 {
+		if err := checkNetAppPoolCoolAccessEnabled(ctx, meta.(*clients.Client).NetApp.PoolClient, id); err != nil {
+			return err
+		}
+
 		coolAccess := d.Get("cool_access").([]interface{})[0].(map[string]interface{})
 		parameters.Properties.CoolAccess = pointer.To(true)
 		parameters.Properties.CoolAccessRetrievalPolicy = pointer.To(volumes.CoolAccessRetrievalPolicy(coolAccess["retrieval_policy"].(string)))
@@ -862,6 +902,10 @@ func resourceNetAppVolumeUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 
 	if d.HasChange("cool_access") {
 		if len(d.Get("cool_access").([]interface{})) > 0 {
+			if err := checkNetAppPoolCoolAccessEnabled(ctx, meta.(*clients.Client).NetApp.PoolClient, *id); err != nil {
+				return err
+			}
+
 			coolAccess := d.Get("cool_access").([]interface{})[0].(map[string]interface{})
 			update.Properties.CoolAccess = pointer.To(true)
 
@@ -895,6 +939,35 @@ func resourceNetAppVolumeUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		return err
 	}
 
+	if d.HasChange("data_protection_replication.0.replication_broken") {
+		replicationClient := meta.(*clients.Client).NetApp.VolumeReplicationClient
+		replicationId := volumesreplication.NewVolumeID(id.SubscriptionId, id.ResourceGroupName, id.NetAppAccountName, id.CapacityPoolName, id.VolumeName)
+
+		if d.Get("data_protection_replication.0.replication_broken").(bool) {
+			// Can't use VolumesBreakReplicationThenPoll because from time to time the LRO SDK fails,
+			// please see Pandora's issue: https://github.com/hashicorp/pandora/issues/4571
+			if _, err = replicationClient.VolumesBreakReplication(ctx, replicationId, volumesreplication.BreakReplicationRequest{
+				ForceBreakReplication: pointer.To(true),
+			}); err != nil {
+				return fmt.Errorf("breaking replication for %s: %+v", id, err)
+			}
+
+			log.Printf("[DEBUG] Waiting for the replication of %s to be in broken state", id)
+			if err := waitForReplMirrorState(ctx, replicationClient, replicationId, "broken"); err != nil {
+				return fmt.Errorf("waiting for the breaking of replication for %s: %+v", id, err)
+			}
+		} else {
+			if err = replicationClient.VolumesResyncReplicationThenPoll(ctx, replicationId); err != nil {
+				return fmt.Errorf("resyncing replication for %s: %+v", id, err)
+			}
+
+			log.Printf("[DEBUG] Waiting for the replication of %s to be in mirrored state", id)
+			if err := waitForReplMirrorState(ctx, replicationClient, replicationId, "mirrored"); err != nil {
+				return fmt.Errorf("waiting for the resync of replication for %s: %+v", id, err)
+			}
+		}
+	}
+
 	if d.HasChanges("service_level", "pool_name") {
 		poolName := d.Get("pool_name").(string)
 		poolId := volumes.NewCapacityPoolID(id.SubscriptionId, id.ResourceGroupName, id.NetAppAccountName, poolName)
@@ -1012,7 +1085,19 @@ func resourceNetAppVolumeRead(d *pluginsdk.ResourceData, meta interface{}) error
 		if err := d.Set("mount_ip_addresses", flattenNetAppVolumeMountIPAddresses(props.MountTargets)); err != nil {
 			return fmt.Errorf("setting `mount_ip_addresses`: %+v", err)
 		}
-		if err := d.Set("data_protection_replication", flattenNetAppVolumeDataProtectionReplication(props.DataProtection)); err != nil {
+		replicationStatus, err := getNetAppVolumeReplicationStatus(ctx, meta.(*clients.Client).NetApp.VolumeReplicationClient, *id, props.DataProtection)
+		if err != nil {
+			return err
+		}
+		dataProtectionReplication := flattenNetAppVolumeDataProtectionReplication(props.DataProtection, replicationStatus)
+		if len(dataProtectionReplication) > 0 {
+			replicationBroken := false
+			if replicationStatus != nil && replicationStatus.MirrorState != nil {
+				replicationBroken = strings.EqualFold(string(*replicationStatus.MirrorState), string(volumesreplication.MirrorStateBroken))
+			}
+			dataProtectionReplication[0].(map[string]interface{})["replication_broken"] = replicationBroken
+		}
+		if err := d.Set("data_protection_replication", dataProtectionReplication); err != nil {
 			return fmt.Errorf("setting `data_protection_replication`: %+v", err)
 		}
 		if err := d.Set("data_protection_snapshot_policy", flattenNetAppVolumeDataProtectionSnapshotPolicy(props.DataProtection)); err != nil {
@@ -1076,19 +1161,21 @@ func resourceNetAppVolumeDelete(d *pluginsdk.ResourceData, meta interface{}) err
 					}
 				}
 
-				// Breaking replication
-				// Can't use VolumesBreakReplicationThenPoll because from time to time the LRO SDK fails,
-				// please see Pandora's issue: https://github.com/hashicorp/pandora/issues/4571
-				if _, err = replicationClient.VolumesBreakReplication(ctx, *replicaVolumeId, volumesreplication.BreakReplicationRequest{
-					ForceBreakReplication: pointer.To(true),
-				}); err != nil {
-					return fmt.Errorf("breaking replication for %s: %+v", *replicaVolumeId, err)
-				}
+				// Breaking replication, unless this has already been done via `replication_broken`
+				if res.Model == nil || !strings.EqualFold(string(pointer.From(res.Model.MirrorState)), string(volumesreplication.MirrorStateBroken)) {
+					// Can't use VolumesBreakReplicationThenPoll because from time to time the LRO SDK fails,
+					// please see Pandora's issue: https://github.com/hashicorp/pandora/issues/4571
+					if _, err = replicationClient.VolumesBreakReplication(ctx, *replicaVolumeId, volumesreplication.BreakReplicationRequest{
+						ForceBreakReplication: pointer.To(true),
+					}); err != nil {
+						return fmt.Errorf("breaking replication for %s: %+v", *replicaVolumeId, err)
+					}
 
-				// Waiting for replication be in broken state
-				log.Printf("[DEBUG] Waiting for the replication of %s to be in broken state", *replicaVolumeId)
-				if err := waitForReplMirrorState(ctx, replicationClient, *replicaVolumeId, "broken"); err != nil {
-					return fmt.Errorf("waiting for the breaking of replication for %s: %+v", *replicaVolumeId, err)
+					// Waiting for replication be in broken state
+					log.Printf("[DEBUG] Waiting for the replication of %s to be in broken state", *replicaVolumeId)
+					if err := waitForReplMirrorState(ctx, replicationClient, *replicaVolumeId, "broken"); err != nil {
+						return fmt.Errorf("waiting for the breaking of replication for %s: %+v", *replicaVolumeId, err)
+					}
 				}
 			}
 
@@ -1399,7 +1486,7 @@ func flattenNetAppVolumeMountIPAddresses(input *[]volumes.MountTargetProperties)
 	return results
 }
 
-func flattenNetAppVolumeDataProtectionReplication(input *volumes.VolumePropertiesDataProtection) []interface{} {
+func flattenNetAppVolumeDataProtectionReplication(input *volumes.VolumePropertiesDataProtection, status *volumesreplication.ReplicationStatus) []interface{} {
 	if input == nil || input.Replication == nil || input.Replication.EndpointType == nil {
 		return []interface{}{}
 	}
@@ -1413,12 +1500,27 @@ func flattenNetAppVolumeDataProtectionReplication(input *volumes.VolumePropertie
 		replicationFrequency = translateSDKSchedule(strings.ToLower(string(*input.Replication.ReplicationSchedule)))
 	}
 
+	healthy := false
+	mirrorState := ""
+	relationshipStatus := ""
+	totalProgress := ""
+	if status != nil {
+		healthy = pointer.From(status.Healthy)
+		mirrorState = string(pointer.From(status.MirrorState))
+		relationshipStatus = string(pointer.From(status.RelationshipStatus))
+		totalProgress = pointer.From(status.TotalProgress)
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"endpoint_type":             strings.ToLower(string(*input.Replication.EndpointType)),
 			"remote_volume_location":    location.NormalizeNilable(input.Replication.RemoteVolumeRegion),
 			"remote_volume_resource_id": input.Replication.RemoteVolumeResourceId,
 			"replication_frequency":     replicationFrequency,
+			"healthy":                   healthy,
+			"mirror_state":              mirrorState,
+			"relationship_status":       relationshipStatus,
+			"total_progress":            totalProgress,
 		},
 	}
 }
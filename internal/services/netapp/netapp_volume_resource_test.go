@@ -223,6 +223,41 @@ func TestAccNetAppVolume_crossRegionReplication(t *testing.T) {
 	})
 }
 
+func TestAccNetAppVolume_crossRegionReplicationBreakAndResync(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_netapp_volume", "test_secondary")
+	r := NetAppVolumeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.crossRegionReplication(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_protection_replication.0.replication_broken").HasValue("false"),
+				check.That(data.ResourceName).Key("data_protection_replication.0.mirror_state").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.crossRegionReplicationBroken(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_protection_replication.0.replication_broken").HasValue("true"),
+				check.That(data.ResourceName).Key("data_protection_replication.0.mirror_state").HasValue("Broken"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.crossRegionReplication(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_protection_replication.0.replication_broken").HasValue("false"),
+				check.That(data.ResourceName).Key("data_protection_replication.0.mirror_state").HasValue("Mirrored"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccNetAppVolume_nfsv3FromSnapshot(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_netapp_volume", "test_snapshot_vol")
 	r := NetAppVolumeResource{}
@@ -1028,7 +1063,11 @@ resource "azurerm_netapp_volume" "test" {
 `, template, data.RandomInteger)
 }
 
-func (NetAppVolumeResource) crossRegionReplication(data acceptance.TestData) string {
+func (r NetAppVolumeResource) crossRegionReplication(data acceptance.TestData) string {
+	return r.crossRegionReplicationBroken(data, false)
+}
+
+func (NetAppVolumeResource) crossRegionReplicationBroken(data acceptance.TestData, broken bool) string {
 	overriddenlocations := getOverriddenTestLocations()
 	template := NetAppVolumeResource{}.templateForCrossRegionReplication(data)
 	return fmt.Sprintf(`
@@ -1089,6 +1128,7 @@ resource "azurerm_netapp_volume" "test_secondary" {
     remote_volume_location    = azurerm_resource_group.test.location
     remote_volume_resource_id = azurerm_netapp_volume.test_primary.id
     replication_frequency     = "10minutes"
+    replication_broken        = %[4]t
   }
 
   tags = {
@@ -1096,7 +1136,7 @@ resource "azurerm_netapp_volume" "test_secondary" {
     "SkipASMAzSecPack" = "true"
   }
 }
-`, template, data.RandomInteger, overriddenlocations.Secondary)
+`, template, data.RandomInteger, overriddenlocations.Secondary, broken)
 }
 
 func (NetAppVolumeResource) nfsv3FromSnapshot(data acceptance.TestData) string {
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"strings"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -1188,6 +1190,20 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Set: applicationGatewaySSLCertificate,
 			},
 
+			"ssl_certificate_rotation_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ssl_certificate_key_vault_secret_versions": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"trusted_client_certificate": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -1718,6 +1734,16 @@ func resourceApplicationGatewayCreate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	d.SetId(id.ID())
+
+	sslCertificateVersions := make(map[string]interface{})
+	if d.Get("ssl_certificate_rotation_enabled").(bool) {
+		sslCertificateVersions, err = applicationGatewaySslCertificateKeyVaultSecretVersions(ctx, meta.(*clients.Client), d.Get("ssl_certificate").(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+	}
+	d.Set("ssl_certificate_key_vault_secret_versions", sslCertificateVersions)
+
 	return resourceApplicationGatewayRead(d, meta)
 }
 
@@ -1788,7 +1814,9 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		payload.Properties.RedirectConfigurations = redirectConfigurations
 	}
 
-	if d.HasChange("ssl_certificate") {
+	// re-submitting the certificates causes the Application Gateway to retrieve the latest version of any Key Vault
+	// Secrets which are referenced using a versionless ID, rather than waiting for it to poll the Key Vault
+	if d.HasChanges("ssl_certificate", "ssl_certificate_key_vault_secret_versions") {
 		sslCertificates, err := expandApplicationGatewaySslCertificates(d)
 		if err != nil {
 			return fmt.Errorf("expanding `ssl_certificate`: %+v", err)
@@ -1976,6 +2004,15 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	// the versions are only unknown during the plan when a referenced Key Vault Secret is changing
+	if d.Get("ssl_certificate_rotation_enabled").(bool) && len(d.Get("ssl_certificate_key_vault_secret_versions").(map[string]interface{})) == 0 {
+		sslCertificateVersions, err := applicationGatewaySslCertificateKeyVaultSecretVersions(ctx, meta.(*clients.Client), d.Get("ssl_certificate").(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+		d.Set("ssl_certificate_key_vault_secret_versions", sslCertificateVersions)
+	}
+
 	d.SetId(id.ID())
 	return resourceApplicationGatewayRead(d, meta)
 }
@@ -4747,7 +4784,7 @@ func checkBasicSkuFeatures(d *pluginsdk.ResourceDiff) error {
 	return nil
 }
 
-func applicationGatewayCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+func applicationGatewayCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
 	_, hasAutoscaleConfig := d.GetOk("autoscale_configuration.0")
 	capacity, hasCapacity := d.GetOk("sku.0.capacity")
 	tier := d.Get("sku.0.tier").(string)
@@ -4781,6 +4818,10 @@ func applicationGatewayCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceD
 		}
 	}
 
+	if err := applicationGatewaySslCertificateRotationCustomizeDiff(ctx, d, meta); err != nil {
+		return err
+	}
+
 	if hasCapacity {
 		if (strings.EqualFold(tier, string(applicationgateways.ApplicationGatewayTierStandard)) || strings.EqualFold(tier, string(applicationgateways.ApplicationGatewayTierWAF))) && (capacity.(int) < 1 || capacity.(int) > 32) {
 			return fmt.Errorf("The value '%d' exceeds the maximum capacity allowed for a %q V1 SKU, the %q SKU must have a capacity value between 1 and 32", capacity, tier, tier)
@@ -4794,6 +4835,73 @@ func applicationGatewayCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceD
 	return nil
 }
 
+// applicationGatewaySslCertificateRotationCustomizeDiff detects when a Key Vault Secret referenced by an `ssl_certificate`
+// using a versionless ID has been rotated, so that the Application Gateway picks up the latest version during the same
+// apply rather than continuing to serve the previous certificate until it next polls the Key Vault.
+func applicationGatewaySslCertificateRotationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	existing := d.Get("ssl_certificate_key_vault_secret_versions").(map[string]interface{})
+
+	if !d.Get("ssl_certificate_rotation_enabled").(bool) {
+		if len(existing) > 0 {
+			return d.SetNew("ssl_certificate_key_vault_secret_versions", map[string]interface{}{})
+		}
+		return nil
+	}
+
+	if !d.NewValueKnown("ssl_certificate") {
+		return d.SetNewComputed("ssl_certificate_key_vault_secret_versions")
+	}
+
+	latest, err := applicationGatewaySslCertificateKeyVaultSecretVersions(ctx, meta.(*clients.Client), d.Get("ssl_certificate").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(existing, latest) {
+		return d.SetNew("ssl_certificate_key_vault_secret_versions", latest)
+	}
+
+	return nil
+}
+
+// applicationGatewaySslCertificateKeyVaultSecretVersions returns the latest version of each Key Vault Secret referenced
+// by an `ssl_certificate` using a versionless ID, keyed by the name of the certificate.
+func applicationGatewaySslCertificateKeyVaultSecretVersions(ctx context.Context, client *clients.Client, input []interface{}) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		kvsid := v["key_vault_secret_id"].(string)
+		if kvsid == "" {
+			continue
+		}
+
+		secretId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(kvsid)
+		if err != nil {
+			return nil, err
+		}
+
+		// a specific version of the secret has been requested, so there's nothing to rotate
+		if secretId.Version != "" {
+			continue
+		}
+
+		latestVersion, err := client.KeyVault.LatestVersionOfNestedItem(ctx, *secretId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving the Key Vault Secret for the `ssl_certificate` %q: %+v", v["name"].(string), err)
+		}
+
+		output[v["name"].(string)] = *latestVersion
+	}
+
+	return output, nil
+}
+
 func applicationGatewayHttpListnerHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -606,7 +606,7 @@ func TestAccApplicationGateway_sslCertificate_keyvault_versionless(t *testing.T)
 
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
-			Config: r.sslCertificate_keyvault_versionless(data),
+			Config: r.sslCertificate_keyvault_versionless(data, false),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("ssl_certificate.0.key_vault_secret_id").Exists(),
@@ -616,6 +616,30 @@ func TestAccApplicationGateway_sslCertificate_keyvault_versionless(t *testing.T)
 	})
 }
 
+func TestAccApplicationGateway_sslCertificate_keyvault_rotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway", "test")
+	r := ApplicationGatewayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sslCertificate_keyvault_versionless(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ssl_certificate_key_vault_secret_versions.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("ssl_certificate_rotation_enabled", "ssl_certificate_key_vault_secret_versions"),
+		{
+			Config: r.sslCertificate_keyvault_versionless(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ssl_certificate_key_vault_secret_versions.%").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccApplicationGateway_sslCertificate_keyvault_versioned(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_application_gateway", "test")
 	r := ApplicationGatewayResource{}
@@ -4658,7 +4682,7 @@ resource "azurerm_application_gateway" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
-func (r ApplicationGatewayResource) sslCertificate_keyvault_versionless(data acceptance.TestData) string {
+func (r ApplicationGatewayResource) sslCertificate_keyvault_versionless(data acceptance.TestData, rotationEnabled bool) string {
 	return fmt.Sprintf(`
 %s
 
@@ -4814,8 +4838,10 @@ resource "azurerm_application_gateway" "test" {
     name                = local.ssl_certificate_name
     key_vault_secret_id = "${azurerm_key_vault.test.vault_uri}secrets/${azurerm_key_vault_certificate.test.name}"
   }
+
+  ssl_certificate_rotation_enabled = %[3]t
 }
-`, r.template(data), data.RandomInteger)
+`, r.template(data), data.RandomInteger, rotationEnabled)
 }
 
 func (r ApplicationGatewayResource) sslCertificate_keyvault_versioned(data acceptance.TestData) string {
@@ -16,7 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-01-01/bastionhosts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/bastionhosts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -100,7 +100,7 @@ func resourceBastionHost() *pluginsdk.Resource {
 						},
 						"public_ip_address_id": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
+							Optional:     true,
 							ForceNew:     true,
 							ValidateFunc: commonids.ValidatePublicIPAddressID,
 						},
@@ -152,6 +152,13 @@ func resourceBastionHost() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"private_only_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"virtual_network_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -182,7 +189,7 @@ func resourceBastionHost() *pluginsdk.Resource {
 }
 
 func resourceBastionHostCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.BastionHostsClient
+	client := meta.(*clients.Client).Network.BastionHosts
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -199,6 +206,7 @@ func resourceBastionHostCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	shareableLinkEnabled := d.Get("shareable_link_enabled").(bool)
 	tunnelingEnabled := d.Get("tunneling_enabled").(bool)
 	sessionRecordingEnabled := d.Get("session_recording_enabled").(bool)
+	privateOnlyEnabled := d.Get("private_only_enabled").(bool)
 
 	if scaleUnits > 2 && (sku != bastionhosts.BastionHostSkuNameStandard && sku != bastionhosts.BastionHostSkuNamePremium) {
 		return fmt.Errorf("`scale_units` only can be changed when `sku` is `Standard` or `Premium`. `scale_units` is always `2` when `sku` is `Basic`")
@@ -228,6 +236,20 @@ func resourceBastionHostCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("`session_recording_enabled` is only supported when `sku` is `Premium`")
 	}
 
+	if privateOnlyEnabled && sku != bastionhosts.BastionHostSkuNamePremium {
+		return fmt.Errorf("`private_only_enabled` is only supported when `sku` is `Premium`")
+	}
+
+	if ipConfigs := d.Get("ip_configuration").([]interface{}); len(ipConfigs) > 0 && ipConfigs[0] != nil {
+		publicIPAddressId := ipConfigs[0].(map[string]interface{})["public_ip_address_id"].(string)
+		if privateOnlyEnabled && publicIPAddressId != "" {
+			return fmt.Errorf("`public_ip_address_id` cannot be specified when `private_only_enabled` is `true`")
+		}
+		if !privateOnlyEnabled && publicIPAddressId == "" {
+			return fmt.Errorf("`public_ip_address_id` is required when `private_only_enabled` is `false`")
+		}
+	}
+
 	existing, err := client.Get(ctx, id)
 	if err != nil {
 		if !response.WasNotFound(existing.HttpResponse) {
@@ -279,6 +301,10 @@ func resourceBastionHostCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		parameters.Properties.EnableSessionRecording = pointer.To(sessionRecordingEnabled)
 	}
 
+	if privateOnlyEnabled {
+		parameters.Properties.EnablePrivateOnlyBastion = pointer.To(privateOnlyEnabled)
+	}
+
 	zones := zones.ExpandUntyped(d.Get("zones").(*schema.Set).List())
 	if len(zones) > 0 {
 		parameters.Zones = pointer.To(zones)
@@ -306,7 +332,7 @@ func resourceBastionHostCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 }
 
 func resourceBastionHostUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.BastionHostsClient
+	client := meta.(*clients.Client).Network.BastionHosts
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -407,7 +433,7 @@ func resourceBastionHostUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 }
 
 func resourceBastionHostRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.BastionHostsClient
+	client := meta.(*clients.Client).Network.BastionHosts
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -447,6 +473,7 @@ func resourceBastionHostRead(d *pluginsdk.ResourceData, meta interface{}) error
 			d.Set("shareable_link_enabled", props.EnableShareableLink)
 			d.Set("tunneling_enabled", props.EnableTunneling)
 			d.Set("session_recording_enabled", props.EnableSessionRecording)
+			d.Set("private_only_enabled", pointer.From(props.EnablePrivateOnlyBastion))
 
 			virtualNetworkId := ""
 			if vnet := props.VirtualNetwork; vnet != nil {
@@ -480,7 +507,7 @@ func resourceBastionHostRead(d *pluginsdk.ResourceData, meta interface{}) error
 }
 
 func resourceBastionHostDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.BastionHostsClient
+	client := meta.(*clients.Client).Network.BastionHosts
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -506,19 +533,23 @@ func expandBastionHostIPConfiguration(input []interface{}) (ipConfigs *[]bastion
 	subID := property["subnet_id"].(string)
 	pipID := property["public_ip_address_id"].(string)
 
-	return &[]bastionhosts.BastionHostIPConfiguration{
-		{
-			Name: &ipConfName,
-			Properties: &bastionhosts.BastionHostIPConfigurationPropertiesFormat{
-				Subnet: bastionhosts.SubResource{
-					Id: &subID,
-				},
-				PublicIPAddress: bastionhosts.SubResource{
-					Id: &pipID,
-				},
+	ipConfig := bastionhosts.BastionHostIPConfiguration{
+		Name: &ipConfName,
+		Properties: &bastionhosts.BastionHostIPConfigurationPropertiesFormat{
+			Subnet: bastionhosts.SubResource{
+				Id: &subID,
 			},
 		},
 	}
+
+	// a Public IP Address isn't used when the Bastion Host is private-only
+	if pipID != "" {
+		ipConfig.Properties.PublicIPAddress = &bastionhosts.SubResource{
+			Id: &pipID,
+		}
+	}
+
+	return &[]bastionhosts.BastionHostIPConfiguration{ipConfig}
 }
 
 func flattenBastionHostIPConfiguration(ipConfigs *[]bastionhosts.BastionHostIPConfiguration) []interface{} {
@@ -542,7 +573,7 @@ func flattenBastionHostIPConfiguration(ipConfigs *[]bastionhosts.BastionHostIPCo
 			ipConfig["subnet_id"] = subnetId
 
 			publicIpId := ""
-			if pip := props.PublicIPAddress; pip.Id != nil {
+			if pip := props.PublicIPAddress; pip != nil && pip.Id != nil {
 				publicIpId = *pip.Id
 			}
 			ipConfig["public_ip_address_id"] = publicIpId
@@ -8,28 +8,24 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkinterfaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/vmsspublicipaddresses"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-01-01/bastionhosts"
 	network_2024_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/privateendpointconnections"
 )
 
 type Client struct {
 	*network_2024_05_01.Client
 
-	BastionHostsClient *bastionhosts.BastionHostsClient
 	// VMSS Data Source requires the Network Interfaces and VMSSPublicIpAddresses client from `2023-09-01` for the `ListVirtualMachineScaleSetVMNetworkInterfacesComplete` method
 	NetworkInterfacesClient     *networkinterfaces.NetworkInterfacesClient
 	VMSSPublicIPAddressesClient *vmsspublicipaddresses.VMSSPublicIPAddressesClient
+
+	// Managed Private Endpoint Approvals require a client for the Private Endpoint Connections of the target resources
+	PrivateEndpointConnectionsClient *privateendpointconnections.Client
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
-	BastionHostsClient, err := bastionhosts.NewBastionHostsClientWithBaseURI(o.Environment.ResourceManager)
-	if err != nil {
-		return nil, fmt.Errorf("building Bastion Client: %+v", err)
-	}
-	o.Configure(BastionHostsClient.Client, o.Authorizers.ResourceManager)
-
 	NetworkInterfacesClient, err := networkinterfaces.NewNetworkInterfacesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Network Interfaces Client: %+v", err)
@@ -42,6 +38,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(VMSSPublicIPAddressesClient.Client, o.Authorizers.ResourceManager)
 
+	privateEndpointConnectionsClient, err := privateendpointconnections.NewClient(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
+		o.Configure(c, o.Authorizers.ResourceManager)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building Private Endpoint Connections Client: %+v", err)
+	}
+
 	client, err := network_2024_05_01.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
 		o.Configure(c, o.Authorizers.ResourceManager)
 	})
@@ -50,9 +53,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 
 	return &Client{
-		BastionHostsClient:          BastionHostsClient,
-		NetworkInterfacesClient:     NetworkInterfacesClient,
-		VMSSPublicIPAddressesClient: VMSSPublicIPAddressesClient,
-		Client:                      client,
+		NetworkInterfacesClient:          NetworkInterfacesClient,
+		VMSSPublicIPAddressesClient:      VMSSPublicIPAddressesClient,
+		PrivateEndpointConnectionsClient: privateEndpointConnectionsClient,
+		Client:                           client,
 	}, nil
 }
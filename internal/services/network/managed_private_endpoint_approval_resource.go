@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/privateendpointconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagedPrivateEndpointApprovalModel struct {
+	TargetResourceId              string `tfschema:"target_resource_id"`
+	PrivateEndpointName           string `tfschema:"private_endpoint_name"`
+	ApprovalDescription           string `tfschema:"approval_description"`
+	PrivateEndpointConnectionName string `tfschema:"private_endpoint_connection_name"`
+	PrivateEndpointId             string `tfschema:"private_endpoint_id"`
+}
+
+var _ sdk.ResourceWithUpdate = ManagedPrivateEndpointApprovalResource{}
+
+type ManagedPrivateEndpointApprovalResource struct{}
+
+func (r ManagedPrivateEndpointApprovalResource) ResourceType() string {
+	return "azurerm_managed_private_endpoint_approval"
+}
+
+func (r ManagedPrivateEndpointApprovalResource) ModelObject() interface{} {
+	return &ManagedPrivateEndpointApprovalModel{}
+}
+
+func (r ManagedPrivateEndpointApprovalResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return privateendpointconnections.ValidateConnectionID
+}
+
+func (r ManagedPrivateEndpointApprovalResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: privateendpointconnections.ValidateTargetResourceID,
+		},
+
+		"private_endpoint_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"approval_description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "Approved by Terraform",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ManagedPrivateEndpointApprovalResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_endpoint_connection_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"private_endpoint_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ManagedPrivateEndpointApprovalResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateEndpointConnectionsClient
+
+			var model ManagedPrivateEndpointApprovalModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Private Endpoint Connection is requested asynchronously once the Managed Private Endpoint has been
+			// created, so it may take a while to show up on the target resource
+			connection, err := waitForManagedPrivateEndpointConnection(ctx, client, model.TargetResourceId, model.PrivateEndpointName)
+			if err != nil {
+				return err
+			}
+
+			id, err := privateendpointconnections.ParseConnectionID(connection.Id)
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case strings.EqualFold(connection.Status, privateendpointconnections.StatusPending):
+				if err := client.Approve(ctx, id.ID(), model.ApprovalDescription); err != nil {
+					return fmt.Errorf("approving %s: %+v", *id, err)
+				}
+			case strings.EqualFold(connection.Status, privateendpointconnections.StatusApproved):
+				log.Printf("[DEBUG] %s has already been approved", *id)
+			default:
+				return fmt.Errorf("%s can't be approved since its status is %q", *id, connection.Status)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagedPrivateEndpointApprovalResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateEndpointConnectionsClient
+
+			id, err := privateendpointconnections.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			connection, err := client.Get(ctx, id.ID())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if connection == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			// a connection which has since been rejected or disconnected is no longer approved
+			if !strings.EqualFold(connection.Status, privateendpointconnections.StatusApproved) {
+				log.Printf("[DEBUG] %s has status %q - removing from state", *id, connection.Status)
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ManagedPrivateEndpointApprovalModel{
+				TargetResourceId:              id.TargetResourceId,
+				PrivateEndpointName:           connection.PrivateEndpointName(),
+				ApprovalDescription:           connection.Description,
+				PrivateEndpointConnectionName: id.Name,
+				PrivateEndpointId:             connection.PrivateEndpointId,
+			}
+
+			// the casing returned by the API can differ from the configuration, which would otherwise force a new resource
+			if v := metadata.ResourceData.Get("target_resource_id").(string); strings.EqualFold(v, state.TargetResourceId) {
+				state.TargetResourceId = v
+			}
+			if v := metadata.ResourceData.Get("private_endpoint_name").(string); strings.EqualFold(v, state.PrivateEndpointName) {
+				state.PrivateEndpointName = v
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagedPrivateEndpointApprovalResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateEndpointConnectionsClient
+
+			id, err := privateendpointconnections.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagedPrivateEndpointApprovalModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("approval_description") {
+				if err := client.Approve(ctx, id.ID(), model.ApprovalDescription); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagedPrivateEndpointApprovalResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// approved Private Endpoint Connections are intentionally left as-is, rejecting them would break the
+			// connectivity of the Data Factories, Synapse Workspaces and Purview Accounts which requested them
+			return nil
+		},
+	}
+}
+
+// waitForManagedPrivateEndpointConnection waits for the Private Endpoint Connection of the named Private Endpoint to be
+// requested on the target resource, then returns it
+func waitForManagedPrivateEndpointConnection(ctx context.Context, client *privateendpointconnections.Client, targetResourceId string, privateEndpointName string) (*privateendpointconnections.Connection, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil, fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"NotFound"},
+		Target:     []string{"Found"},
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh: func() (interface{}, string, error) {
+			connections, err := client.List(ctx, targetResourceId)
+			if err != nil {
+				return nil, "", fmt.Errorf("listing Private Endpoint Connections for %q: %+v", targetResourceId, err)
+			}
+			if connections == nil {
+				return nil, "", fmt.Errorf("the target resource %q was not found", targetResourceId)
+			}
+
+			for _, connection := range *connections {
+				if strings.EqualFold(connection.PrivateEndpointName(), privateEndpointName) {
+					return pointer.To(connection), "Found", nil
+				}
+			}
+
+			return connections, "NotFound", nil
+		},
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for the Private Endpoint Connection of Private Endpoint %q to be requested on %q: %+v", privateEndpointName, targetResourceId, err)
+	}
+
+	return result.(*privateendpointconnections.Connection), nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/privateendpointconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagedPrivateEndpointApprovalResource struct{}
+
+func TestAccManagedPrivateEndpointApproval_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_private_endpoint_approval", "test")
+	r := ManagedPrivateEndpointApprovalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_endpoint_connection_name").IsNotEmpty(),
+				check.That(data.ResourceName).Key("private_endpoint_id").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagedPrivateEndpointApproval_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_private_endpoint_approval", "test")
+	r := ManagedPrivateEndpointApprovalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.description(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("approval_description").HasValue("Approved for acctest"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagedPrivateEndpointApprovalResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := privateendpointconnections.ParseConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := clients.Network.PrivateEndpointConnectionsClient.Get(ctx, id.ID())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(connection != nil && strings.EqualFold(connection.Status, privateendpointconnections.StatusApproved)), nil
+}
+
+func (ManagedPrivateEndpointApprovalResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mpea-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                            = "acctestdf%[1]d"
+  location                        = azurerm_resource_group.test.location
+  resource_group_name             = azurerm_resource_group.test.name
+  managed_virtual_network_enabled = true
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_data_factory_managed_private_endpoint" "test" {
+  name               = "acctestblob%[1]d"
+  data_factory_id    = azurerm_data_factory.test.id
+  target_resource_id = azurerm_storage_account.test.id
+  subresource_name   = "blob"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r ManagedPrivateEndpointApprovalResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_private_endpoint_approval" "test" {
+  target_resource_id    = azurerm_storage_account.test.id
+  private_endpoint_name = "${azurerm_data_factory.test.name}.${azurerm_data_factory_managed_private_endpoint.test.name}"
+}
+`, r.template(data))
+}
+
+func (r ManagedPrivateEndpointApprovalResource) description(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_private_endpoint_approval" "test" {
+  target_resource_id    = azurerm_storage_account.test.id
+  private_endpoint_name = "${azurerm_data_factory.test.name}.${azurerm_data_factory_managed_private_endpoint.test.name}"
+  approval_description  = "Approved for acctest"
+}
+`, r.template(data))
+}
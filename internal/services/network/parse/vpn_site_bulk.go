@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type VpnSiteBulkId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	VirtualWanName string
+	Name           string
+}
+
+func NewVpnSiteBulkID(subscriptionId, resourceGroup, virtualWanName, name string) VpnSiteBulkId {
+	return VpnSiteBulkId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VirtualWanName: virtualWanName,
+		Name:           name,
+	}
+}
+
+func (id VpnSiteBulkId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Virtual Wan Name %q", id.VirtualWanName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Vpn Site Bulk", segmentsStr)
+}
+
+func (id VpnSiteBulkId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualWans/%s/vpnSiteBulks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualWanName, id.Name)
+}
+
+// VpnSiteBulkID parses a VpnSiteBulk ID into an VpnSiteBulkId struct
+func VpnSiteBulkID(input string) (*VpnSiteBulkId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an VpnSiteBulk ID: %+v", input, err)
+	}
+
+	resourceId := VpnSiteBulkId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualWanName, err = id.PopSegment("virtualWans"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("vpnSiteBulks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/p2svpngateways"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualwans"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -183,8 +185,41 @@ func resourcePointToSiteVPNGateway() *pluginsdk.Resource {
 				},
 			},
 
+			"vpn_client_profile_generation_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"vpn_client_profile_authentication_method": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(p2svpngateways.PossibleValuesForAuthenticationMethod(), false),
+			},
+
+			"vpn_client_profile_url": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			if !d.Get("vpn_client_profile_generation_enabled").(bool) {
+				if d.Get("vpn_client_profile_url").(string) != "" {
+					return d.SetNew("vpn_client_profile_url", "")
+				}
+				return nil
+			}
+
+			if d.Id() == "" || d.HasChanges("vpn_client_profile_generation_enabled", "vpn_client_profile_authentication_method", "connection_configuration", "dns_servers") {
+				return d.SetNewComputed("vpn_client_profile_url")
+			}
+
+			return nil
+		}),
 	}
 }
 
@@ -233,6 +268,14 @@ func resourcePointToSiteVPNGatewayCreate(d *pluginsdk.ResourceData, meta interfa
 
 	d.SetId(id.ID())
 
+	if d.Get("vpn_client_profile_generation_enabled").(bool) {
+		profileUrl, err := generatePointToSiteVPNGatewayProfile(ctx, meta.(*clients.Client).Network.P2sVpnGateways, id, d.Get("vpn_client_profile_authentication_method").(string))
+		if err != nil {
+			return err
+		}
+		d.Set("vpn_client_profile_url", profileUrl)
+	}
+
 	return resourcePointToSiteVPNGatewayRead(d, meta)
 }
 
@@ -286,6 +329,19 @@ func resourcePointToSiteVPNGatewayUpdate(d *pluginsdk.ResourceData, meta interfa
 
 	d.SetId(id.ID())
 
+	// the VPN Client Profile contains the connection configurations, so it needs to be regenerated when these change
+	if d.Get("vpn_client_profile_generation_enabled").(bool) {
+		if d.HasChanges("vpn_client_profile_generation_enabled", "vpn_client_profile_authentication_method", "connection_configuration", "dns_servers") {
+			profileUrl, err := generatePointToSiteVPNGatewayProfile(ctx, meta.(*clients.Client).Network.P2sVpnGateways, *id, d.Get("vpn_client_profile_authentication_method").(string))
+			if err != nil {
+				return err
+			}
+			d.Set("vpn_client_profile_url", profileUrl)
+		}
+	} else {
+		d.Set("vpn_client_profile_url", "")
+	}
+
 	return resourcePointToSiteVPNGatewayRead(d, meta)
 }
 
@@ -368,6 +424,34 @@ func resourcePointToSiteVPNGatewayDelete(d *pluginsdk.ResourceData, meta interfa
 	return nil
 }
 
+// generatePointToSiteVPNGatewayProfile generates the VPN Client Profile package for the Point-to-Site VPN Gateway
+// and returns the (time-limited) URL from which it can be downloaded
+func generatePointToSiteVPNGatewayProfile(ctx context.Context, client *p2svpngateways.P2sVpnGatewaysClient, id commonids.VirtualWANP2SVPNGatewayId, authenticationMethod string) (string, error) {
+	input := p2svpngateways.P2SVpnProfileParameters{}
+	if authenticationMethod != "" {
+		input.AuthenticationMethod = pointer.To(p2svpngateways.AuthenticationMethod(authenticationMethod))
+	}
+
+	resp, err := client.GenerateVpnProfile(ctx, id, input)
+	if err != nil {
+		return "", fmt.Errorf("generating the VPN Client Profile for %s: %+v", id, err)
+	}
+	if err := resp.Poller.PollUntilDone(ctx); err != nil {
+		return "", fmt.Errorf("polling after generating the VPN Client Profile for %s: %+v", id, err)
+	}
+
+	var result p2svpngateways.VpnProfileResponse
+	if err := resp.Poller.FinalResult(&result); err != nil {
+		return "", fmt.Errorf("retrieving the VPN Client Profile for %s: %+v", id, err)
+	}
+
+	if result.ProfileURL == nil {
+		return "", fmt.Errorf("generating the VPN Client Profile for %s: `profileUrl` was nil", id)
+	}
+
+	return *result.ProfileURL, nil
+}
+
 func expandPointToSiteVPNGatewayConnectionConfiguration(input []interface{}) *[]virtualwans.P2SConnectionConfiguration {
 	configurations := make([]virtualwans.P2SConnectionConfiguration, 0)
 
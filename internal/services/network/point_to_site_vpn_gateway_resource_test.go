@@ -148,6 +148,38 @@ func TestAccPointToSiteVPNGateway_routing(t *testing.T) {
 	})
 }
 
+func TestAccPointToSiteVPNGateway_vpnClientProfile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_point_to_site_vpn_gateway", "test")
+	r := PointToSiteVPNGatewayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vpn_client_profile_url").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.vpnClientProfile(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vpn_client_profile_url").IsSet(),
+			),
+		},
+		data.ImportStep("vpn_client_profile_generation_enabled", "vpn_client_profile_authentication_method", "vpn_client_profile_url"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vpn_client_profile_url").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (PointToSiteVPNGatewayResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := commonids.ParseVirtualWANP2SVPNGatewayID(state.ID)
 	if err != nil {
@@ -184,6 +216,31 @@ resource "azurerm_point_to_site_vpn_gateway" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r PointToSiteVPNGatewayResource) vpnClientProfile(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_point_to_site_vpn_gateway" "test" {
+  name                        = "acctestp2sVPNG-%d"
+  location                    = azurerm_resource_group.test.location
+  resource_group_name         = azurerm_resource_group.test.name
+  virtual_hub_id              = azurerm_virtual_hub.test.id
+  vpn_server_configuration_id = azurerm_vpn_server_configuration.test.id
+  scale_unit                  = 1
+
+  vpn_client_profile_generation_enabled    = true
+  vpn_client_profile_authentication_method = "EAPTLS"
+
+  connection_configuration {
+    name = "first"
+    vpn_client_address_pool {
+      address_prefixes = ["172.100.0.0/14"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r PointToSiteVPNGatewayResource) singleConnectionConfiguration(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
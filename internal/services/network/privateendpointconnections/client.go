@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// each Service which supports Private Link exposes Private Endpoint Connections using the same shape, but through its
+// own Resource Provider, API Version and SDK package - so this client wraps the SDK client for each of the supported
+// Resource Types behind a common interface
+
+const (
+	StatusApproved = "Approved"
+	StatusPending  = "Pending"
+)
+
+// Connection is the subset of a Private Endpoint Connection which is common to all of the supported Resource Types.
+type Connection struct {
+	Id                string
+	Name              string
+	PrivateEndpointId string
+	Status            string
+	Description       string
+}
+
+// PrivateEndpointName returns the name of the Private Endpoint behind the Private Endpoint Connection, which for Managed
+// Private Endpoints is derived from the requesting resource (e.g. `{factoryName}.{managedPrivateEndpointName}`).
+func (c Connection) PrivateEndpointName() string {
+	if c.PrivateEndpointId == "" {
+		return ""
+	}
+
+	segments := strings.Split(strings.TrimSuffix(c.PrivateEndpointId, "/"), "/")
+	return segments[len(segments)-1]
+}
+
+type approver interface {
+	// list returns the Private Endpoint Connections of the target resource, or nil when the target doesn't exist
+	list(ctx context.Context, targetResourceId string) (*[]Connection, error)
+
+	// get returns the Private Endpoint Connection, or nil when it doesn't exist
+	get(ctx context.Context, connectionId string) (*Connection, error)
+
+	// approve sets the status of the Private Endpoint Connection to Approved
+	approve(ctx context.Context, connectionId string, description string) error
+}
+
+type Client struct {
+	approvers map[string]approver
+}
+
+func NewClient(api environments.Api, configure func(c *resourcemanager.Client)) (*Client, error) {
+	approvers := make(map[string]approver)
+	for resourceType, build := range approverBuilders {
+		a, err := build(api, configure)
+		if err != nil {
+			return nil, fmt.Errorf("building Private Endpoint Connections client for %q: %+v", resourceType, err)
+		}
+		approvers[resourceType] = a
+	}
+
+	return &Client{
+		approvers: approvers,
+	}, nil
+}
+
+type approverBuilder func(api environments.Api, configure func(c *resourcemanager.Client)) (approver, error)
+
+var approverBuilders = map[string]approverBuilder{
+	"microsoft.cognitiveservices/accounts":  newCognitiveServicesApprover,
+	"microsoft.documentdb/databaseaccounts": newCosmosDBApprover,
+	"microsoft.keyvault/vaults":             newKeyVaultApprover,
+	"microsoft.purview/accounts":            newPurviewApprover,
+	"microsoft.search/searchservices":       newSearchApprover,
+	"microsoft.sql/servers":                 newSqlApprover,
+	"microsoft.storage/storageaccounts":     newStorageApprover,
+}
+
+// List retrieves the Private Endpoint Connections of the specified Resource, returning nil when the Resource doesn't exist.
+func (c Client) List(ctx context.Context, targetResourceId string) (*[]Connection, error) {
+	a, err := c.approverForResource(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.list(ctx, targetResourceId)
+}
+
+// Get retrieves the specified Private Endpoint Connection, returning nil when it doesn't exist.
+func (c Client) Get(ctx context.Context, connectionId string) (*Connection, error) {
+	id, err := ParseConnectionID(connectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := c.approverForResource(id.TargetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.get(ctx, connectionId)
+}
+
+// Approve approves the specified Private Endpoint Connection and waits for the change to be applied.
+func (c Client) Approve(ctx context.Context, connectionId string, description string) error {
+	id, err := ParseConnectionID(connectionId)
+	if err != nil {
+		return err
+	}
+
+	a, err := c.approverForResource(id.TargetResourceId)
+	if err != nil {
+		return err
+	}
+
+	return a.approve(ctx, connectionId, description)
+}
+
+func (c Client) approverForResource(targetResourceId string) (approver, error) {
+	resourceType, err := ResourceTypeForId(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	a, ok := c.approvers[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("the Resource Type %q isn't supported", resourceType)
+	}
+
+	return a, nil
+}
+
+// SupportedResourceTypes returns the Resource Types whose Private Endpoint Connections can be managed by this client.
+func SupportedResourceTypes() []string {
+	out := make([]string, 0, len(approverBuilders))
+	for resourceType := range approverBuilders {
+		out = append(out, resourceType)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// ResourceTypeForId returns the lower-cased Resource Type (e.g. `microsoft.storage/storageaccounts`) of a top-level
+// Resource ID.
+func ResourceTypeForId(id string) (string, error) {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	if len(segments) != 8 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "resourceGroups") || !strings.EqualFold(segments[4], "providers") {
+		return "", fmt.Errorf("expected %q to be a Resource ID in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{namespace}/{type}/{name}`", id)
+	}
+
+	return strings.ToLower(fmt.Sprintf("%s/%s", segments[5], segments[6])), nil
+}
+
+type ConnectionId struct {
+	TargetResourceId string
+	Name             string
+}
+
+func NewConnectionID(targetResourceId string, name string) ConnectionId {
+	return ConnectionId{
+		TargetResourceId: targetResourceId,
+		Name:             name,
+	}
+}
+
+func (id ConnectionId) ID() string {
+	return fmt.Sprintf("%s/privateEndpointConnections/%s", id.TargetResourceId, id.Name)
+}
+
+func (id ConnectionId) String() string {
+	return fmt.Sprintf("Private Endpoint Connection %q (Target Resource %q)", id.Name, id.TargetResourceId)
+}
+
+// ParseConnectionID parses the ID of a Private Endpoint Connection of any of the supported Resource Types, in the format
+// `{targetResourceId}/privateEndpointConnections/{name}`.
+func ParseConnectionID(input string) (*ConnectionId, error) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	if len(segments) != 10 || !strings.EqualFold(segments[8], "privateEndpointConnections") || segments[9] == "" {
+		return nil, fmt.Errorf("expected %q to be a Private Endpoint Connection ID in the format `{targetResourceId}/privateEndpointConnections/{name}`", input)
+	}
+
+	targetResourceId := "/" + strings.Join(segments[0:8], "/")
+	resourceType, err := ResourceTypeForId(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := approverBuilders[resourceType]; !ok {
+		return nil, fmt.Errorf("the Resource Type %q isn't supported, supported Resource Types are %s", resourceType, strings.Join(SupportedResourceTypes(), ", "))
+	}
+
+	id := NewConnectionID(targetResourceId, segments[9])
+	return &id, nil
+}
+
+func ValidateTargetResourceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	resourceType, err := ResourceTypeForId(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q: %+v", k, err))
+		return
+	}
+
+	if _, ok := approverBuilders[resourceType]; !ok {
+		errors = append(errors, fmt.Errorf("%q: the Resource Type %q isn't supported, supported Resource Types are %s", k, resourceType, strings.Join(SupportedResourceTypes(), ", ")))
+	}
+
+	return
+}
+
+func ValidateConnectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseConnectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"testing"
+)
+
+func TestParseConnectionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ConnectionId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing connection name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1/privateEndpointConnections/",
+			Error: true,
+		},
+		{
+			// missing privateEndpointConnections segment
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1",
+			Error: true,
+		},
+		{
+			// unsupported resource type
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/privateEndpointConnections/connection1",
+			Error: true,
+		},
+		{
+			// nested resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/privateEndpointConnections/connection1",
+			Error: true,
+		},
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1/privateEndpointConnections/connection1",
+			Expected: &ConnectionId{
+				TargetResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1",
+				Name:             "connection1",
+			},
+		},
+		{
+			// valid with different casing
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/PrivateEndpointConnections/connection1",
+			Expected: &ConnectionId{
+				TargetResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1",
+				Name:             "connection1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseConnectionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.TargetResourceId != v.Expected.TargetResourceId {
+			t.Fatalf("Expected %q but got %q for TargetResourceId", v.Expected.TargetResourceId, actual.TargetResourceId)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
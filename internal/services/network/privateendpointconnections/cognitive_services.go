@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/privateendpointconnections"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type cognitiveServicesApprover struct {
+	client *privateendpointconnections.PrivateEndpointConnectionsClient
+}
+
+func newCognitiveServicesApprover(api environments.Api, configure func(c *resourcemanager.Client)) (approver, error) {
+	client, err := privateendpointconnections.NewPrivateEndpointConnectionsClientWithBaseURI(api)
+	if err != nil {
+		return nil, err
+	}
+	configure(client.Client)
+
+	return cognitiveServicesApprover{client: client}, nil
+}
+
+func (a cognitiveServicesApprover) list(ctx context.Context, targetResourceId string) (*[]Connection, error) {
+	id, err := privateendpointconnections.ParseAccountIDInsensitively(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.List(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("listing Private Endpoint Connections for %s: %+v", *id, err)
+	}
+
+	out := make([]Connection, 0)
+	if model := resp.Model; model != nil && model.Value != nil {
+		for _, item := range *model.Value {
+			out = append(out, flattenCognitiveServicesConnection(item))
+		}
+	}
+
+	return &out, nil
+}
+
+func (a cognitiveServicesApprover) get(ctx context.Context, connectionId string) (*Connection, error) {
+	id, err := privateendpointconnections.ParsePrivateEndpointConnectionIDInsensitively(connectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
+	out := flattenCognitiveServicesConnection(*resp.Model)
+	return &out, nil
+}
+
+func (a cognitiveServicesApprover) approve(ctx context.Context, connectionId string, description string) error {
+	id, err := privateendpointconnections.ParsePrivateEndpointConnectionIDInsensitively(connectionId)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	payload := *resp.Model
+	payload.Properties.PrivateLinkServiceConnectionState = privateendpointconnections.PrivateLinkServiceConnectionState{
+		Description: pointer.To(description),
+		Status:      pointer.To(privateendpointconnections.PrivateEndpointServiceConnectionStatusApproved),
+	}
+
+	if err := a.client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("approving %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func flattenCognitiveServicesConnection(input privateendpointconnections.PrivateEndpointConnection) Connection {
+	out := Connection{
+		Id:   pointer.From(input.Id),
+		Name: pointer.From(input.Name),
+	}
+
+	if props := input.Properties; props != nil {
+		if props.PrivateEndpoint != nil {
+			out.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+		}
+		out.Status = string(pointer.From(props.PrivateLinkServiceConnectionState.Status))
+		out.Description = pointer.From(props.PrivateLinkServiceConnectionState.Description)
+	}
+
+	return out
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2025-04-15/privateendpointconnections"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type cosmosDBApprover struct {
+	client *privateendpointconnections.PrivateEndpointConnectionsClient
+}
+
+func newCosmosDBApprover(api environments.Api, configure func(c *resourcemanager.Client)) (approver, error) {
+	client, err := privateendpointconnections.NewPrivateEndpointConnectionsClientWithBaseURI(api)
+	if err != nil {
+		return nil, err
+	}
+	configure(client.Client)
+
+	return cosmosDBApprover{client: client}, nil
+}
+
+func (a cosmosDBApprover) list(ctx context.Context, targetResourceId string) (*[]Connection, error) {
+	id, err := privateendpointconnections.ParseDatabaseAccountIDInsensitively(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.ListByDatabaseAccount(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("listing Private Endpoint Connections for %s: %+v", *id, err)
+	}
+
+	out := make([]Connection, 0)
+	if model := resp.Model; model != nil && model.Value != nil {
+		for _, item := range *model.Value {
+			out = append(out, flattenCosmosDBConnection(item))
+		}
+	}
+
+	return &out, nil
+}
+
+func (a cosmosDBApprover) get(ctx context.Context, connectionId string) (*Connection, error) {
+	id, err := privateendpointconnections.ParsePrivateEndpointConnectionIDInsensitively(connectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
+	out := flattenCosmosDBConnection(*resp.Model)
+	return &out, nil
+}
+
+func (a cosmosDBApprover) approve(ctx context.Context, connectionId string, description string) error {
+	id, err := privateendpointconnections.ParsePrivateEndpointConnectionIDInsensitively(connectionId)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	payload := *resp.Model
+	payload.Properties.PrivateLinkServiceConnectionState = &privateendpointconnections.PrivateLinkServiceConnectionStateProperty{
+		Description: pointer.To(description),
+		Status:      pointer.To(StatusApproved),
+	}
+
+	if err := a.client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("approving %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func flattenCosmosDBConnection(input privateendpointconnections.PrivateEndpointConnection) Connection {
+	out := Connection{
+		Id:   pointer.From(input.Id),
+		Name: pointer.From(input.Name),
+	}
+
+	if props := input.Properties; props != nil {
+		if props.PrivateEndpoint != nil {
+			out.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+		}
+		if state := props.PrivateLinkServiceConnectionState; state != nil {
+			out.Status = pointer.From(state.Status)
+			out.Description = pointer.From(state.Description)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/privateendpointconnections"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type keyVaultApprover struct {
+	client *privateendpointconnections.PrivateEndpointConnectionsClient
+}
+
+func newKeyVaultApprover(api environments.Api, configure func(c *resourcemanager.Client)) (approver, error) {
+	client, err := privateendpointconnections.NewPrivateEndpointConnectionsClientWithBaseURI(api)
+	if err != nil {
+		return nil, err
+	}
+	configure(client.Client)
+
+	return keyVaultApprover{client: client}, nil
+}
+
+func (a keyVaultApprover) list(ctx context.Context, targetResourceId string) (*[]Connection, error) {
+	id, err := commonids.ParseKeyVaultIDInsensitively(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.ListByResourceComplete(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.LatestHttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("listing Private Endpoint Connections for %s: %+v", *id, err)
+	}
+
+	out := make([]Connection, 0)
+	for _, item := range resp.Items {
+		out = append(out, flattenKeyVaultConnection(item))
+	}
+
+	return &out, nil
+}
+
+func (a keyVaultApprover) get(ctx context.Context, connectionId string) (*Connection, error) {
+	id, err := commonids.ParseKeyVaultPrivateEndpointConnectionIDInsensitively(connectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
+	out := flattenKeyVaultConnection(*resp.Model)
+	return &out, nil
+}
+
+func (a keyVaultApprover) approve(ctx context.Context, connectionId string, description string) error {
+	id, err := commonids.ParseKeyVaultPrivateEndpointConnectionIDInsensitively(connectionId)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	payload := *resp.Model
+	payload.Properties.PrivateLinkServiceConnectionState = &privateendpointconnections.PrivateLinkServiceConnectionState{
+		Description: pointer.To(description),
+		Status:      pointer.To(privateendpointconnections.PrivateEndpointServiceConnectionStatusApproved),
+	}
+
+	if _, err := a.client.Put(ctx, *id, payload); err != nil {
+		return fmt.Errorf("approving %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func flattenKeyVaultConnection(input privateendpointconnections.PrivateEndpointConnection) Connection {
+	out := Connection{
+		Id:   pointer.From(input.Id),
+		Name: pointer.From(input.Name),
+	}
+
+	if props := input.Properties; props != nil {
+		if props.PrivateEndpoint != nil {
+			out.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+		}
+		if state := props.PrivateLinkServiceConnectionState; state != nil {
+			out.Status = string(pointer.From(state.Status))
+			out.Description = pointer.From(state.Description)
+		}
+	}
+
+	return out
+}
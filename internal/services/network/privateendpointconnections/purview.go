@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-12-01/privateendpointconnection"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type purviewApprover struct {
+	client *privateendpointconnection.PrivateEndpointConnectionClient
+}
+
+func newPurviewApprover(api environments.Api, configure func(c *resourcemanager.Client)) (approver, error) {
+	client, err := privateendpointconnection.NewPrivateEndpointConnectionClientWithBaseURI(api)
+	if err != nil {
+		return nil, err
+	}
+	configure(client.Client)
+
+	return purviewApprover{client: client}, nil
+}
+
+func (a purviewApprover) list(ctx context.Context, targetResourceId string) (*[]Connection, error) {
+	id, err := privateendpointconnection.ParseAccountIDInsensitively(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.ListByAccountComplete(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.LatestHttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("listing Private Endpoint Connections for %s: %+v", *id, err)
+	}
+
+	out := make([]Connection, 0)
+	for _, item := range resp.Items {
+		out = append(out, flattenPurviewConnection(item))
+	}
+
+	return &out, nil
+}
+
+func (a purviewApprover) get(ctx context.Context, connectionId string) (*Connection, error) {
+	id, err := privateendpointconnection.ParsePrivateEndpointConnectionIDInsensitively(connectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
+	out := flattenPurviewConnection(*resp.Model)
+	return &out, nil
+}
+
+func (a purviewApprover) approve(ctx context.Context, connectionId string, description string) error {
+	id, err := privateendpointconnection.ParsePrivateEndpointConnectionIDInsensitively(connectionId)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	payload := *resp.Model
+	payload.Properties.PrivateLinkServiceConnectionState = &privateendpointconnection.PrivateLinkServiceConnectionState{
+		Description: pointer.To(description),
+		Status:      pointer.To(privateendpointconnection.StatusApproved),
+	}
+
+	if err := a.client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("approving %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func flattenPurviewConnection(input privateendpointconnection.PrivateEndpointConnection) Connection {
+	out := Connection{
+		Id:   pointer.From(input.Id),
+		Name: pointer.From(input.Name),
+	}
+
+	if props := input.Properties; props != nil {
+		if props.PrivateEndpoint != nil {
+			out.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+		}
+		if state := props.PrivateLinkServiceConnectionState; state != nil {
+			out.Status = string(pointer.From(state.Status))
+			out.Description = pointer.From(state.Description)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/privateendpointconnections"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type searchApprover struct {
+	client *privateendpointconnections.PrivateEndpointConnectionsClient
+}
+
+func newSearchApprover(api environments.Api, configure func(c *resourcemanager.Client)) (approver, error) {
+	client, err := privateendpointconnections.NewPrivateEndpointConnectionsClientWithBaseURI(api)
+	if err != nil {
+		return nil, err
+	}
+	configure(client.Client)
+
+	return searchApprover{client: client}, nil
+}
+
+func (a searchApprover) list(ctx context.Context, targetResourceId string) (*[]Connection, error) {
+	id, err := privateendpointconnections.ParseSearchServiceIDInsensitively(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.ListByServiceComplete(ctx, *id, privateendpointconnections.DefaultListByServiceOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.LatestHttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("listing Private Endpoint Connections for %s: %+v", *id, err)
+	}
+
+	out := make([]Connection, 0)
+	for _, item := range resp.Items {
+		out = append(out, flattenSearchConnection(item))
+	}
+
+	return &out, nil
+}
+
+func (a searchApprover) get(ctx context.Context, connectionId string) (*Connection, error) {
+	id, err := privateendpointconnections.ParsePrivateEndpointConnectionIDInsensitively(connectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Get(ctx, *id, privateendpointconnections.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
+	out := flattenSearchConnection(*resp.Model)
+	return &out, nil
+}
+
+func (a searchApprover) approve(ctx context.Context, connectionId string, description string) error {
+	id, err := privateendpointconnections.ParsePrivateEndpointConnectionIDInsensitively(connectionId)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.Get(ctx, *id, privateendpointconnections.DefaultGetOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	payload := *resp.Model
+	payload.Properties.PrivateLinkServiceConnectionState = &privateendpointconnections.PrivateEndpointConnectionPropertiesPrivateLinkServiceConnectionState{
+		Description: pointer.To(description),
+		Status:      pointer.To(privateendpointconnections.PrivateLinkServiceConnectionStatusApproved),
+	}
+
+	if _, err := a.client.Update(ctx, *id, payload, privateendpointconnections.DefaultUpdateOperationOptions()); err != nil {
+		return fmt.Errorf("approving %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func flattenSearchConnection(input privateendpointconnections.PrivateEndpointConnection) Connection {
+	out := Connection{
+		Id:   pointer.From(input.Id),
+		Name: pointer.From(input.Name),
+	}
+
+	if props := input.Properties; props != nil {
+		if props.PrivateEndpoint != nil {
+			out.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+		}
+		if state := props.PrivateLinkServiceConnectionState; state != nil {
+			out.Status = string(pointer.From(state.Status))
+			out.Description = pointer.From(state.Description)
+		}
+	}
+
+	return out
+}
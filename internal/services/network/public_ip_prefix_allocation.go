@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/publicipprefixes"
+)
+
+func flattenPublicIpPrefixPublicIpAddressIds(input *[]publicipprefixes.ReferencedPublicIPAddress) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if v.Id != nil {
+			output = append(output, *v.Id)
+		}
+	}
+
+	return output
+}
+
+// publicIpPrefixAvailableIpAddressCount returns the number of IP Addresses within the Public IP Prefix which haven't
+// yet been allocated to a Public IP.
+func publicIpPrefixAvailableIpAddressCount(prefixLength int64, ipVersion publicipprefixes.IPVersion, allocated int) int {
+	bits := int64(32)
+	if ipVersion == publicipprefixes.IPVersionIPvSix {
+		bits = 128
+	}
+	if prefixLength < 0 || prefixLength > bits {
+		return 0
+	}
+
+	total := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefixLength))
+	available := total.Sub(total, big.NewInt(int64(allocated)))
+	if available.Sign() < 0 {
+		return 0
+	}
+	if !available.IsInt64() || available.Int64() > int64(^uint32(0)>>1) {
+		// the count of an IPv6 prefix can exceed the range of the schema type, which is never reachable in practice
+		return int(^uint32(0) >> 1)
+	}
+
+	return int(available.Int64())
+}
+
+// publicIpPrefixIndexOfIpAddress returns the offset of the IP Address within the Public IP Prefix, which is the index
+// that Azure assigned the Public IP when allocating it from the prefix.
+func publicIpPrefixIndexOfIpAddress(ipPrefix string, ipAddress string) (int, error) {
+	prefix, err := netip.ParsePrefix(ipPrefix)
+	if err != nil {
+		return 0, fmt.Errorf("parsing IP Prefix %q: %+v", ipPrefix, err)
+	}
+
+	address, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return 0, fmt.Errorf("parsing IP Address %q: %+v", ipAddress, err)
+	}
+
+	if !prefix.Contains(address) {
+		return 0, fmt.Errorf("IP Address %q is not within the IP Prefix %q", ipAddress, ipPrefix)
+	}
+
+	start := prefix.Masked().Addr().AsSlice()
+	offset := new(big.Int).Sub(new(big.Int).SetBytes(address.AsSlice()), new(big.Int).SetBytes(start))
+
+	return int(offset.Int64()), nil
+}
+
+// publicIpPrefixIndexForPublicIp retrieves the Public IP Prefix which the Public IP was allocated from and returns the
+// index of the Public IP within it, or nil if either the prefix or the IP Address isn't available.
+func publicIpPrefixIndexForPublicIp(ctx context.Context, client *publicipprefixes.PublicIPPrefixesClient, publicIpPrefixId string, ipAddress string) (*int, error) {
+	if ipAddress == "" {
+		return nil, nil
+	}
+
+	id, err := publicipprefixes.ParsePublicIPPrefixIDInsensitively(publicIpPrefixId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, *id, publicipprefixes.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.IPPrefix == nil {
+		return nil, nil
+	}
+
+	index, err := publicIpPrefixIndexOfIpAddress(*resp.Model.Properties.IPPrefix, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(index), nil
+}
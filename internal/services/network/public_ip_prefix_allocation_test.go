@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/publicipprefixes"
+)
+
+func TestPublicIpPrefixAvailableIpAddressCount(t *testing.T) {
+	testData := []struct {
+		PrefixLength int64
+		IpVersion    publicipprefixes.IPVersion
+		Allocated    int
+		Expected     int
+	}{
+		{
+			PrefixLength: 28,
+			IpVersion:    publicipprefixes.IPVersionIPvFour,
+			Allocated:    0,
+			Expected:     16,
+		},
+		{
+			PrefixLength: 31,
+			IpVersion:    publicipprefixes.IPVersionIPvFour,
+			Allocated:    1,
+			Expected:     1,
+		},
+		{
+			PrefixLength: 31,
+			IpVersion:    publicipprefixes.IPVersionIPvFour,
+			Allocated:    3,
+			Expected:     0,
+		},
+		{
+			PrefixLength: 126,
+			IpVersion:    publicipprefixes.IPVersionIPvSix,
+			Allocated:    2,
+			Expected:     2,
+		},
+		{
+			// out of range
+			PrefixLength: 33,
+			IpVersion:    publicipprefixes.IPVersionIPvFour,
+			Expected:     0,
+		},
+		{
+			// exceeds the range of the schema type
+			PrefixLength: 64,
+			IpVersion:    publicipprefixes.IPVersionIPvSix,
+			Expected:     2147483647,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing /%d (%s) with %d allocated", v.PrefixLength, v.IpVersion, v.Allocated)
+
+		actual := publicIpPrefixAvailableIpAddressCount(v.PrefixLength, v.IpVersion, v.Allocated)
+		if actual != v.Expected {
+			t.Fatalf("expected %d but got %d", v.Expected, actual)
+		}
+	}
+}
+
+func TestPublicIpPrefixIndexOfIpAddress(t *testing.T) {
+	testData := []struct {
+		IpPrefix  string
+		IpAddress string
+		Expected  int
+		Error     bool
+	}{
+		{
+			IpPrefix:  "20.1.2.0/28",
+			IpAddress: "20.1.2.0",
+			Expected:  0,
+		},
+		{
+			IpPrefix:  "20.1.2.0/28",
+			IpAddress: "20.1.2.13",
+			Expected:  13,
+		},
+		{
+			IpPrefix:  "2603:1030:10::/126",
+			IpAddress: "2603:1030:10::3",
+			Expected:  3,
+		},
+		{
+			// not within the prefix
+			IpPrefix:  "20.1.2.0/28",
+			IpAddress: "20.1.2.16",
+			Error:     true,
+		},
+		{
+			IpPrefix:  "not-a-prefix",
+			IpAddress: "20.1.2.1",
+			Error:     true,
+		},
+		{
+			IpPrefix:  "20.1.2.0/28",
+			IpAddress: "not-an-address",
+			Error:     true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q within %q", v.IpAddress, v.IpPrefix)
+
+		actual, err := publicIpPrefixIndexOfIpAddress(v.IpPrefix, v.IpAddress)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %d but got %d", v.Expected, actual)
+		}
+	}
+}
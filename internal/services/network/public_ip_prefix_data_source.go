@@ -57,6 +57,19 @@ func dataSourcePublicIpPrefix() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"public_ip_address_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"available_ip_address_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
 			"zones": commonschema.ZonesMultipleComputed(),
 
 			"tags": commonschema.TagsDataSource(),
@@ -92,6 +105,12 @@ func dataSourcePublicIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) e
 		if props := model.Properties; props != nil {
 			d.Set("prefix_length", props.PrefixLength)
 			d.Set("ip_prefix", props.IPPrefix)
+
+			publicIpAddressIds := flattenPublicIpPrefixPublicIpAddressIds(props.PublicIPAddresses)
+			if err := d.Set("public_ip_address_ids", publicIpAddressIds); err != nil {
+				return fmt.Errorf("setting `public_ip_address_ids`: %+v", err)
+			}
+			d.Set("available_ip_address_count", publicIpPrefixAvailableIpAddressCount(pointer.From(props.PrefixLength), pointer.From(props.PublicIPAddressVersion), len(publicIpAddressIds)))
 		}
 		return tags.FlattenAndSet(d, model.Tags)
 	}
@@ -108,6 +108,19 @@ func resourcePublicIpPrefix() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"public_ip_address_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"available_ip_address_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -235,6 +248,12 @@ func resourcePublicIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) err
 				customIpPrefixId = id.ID()
 			}
 			d.Set("custom_ip_prefix_id", customIpPrefixId)
+
+			publicIpAddressIds := flattenPublicIpPrefixPublicIpAddressIds(props.PublicIPAddresses)
+			if err := d.Set("public_ip_address_ids", publicIpAddressIds); err != nil {
+				return fmt.Errorf("setting `public_ip_address_ids`: %+v", err)
+			}
+			d.Set("available_ip_address_count", publicIpPrefixAvailableIpAddressCount(pointer.From(props.PrefixLength), pointer.From(props.PublicIPAddressVersion), len(publicIpAddressIds)))
 		}
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return err
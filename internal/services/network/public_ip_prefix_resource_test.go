@@ -210,6 +210,31 @@ func TestAccPublicIpPrefix_update(t *testing.T) {
 	})
 }
 
+func TestAccPublicIpPrefix_publicIpAllocation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_public_ip_prefix", "test")
+	r := PublicIpPrefixResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.publicIpAllocation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_public_ip.test").Key("public_ip_prefix_index").Exists(),
+			),
+		},
+		{
+			// the Public IP is allocated after the prefix has been read, so this is reflected once it's been refreshed
+			Config: r.publicIpAllocation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_ip_address_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("available_ip_address_count").HasValue("15"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccPublicIpPrefix_disappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_public_ip_prefix", "test")
 	r := PublicIpPrefixResource{}
@@ -271,6 +296,20 @@ resource "azurerm_public_ip_prefix" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (r PublicIpPrefixResource) publicIpAllocation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  public_ip_prefix_id = azurerm_public_ip_prefix.test.id
+}
+`, r.basic(data), data.RandomInteger)
+}
+
 func (PublicIpPrefixResource) ipv6(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
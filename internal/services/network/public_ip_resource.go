@@ -165,6 +165,11 @@ func resourcePublicIp() *pluginsdk.Resource {
 				ValidateFunc: publicipprefixes.ValidatePublicIPPrefixID,
 			},
 
+			"public_ip_prefix_index": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
 			"ip_tags": {
 				Type:     pluginsdk.TypeMap,
 				Optional: true,
@@ -451,6 +456,15 @@ func resourcePublicIpRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 			d.Set("ip_address", props.IPAddress)
 			d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
+
+			var publicIpPrefixIndex *int
+			if publicIpPrefix := props.PublicIPPrefix; publicIpPrefix != nil && publicIpPrefix.Id != nil {
+				publicIpPrefixIndex, err = publicIpPrefixIndexForPublicIp(ctx, meta.(*clients.Client).Network.PublicIPPrefixes, *publicIpPrefix.Id, pointer.From(props.IPAddress))
+				if err != nil {
+					return fmt.Errorf("determining the index of %s within the Public IP Prefix: %+v", id, err)
+				}
+			}
+			d.Set("public_ip_prefix_index", pointer.From(publicIpPrefixIndex))
 		}
 		return tags.FlattenAndSet(d, model.Tags)
 	}
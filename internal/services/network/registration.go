@@ -37,6 +37,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 		ManagerDataSource{},
 		ManagerNetworkGroupDataSource{},
 		ManagerConnectivityConfigurationDataSource{},
+		SubnetDelegationCapacityDataSource{},
 		VPNServerConfigurationDataSource{},
 		VirtualNetworkPeeringDataSource{},
 	}
@@ -45,6 +46,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		CustomIpPrefixResource{},
+		ManagedPrivateEndpointApprovalResource{},
 		ManagerAdminRuleResource{},
 		ManagerAdminRuleCollectionResource{},
 		ManagerDeploymentResource{},
@@ -63,6 +65,7 @@ func (r Registration) Resources() []sdk.Resource {
 		PrivateEndpointApplicationSecurityGroupAssociationResource{},
 		RouteMapResource{},
 		VirtualHubRoutingIntentResource{},
+		VpnSiteBulkResource{},
 	}
 }
 
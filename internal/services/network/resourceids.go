@@ -38,3 +38,8 @@ package network
 
 // Network
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=NetworkInterfaceIpConfiguration -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/networkInterface1/ipConfigurations/config1
+
+// Virtual WAN
+// VPN Site Bulks are Terraform specific - a set of VPN Sites within a Virtual WAN is managed as a single resource, but
+// there's no such resource in ARM, so this is composed of the Virtual WAN ID and the name of the set
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=VpnSiteBulk -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualWans/virtualWan1/vpnSiteBulks/bulk1
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/subnets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// SubnetDelegationCapacityDataSource checks that a Subnet is delegated to a given service and has enough free IP
+// addresses, so that services which inject into a delegated Subnet (e.g. Oracle Database, NetApp or Container Apps)
+// fail during the plan rather than part-way through a long-running create.
+type SubnetDelegationCapacityDataSource struct{}
+
+var _ sdk.DataSource = SubnetDelegationCapacityDataSource{}
+
+type SubnetDelegationCapacityDataSourceModel struct {
+	SubnetId                string   `tfschema:"subnet_id"`
+	ServiceDelegationName   string   `tfschema:"service_delegation_name"`
+	RequiredIPAddressCount  int64    `tfschema:"required_ip_address_count"`
+	DelegatedServiceNames   []string `tfschema:"delegated_service_names"`
+	TotalIPAddressCount     int64    `tfschema:"total_ip_address_count"`
+	UsedIPAddressCount      int64    `tfschema:"used_ip_address_count"`
+	AvailableIPAddressCount int64    `tfschema:"available_ip_address_count"`
+}
+
+func (SubnetDelegationCapacityDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateSubnetID,
+		},
+
+		"service_delegation_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"required_ip_address_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+}
+
+func (SubnetDelegationCapacityDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"delegated_service_names": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"total_ip_address_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"used_ip_address_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"available_ip_address_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (SubnetDelegationCapacityDataSource) ModelObject() interface{} {
+	return &SubnetDelegationCapacityDataSourceModel{}
+}
+
+func (SubnetDelegationCapacityDataSource) ResourceType() string {
+	return "azurerm_subnet_delegation_capacity"
+}
+
+func (SubnetDelegationCapacityDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			subnetsClient := metadata.Client.Network.Client.Subnets
+			virtualNetworksClient := metadata.Client.Network.Client.VirtualNetworks
+
+			var state SubnetDelegationCapacityDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseSubnetID(state.SubnetId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := subnetsClient.Get(ctx, *id, subnets.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			delegatedServiceNames := make([]string, 0)
+			if model := resp.Model; model != nil && model.Properties != nil {
+				for _, delegation := range pointer.From(model.Properties.Delegations) {
+					if delegation.Properties != nil && delegation.Properties.ServiceName != nil {
+						delegatedServiceNames = append(delegatedServiceNames, *delegation.Properties.ServiceName)
+					}
+				}
+			}
+			state.DelegatedServiceNames = delegatedServiceNames
+
+			// the usage of each Subnet is only exposed at the Virtual Network level
+			virtualNetworkId := commonids.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName)
+			usages, err := virtualNetworksClient.VirtualNetworksListUsageComplete(ctx, virtualNetworkId)
+			if err != nil {
+				return fmt.Errorf("listing usages for %s: %+v", virtualNetworkId, err)
+			}
+
+			found := false
+			for _, usage := range usages.Items {
+				if usage.Id == nil {
+					continue
+				}
+				usageSubnetId, err := commonids.ParseSubnetIDInsensitively(*usage.Id)
+				if err != nil || !strings.EqualFold(usageSubnetId.SubnetName, id.SubnetName) {
+					continue
+				}
+
+				found = true
+				state.TotalIPAddressCount = int64(pointer.From(usage.Limit))
+				state.UsedIPAddressCount = int64(pointer.From(usage.CurrentValue))
+				state.AvailableIPAddressCount = state.TotalIPAddressCount - state.UsedIPAddressCount
+				break
+			}
+			if !found {
+				return fmt.Errorf("retrieving the usage of %s: no usage was returned for the Subnet", id)
+			}
+
+			if state.ServiceDelegationName != "" && !subnetIsDelegatedTo(delegatedServiceNames, state.ServiceDelegationName) {
+				if len(delegatedServiceNames) == 0 {
+					return fmt.Errorf("%s must be delegated to `%s` but is not delegated to any service", id, state.ServiceDelegationName)
+				}
+				return fmt.Errorf("%s must be delegated to `%s` but is delegated to `%s`", id, state.ServiceDelegationName, strings.Join(delegatedServiceNames, "`, `"))
+			}
+
+			if state.RequiredIPAddressCount > 0 && state.AvailableIPAddressCount < state.RequiredIPAddressCount {
+				return fmt.Errorf("%s requires at least %d available IP addresses but only %d of %d are available", id, state.RequiredIPAddressCount, state.AvailableIPAddressCount, state.TotalIPAddressCount)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func subnetIsDelegatedTo(delegatedServiceNames []string, serviceName string) bool {
+	for _, v := range delegatedServiceNames {
+		if strings.EqualFold(v, serviceName) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SubnetDelegationCapacityDataSource struct{}
+
+func TestAccDataSourceSubnetDelegationCapacity_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_subnet_delegation_capacity", "test")
+	r := SubnetDelegationCapacityDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("delegated_service_names.#").HasValue("1"),
+				check.That(data.ResourceName).Key("delegated_service_names.0").HasValue("Microsoft.Netapp/volumes"),
+				check.That(data.ResourceName).Key("total_ip_address_count").HasValue("251"),
+				check.That(data.ResourceName).Key("used_ip_address_count").HasValue("0"),
+				check.That(data.ResourceName).Key("available_ip_address_count").HasValue("251"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceSubnetDelegationCapacity_wrongDelegation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_subnet_delegation_capacity", "test")
+	r := SubnetDelegationCapacityDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      r.wrongDelegation(data),
+			ExpectError: regexp.MustCompile("must be delegated to `Oracle.Database/networkAttachments`"),
+		},
+	})
+}
+
+func TestAccDataSourceSubnetDelegationCapacity_insufficientCapacity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_subnet_delegation_capacity", "test")
+	r := SubnetDelegationCapacityDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      r.insufficientCapacity(data),
+			ExpectError: regexp.MustCompile("requires at least 512 available IP addresses"),
+		},
+	})
+}
+
+func (SubnetDelegationCapacityDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+
+  delegation {
+    name = "netapp"
+
+    service_delegation {
+      name    = "Microsoft.Netapp/volumes"
+      actions = ["Microsoft.Network/networkinterfaces/*", "Microsoft.Network/virtualNetworks/subnets/join/action"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r SubnetDelegationCapacityDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_subnet_delegation_capacity" "test" {
+  subnet_id                 = azurerm_subnet.test.id
+  service_delegation_name   = "Microsoft.Netapp/volumes"
+  required_ip_address_count = 16
+}
+`, r.template(data))
+}
+
+func (r SubnetDelegationCapacityDataSource) wrongDelegation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_subnet_delegation_capacity" "test" {
+  subnet_id               = azurerm_subnet.test.id
+  service_delegation_name = "Oracle.Database/networkAttachments"
+}
+`, r.template(data))
+}
+
+func (r SubnetDelegationCapacityDataSource) insufficientCapacity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_subnet_delegation_capacity" "test" {
+  subnet_id                 = azurerm_subnet.test.id
+  required_ip_address_count = 512
+}
+`, r.template(data))
+}
@@ -81,14 +81,7 @@ func resourceSubnetServiceEndpointStoragePolicy() *pluginsdk.Resource {
 								ValidateFunc: validation.Any(
 									azure.ValidateResourceID,
 									mgValidate.ManagementGroupID,
-									validation.StringInSlice([]string{
-										"/services/Azure",
-										"/services/Azure/Batch",
-										"/services/Azure/DataFactory",
-										"/services/Azure/MachineLearning",
-										"/services/Azure/ManagedInstance",
-										"/services/Azure/WebPI",
-									}, false),
+									validation.StringInSlice(serviceEndpointPolicyServiceAliases(), false),
 								),
 							},
 						},
@@ -112,11 +105,38 @@ func resourceSubnetServiceEndpointStoragePolicy() *pluginsdk.Resource {
 				},
 			},
 
+			"service_alias": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(serviceEndpointPolicyServiceAliases(), false),
+			},
+
+			"contextual_service_endpoint_policies": {
+				Type:         pluginsdk.TypeSet,
+				Optional:     true,
+				RequiredWith: []string{"service_alias"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
 }
 
+func serviceEndpointPolicyServiceAliases() []string {
+	return []string{
+		"/services/Azure",
+		"/services/Azure/Batch",
+		"/services/Azure/DataFactory",
+		"/services/Azure/MachineLearning",
+		"/services/Azure/ManagedInstance",
+		"/services/Azure/WebPI",
+	}
+}
+
 func resourceSubnetServiceEndpointStoragePolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.ServiceEndpointPolicies
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -144,6 +164,14 @@ func resourceSubnetServiceEndpointStoragePolicyCreate(d *pluginsdk.ResourceData,
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if v := d.Get("service_alias").(string); v != "" {
+		param.Properties.ServiceAlias = pointer.To(v)
+	}
+
+	if v := d.Get("contextual_service_endpoint_policies").(*pluginsdk.Set).List(); len(v) > 0 {
+		param.Properties.ContextualServiceEndpointPolicies = utils.ExpandStringSlice(v)
+	}
+
 	if err := client.CreateOrUpdateThenPoll(ctx, id, param); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -178,11 +206,20 @@ func resourceSubnetServiceEndpointStoragePolicyUpdate(d *pluginsdk.ResourceData,
 	payload := existing.Model
 
 	if d.HasChange("definition") {
-		payload.Properties = &serviceendpointpolicies.ServiceEndpointPolicyPropertiesFormat{
-			ServiceEndpointPolicyDefinitions: expandServiceEndpointPolicyDefinitions(d.Get("definition").([]interface{})),
+		payload.Properties.ServiceEndpointPolicyDefinitions = expandServiceEndpointPolicyDefinitions(d.Get("definition").([]interface{}))
+	}
+
+	if d.HasChange("service_alias") {
+		payload.Properties.ServiceAlias = nil
+		if v := d.Get("service_alias").(string); v != "" {
+			payload.Properties.ServiceAlias = pointer.To(v)
 		}
 	}
 
+	if d.HasChange("contextual_service_endpoint_policies") {
+		payload.Properties.ContextualServiceEndpointPolicies = utils.ExpandStringSlice(d.Get("contextual_service_endpoint_policies").(*pluginsdk.Set).List())
+	}
+
 	if d.HasChange("tags") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
@@ -225,6 +262,11 @@ func resourceSubnetServiceEndpointStoragePolicyRead(d *pluginsdk.ResourceData, m
 			if err := d.Set("definition", flattenServiceEndpointPolicyDefinitions(props.ServiceEndpointPolicyDefinitions)); err != nil {
 				return fmt.Errorf("setting `definition`: %v", err)
 			}
+
+			d.Set("service_alias", pointer.From(props.ServiceAlias))
+			if err := d.Set("contextual_service_endpoint_policies", utils.FlattenStringSlice(props.ContextualServiceEndpointPolicies)); err != nil {
+				return fmt.Errorf("setting `contextual_service_endpoint_policies`: %v", err)
+			}
 		}
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return err
@@ -63,6 +63,36 @@ func TestAccSubnetServiceEndpointStoragePolicy_alias(t *testing.T) {
 	})
 }
 
+func TestAccSubnetServiceEndpointStoragePolicy_serviceAlias(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subnet_service_endpoint_storage_policy", "test")
+	r := SubnetServiceEndpointStoragePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.serviceAlias(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_alias").HasValue("/services/Azure/ManagedInstance"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccSubnetServiceEndpointStoragePolicy_storage(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_subnet_service_endpoint_storage_policy", "test")
 	r := SubnetServiceEndpointStoragePolicyResource{}
@@ -293,6 +323,24 @@ resource "azurerm_subnet_service_endpoint_storage_policy" "test" {
 `, r.template(data), data.RandomString, data.RandomInteger)
 }
 
+func (r SubnetServiceEndpointStoragePolicyResource) serviceAlias(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_service_endpoint_storage_policy" "test" {
+  name                = "acctestSEP-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  service_alias       = "/services/Azure/ManagedInstance"
+
+  contextual_service_endpoint_policies = [
+    "/",
+    azurerm_resource_group.test.id,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r SubnetServiceEndpointStoragePolicyResource) storage(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
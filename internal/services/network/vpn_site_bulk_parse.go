@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+)
+
+type vpnSiteBulkSite struct {
+	Name         string            `json:"name"`
+	AddressCidrs []string          `json:"address_cidrs,omitempty"`
+	DeviceVendor string            `json:"device_vendor,omitempty"`
+	DeviceModel  string            `json:"device_model,omitempty"`
+	Links        []vpnSiteBulkLink `json:"links"`
+}
+
+type vpnSiteBulkLink struct {
+	Name         string              `json:"name"`
+	IpAddress    string              `json:"ip_address,omitempty"`
+	Fqdn         string              `json:"fqdn,omitempty"`
+	ProviderName string              `json:"provider_name,omitempty"`
+	SpeedInMbps  int64               `json:"speed_in_mbps,omitempty"`
+	Bgp          *vpnSiteBulkLinkBgp `json:"bgp,omitempty"`
+}
+
+type vpnSiteBulkLinkBgp struct {
+	Asn            int64  `json:"asn"`
+	PeeringAddress string `json:"peering_address"`
+}
+
+// vpnSiteBulkCsvColumns are the supported columns of the CSV format, where each row describes a single link and rows
+// sharing the same `site_name` are grouped into a single VPN Site
+var vpnSiteBulkCsvColumns = []string{
+	"site_name",
+	"address_cidrs",
+	"device_vendor",
+	"device_model",
+	"link_name",
+	"link_ip_address",
+	"link_fqdn",
+	"link_provider_name",
+	"link_speed_in_mbps",
+	"bgp_asn",
+	"bgp_peering_address",
+}
+
+// parseVpnSiteBulkSites parses and validates the VPN Sites defined in either the CSV or the JSON document
+func parseVpnSiteBulkSites(sitesCsv, sitesJson string) ([]vpnSiteBulkSite, error) {
+	var sites []vpnSiteBulkSite
+	var err error
+
+	switch {
+	case sitesCsv != "":
+		sites, err = parseVpnSiteBulkCsv(sitesCsv)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `sites_csv`: %+v", err)
+		}
+	case sitesJson != "":
+		sites, err = parseVpnSiteBulkJson(sitesJson)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `sites_json`: %+v", err)
+		}
+	default:
+		return nil, nil
+	}
+
+	if err := validateVpnSiteBulkSites(sites); err != nil {
+		return nil, err
+	}
+
+	return sites, nil
+}
+
+func parseVpnSiteBulkJson(input string) ([]vpnSiteBulkSite, error) {
+	decoder := json.NewDecoder(strings.NewReader(input))
+	decoder.DisallowUnknownFields()
+
+	sites := make([]vpnSiteBulkSite, 0)
+	if err := decoder.Decode(&sites); err != nil {
+		return nil, err
+	}
+
+	return sites, nil
+}
+
+func parseVpnSiteBulkCsv(input string) ([]vpnSiteBulkSite, error) {
+	reader := csv.NewReader(bytes.NewBufferString(input))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("the header row was missing")
+		}
+		return nil, err
+	}
+
+	columns := make(map[string]int)
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		if !isVpnSiteBulkCsvColumn(column) {
+			return nil, fmt.Errorf("unsupported column %q, supported columns are: %s", column, strings.Join(vpnSiteBulkCsvColumns, ", "))
+		}
+		if _, exists := columns[column]; exists {
+			return nil, fmt.Errorf("the column %q was specified more than once", column)
+		}
+		columns[column] = i
+	}
+
+	for _, required := range []string{"site_name", "link_name"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("the required column %q was missing", required)
+		}
+	}
+
+	sites := make([]vpnSiteBulkSite, 0)
+	siteIndexes := make(map[string]int)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		value := func(column string) string {
+			if i, ok := columns[column]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		site := vpnSiteBulkSite{
+			Name:         value("site_name"),
+			DeviceVendor: value("device_vendor"),
+			DeviceModel:  value("device_model"),
+		}
+		if v := value("address_cidrs"); v != "" {
+			site.AddressCidrs = strings.Fields(v)
+		}
+
+		link := vpnSiteBulkLink{
+			Name:         value("link_name"),
+			IpAddress:    value("link_ip_address"),
+			Fqdn:         value("link_fqdn"),
+			ProviderName: value("link_provider_name"),
+		}
+
+		if v := value("link_speed_in_mbps"); v != "" {
+			speed, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: parsing `link_speed_in_mbps` %q: %+v", line, v, err)
+			}
+			link.SpeedInMbps = speed
+		}
+
+		if asn, peeringAddress := value("bgp_asn"), value("bgp_peering_address"); asn != "" || peeringAddress != "" {
+			bgp := vpnSiteBulkLinkBgp{
+				PeeringAddress: peeringAddress,
+			}
+			if asn != "" {
+				v, err := strconv.ParseInt(asn, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: parsing `bgp_asn` %q: %+v", line, asn, err)
+				}
+				bgp.Asn = v
+			}
+			link.Bgp = &bgp
+		}
+
+		i, exists := siteIndexes[site.Name]
+		if !exists {
+			site.Links = []vpnSiteBulkLink{link}
+			siteIndexes[site.Name] = len(sites)
+			sites = append(sites, site)
+			continue
+		}
+
+		// the site level columns only need to be specified once, but must not conflict when repeated
+		existing := &sites[i]
+		if err := mergeVpnSiteBulkCsvSiteValue(&existing.DeviceVendor, site.DeviceVendor); err != nil {
+			return nil, fmt.Errorf("line %d: `device_vendor` for site %q: %+v", line, site.Name, err)
+		}
+		if err := mergeVpnSiteBulkCsvSiteValue(&existing.DeviceModel, site.DeviceModel); err != nil {
+			return nil, fmt.Errorf("line %d: `device_model` for site %q: %+v", line, site.Name, err)
+		}
+		if len(site.AddressCidrs) > 0 {
+			if len(existing.AddressCidrs) > 0 && strings.Join(existing.AddressCidrs, " ") != strings.Join(site.AddressCidrs, " ") {
+				return nil, fmt.Errorf("line %d: `address_cidrs` for site %q conflicts with a previous row", line, site.Name)
+			}
+			existing.AddressCidrs = site.AddressCidrs
+		}
+		existing.Links = append(existing.Links, link)
+	}
+
+	return sites, nil
+}
+
+func isVpnSiteBulkCsvColumn(input string) bool {
+	for _, column := range vpnSiteBulkCsvColumns {
+		if column == input {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeVpnSiteBulkCsvSiteValue(existing *string, value string) error {
+	if value == "" {
+		return nil
+	}
+	if *existing != "" && *existing != value {
+		return fmt.Errorf("%q conflicts with the previously specified value %q", value, *existing)
+	}
+	*existing = value
+	return nil
+}
+
+func validateVpnSiteBulkSites(sites []vpnSiteBulkSite) error {
+	if len(sites) == 0 {
+		return errors.New("at least one VPN Site must be specified")
+	}
+
+	siteNames := make(map[string]struct{})
+	for _, site := range sites {
+		if site.Name == "" {
+			return errors.New("the `name` of a VPN Site must not be empty")
+		}
+		if _, errs := validate.VpnSiteName()(site.Name, "name"); len(errs) > 0 {
+			return fmt.Errorf("VPN Site %q: %+v", site.Name, errors.Join(errs...))
+		}
+		if _, exists := siteNames[strings.ToLower(site.Name)]; exists {
+			return fmt.Errorf("the VPN Site %q was specified more than once", site.Name)
+		}
+		siteNames[strings.ToLower(site.Name)] = struct{}{}
+
+		for _, cidr := range site.AddressCidrs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return fmt.Errorf("VPN Site %q: %q is not a valid CIDR", site.Name, cidr)
+			}
+		}
+
+		if len(site.Links) == 0 {
+			return fmt.Errorf("VPN Site %q: at least one link must be specified", site.Name)
+		}
+
+		linkNames := make(map[string]struct{})
+		for _, link := range site.Links {
+			if link.Name == "" {
+				return fmt.Errorf("VPN Site %q: the `name` of a link must not be empty", site.Name)
+			}
+			if _, exists := linkNames[strings.ToLower(link.Name)]; exists {
+				return fmt.Errorf("VPN Site %q: the link %q was specified more than once", site.Name, link.Name)
+			}
+			linkNames[strings.ToLower(link.Name)] = struct{}{}
+
+			if (link.IpAddress == "") == (link.Fqdn == "") {
+				return fmt.Errorf("VPN Site %q: link %q: exactly one of `ip_address` or `fqdn` must be specified", site.Name, link.Name)
+			}
+			if link.IpAddress != "" && net.ParseIP(link.IpAddress) == nil {
+				return fmt.Errorf("VPN Site %q: link %q: %q is not a valid IP Address", site.Name, link.Name, link.IpAddress)
+			}
+			if link.SpeedInMbps < 0 {
+				return fmt.Errorf("VPN Site %q: link %q: `speed_in_mbps` must not be negative", site.Name, link.Name)
+			}
+
+			if bgp := link.Bgp; bgp != nil {
+				if bgp.Asn < 1 {
+					return fmt.Errorf("VPN Site %q: link %q: the BGP `asn` must be at least 1", site.Name, link.Name)
+				}
+				if net.ParseIP(bgp.PeeringAddress) == nil {
+					return fmt.Errorf("VPN Site %q: link %q: the BGP `peering_address` %q is not a valid IP Address", site.Name, link.Name, bgp.PeeringAddress)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseVpnSiteBulkSitesCsv(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected []vpnSiteBulkSite
+		Error    bool
+	}{
+		{
+			// header only
+			Input: "site_name,link_name,link_ip_address\n",
+			Error: true,
+		},
+		{
+			// missing required column
+			Input: "site_name,link_ip_address\nsite1,10.0.0.1\n",
+			Error: true,
+		},
+		{
+			// unsupported column
+			Input: "site_name,link_name,link_ip_address,foo\nsite1,link1,10.0.0.1,bar\n",
+			Error: true,
+		},
+		{
+			// both ip address and fqdn
+			Input: "site_name,link_name,link_ip_address,link_fqdn\nsite1,link1,10.0.0.1,foo.com\n",
+			Error: true,
+		},
+		{
+			// duplicate link
+			Input: "site_name,link_name,link_ip_address\nsite1,link1,10.0.0.1\nsite1,link1,10.0.0.2\n",
+			Error: true,
+		},
+		{
+			// conflicting site level values
+			Input: "site_name,device_vendor,link_name,link_ip_address\nsite1,Cisco,link1,10.0.0.1\nsite1,Juniper,link2,10.0.0.2\n",
+			Error: true,
+		},
+		{
+			// invalid bgp asn
+			Input: "site_name,link_name,link_ip_address,bgp_asn,bgp_peering_address\nsite1,link1,10.0.0.1,0,10.0.0.1\n",
+			Error: true,
+		},
+		{
+			Input: `site_name,address_cidrs,device_vendor,link_name,link_ip_address,link_fqdn,link_speed_in_mbps,bgp_asn,bgp_peering_address
+site1,10.0.0.0/24 10.0.1.0/24,Cisco,link1,10.0.0.1,,50,65001,10.0.0.1
+site1,,,link2,,foo.com,,,
+site2,10.1.0.0/24,,link1,10.1.0.1,,,,
+`,
+			Expected: []vpnSiteBulkSite{
+				{
+					Name:         "site1",
+					AddressCidrs: []string{"10.0.0.0/24", "10.0.1.0/24"},
+					DeviceVendor: "Cisco",
+					Links: []vpnSiteBulkLink{
+						{
+							Name:        "link1",
+							IpAddress:   "10.0.0.1",
+							SpeedInMbps: 50,
+							Bgp: &vpnSiteBulkLinkBgp{
+								Asn:            65001,
+								PeeringAddress: "10.0.0.1",
+							},
+						},
+						{
+							Name: "link2",
+							Fqdn: "foo.com",
+						},
+					},
+				},
+				{
+					Name:         "site2",
+					AddressCidrs: []string{"10.1.0.0/24"},
+					Links: []vpnSiteBulkLink{
+						{
+							Name:      "link1",
+							IpAddress: "10.1.0.1",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseVpnSiteBulkSites(v.Input, "")
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestParseVpnSiteBulkSitesJson(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected []vpnSiteBulkSite
+		Error    bool
+	}{
+		{
+			// empty list
+			Input: "[]",
+			Error: true,
+		},
+		{
+			// unknown field
+			Input: `[{"name": "site1", "foo": "bar", "links": [{"name": "link1", "ip_address": "10.0.0.1"}]}]`,
+			Error: true,
+		},
+		{
+			// no links
+			Input: `[{"name": "site1", "links": []}]`,
+			Error: true,
+		},
+		{
+			// duplicate site, case insensitively
+			Input: `[{"name": "site1", "links": [{"name": "link1", "ip_address": "10.0.0.1"}]}, {"name": "SITE1", "links": [{"name": "link1", "ip_address": "10.0.0.2"}]}]`,
+			Error: true,
+		},
+		{
+			// invalid cidr
+			Input: `[{"name": "site1", "address_cidrs": ["10.0.0.0"], "links": [{"name": "link1", "ip_address": "10.0.0.1"}]}]`,
+			Error: true,
+		},
+		{
+			Input: `[{"name": "site1", "device_model": "foo", "links": [{"name": "link1", "fqdn": "foo.com", "provider_name": "Verizon"}]}]`,
+			Expected: []vpnSiteBulkSite{
+				{
+					Name:        "site1",
+					DeviceModel: "foo",
+					Links: []vpnSiteBulkLink{
+						{
+							Name:         "link1",
+							Fqdn:         "foo.com",
+							ProviderName: "Verizon",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseVpnSiteBulkSites("", v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
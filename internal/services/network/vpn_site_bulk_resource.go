@@ -0,0 +1,510 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualwans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VpnSiteBulkModel struct {
+	Name                  string            `tfschema:"name"`
+	VirtualWanId          string            `tfschema:"virtual_wan_id"`
+	Location              string            `tfschema:"location"`
+	SitesCsv              string            `tfschema:"sites_csv"`
+	SitesJson             string            `tfschema:"sites_json"`
+	MaxParallelOperations int64             `tfschema:"max_parallel_operations"`
+	Tags                  map[string]string `tfschema:"tags"`
+	VpnSiteIds            map[string]string `tfschema:"vpn_site_ids"`
+}
+
+var _ sdk.ResourceWithUpdate = VpnSiteBulkResource{}
+
+var _ sdk.ResourceWithCustomizeDiff = VpnSiteBulkResource{}
+
+var _ sdk.ResourceWithCustomImporter = VpnSiteBulkResource{}
+
+type VpnSiteBulkResource struct{}
+
+func (r VpnSiteBulkResource) ResourceType() string {
+	return "azurerm_vpn_site_bulk"
+}
+
+func (r VpnSiteBulkResource) ModelObject() interface{} {
+	return &VpnSiteBulkModel{}
+}
+
+func (r VpnSiteBulkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.VpnSiteBulkID
+}
+
+func (r VpnSiteBulkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"virtual_wan_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: virtualwans.ValidateVirtualWANID,
+		},
+
+		"location": commonschema.Location(),
+
+		"sites_csv": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"sites_csv", "sites_json"},
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sites_json": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"sites_csv", "sites_json"},
+			ValidateFunc: validation.StringIsJSON,
+		},
+
+		"max_parallel_operations": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      5,
+			ValidateFunc: validation.IntBetween(1, 25),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r VpnSiteBulkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"vpn_site_ids": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r VpnSiteBulkResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config VpnSiteBulkModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the content may not be known until apply when it's sourced from another resource
+			if !metadata.ResourceDiff.NewValueKnown("sites_csv") || !metadata.ResourceDiff.NewValueKnown("sites_json") {
+				return nil
+			}
+
+			sites, err := parseVpnSiteBulkSites(config.SitesCsv, config.SitesJson)
+			if err != nil {
+				return err
+			}
+
+			if metadata.ResourceDiff.Id() == "" {
+				return nil
+			}
+
+			// sites which have been removed outside of Terraform are dropped from `vpn_site_ids` during Read, so any
+			// difference between the defined and the tracked sites needs an update to (re)create them
+			tracked, _ := metadata.ResourceDiff.GetChange("vpn_site_ids")
+			trackedSites := tracked.(map[string]interface{})
+			if len(trackedSites) != len(sites) {
+				return metadata.ResourceDiff.SetNewComputed("vpn_site_ids")
+			}
+			for _, site := range sites {
+				if _, ok := trackedSites[site.Name]; !ok {
+					return metadata.ResourceDiff.SetNewComputed("vpn_site_ids")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VpnSiteBulkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualWANs
+
+			var model VpnSiteBulkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			virtualWanId, err := virtualwans.ParseVirtualWANID(model.VirtualWanId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewVpnSiteBulkID(virtualWanId.SubscriptionId, virtualWanId.ResourceGroupName, virtualWanId.VirtualWanName, model.Name)
+
+			sites, err := parseVpnSiteBulkSites(model.SitesCsv, model.SitesJson)
+			if err != nil {
+				return err
+			}
+
+			if err := checkVpnSiteBulkSitesDoNotExist(ctx, client, id, int(model.MaxParallelOperations), sites); err != nil {
+				return err
+			}
+
+			model.VpnSiteIds = make(map[string]string)
+			var mutex sync.Mutex
+			err = utils.RunInParallel(int(model.MaxParallelOperations), sites, func(site vpnSiteBulkSite) error {
+				siteId := virtualwans.NewVpnSiteID(virtualWanId.SubscriptionId, virtualWanId.ResourceGroupName, site.Name)
+				if err := client.VpnSitesCreateOrUpdateThenPoll(ctx, siteId, expandVpnSiteBulkSite(site, *virtualWanId, model.Location, model.Tags)); err != nil {
+					return fmt.Errorf("creating %s: %+v", siteId, err)
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				model.VpnSiteIds[site.Name] = siteId.ID()
+				return nil
+			})
+			if err != nil {
+				// returning an error once the ID has been set would taint the resource, replacing every VPN Site on the
+				// next apply - as such the VPN Sites which were provisioned are removed again instead
+				provisioned := make([]vpnSiteBulkSite, 0, len(model.VpnSiteIds))
+				for name := range model.VpnSiteIds {
+					provisioned = append(provisioned, vpnSiteBulkSite{Name: name})
+				}
+				if deleteErr := utils.RunInParallel(int(model.MaxParallelOperations), provisioned, func(site vpnSiteBulkSite) error {
+					siteId := virtualwans.NewVpnSiteID(virtualWanId.SubscriptionId, virtualWanId.ResourceGroupName, site.Name)
+					if err := client.VpnSitesDeleteThenPoll(ctx, siteId); err != nil {
+						return fmt.Errorf("deleting %s: %+v", siteId, err)
+					}
+					return nil
+				}); deleteErr != nil {
+					return errors.Join(err, fmt.Errorf("removing the VPN Sites which were provisioned: %+v", deleteErr))
+				}
+
+				return err
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r VpnSiteBulkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualWANs
+
+			id, err := parse.VpnSiteBulkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state VpnSiteBulkModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.Name = id.Name
+			state.VirtualWanId = virtualwans.NewVirtualWANID(id.SubscriptionId, id.ResourceGroup, id.VirtualWanName).ID()
+			if state.MaxParallelOperations == 0 {
+				state.MaxParallelOperations = 5
+			}
+
+			tracked := make([]vpnSiteBulkSite, 0)
+			for name := range state.VpnSiteIds {
+				tracked = append(tracked, vpnSiteBulkSite{Name: name})
+			}
+
+			var mutex sync.Mutex
+			existingSiteIds := make(map[string]string)
+			err = utils.RunInParallel(int(state.MaxParallelOperations), tracked, func(site vpnSiteBulkSite) error {
+				siteId, err := virtualwans.ParseVpnSiteIDInsensitively(state.VpnSiteIds[site.Name])
+				if err != nil {
+					return err
+				}
+
+				resp, err := client.VpnSitesGet(ctx, *siteId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return nil
+					}
+					return fmt.Errorf("retrieving %s: %+v", *siteId, err)
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				existingSiteIds[site.Name] = siteId.ID()
+
+				if model := resp.Model; model != nil && state.Location == "" {
+					state.Location = location.NormalizeNilable(model.Location)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(state.VpnSiteIds) > 0 && len(existingSiteIds) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+			state.VpnSiteIds = existingSiteIds
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VpnSiteBulkResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualWANs
+
+			id, err := parse.VpnSiteBulkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VpnSiteBulkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			virtualWanId := virtualwans.NewVirtualWANID(id.SubscriptionId, id.ResourceGroup, id.VirtualWanName)
+
+			sites, err := parseVpnSiteBulkSites(model.SitesCsv, model.SitesJson)
+			if err != nil {
+				return err
+			}
+
+			oldCsv, _ := metadata.ResourceData.GetChange("sites_csv")
+			oldJson, _ := metadata.ResourceData.GetChange("sites_json")
+			previousSites, err := parseVpnSiteBulkSites(oldCsv.(string), oldJson.(string))
+			if err != nil {
+				// the previous definition is only used to skip unchanged sites, so an invalid one means every site is updated
+				previousSites = nil
+			}
+			previous := make(map[string]vpnSiteBulkSite)
+			for _, site := range previousSites {
+				previous[site.Name] = site
+			}
+
+			oldSiteIds, _ := metadata.ResourceData.GetChange("vpn_site_ids")
+			trackedSiteIds := make(map[string]string)
+			for name, siteId := range oldSiteIds.(map[string]interface{}) {
+				trackedSiteIds[name] = siteId.(string)
+			}
+
+			forceUpdate := metadata.ResourceData.HasChange("tags")
+
+			desired := make(map[string]struct{})
+			sitesToUpsert := make([]vpnSiteBulkSite, 0)
+			for _, site := range sites {
+				desired[site.Name] = struct{}{}
+
+				_, isTracked := trackedSiteIds[site.Name]
+				if previousSite, ok := previous[site.Name]; !forceUpdate && isTracked && ok && reflect.DeepEqual(previousSite, site) {
+					continue
+				}
+				sitesToUpsert = append(sitesToUpsert, site)
+			}
+
+			sitesToDelete := make([]vpnSiteBulkSite, 0)
+			for name := range trackedSiteIds {
+				if _, ok := desired[name]; !ok {
+					sitesToDelete = append(sitesToDelete, vpnSiteBulkSite{Name: name})
+				}
+			}
+
+			sitesToAdd := make([]vpnSiteBulkSite, 0)
+			for _, site := range sitesToUpsert {
+				if _, ok := trackedSiteIds[site.Name]; !ok {
+					sitesToAdd = append(sitesToAdd, site)
+				}
+			}
+			if err := checkVpnSiteBulkSitesDoNotExist(ctx, client, *id, int(model.MaxParallelOperations), sitesToAdd); err != nil {
+				return err
+			}
+
+			var mutex sync.Mutex
+			deleteErr := utils.RunInParallel(int(model.MaxParallelOperations), sitesToDelete, func(site vpnSiteBulkSite) error {
+				siteId, err := virtualwans.ParseVpnSiteIDInsensitively(trackedSiteIds[site.Name])
+				if err != nil {
+					return err
+				}
+
+				if err := client.VpnSitesDeleteThenPoll(ctx, *siteId); err != nil {
+					return fmt.Errorf("deleting %s: %+v", *siteId, err)
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				delete(trackedSiteIds, site.Name)
+				return nil
+			})
+
+			upsertErr := utils.RunInParallel(int(model.MaxParallelOperations), sitesToUpsert, func(site vpnSiteBulkSite) error {
+				siteId := virtualwans.NewVpnSiteID(virtualWanId.SubscriptionId, virtualWanId.ResourceGroupName, site.Name)
+				if err := client.VpnSitesCreateOrUpdateThenPoll(ctx, siteId, expandVpnSiteBulkSite(site, virtualWanId, model.Location, model.Tags)); err != nil {
+					return fmt.Errorf("creating/updating %s: %+v", siteId, err)
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				trackedSiteIds[site.Name] = siteId.ID()
+				return nil
+			})
+
+			model.VpnSiteIds = trackedSiteIds
+			err = errors.Join(deleteErr, upsertErr)
+			if encodeErr := metadata.Encode(&model); encodeErr != nil {
+				return errors.Join(err, fmt.Errorf("encoding: %+v", encodeErr))
+			}
+
+			return err
+		},
+	}
+}
+
+func (r VpnSiteBulkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualWANs
+
+			id, err := parse.VpnSiteBulkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VpnSiteBulkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			sites := make([]vpnSiteBulkSite, 0)
+			for name := range model.VpnSiteIds {
+				sites = append(sites, vpnSiteBulkSite{Name: name})
+			}
+
+			if err := utils.RunInParallel(int(model.MaxParallelOperations), sites, func(site vpnSiteBulkSite) error {
+				siteId, err := virtualwans.ParseVpnSiteIDInsensitively(model.VpnSiteIds[site.Name])
+				if err != nil {
+					return err
+				}
+
+				if err := client.VpnSitesDeleteThenPoll(ctx, *siteId); err != nil {
+					return fmt.Errorf("deleting %s: %+v", *siteId, err)
+				}
+				return nil
+			}); err != nil {
+				return fmt.Errorf("deleting the VPN Sites of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VpnSiteBulkResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`azurerm_vpn_site_bulk` can't be imported since the VPN Sites it manages are only tracked in the state")
+	}
+}
+
+// checkVpnSiteBulkSitesDoNotExist checks the VPN Sites which are about to be created up front, so that an existing site
+// doesn't leave a partially provisioned set of sites
+func checkVpnSiteBulkSitesDoNotExist(ctx context.Context, client *virtualwans.VirtualWANsClient, id parse.VpnSiteBulkId, maxParallel int, sites []vpnSiteBulkSite) error {
+	return utils.RunInParallel(maxParallel, sites, func(site vpnSiteBulkSite) error {
+		siteId := virtualwans.NewVpnSiteID(id.SubscriptionId, id.ResourceGroup, site.Name)
+		existing, err := client.VpnSitesGet(ctx, siteId)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", siteId, err)
+			}
+			return nil
+		}
+		return fmt.Errorf("%s already exists and cannot be managed by %s - either delete it or remove it from the site definitions", siteId, id)
+	})
+}
+
+func expandVpnSiteBulkSite(site vpnSiteBulkSite, virtualWanId virtualwans.VirtualWANId, loc string, tags map[string]string) virtualwans.VpnSite {
+	payload := virtualwans.VpnSite{
+		Location: pointer.To(location.Normalize(loc)),
+		Properties: &virtualwans.VpnSiteProperties{
+			VirtualWAN: &virtualwans.SubResource{
+				Id: pointer.To(virtualWanId.ID()),
+			},
+			DeviceProperties: expandVpnSiteDeviceProperties(site.DeviceVendor, site.DeviceModel),
+		},
+		Tags: pointer.To(tags),
+	}
+
+	if len(site.AddressCidrs) > 0 {
+		payload.Properties.AddressSpace = &virtualwans.AddressSpace{
+			AddressPrefixes: pointer.To(site.AddressCidrs),
+		}
+	}
+
+	links := make([]virtualwans.VpnSiteLink, 0)
+	for _, link := range site.Links {
+		properties := virtualwans.VpnSiteLinkProperties{
+			LinkProperties: &virtualwans.VpnLinkProviderProperties{
+				LinkSpeedInMbps: pointer.To(link.SpeedInMbps),
+			},
+		}
+
+		if link.ProviderName != "" {
+			properties.LinkProperties.LinkProviderName = pointer.To(link.ProviderName)
+		}
+		if link.IpAddress != "" {
+			properties.IPAddress = pointer.To(link.IpAddress)
+		}
+		if link.Fqdn != "" {
+			properties.Fqdn = pointer.To(link.Fqdn)
+		}
+		if link.Bgp != nil {
+			properties.BgpProperties = &virtualwans.VpnLinkBgpSettings{
+				Asn:               pointer.To(link.Bgp.Asn),
+				BgpPeeringAddress: pointer.To(link.Bgp.PeeringAddress),
+			}
+		}
+
+		links = append(links, virtualwans.VpnSiteLink{
+			Name:       pointer.To(link.Name),
+			Properties: &properties,
+		})
+	}
+	payload.Properties.VpnSiteLinks = &links
+
+	return payload
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualwans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VpnSiteBulkResource struct{}
+
+func TestAccVpnSiteBulk_csv(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_site_bulk", "test")
+	r := VpnSiteBulkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.csv(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vpn_site_ids.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccVpnSiteBulk_json(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_site_bulk", "test")
+	r := VpnSiteBulkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.json(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vpn_site_ids.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccVpnSiteBulk_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_site_bulk", "test")
+	r := VpnSiteBulkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.csv(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vpn_site_ids.%").HasValue("2"),
+			),
+		},
+		{
+			Config: r.csvUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vpn_site_ids.%").HasValue("3"),
+			),
+		},
+		{
+			Config: r.csv(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vpn_site_ids.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (r VpnSiteBulkResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	count := 0
+	for key, v := range state.Attributes {
+		if key == "vpn_site_ids.%" {
+			continue
+		}
+		id, err := virtualwans.ParseVpnSiteID(v)
+		if err != nil {
+			continue
+		}
+
+		resp, err := clients.Network.VirtualWANs.VpnSitesGet(ctx, *id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		count++
+	}
+
+	return pointer.To(count > 0), nil
+}
+
+func (r VpnSiteBulkResource) csv(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site_bulk" "test" {
+  name           = "acctest-VpnSiteBulk-%[2]d"
+  virtual_wan_id = azurerm_virtual_wan.test.id
+  location       = azurerm_resource_group.test.location
+
+  sites_csv = <<CSV
+site_name,address_cidrs,device_vendor,link_name,link_ip_address,link_fqdn,bgp_asn,bgp_peering_address
+acctest-site1-%[2]d,10.0.0.0/24,Cisco,link1,10.0.0.1,,65001,10.0.0.1
+acctest-site1-%[2]d,,,link2,,foo.com,,
+acctest-site2-%[2]d,10.1.0.0/24,,link1,10.1.0.1,,,
+CSV
+}
+`, VPNSiteResource{}.template(data), data.RandomInteger)
+}
+
+func (r VpnSiteBulkResource) csvUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site_bulk" "test" {
+  name                    = "acctest-VpnSiteBulk-%[2]d"
+  virtual_wan_id          = azurerm_virtual_wan.test.id
+  location                = azurerm_resource_group.test.location
+  max_parallel_operations = 2
+
+  sites_csv = <<CSV
+site_name,address_cidrs,device_vendor,link_name,link_ip_address,link_fqdn,bgp_asn,bgp_peering_address
+acctest-site1-%[2]d,10.0.0.0/24,Juniper,link1,10.0.0.1,,65001,10.0.0.1
+acctest-site2-%[2]d,10.1.0.0/24,,link1,10.1.0.1,,,
+acctest-site3-%[2]d,10.2.0.0/24,,link1,10.2.0.1,,,
+CSV
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, VPNSiteResource{}.template(data), data.RandomInteger)
+}
+
+func (r VpnSiteBulkResource) json(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site_bulk" "test" {
+  name           = "acctest-VpnSiteBulk-%[2]d"
+  virtual_wan_id = azurerm_virtual_wan.test.id
+  location       = azurerm_resource_group.test.location
+
+  sites_json = jsonencode([
+    {
+      name          = "acctest-site1-%[2]d"
+      address_cidrs = ["10.0.0.0/24"]
+      links = [
+        {
+          name          = "link1"
+          ip_address    = "10.0.0.1"
+          provider_name = "Verizon"
+          speed_in_mbps = 50
+        },
+      ]
+    },
+    {
+      name = "acctest-site2-%[2]d"
+      links = [
+        {
+          name = "link1"
+          fqdn = "foo.com"
+          bgp = {
+            asn             = 65002
+            peering_address = "10.1.0.1"
+          }
+        },
+      ]
+    },
+  ])
+}
+`, VPNSiteResource{}.template(data), data.RandomInteger)
+}
@@ -511,7 +511,8 @@ func expandVpnSiteVpnLinkBgpSettings(input []interface{}) *virtualwans.VpnLinkBg
 }
 
 func flattenVpnSiteVpnSiteBgpSettings(input *virtualwans.VpnLinkBgpSettings) []interface{} {
-	if input == nil {
+	// the API returns an empty `bgpProperties` object for links which don't have BGP enabled
+	if input == nil || (pointer.From(input.Asn) == 0 && pointer.From(input.BgpPeeringAddress) == "") {
 		return make([]interface{}, 0)
 	}
 
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/webapplicationfirewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	wafHelper "github.com/hashicorp/terraform-provider-azurerm/helpers/waf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/migration"
@@ -49,6 +51,10 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 			0: migration.WebApplicationFirewallPolicyV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			return wafHelper.ValidateCustomRules(diff.Get("custom_rules").([]interface{}), "match_conditions")
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -86,13 +92,7 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 							Required: true,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
-									"match_values": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-										},
-									},
+									"match_values": wafHelper.MatchValuesSchema(false, 0, nil),
 									"match_variables": {
 										Type:     pluginsdk.TypeList,
 										Required: true,
@@ -951,7 +951,7 @@ func expandWebApplicationFirewallPolicyMatchCondition(input []interface{}) []web
 			transforms = append(transforms, webapplicationfirewallpolicies.WebApplicationFirewallTransform(trans.(string)))
 		}
 		result := webapplicationfirewallpolicies.MatchCondition{
-			MatchValues:      pointer.From(utils.ExpandStringSlice(matchValues)),
+			MatchValues:      wafHelper.NormalizeMatchValues(operator, matchValues),
 			MatchVariables:   expandWebApplicationFirewallPolicyMatchVariable(matchVariables),
 			NegationConditon: utils.Bool(negationCondition),
 			Operator:         webapplicationfirewallpolicies.WebApplicationFirewallOperator(operator),
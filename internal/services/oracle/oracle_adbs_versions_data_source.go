@@ -0,0 +1,149 @@
+// Copyright © 2024, Oracle and/or its affiliates. All rights reserved
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/autonomousdatabaseversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AdbsVersionsDataSource struct{}
+
+type AdbsVersionsModel struct {
+	AdbsVersions []AdbsVersionModel `tfschema:"versions"`
+	DbWorkload   string             `tfschema:"db_workload"`
+	Location     string             `tfschema:"location"`
+}
+
+type AdbsVersionModel struct {
+	DbWorkload      string `tfschema:"db_workload"`
+	DefaultForFree  bool   `tfschema:"default_for_free"`
+	DefaultForPaid  bool   `tfschema:"default_for_paid"`
+	FreeTierEnabled bool   `tfschema:"free_tier_enabled"`
+	PaidTierEnabled bool   `tfschema:"paid_tier_enabled"`
+	Version         string `tfschema:"version"`
+}
+
+func (d AdbsVersionsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"db_workload": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(autonomousdatabaseversions.PossibleValuesForWorkloadType(), false),
+		},
+	}
+}
+
+func (d AdbsVersionsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"versions": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"db_workload": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"default_for_free": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+					"default_for_paid": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+					"free_tier_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+					"paid_tier_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+					"version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d AdbsVersionsDataSource) ModelObject() interface{} {
+	return &AdbsVersionsModel{}
+}
+
+func (d AdbsVersionsDataSource) ResourceType() string {
+	return "azurerm_oracle_adbs_versions"
+}
+
+func (d AdbsVersionsDataSource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return autonomousdatabaseversions.ValidateAutonomousDbVersionID
+}
+
+func (d AdbsVersionsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.AutonomousDatabaseVersions
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			state := AdbsVersionsModel{
+				AdbsVersions: make([]AdbsVersionModel, 0),
+			}
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := autonomousdatabaseversions.NewLocationID(subscriptionId, state.Location)
+
+			resp, err := client.ListByLocation(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				for _, element := range *model {
+					props := element.Properties
+					if props == nil {
+						continue
+					}
+
+					dbWorkload := pointer.FromEnum(props.DbWorkload)
+					if state.DbWorkload != "" && dbWorkload != state.DbWorkload {
+						continue
+					}
+
+					state.AdbsVersions = append(state.AdbsVersions, AdbsVersionModel{
+						DbWorkload:      dbWorkload,
+						DefaultForFree:  pointer.From(props.IsDefaultForFree),
+						DefaultForPaid:  pointer.From(props.IsDefaultForPaid),
+						FreeTierEnabled: pointer.From(props.IsFreeTierEnabled),
+						PaidTierEnabled: pointer.From(props.IsPaidEnabled),
+						Version:         props.Version,
+					})
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright © 2024, Oracle and/or its affiliates. All rights reserved
+
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AdbsVersionsDataSource struct{}
+
+func TestAdbsVersionsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_oracle_adbs_versions", "test")
+	r := AdbsVersionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("versions.0.version").Exists(),
+			),
+		},
+	})
+}
+
+func TestAdbsVersionsDataSource_dbWorkload(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_oracle_adbs_versions", "test")
+	r := AdbsVersionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.dbWorkload(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("versions.0.db_workload").HasValue("OLTP"),
+			),
+		},
+	})
+}
+
+func (d AdbsVersionsDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_oracle_adbs_versions" "test" {
+  location = "eastus"
+}
+`
+}
+
+func (d AdbsVersionsDataSource) dbWorkload() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_oracle_adbs_versions" "test" {
+  location    = "eastus"
+  db_workload = "OLTP"
+}
+`
+}
@@ -0,0 +1,552 @@
+// Copyright © 2024, Oracle and/or its affiliates. All rights reserved
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/autonomousdatabases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource                  = AutonomousDatabaseCloneResource{}
+	_ sdk.ResourceWithUpdate        = AutonomousDatabaseCloneResource{}
+	_ sdk.ResourceWithCustomizeDiff = AutonomousDatabaseCloneResource{}
+)
+
+type AutonomousDatabaseCloneResource struct{}
+
+type AutonomousDatabaseCloneResourceModel struct {
+	Location          string            `tfschema:"location"`
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Tags              map[string]string `tfschema:"tags"`
+
+	// Required
+	SourceAutonomousDatabaseId   string  `tfschema:"source_autonomous_database_id"`
+	CloneType                    string  `tfschema:"clone_type"`
+	AdminPassword                string  `tfschema:"admin_password"`
+	BackupRetentionPeriodInDays  int64   `tfschema:"backup_retention_period_in_days"`
+	ComputeCount                 float64 `tfschema:"compute_count"`
+	ComputeModel                 string  `tfschema:"compute_model"`
+	DataStorageSizeInTbs         int64   `tfschema:"data_storage_size_in_tbs"`
+	DbWorkload                   string  `tfschema:"db_workload"`
+	DisplayName                  string  `tfschema:"display_name"`
+	LicenseModel                 string  `tfschema:"license_model"`
+	AutoScalingEnabled           bool    `tfschema:"auto_scaling_enabled"`
+	AutoScalingForStorageEnabled bool    `tfschema:"auto_scaling_for_storage_enabled"`
+	MtlsConnectionRequired       bool    `tfschema:"mtls_connection_required"`
+
+	// Optional
+	DbVersion               string   `tfschema:"db_version"`
+	RefreshableCloneEnabled bool     `tfschema:"refreshable_clone_enabled"`
+	RefreshableModel        string   `tfschema:"refreshable_model"`
+	SubnetId                string   `tfschema:"subnet_id"`
+	VnetId                  string   `tfschema:"virtual_network_id"`
+	AllowedIps              []string `tfschema:"allowed_ips"`
+	CustomerContacts        []string `tfschema:"customer_contacts"`
+
+	// Computed
+	ReconnectCloneEnabled        bool   `tfschema:"reconnect_clone_enabled"`
+	RefreshableStatus            string `tfschema:"refreshable_status"`
+	RefreshLagInSeconds          int64  `tfschema:"refresh_lag_in_seconds"`
+	TimeOfLastRefresh            string `tfschema:"time_of_last_refresh"`
+	TimeOfLastRefreshPoint       string `tfschema:"time_of_last_refresh_point"`
+	ReconnectCloneAvailableUntil string `tfschema:"reconnect_clone_available_until"`
+}
+
+func (AutonomousDatabaseCloneResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.AutonomousDatabaseName,
+			ForceNew:     true,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		// Required
+		"source_autonomous_database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: autonomousdatabases.ValidateAutonomousDatabaseID,
+		},
+
+		"clone_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(autonomousdatabases.PossibleValuesForCloneType(), false),
+		},
+
+		"admin_password": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ForceNew:     true,
+			ValidateFunc: validate.AutonomousDatabasePassword,
+		},
+
+		"backup_retention_period_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 60),
+		},
+
+		"compute_count": {
+			Type:         pluginsdk.TypeFloat,
+			Required:     true,
+			ValidateFunc: validation.FloatBetween(2.0, 512.0),
+		},
+
+		"compute_model": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.AdbsComputeModel,
+		},
+
+		"data_storage_size_in_tbs": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 384),
+		},
+
+		"db_workload": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(autonomousdatabases.WorkloadTypeDW),
+				string(autonomousdatabases.WorkloadTypeOLTP),
+			}, false),
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.AutonomousDatabaseName,
+		},
+
+		"auto_scaling_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+
+		"auto_scaling_for_storage_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+
+		"license_model": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(autonomousdatabases.LicenseModelLicenseIncluded),
+				string(autonomousdatabases.LicenseModelBringYourOwnLicense),
+			}, false),
+		},
+
+		"mtls_connection_required": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+			ForceNew: true,
+		},
+
+		// Optional
+		"db_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"refreshable_clone_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"refreshable_model": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(autonomousdatabases.PossibleValuesForRefreshableModelType(), false),
+		},
+
+		"customer_contacts": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.CustomerContactEmail,
+			},
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSubnetID,
+		},
+
+		"virtual_network_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateVirtualNetworkID,
+		},
+
+		"allowed_ips": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (AutonomousDatabaseCloneResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"reconnect_clone_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"refreshable_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"refresh_lag_in_seconds": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"time_of_last_refresh": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"time_of_last_refresh_point": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"reconnect_clone_available_until": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (AutonomousDatabaseCloneResource) ModelObject() interface{} {
+	return &AutonomousDatabaseCloneResourceModel{}
+}
+
+func (AutonomousDatabaseCloneResource) ResourceType() string {
+	return "azurerm_oracle_autonomous_database_clone"
+}
+
+func (AutonomousDatabaseCloneResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model AutonomousDatabaseCloneResourceModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if model.RefreshableCloneEnabled {
+				if model.RefreshableModel == "" {
+					return fmt.Errorf("`refreshable_model` must be specified when `refreshable_clone_enabled` is `true`")
+				}
+				// a refreshable clone keeps the data of the source database, so it cannot be a metadata only clone
+				if model.CloneType == string(autonomousdatabases.CloneTypeMetadata) {
+					return fmt.Errorf("`clone_type` must be `%s` when `refreshable_clone_enabled` is `true`", autonomousdatabases.CloneTypeFull)
+				}
+			} else if model.RefreshableModel != "" {
+				return fmt.Errorf("`refreshable_model` can only be specified when `refreshable_clone_enabled` is `true`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AutonomousDatabaseCloneResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 120 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.AutonomousDatabases
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model AutonomousDatabaseCloneResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id := autonomousdatabases.NewAutonomousDatabaseID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			sourceId, err := autonomousdatabases.ParseAutonomousDatabaseID(model.SourceAutonomousDatabaseId)
+			if err != nil {
+				return err
+			}
+
+			source := autonomousdatabases.SourceTypeDatabase
+			if model.RefreshableCloneEnabled {
+				source = autonomousdatabases.SourceTypeCloneToRefreshable
+			}
+
+			properties := &autonomousdatabases.AutonomousDatabaseCloneProperties{
+				CloneType:                      autonomousdatabases.CloneType(model.CloneType),
+				DataBaseType:                   autonomousdatabases.DataBaseTypeClone,
+				IsRefreshableClone:             pointer.To(model.RefreshableCloneEnabled),
+				Source:                         pointer.To(source),
+				SourceId:                       sourceId.ID(),
+				AdminPassword:                  pointer.To(model.AdminPassword),
+				BackupRetentionPeriodInDays:    pointer.To(model.BackupRetentionPeriodInDays),
+				ComputeCount:                   pointer.To(model.ComputeCount),
+				ComputeModel:                   pointer.To(autonomousdatabases.ComputeModel(model.ComputeModel)),
+				DataStorageSizeInTbs:           pointer.To(model.DataStorageSizeInTbs),
+				DbWorkload:                     pointer.To(autonomousdatabases.WorkloadType(model.DbWorkload)),
+				DisplayName:                    pointer.To(model.DisplayName),
+				IsAutoScalingEnabled:           pointer.To(model.AutoScalingEnabled),
+				IsAutoScalingForStorageEnabled: pointer.To(model.AutoScalingForStorageEnabled),
+				IsMtlsConnectionRequired:       pointer.To(model.MtlsConnectionRequired),
+				LicenseModel:                   pointer.To(autonomousdatabases.LicenseModel(model.LicenseModel)),
+				WhitelistedIPs:                 pointer.To(model.AllowedIps),
+			}
+
+			if model.RefreshableModel != "" {
+				properties.RefreshableModel = pointer.To(autonomousdatabases.RefreshableModelType(model.RefreshableModel))
+			}
+
+			if model.DbVersion != "" {
+				properties.DbVersion = pointer.To(model.DbVersion)
+			}
+
+			if len(model.CustomerContacts) > 0 {
+				properties.CustomerContacts = pointer.To(expandAdbsCustomerContacts(model.CustomerContacts))
+			}
+
+			if model.SubnetId != "" {
+				properties.SubnetId = pointer.To(model.SubnetId)
+			}
+
+			if model.VnetId != "" {
+				properties.VnetId = pointer.To(model.VnetId)
+			}
+
+			param := autonomousdatabases.AutonomousDatabase{
+				Name:       pointer.To(model.Name),
+				Location:   location.Normalize(model.Location),
+				Tags:       pointer.To(model.Tags),
+				Properties: properties,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AutonomousDatabaseCloneResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.AutonomousDatabases
+			id, err := autonomousdatabases.ParseAutonomousDatabaseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AutonomousDatabaseCloneResourceModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			update := autonomousdatabases.AutonomousDatabaseUpdate{
+				Properties: &autonomousdatabases.AutonomousDatabaseUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				update.Tags = pointer.To(model.Tags)
+			}
+			if metadata.ResourceData.HasChange("backup_retention_period_in_days") {
+				update.Properties.BackupRetentionPeriodInDays = pointer.To(model.BackupRetentionPeriodInDays)
+			}
+			if metadata.ResourceData.HasChange("data_storage_size_in_tbs") {
+				update.Properties.DataStorageSizeInTbs = pointer.To(model.DataStorageSizeInTbs)
+			}
+			if metadata.ResourceData.HasChange("compute_count") {
+				update.Properties.ComputeCount = pointer.To(model.ComputeCount)
+			}
+			if metadata.ResourceData.HasChange("auto_scaling_enabled") {
+				update.Properties.IsAutoScalingEnabled = pointer.To(model.AutoScalingEnabled)
+			}
+			if metadata.ResourceData.HasChange("auto_scaling_for_storage_enabled") {
+				update.Properties.IsAutoScalingForStorageEnabled = pointer.To(model.AutoScalingForStorageEnabled)
+			}
+			if metadata.ResourceData.HasChange("allowed_ips") {
+				update.Properties.WhitelistedIPs = pointer.To(model.AllowedIps)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, update); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (AutonomousDatabaseCloneResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := autonomousdatabases.ParseAutonomousDatabaseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client := metadata.Client.Oracle.OracleClient.AutonomousDatabases
+			result, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(result.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := AutonomousDatabaseCloneResourceModel{
+				Name:              id.AutonomousDatabaseName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+			if model := result.Model; model != nil {
+				props, ok := model.Properties.(autonomousdatabases.AutonomousDatabaseCloneProperties)
+				if !ok {
+					return fmt.Errorf("%s was not of type `Clone`", id)
+				}
+
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				sourceId, err := autonomousdatabases.ParseAutonomousDatabaseIDInsensitively(props.SourceId)
+				if err != nil {
+					return err
+				}
+				state.SourceAutonomousDatabaseId = sourceId.ID()
+				state.CloneType = string(props.CloneType)
+				state.AdminPassword = metadata.ResourceData.Get("admin_password").(string)
+				state.AutoScalingEnabled = pointer.From(props.IsAutoScalingEnabled)
+				state.AutoScalingForStorageEnabled = pointer.From(props.IsAutoScalingForStorageEnabled)
+				state.BackupRetentionPeriodInDays = pointer.From(props.BackupRetentionPeriodInDays)
+				state.ComputeCount = pointer.From(props.ComputeCount)
+				state.ComputeModel = pointer.FromEnum(props.ComputeModel)
+				state.CustomerContacts = flattenAdbsCustomerContacts(props.CustomerContacts)
+				state.DataStorageSizeInTbs = pointer.From(props.DataStorageSizeInTbs)
+				state.DbVersion = pointer.From(props.DbVersion)
+				state.DbWorkload = pointer.FromEnum(props.DbWorkload)
+				state.DisplayName = pointer.From(props.DisplayName)
+				state.LicenseModel = pointer.FromEnum(props.LicenseModel)
+				state.MtlsConnectionRequired = pointer.From(props.IsMtlsConnectionRequired)
+				state.SubnetId = pointer.From(props.SubnetId)
+				state.VnetId = pointer.From(props.VnetId)
+				state.AllowedIps = pointer.From(props.WhitelistedIPs)
+
+				state.RefreshableCloneEnabled = pointer.From(props.IsRefreshableClone)
+				state.RefreshableModel = pointer.FromEnum(props.RefreshableModel)
+				state.RefreshableStatus = pointer.FromEnum(props.RefreshableStatus)
+				state.ReconnectCloneEnabled = pointer.From(props.IsReconnectCloneEnabled)
+				state.TimeOfLastRefresh = pointer.From(props.TimeOfLastRefresh)
+				state.TimeOfLastRefreshPoint = pointer.From(props.TimeOfLastRefreshPoint)
+				state.ReconnectCloneAvailableUntil = pointer.From(props.TimeUntilReconnectCloneEnabled)
+				state.RefreshLagInSeconds = refreshableCloneLagInSeconds(state.TimeOfLastRefresh, state.TimeOfLastRefreshPoint)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (AutonomousDatabaseCloneResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.AutonomousDatabases
+
+			id, err := autonomousdatabases.ParseAutonomousDatabaseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err = client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (AutonomousDatabaseCloneResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return autonomousdatabases.ValidateAutonomousDatabaseID
+}
+
+// refreshableCloneLagInSeconds returns how far behind the source database the data of a refreshable clone was
+// at the time of the last refresh, that is the difference between the last refresh and the point in time it refreshed to
+func refreshableCloneLagInSeconds(timeOfLastRefresh, timeOfLastRefreshPoint string) int64 {
+	if timeOfLastRefresh == "" || timeOfLastRefreshPoint == "" {
+		return 0
+	}
+
+	refresh, err := time.Parse(time.RFC3339, timeOfLastRefresh)
+	if err != nil {
+		return 0
+	}
+
+	refreshPoint, err := time.Parse(time.RFC3339, timeOfLastRefreshPoint)
+	if err != nil {
+		return 0
+	}
+
+	return int64(refresh.Sub(refreshPoint).Seconds())
+}
@@ -0,0 +1,146 @@
+// Copyright © 2024, Oracle and/or its affiliates. All rights reserved
+
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/autonomousdatabases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AdbsCloneResource struct{}
+
+func (a AdbsCloneResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := autonomousdatabases.ParseAutonomousDatabaseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Oracle.OracleClient.AutonomousDatabases.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving adbs clone %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func TestAdbsCloneResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.AutonomousDatabaseCloneResource{}.ResourceType(), "test")
+	r := AdbsCloneResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password"),
+	})
+}
+
+func TestAdbsCloneResource_metadata(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.AutonomousDatabaseCloneResource{}.ResourceType(), "test")
+	r := AdbsCloneResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.metadata(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password"),
+	})
+}
+
+func TestAdbsCloneResource_refreshable(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.AutonomousDatabaseCloneResource{}.ResourceType(), "test")
+	r := AdbsCloneResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.refreshable(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("refreshable_status").Exists(),
+			),
+		},
+		data.ImportStep("admin_password"),
+	})
+}
+
+func TestAdbsCloneResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.AutonomousDatabaseCloneResource{}.ResourceType(), "test")
+	r := AdbsCloneResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password"),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password"),
+	})
+}
+
+func (a AdbsCloneResource) basic(data acceptance.TestData) string {
+	return a.clone(data, "Full", 7, 2, "")
+}
+
+func (a AdbsCloneResource) update(data acceptance.TestData) string {
+	return a.clone(data, "Full", 14, 4, `
+  tags = {
+    ENV = "Test"
+  }
+`)
+}
+
+func (a AdbsCloneResource) metadata(data acceptance.TestData) string {
+	return a.clone(data, "Metadata", 7, 2, "")
+}
+
+func (a AdbsCloneResource) refreshable(data acceptance.TestData) string {
+	return a.clone(data, "Full", 7, 2, `
+  refreshable_clone_enabled = true
+  refreshable_model         = "Manual"
+`)
+}
+
+func (a AdbsCloneResource) clone(data acceptance.TestData, cloneType string, backupRetention, computeCount int, extra string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_oracle_autonomous_database_clone" "test" {
+  name                             = "OFakeClone%[2]d"
+  display_name                     = "OFakeClone%[2]d"
+  resource_group_name              = azurerm_resource_group.test.name
+  location                         = "%[3]s"
+  source_autonomous_database_id    = azurerm_oracle_autonomous_database.test.id
+  clone_type                       = "%[4]s"
+  compute_model                    = "ECPU"
+  compute_count                    = %[6]d
+  license_model                    = "BringYourOwnLicense"
+  backup_retention_period_in_days  = %[5]d
+  auto_scaling_enabled             = false
+  auto_scaling_for_storage_enabled = false
+  mtls_connection_required         = false
+  data_storage_size_in_tbs         = 1
+  db_workload                      = "OLTP"
+  admin_password                   = "TestPass#2024#"
+  subnet_id                        = azurerm_subnet.test.id
+  virtual_network_id               = azurerm_virtual_network.test.id
+%[7]s
+}
+`, AdbsRegularResource{}.basic(data), data.RandomInteger, data.Locations.Primary, cloneType, backupRetention, computeCount, extra)
+}
@@ -0,0 +1,125 @@
+// Copyright © 2024, Oracle and/or its affiliates. All rights reserved
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/autonomousdatabases"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/validate"
+)
+
+var _ sdk.EphemeralResource = &AutonomousDatabaseWalletEphemeralResource{}
+
+func NewAutonomousDatabaseWalletEphemeralResource() ephemeral.EphemeralResource {
+	return &AutonomousDatabaseWalletEphemeralResource{}
+}
+
+type AutonomousDatabaseWalletEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type AutonomousDatabaseWalletEphemeralResourceModel struct {
+	AutonomousDatabaseId types.String `tfsdk:"autonomous_database_id"`
+	Password             types.String `tfsdk:"password"`
+	Regional             types.Bool   `tfsdk:"regional"`
+	WalletFiles          types.String `tfsdk:"wallet_files"`
+}
+
+func (e *AutonomousDatabaseWalletEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_oracle_autonomous_database_wallet"
+}
+
+func (e *AutonomousDatabaseWalletEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *AutonomousDatabaseWalletEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"autonomous_database_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: autonomousdatabases.ValidateAutonomousDatabaseID,
+					},
+				},
+			},
+
+			"password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.AutonomousDatabaseWalletPassword,
+					},
+				},
+			},
+
+			"regional": schema.BoolAttribute{
+				Optional: true,
+			},
+
+			"wallet_files": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *AutonomousDatabaseWalletEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Oracle.OracleClient.AutonomousDatabases
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data AutonomousDatabaseWalletEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := autonomousdatabases.ParseAutonomousDatabaseID(data.AutonomousDatabaseId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	// a regional wallet contains the credentials for all of the Autonomous Databases in the region,
+	// whereas an instance wallet only contains the credentials for this Autonomous Database
+	generateType := autonomousdatabases.GenerateTypeSingle
+	if data.Regional.ValueBool() {
+		generateType = autonomousdatabases.GenerateTypeAll
+	}
+
+	input := autonomousdatabases.GenerateAutonomousDatabaseWalletDetails{
+		GenerateType: pointer.To(generateType),
+		IsRegional:   pointer.To(data.Regional.ValueBool()),
+		Password:     data.Password.ValueString(),
+	}
+
+	result, err := client.GenerateWallet(ctx, *id, input)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("generating wallet for %s", id), err)
+		return
+	}
+
+	if result.Model == nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("generating wallet for %s", id), "model was nil")
+		return
+	}
+
+	data.Regional = types.BoolValue(data.Regional.ValueBool())
+	data.WalletFiles = types.StringValue(result.Model.WalletFiles)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,98 @@
+// Copyright © 2024, Oracle and/or its affiliates. All rights reserved
+
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type AutonomousDatabaseWalletEphemeral struct{}
+
+func TestAccEphemeralAutonomousDatabaseWallet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_oracle_autonomous_database_wallet", "test")
+	r := AutonomousDatabaseWalletEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("wallet_files"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccEphemeralAutonomousDatabaseWallet_regional(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_oracle_autonomous_database_wallet", "test")
+	r := AutonomousDatabaseWalletEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.regional(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("regional"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("wallet_files"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func (AutonomousDatabaseWalletEphemeral) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_oracle_autonomous_database_wallet" "test" {
+  autonomous_database_id = azurerm_oracle_autonomous_database.test.id
+  password               = "TestPass#2024#"
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_oracle_autonomous_database_wallet.test
+}
+
+resource "echo" "test" {}
+`, AdbsRegularResource{}.basic(data))
+}
+
+func (AutonomousDatabaseWalletEphemeral) regional(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_oracle_autonomous_database_wallet" "test" {
+  autonomous_database_id = azurerm_oracle_autonomous_database.test.id
+  password               = "TestPass#2024#"
+  regional               = true
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_oracle_autonomous_database_wallet.test
+}
+
+resource "echo" "test" {}
+`, AdbsRegularResource{}.basic(data))
+}
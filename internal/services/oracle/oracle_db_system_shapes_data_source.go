@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/dbsystemshapes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 type DbSystemShapesDataSource struct{}
@@ -20,6 +21,7 @@ type DbSystemShapesDataSource struct{}
 type DbSystemShapesModel struct {
 	DbSystemShapes []DbSystemShapeModel `tfschema:"db_system_shapes"`
 	Location       string               `tfschema:"location"`
+	Zone           string               `tfschema:"zone"`
 }
 
 type DbSystemShapeModel struct {
@@ -46,11 +48,18 @@ type DbSystemShapeModel struct {
 	MinimumNodeCount                   int64   `tfschema:"minimum_node_count"`
 	RuntimeMinimumCoreCount            int64   `tfschema:"runtime_minimum_core_count"`
 	ShapeFamily                        string  `tfschema:"shape_family"`
+	ShapeName                          string  `tfschema:"shape_name"`
 }
 
 func (d DbSystemShapesDataSource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"location": commonschema.Location(),
+
+		"zone": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
 	}
 }
 
@@ -153,6 +162,10 @@ func (d DbSystemShapesDataSource) Attributes() map[string]*pluginsdk.Schema {
 						Type:     pluginsdk.TypeString,
 						Computed: true,
 					},
+					"shape_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
 				},
 			},
 		},
@@ -187,7 +200,12 @@ func (d DbSystemShapesDataSource) Read() sdk.ResourceFunc {
 
 			id := dbsystemshapes.NewLocationID(subscriptionId, state.Location)
 
-			resp, err := client.ListByLocation(ctx, id, dbsystemshapes.DefaultListByLocationOperationOptions())
+			options := dbsystemshapes.DefaultListByLocationOperationOptions()
+			if state.Zone != "" {
+				options.Zone = pointer.To(state.Zone)
+			}
+
+			resp, err := client.ListByLocation(ctx, id, options)
 			if err != nil {
 				if response.WasNotFound(resp.HttpResponse) {
 					return fmt.Errorf("%s was not found", id)
@@ -222,6 +240,7 @@ func (d DbSystemShapesDataSource) Read() sdk.ResourceFunc {
 							MinimumNodeCount:                   pointer.From(props.MinimumNodeCount),
 							RuntimeMinimumCoreCount:            pointer.From(props.RuntimeMinimumCoreCount),
 							ShapeFamily:                        pointer.From(props.ShapeFamily),
+							ShapeName:                          props.ShapeName,
 						})
 					}
 				}
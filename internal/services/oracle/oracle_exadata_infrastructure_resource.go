@@ -13,13 +13,18 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/cloudexadatainfrastructures"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/dbsystemshapes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.Resource = ExadataInfraResource{}
+var (
+	_ sdk.Resource                  = ExadataInfraResource{}
+	_ sdk.ResourceWithUpdate        = ExadataInfraResource{}
+	_ sdk.ResourceWithCustomizeDiff = ExadataInfraResource{}
+)
 
 type ExadataInfraResource struct{}
 
@@ -63,7 +68,6 @@ func (ExadataInfraResource) Arguments() map[string]*pluginsdk.Schema {
 			Type:         pluginsdk.TypeInt,
 			Required:     true,
 			ValidateFunc: validate.ComputeCount,
-			ForceNew:     true,
 		},
 
 		"database_server_type": {
@@ -91,7 +95,6 @@ func (ExadataInfraResource) Arguments() map[string]*pluginsdk.Schema {
 			Type:         pluginsdk.TypeInt,
 			Required:     true,
 			ValidateFunc: validate.StorageCount,
-			ForceNew:     true,
 		},
 
 		// Optional
@@ -99,7 +102,6 @@ func (ExadataInfraResource) Arguments() map[string]*pluginsdk.Schema {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
 			Computed: true,
-			ForceNew: true,
 			Elem: &pluginsdk.Schema{
 				Type: pluginsdk.TypeString,
 			},
@@ -109,14 +111,12 @@ func (ExadataInfraResource) Arguments() map[string]*pluginsdk.Schema {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
 			Computed: true,
-			ForceNew: true,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"days_of_week": {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
 						Computed: true,
-						ForceNew: true,
 						Elem: &pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							ValidateFunc: validate.DaysOfWeek,
@@ -127,7 +127,6 @@ func (ExadataInfraResource) Arguments() map[string]*pluginsdk.Schema {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
 						Computed: true,
-						ForceNew: true,
 						Elem: &pluginsdk.Schema{
 							Type:         pluginsdk.TypeInt,
 							ValidateFunc: validate.HoursOfDay,
@@ -138,7 +137,6 @@ func (ExadataInfraResource) Arguments() map[string]*pluginsdk.Schema {
 						Type:         pluginsdk.TypeInt,
 						Optional:     true,
 						Computed:     true,
-						ForceNew:     true,
 						ValidateFunc: validate.LeadTimeInWeeks,
 					},
 
@@ -146,7 +144,6 @@ func (ExadataInfraResource) Arguments() map[string]*pluginsdk.Schema {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
 						Computed: true,
-						ForceNew: true,
 						Elem: &pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							ValidateFunc: validate.Month,
@@ -157,7 +154,6 @@ func (ExadataInfraResource) Arguments() map[string]*pluginsdk.Schema {
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
 						Computed:     true,
-						ForceNew:     true,
 						ValidateFunc: validate.PatchingMode,
 					},
 
@@ -165,7 +161,6 @@ func (ExadataInfraResource) Arguments() map[string]*pluginsdk.Schema {
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
 						Computed:     true,
-						ForceNew:     true,
 						ValidateFunc: validate.Preference,
 					},
 
@@ -173,7 +168,6 @@ func (ExadataInfraResource) Arguments() map[string]*pluginsdk.Schema {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
 						Computed: true,
-						ForceNew: true,
 						Elem: &pluginsdk.Schema{
 							Type:         pluginsdk.TypeInt,
 							ValidateFunc: validate.WeeksOfMonth,
@@ -209,6 +203,73 @@ func (ExadataInfraResource) ResourceType() string {
 	return "azurerm_oracle_exadata_infrastructure"
 }
 
+func (ExadataInfraResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.DbSystemShapes
+			rd := metadata.ResourceDiff
+
+			// database and storage servers can be added to an existing Exadata Infrastructure, but not removed
+			for _, key := range []string{"compute_count", "storage_count"} {
+				if rd.Id() != "" && rd.HasChange(key) {
+					oldValue, newValue := rd.GetChange(key)
+					if newValue.(int) < oldValue.(int) {
+						if err := rd.ForceNew(key); err != nil {
+							return err
+						}
+					}
+				}
+			}
+
+			// the shape is only checked when it's used, so that existing infrastructure can still be planned should the
+			// region stop listing the shape
+			if rd.Id() != "" && !rd.HasChanges("shape", "compute_count", "storage_count") {
+				return nil
+			}
+
+			if !rd.NewValueKnown("shape") || !rd.NewValueKnown("location") || !rd.NewValueKnown("compute_count") || !rd.NewValueKnown("storage_count") {
+				return nil
+			}
+
+			shape := rd.Get("shape").(string)
+			loc := location.Normalize(rd.Get("location").(string))
+			if shape == "" || loc == "" {
+				return nil
+			}
+
+			id := dbsystemshapes.NewLocationID(metadata.Client.Account.SubscriptionId, loc)
+			resp, err := client.ListByLocationComplete(ctx, id, dbsystemshapes.DefaultListByLocationOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving DB System Shapes for %s: %+v", id, err)
+			}
+
+			var props *dbsystemshapes.DbSystemShapeProperties
+			for _, item := range resp.Items {
+				if item.Properties != nil && item.Properties.ShapeName == shape {
+					props = item.Properties
+					break
+				}
+			}
+			if props == nil {
+				return fmt.Errorf("the shape %q is not available in %q", shape, loc)
+			}
+
+			computeCount := int64(rd.Get("compute_count").(int))
+			if minCount, maxCount := pointer.From(props.MinimumNodeCount), pointer.From(props.MaximumNodeCount); (minCount > 0 && computeCount < minCount) || (maxCount > 0 && computeCount > maxCount) {
+				return fmt.Errorf("`compute_count` must be between %d and %d for the shape %q, got %d", minCount, maxCount, shape, computeCount)
+			}
+
+			storageCount := int64(rd.Get("storage_count").(int))
+			if minCount, maxCount := pointer.From(props.MinStorageCount), pointer.From(props.MaxStorageCount); (minCount > 0 && storageCount < minCount) || (maxCount > 0 && storageCount > maxCount) {
+				return fmt.Errorf("`storage_count` must be between %d and %d for the shape %q, got %d", minCount, maxCount, shape, storageCount)
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r ExadataInfraResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 120 * time.Minute,
@@ -253,15 +314,7 @@ func (r ExadataInfraResource) Create() sdk.ResourceFunc {
 				param.Properties.StorageServerType = pointer.To(model.StorageServerType)
 			}
 			if len(model.MaintenanceWindow) > 0 {
-				param.Properties.MaintenanceWindow = &cloudexadatainfrastructures.MaintenanceWindow{
-					DaysOfWeek:      pointer.To(ExpandDayOfWeekTo(model.MaintenanceWindow[0].DaysOfWeek)),
-					HoursOfDay:      pointer.To(model.MaintenanceWindow[0].HoursOfDay),
-					LeadTimeInWeeks: pointer.To(model.MaintenanceWindow[0].LeadTimeInWeeks),
-					Months:          pointer.To(ExpandMonths(model.MaintenanceWindow[0].Months)),
-					PatchingMode:    pointer.To(cloudexadatainfrastructures.PatchingMode(model.MaintenanceWindow[0].PatchingMode)),
-					Preference:      pointer.To(cloudexadatainfrastructures.Preference(model.MaintenanceWindow[0].Preference)),
-					WeeksOfMonth:    pointer.To(model.MaintenanceWindow[0].WeeksOfMonth),
-				}
+				param.Properties.MaintenanceWindow = ExpandMaintenanceWindow(model.MaintenanceWindow)
 			}
 
 			if err := client.CreateOrUpdateThenPoll(ctx, id, param); err != nil {
@@ -276,7 +329,7 @@ func (r ExadataInfraResource) Create() sdk.ResourceFunc {
 
 func (r ExadataInfraResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
-		Timeout: 30 * time.Minute,
+		Timeout: 120 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.Oracle.OracleClient.CloudExadataInfrastructures
 			id, err := cloudexadatainfrastructures.ParseCloudExadataInfrastructureID(metadata.ResourceData.Id())
@@ -294,14 +347,33 @@ func (r ExadataInfraResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: ", *id)
 			}
 
+			update := &cloudexadatainfrastructures.CloudExadataInfrastructureUpdate{
+				Properties: &cloudexadatainfrastructures.CloudExadataInfrastructureUpdateProperties{},
+			}
+
 			if metadata.ResourceData.HasChange("tags") {
-				update := &cloudexadatainfrastructures.CloudExadataInfrastructureUpdate{
-					Tags: pointer.To(model.Tags),
-				}
-				err = client.UpdateThenPoll(ctx, *id, *update)
-				if err != nil {
-					return fmt.Errorf("updating %s: %v", id, err)
-				}
+				update.Tags = pointer.To(model.Tags)
+			}
+
+			if metadata.ResourceData.HasChange("compute_count") {
+				update.Properties.ComputeCount = pointer.To(model.ComputeCount)
+			}
+
+			if metadata.ResourceData.HasChange("storage_count") {
+				update.Properties.StorageCount = pointer.To(model.StorageCount)
+			}
+
+			if metadata.ResourceData.HasChange("customer_contacts") {
+				update.Properties.CustomerContacts = pointer.To(ExpandCustomerContacts(model.CustomerContacts))
+			}
+
+			if metadata.ResourceData.HasChange("maintenance_window") {
+				update.Properties.MaintenanceWindow = ExpandMaintenanceWindow(model.MaintenanceWindow)
+			}
+
+			err = client.UpdateThenPoll(ctx, *id, *update)
+			if err != nil {
+				return fmt.Errorf("updating %s: %v", id, err)
 			}
 			return nil
 		},
@@ -392,6 +464,23 @@ func ExpandCustomerContacts(customerContactsList []string) []cloudexadatainfrast
 	return customerContacts
 }
 
+func ExpandMaintenanceWindow(input []MaintenanceWindowModel) *cloudexadatainfrastructures.MaintenanceWindow {
+	if len(input) == 0 {
+		return nil
+	}
+
+	maintenanceWindow := input[0]
+	return &cloudexadatainfrastructures.MaintenanceWindow{
+		DaysOfWeek:      pointer.To(ExpandDayOfWeekTo(maintenanceWindow.DaysOfWeek)),
+		HoursOfDay:      pointer.To(maintenanceWindow.HoursOfDay),
+		LeadTimeInWeeks: pointer.To(maintenanceWindow.LeadTimeInWeeks),
+		Months:          pointer.To(ExpandMonths(maintenanceWindow.Months)),
+		PatchingMode:    pointer.To(cloudexadatainfrastructures.PatchingMode(maintenanceWindow.PatchingMode)),
+		Preference:      pointer.To(cloudexadatainfrastructures.Preference(maintenanceWindow.Preference)),
+		WeeksOfMonth:    pointer.To(maintenanceWindow.WeeksOfMonth),
+	}
+}
+
 func ExpandDayOfWeekTo(daysOfWeek []string) []cloudexadatainfrastructures.DayOfWeek {
 	daysOfWeekConverted := make([]cloudexadatainfrastructures.DayOfWeek, 0, len(daysOfWeek))
 	for _, day := range daysOfWeek {
@@ -94,6 +94,29 @@ func TestExaInfra_update(t *testing.T) {
 	})
 }
 
+func TestExaInfra_updateScaleAndMaintenance(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.ExadataInfraResource{}.ResourceType(), "test")
+	r := ExadataInfraResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.scaledWithMaintenance(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("compute_count").HasValue("3"),
+				check.That(data.ResourceName).Key("storage_count").HasValue("4"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (a ExadataInfraResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -178,6 +201,38 @@ resource "azurerm_oracle_exadata_infrastructure" "test" {
 `, a.template(data), data.RandomInteger, data.Locations.Primary)
 }
 
+func (a ExadataInfraResource) scaledWithMaintenance(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_oracle_exadata_infrastructure" "test" {
+  name                = "OFakeacctest%[2]d"
+  location            = "%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  compute_count       = "3"
+  display_name        = "OFakeacctest%[2]d"
+  shape               = "Exadata.X9M"
+  storage_count       = "4"
+  zones               = ["2"]
+  customer_contacts   = ["test@test.com"]
+
+  maintenance_window {
+    days_of_week       = ["Sunday"]
+    hours_of_day       = [2]
+    months             = ["March"]
+    weeks_of_month     = [1]
+    lead_time_in_weeks = 2
+    patching_mode      = "NonRolling"
+    preference         = "CustomPreference"
+  }
+}
+`, a.template(data), data.RandomInteger, data.Locations.Primary)
+}
+
 func (a ExadataInfraResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -25,6 +25,20 @@ func TestGiVersionsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestGiVersionsDataSource_shape(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_oracle_gi_versions", "test")
+	r := GiVersionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.shape(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("versions.0").Exists(),
+			),
+		},
+	})
+}
+
 func (d GiVersionsDataSource) basic() string {
 	return `
 provider "azurerm" {
@@ -36,3 +50,17 @@ data "azurerm_oracle_gi_versions" "test" {
 }
 `
 }
+
+func (d GiVersionsDataSource) shape() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_oracle_gi_versions" "test" {
+  location = "eastus"
+  shape    = "Exadata.X11M"
+  zone     = "2"
+}
+`
+}
@@ -84,6 +84,35 @@ func AutonomousDatabasePassword(i interface{}, k string) (warnings []string, err
 	return []string{}, []error{}
 }
 
+func AutonomousDatabaseWalletPassword(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return []string{}, append(errors, fmt.Errorf("expected type of %s to be string", k))
+	}
+
+	if len(v) < 8 || len(v) > 60 {
+		return []string{}, append(errors, fmt.Errorf("%v must be 8 to 60 characters", k))
+	}
+
+	hasLetter := false
+	hasNumberOrSpecial := false
+	for _, r := range v {
+		if unicode.IsLetter(r) {
+			hasLetter = true
+		} else {
+			hasNumberOrSpecial = true
+		}
+	}
+	if !hasLetter {
+		return []string{}, append(errors, fmt.Errorf("%v must contain at least one letter", k))
+	}
+	if !hasNumberOrSpecial {
+		return []string{}, append(errors, fmt.Errorf("%v must contain at least one number or special character", k))
+	}
+
+	return []string{}, []error{}
+}
+
 func CustomerContactEmail(i interface{}, k string) (warnings []string, errors []error) {
 	v, ok := i.(string)
 	if !ok {
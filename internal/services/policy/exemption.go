@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// policyExemptionCustomizeDiff validates the arguments shared by the Policy Exemption resources which can't be
+// validated by the schema alone
+func policyExemptionCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// an exemption which has already expired can still be planned, so `expires_on` is only checked when it's changed
+	if d.HasChange("expires_on") && d.NewValueKnown("expires_on") {
+		if err := validatePolicyExemptionExpiresOn(d.Get("expires_on").(string), time.Now()); err != nil {
+			return err
+		}
+	}
+
+	if d.NewValueKnown("policy_definition_reference_ids") {
+		seen := make(map[string]struct{})
+		for _, v := range d.Get("policy_definition_reference_ids").([]interface{}) {
+			referenceId, ok := v.(string)
+			if !ok || referenceId == "" {
+				continue
+			}
+
+			// Policy Definition Reference IDs are unique within a Policy Set Definition regardless of the casing
+			key := strings.ToLower(referenceId)
+			if _, exists := seen[key]; exists {
+				return fmt.Errorf("the Policy Definition Reference ID %q is specified more than once within `policy_definition_reference_ids`", referenceId)
+			}
+			seen[key] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// validatePolicyExemptionExpiresOn validates that the `expires_on` time is after the time the plan is made, since
+// Azure accepts an expiry in the past which results in an exemption which never applies
+func validatePolicyExemptionExpiresOn(input string, now time.Time) error {
+	if input == "" {
+		return nil
+	}
+
+	expiresOn, err := time.Parse(time.RFC3339, input)
+	if err != nil {
+		return fmt.Errorf("parsing `expires_on`: %+v", err)
+	}
+
+	if !expiresOn.After(now) {
+		return fmt.Errorf("`expires_on` must be in the future, got %q", input)
+	}
+
+	return nil
+}
+
+// policyExemptionIsActive returns whether an exemption with the expiry expiresOn applies at the time now
+func policyExemptionIsActive(expiresOn *time.Time, now time.Time) bool {
+	return expiresOn == nil || expiresOn.After(now)
+}
@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/accounts"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+const (
+	machineConfigurationPackageMetaDataName        = "configurationname"
+	machineConfigurationPackageMetaDataVersion     = "configurationversion"
+	machineConfigurationPackageMetaDataContentHash = "contenthash"
+)
+
+type MachineConfigurationPackageResource struct{}
+
+var (
+	_ sdk.Resource                  = MachineConfigurationPackageResource{}
+	_ sdk.ResourceWithCustomizeDiff = MachineConfigurationPackageResource{}
+)
+
+type MachineConfigurationPackageResourceModel struct {
+	Name               string `tfschema:"name"`
+	StorageContainerId string `tfschema:"storage_container_id"`
+	Version            string `tfschema:"version"`
+	Source             string `tfschema:"source"`
+	ContentHash        string `tfschema:"content_hash"`
+	ContentUri         string `tfschema:"content_uri"`
+}
+
+func (r MachineConfigurationPackageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.MachineConfigurationPackageName,
+		},
+
+		"storage_container_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageContainerID,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+\.\d+\.\d+$`), "`version` must be in the format `major.minor.patch`, e.g. `1.0.0`"),
+		},
+
+		"source": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r MachineConfigurationPackageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"content_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MachineConfigurationPackageResource) ModelObject() interface{} {
+	return &MachineConfigurationPackageResourceModel{}
+}
+
+func (r MachineConfigurationPackageResource) ResourceType() string {
+	return "azurerm_policy_machine_configuration_package"
+}
+
+func (r MachineConfigurationPackageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.IsURLWithHTTPS
+}
+
+func (r MachineConfigurationPackageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var model MachineConfigurationPackageResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			containerId, err := commonids.ParseStorageContainerID(model.StorageContainerId)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(containerId.SubscriptionId, containerId.ResourceGroupName, containerId.StorageAccountName))
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", containerId.StorageAccountName, containerId, err)
+			}
+
+			blobsClient, err := storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Blobs Client: %+v", err)
+			}
+
+			accountId := accounts.AccountId{
+				AccountName:   containerId.StorageAccountName,
+				DomainSuffix:  storageClient.StorageDomainSuffix,
+				SubDomainType: accounts.BlobSubDomainType,
+			}
+			blobName := machineConfigurationPackageBlobName(model.Name, model.Version)
+			id := blobs.NewBlobID(accountId, containerId.ContainerName, blobName)
+
+			existing, err := blobsClient.GetProperties(ctx, id.ContainerName, id.BlobName, blobs.GetPropertiesInput{})
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			file, err := os.Open(model.Source)
+			if err != nil {
+				return fmt.Errorf("opening `source` %q: %+v", model.Source, err)
+			}
+			defer file.Close()
+
+			contentHash, err := machineConfigurationPackageContentHash(file)
+			if err != nil {
+				return fmt.Errorf("computing content hash of `source` %q: %+v", model.Source, err)
+			}
+
+			input := blobs.PutBlockBlobInput{
+				ContentType: pointer.To("application/zip"),
+				MetaData: map[string]string{
+					machineConfigurationPackageMetaDataName:        model.Name,
+					machineConfigurationPackageMetaDataVersion:     model.Version,
+					machineConfigurationPackageMetaDataContentHash: contentHash,
+				},
+			}
+			if err := blobsClient.PutBlockBlobFromFile(ctx, id.ContainerName, id.BlobName, file, input); err != nil {
+				return fmt.Errorf("uploading %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineConfigurationPackageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := blobs.ParseBlobID(metadata.ResourceData.Id(), storageClient.StorageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			blobsClient, err := storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Blobs Client: %+v", err)
+			}
+
+			props, err := blobsClient.GetProperties(ctx, id.ContainerName, id.BlobName, blobs.GetPropertiesInput{})
+			if err != nil {
+				if response.WasNotFound(props.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := MachineConfigurationPackageResourceModel{
+				StorageContainerId: commonids.NewStorageContainerID(account.StorageAccountId.SubscriptionId, account.StorageAccountId.ResourceGroupName, account.StorageAccountId.StorageAccountName, id.ContainerName).ID(),
+				Name:               props.MetaData[machineConfigurationPackageMetaDataName],
+				Version:            props.MetaData[machineConfigurationPackageMetaDataVersion],
+				ContentHash:        props.MetaData[machineConfigurationPackageMetaDataContentHash],
+				ContentUri:         id.ID(),
+			}
+
+			// `source` is a local path which can't be retrieved from the blob, so it's only available from state/config
+			if v, ok := metadata.ResourceData.GetOk("source"); ok {
+				state.Source = v.(string)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineConfigurationPackageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := blobs.ParseBlobID(metadata.ResourceData.Id(), storageClient.StorageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q", id.AccountId.AccountName)
+			}
+
+			blobsClient, err := storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Blobs Client: %+v", err)
+			}
+
+			if _, err := blobsClient.Delete(ctx, id.ContainerName, id.BlobName, blobs.DeleteInput{DeleteSnapshots: true}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineConfigurationPackageResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+			if rd.Id() == "" {
+				return nil
+			}
+
+			// the package is published by content hash, so a change to the contents of the file at `source`
+			// requires the package to be published again
+			source := rd.Get("source").(string)
+			if source == "" || rd.HasChange("source") {
+				return nil
+			}
+
+			file, err := os.Open(source)
+			if err != nil {
+				// the file may not exist on this machine, in which case there's nothing to compare against
+				return nil
+			}
+			defer file.Close()
+
+			contentHash, err := machineConfigurationPackageContentHash(file)
+			if err != nil {
+				return fmt.Errorf("computing content hash of `source` %q: %+v", source, err)
+			}
+
+			if old := rd.Get("content_hash").(string); old != "" && !strings.EqualFold(old, contentHash) {
+				if err := rd.SetNew("content_hash", contentHash); err != nil {
+					return fmt.Errorf("setting `content_hash`: %+v", err)
+				}
+				if err := rd.ForceNew("content_hash"); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// machineConfigurationPackageBlobName returns the name of the blob a package version is published to, keeping each
+// version at its own path so that assignments referencing an earlier version are unaffected by a new one
+func machineConfigurationPackageBlobName(name, version string) string {
+	return fmt.Sprintf("%s/%s/%s.zip", name, version, name)
+}
+
+// machineConfigurationPackageContentHash returns the SHA256 hash of the package in the upper-case hexadecimal form
+// expected by the `content_hash` of a Guest Configuration Assignment
+func machineConfigurationPackageContentHash(file *os.File) (string, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return strings.ToUpper(hex.EncodeToString(hash.Sum(nil))), nil
+}
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy_test
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+type MachineConfigurationPackageResource struct{}
+
+func TestAccMachineConfigurationPackage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_machine_configuration_package", "test")
+	r := MachineConfigurationPackageResource{}
+	source := writeMachineConfigurationPackage(t, "AzureWebServer", "1.0.0")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source, "1.0.0"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_hash").IsNotEmpty(),
+				check.That(data.ResourceName).Key("content_uri").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("source"),
+	})
+}
+
+func TestAccMachineConfigurationPackage_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_machine_configuration_package", "test")
+	r := MachineConfigurationPackageResource{}
+	source := writeMachineConfigurationPackage(t, "AzureWebServer", "1.0.0")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source, "1.0.0"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, source)
+		}),
+	})
+}
+
+func TestAccMachineConfigurationPackage_newVersion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_machine_configuration_package", "test")
+	r := MachineConfigurationPackageResource{}
+	source := writeMachineConfigurationPackage(t, "AzureWebServer", "1.0.0")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source, "1.0.0"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source"),
+		{
+			PreConfig: func() {
+				writeMachineConfigurationPackageTo(t, source, "AzureWebServer", "1.1.0")
+			},
+			Config: r.basic(data, source, "1.1.0"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").HasValue("1.1.0"),
+			),
+		},
+		data.ImportStep("source"),
+	})
+}
+
+func TestAccMachineConfigurationPackage_contentChanged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_machine_configuration_package", "test")
+	r := MachineConfigurationPackageResource{}
+	source := writeMachineConfigurationPackage(t, "AzureWebServer", "1.0.0")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source, "1.0.0"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source"),
+		{
+			PreConfig: func() {
+				writeMachineConfigurationPackageTo(t, source, "AzureWebServer", "1.0.0-updated")
+			},
+			Config: r.basic(data, source, "1.0.0"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source"),
+	})
+}
+
+func (r MachineConfigurationPackageResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := blobs.ParseBlobID(state.ID, client.Storage.StorageDomainSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, client.Account.SubscriptionId, id.AccountId.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Account %q for %s", id.AccountId.AccountName, id)
+	}
+
+	blobsClient, err := client.Storage.BlobsDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Blobs Client: %+v", err)
+	}
+
+	resp, err := blobsClient.GetProperties(ctx, id.ContainerName, id.BlobName, blobs.GetPropertiesInput{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+// writeMachineConfigurationPackage writes a minimal Machine Configuration package to a temporary file, the contents
+// only need to be a valid zip for publishing since the package isn't assigned to a machine in these tests
+func writeMachineConfigurationPackage(t *testing.T, name, content string) string {
+	file, err := os.CreateTemp("", "*.zip")
+	if err != nil {
+		t.Fatalf("creating temporary package: %+v", err)
+	}
+	file.Close()
+	t.Cleanup(func() {
+		os.Remove(file.Name())
+	})
+
+	writeMachineConfigurationPackageTo(t, file.Name(), name, content)
+	return file.Name()
+}
+
+func writeMachineConfigurationPackageTo(t *testing.T, path, name, content string) {
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("opening temporary package %q: %+v", path, err)
+	}
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	entries := map[string]string{
+		fmt.Sprintf("%s.mof", name):             fmt.Sprintf("// %s\n", content),
+		fmt.Sprintf("%s.metaconfig.json", name): `{"Type": "Audit"}`,
+	}
+	for entry, body := range entries {
+		w, err := writer.Create(entry)
+		if err != nil {
+			t.Fatalf("adding %q to temporary package: %+v", entry, err)
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Fatalf("writing %q to temporary package: %+v", entry, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("closing temporary package %q: %+v", path, err)
+	}
+}
+
+func (r MachineConfigurationPackageResource) basic(data acceptance.TestData, source, version string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_policy_machine_configuration_package" "test" {
+  name                 = "AzureWebServer"
+  storage_container_id = azurerm_storage_container.test.id
+  version              = "%s"
+  source               = "%s"
+}
+`, r.template(data), version, source)
+}
+
+func (r MachineConfigurationPackageResource) requiresImport(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_policy_machine_configuration_package" "import" {
+  name                 = azurerm_policy_machine_configuration_package.test.name
+  storage_container_id = azurerm_policy_machine_configuration_package.test.storage_container_id
+  version              = azurerm_policy_machine_configuration_package.test.version
+  source               = azurerm_policy_machine_configuration_package.test.source
+}
+`, r.basic(data, source, "1.0.0"))
+}
+
+func (r MachineConfigurationPackageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mcp-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "machineconfiguration"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
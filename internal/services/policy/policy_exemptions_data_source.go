@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PolicyExemptionsDataSource struct{}
+
+var _ sdk.DataSource = PolicyExemptionsDataSource{}
+
+type PolicyExemptionsDataSourceModel struct {
+	ScopeId            string                  `tfschema:"scope_id"`
+	PolicyAssignmentId string                  `tfschema:"policy_assignment_id"`
+	PolicyExemptions   []PolicyExemptionsModel `tfschema:"policy_exemptions"`
+}
+
+type PolicyExemptionsModel struct {
+	Id                           string   `tfschema:"id"`
+	Name                         string   `tfschema:"name"`
+	DisplayName                  string   `tfschema:"display_name"`
+	Description                  string   `tfschema:"description"`
+	ExemptionCategory            string   `tfschema:"exemption_category"`
+	PolicyAssignmentId           string   `tfschema:"policy_assignment_id"`
+	PolicyDefinitionReferenceIds []string `tfschema:"policy_definition_reference_ids"`
+	ExpiresOn                    string   `tfschema:"expires_on"`
+	Metadata                     string   `tfschema:"metadata"`
+}
+
+func (PolicyExemptionsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.Any(
+				commonids.ValidateManagementGroupID,
+				commonids.ValidateSubscriptionID,
+				commonids.ValidateResourceGroupID,
+			),
+		},
+
+		"policy_assignment_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.PolicyAssignmentID,
+		},
+	}
+}
+
+func (PolicyExemptionsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"policy_exemptions": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"exemption_category": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"policy_assignment_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"policy_definition_reference_ids": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"expires_on": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"metadata": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (PolicyExemptionsDataSource) ModelObject() interface{} {
+	return &PolicyExemptionsDataSourceModel{}
+}
+
+func (PolicyExemptionsDataSource) ResourceType() string {
+	return "azurerm_policy_exemptions"
+}
+
+func (PolicyExemptionsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := *metadata.Client.Policy.ExemptionsClient
+
+			var state PolicyExemptionsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := commonids.NewScopeID(state.ScopeId)
+
+			scope, err := parse.PolicyScopeID(state.ScopeId)
+			if err != nil {
+				return err
+			}
+
+			// only the exemptions which haven't expired are returned
+			filter := "excludeExpired()"
+
+			var iterator policy.ExemptionListResultIterator
+			switch scopeId := scope.(type) {
+			case parse.ScopeAtManagementGroup:
+				iterator, err = client.ListForManagementGroupComplete(ctx, scopeId.ManagementGroupName, filter)
+			case parse.ScopeAtSubscription:
+				client.SubscriptionID = scopeId.SubscriptionId
+				iterator, err = client.ListComplete(ctx, filter)
+			case parse.ScopeAtResourceGroup:
+				client.SubscriptionID = scopeId.SubscriptionId
+				iterator, err = client.ListForResourceGroupComplete(ctx, scopeId.ResourceGroup, filter)
+			default:
+				return fmt.Errorf("listing Policy Exemptions is only supported for a Management Group, Subscription or Resource Group, got %q", state.ScopeId)
+			}
+			if err != nil {
+				return fmt.Errorf("listing Policy Exemptions for %s: %+v", id, err)
+			}
+
+			now := time.Now()
+			exemptions := make([]PolicyExemptionsModel, 0)
+			for iterator.NotDone() {
+				exemption := iterator.Value()
+				if err := iterator.NextWithContext(ctx); err != nil {
+					return fmt.Errorf("listing Policy Exemptions for %s: %+v", id, err)
+				}
+
+				props := exemption.ExemptionProperties
+				if props == nil {
+					continue
+				}
+
+				if state.PolicyAssignmentId != "" && !strings.EqualFold(pointer.From(props.PolicyAssignmentID), state.PolicyAssignmentId) {
+					continue
+				}
+
+				var expiresOn *time.Time
+				if props.ExpiresOn != nil {
+					expiresOn = pointer.To(props.ExpiresOn.ToTime())
+				}
+				if !policyExemptionIsActive(expiresOn, now) {
+					continue
+				}
+
+				model := PolicyExemptionsModel{
+					Id:                           pointer.From(exemption.ID),
+					Name:                         pointer.From(exemption.Name),
+					DisplayName:                  pointer.From(props.DisplayName),
+					Description:                  pointer.From(props.Description),
+					ExemptionCategory:            string(props.ExemptionCategory),
+					PolicyAssignmentId:           pointer.From(props.PolicyAssignmentID),
+					PolicyDefinitionReferenceIds: pointer.From(props.PolicyDefinitionReferenceIds),
+					Metadata:                     flattenJSON(props.Metadata),
+				}
+				if expiresOn != nil {
+					model.ExpiresOn = props.ExpiresOn.String()
+				}
+
+				exemptions = append(exemptions, model)
+			}
+
+			sort.Slice(exemptions, func(i, j int) bool {
+				return strings.ToLower(exemptions[i].Id) < strings.ToLower(exemptions[j].Id)
+			})
+			state.PolicyExemptions = exemptions
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PolicyExemptionsDataSource struct{}
+
+func TestAccDataSourcePolicyExemptions_subscription(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_exemptions", "test")
+	d := PolicyExemptionsDataSource{}
+	endDate := time.Now().UTC().Add(time.Hour * 24).Format(time.RFC3339)
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.subscription(data, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("policy_exemptions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("policy_exemptions.0.name").HasValue(fmt.Sprintf("acctest-exemption-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("policy_exemptions.0.exemption_category").HasValue("Waiver"),
+				check.That(data.ResourceName).Key("policy_exemptions.0.expires_on").Exists(),
+			),
+		},
+	})
+}
+
+func (d PolicyExemptionsDataSource) subscription(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_policy_exemptions" "test" {
+  scope_id             = azurerm_subscription_policy_exemption.test.subscription_id
+  policy_assignment_id = azurerm_subscription_policy_exemption.test.policy_assignment_id
+}
+`, SubscriptionPolicyExemptionResource{}.complete(data, endDate))
+}
@@ -325,3 +325,141 @@ func policySetDefinitionRefreshFunc(ctx context.Context, client *policysetdefini
 		return resp, strconv.Itoa(resp.StatusCode), nil
 	}
 }
+
+// policySetDefinitionJSONSchema returns the schema for `definition_json`, which accepts the JSON of a Policy Set
+// Definition (such as one exported from the Azure Portal) in place of the individual arguments
+func policySetDefinitionJSONSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:             pluginsdk.TypeString,
+		Optional:         true,
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: policySetDefinitionJSONDiffSuppressFunc,
+		ConflictsWith: []string{
+			"description",
+			"metadata",
+			"parameters",
+			"policy_definition_group",
+		},
+	}
+}
+
+// expandPolicySetDefinitionJSON returns the properties of the Policy Set Definition from `definition_json`, where the
+// `policyType` is always taken from the `policy_type` argument
+func expandPolicySetDefinitionJSON(input string, policyType string) (*policysetdefinitions.PolicySetDefinitionProperties, error) {
+	raw, err := policySetDefinitionJSONProperties(input)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var props policysetdefinitions.PolicySetDefinitionProperties
+	if err := json.Unmarshal(b, &props); err != nil {
+		return nil, fmt.Errorf("unmarshaling the Policy Set Definition properties: %+v", err)
+	}
+
+	if pointer.From(props.DisplayName) == "" {
+		return nil, fmt.Errorf("`displayName` must be specified")
+	}
+	if len(props.PolicyDefinitions) == 0 {
+		return nil, fmt.Errorf("at least one of `policyDefinitions` must be specified")
+	}
+
+	props.PolicyType = pointer.To(policysetdefinitions.PolicyType(policyType))
+
+	return &props, nil
+}
+
+// flattenPolicySetDefinitionJSON returns the properties of the Policy Set Definition in the same format accepted by
+// `definition_json`
+func flattenPolicySetDefinitionJSON(input *policysetdefinitions.PolicySetDefinitionProperties) (string, error) {
+	if input == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(map[string]interface{}{
+		"properties": input,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// policySetDefinitionJSONProperties returns the properties from the JSON of a Policy Set Definition, which can either
+// be the whole Policy Set Definition or only its properties
+func policySetDefinitionJSONProperties(input string) (map[string]interface{}, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &raw); err != nil {
+		return nil, fmt.Errorf("unmarshaling the Policy Set Definition JSON: %+v", err)
+	}
+
+	if props, ok := raw["properties"].(map[string]interface{}); ok {
+		return props, nil
+	}
+
+	return raw, nil
+}
+
+// policySetDefinitionJSONDiffSuppressFunc suppresses the difference when each value in the configuration matches the
+// value returned by the API, since the API populates values which weren't specified such as the
+// `policyDefinitionReferenceId` and `definitionVersion` of each Policy Definition Reference
+func policySetDefinitionJSONDiffSuppressFunc(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldProps, err := policySetDefinitionJSONProperties(old)
+	if err != nil {
+		return false
+	}
+	newProps, err := policySetDefinitionJSONProperties(new)
+	if err != nil {
+		return false
+	}
+
+	// the `policyType` is managed using the `policy_type` argument
+	for _, props := range []map[string]interface{}{oldProps, newProps} {
+		delete(props, "policyType")
+		if metadata, ok := props["metadata"].(map[string]interface{}); ok {
+			for _, key := range []string{"createdBy", "createdOn", "updatedBy", "updatedOn"} {
+				delete(metadata, key)
+			}
+		}
+	}
+
+	return policySetDefinitionJSONIsSubset(newProps, oldProps)
+}
+
+func policySetDefinitionJSONIsSubset(subset, superset interface{}) bool {
+	switch s := subset.(type) {
+	case map[string]interface{}:
+		m, ok := superset.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range s {
+			if !policySetDefinitionJSONIsSubset(v, m[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := superset.([]interface{})
+		if !ok || len(s) != len(l) {
+			return false
+		}
+		for i := range s {
+			if !policySetDefinitionJSONIsSubset(s[i], l[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(subset, superset)
+	}
+}
@@ -111,6 +111,11 @@ func dataSourceArmPolicySetDefinition() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"definition_json": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"policy_definition_group": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -213,6 +218,14 @@ func dataSourceArmPolicySetDefinitionRead(d *pluginsdk.ResourceData, meta interf
 		return fmt.Errorf("setting `policy_definition_group`: %+v", err)
 	}
 
+	definitionJson, err := json.Marshal(map[string]interface{}{
+		"properties": setDefinition.SetDefinitionProperties,
+	})
+	if err != nil {
+		return fmt.Errorf("flattening `definition_json`: %+v", err)
+	}
+	d.Set("definition_json", string(definitionJson))
+
 	return nil
 }
 
@@ -27,6 +27,7 @@ func TestAccDataSourceAzureRMPolicySetDefinition_builtIn(t *testing.T) {
 				check.That(data.ResourceName).Key("parameters").HasValue("{\"EnforcePasswordHistory\":{\"type\":\"String\",\"defaultValue\":\"24\",\"metadata\":{\"description\":\"The Enforce password history setting determines the number of unique new passwords that must be associated with a user account before an old password can be reused.\",\"displayName\":\"Enforce password history\"}},\"IncludeArcMachines\":{\"type\":\"String\",\"allowedValues\":[\"true\",\"false\"],\"defaultValue\":\"false\",\"metadata\":{\"description\":\"By selecting this option, you agree to be charged monthly per Arc connected machine.\",\"displayName\":\"Include Arc connected servers\"}},\"MaximumPasswordAge\":{\"type\":\"String\",\"defaultValue\":\"70\",\"metadata\":{\"description\":\"The Maximum password age setting determines the period of time (in days) that a password can be used before the system requires the user to change it.\",\"displayName\":\"Maximum password age\"}},\"MinimumPasswordAge\":{\"type\":\"String\",\"defaultValue\":\"1\",\"metadata\":{\"description\":\"The Minimum password age setting determines the period of time (in days) that a password must be used before the user can change it.\",\"displayName\":\"Minimum password age\"}},\"MinimumPasswordLength\":{\"type\":\"String\",\"defaultValue\":\"14\",\"metadata\":{\"description\":\"The Minimum password length setting determines the least number of characters that can make up a password for a user account.\",\"displayName\":\"Minimum password length\"}}}"),
 				check.That(data.ResourceName).Key("policy_definitions").Exists(),
 				check.That(data.ResourceName).Key("policy_definition_reference.#").HasValue("9"),
+				check.That(data.ResourceName).Key("definition_json").Exists(),
 			),
 		},
 	})
@@ -83,10 +83,13 @@ func resourcePolicySetDefinitionSchema() map[string]*pluginsdk.Schema {
 
 		"display_name": {
 			Type:         pluginsdk.TypeString,
-			Required:     true,
+			Optional:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"display_name", "definition_json"},
 		},
 
+		"definition_json": policySetDefinitionJSONSchema(),
+
 		"description": {
 			Type:     pluginsdk.TypeString,
 			Optional: true,
@@ -109,8 +112,9 @@ func resourcePolicySetDefinitionSchema() map[string]*pluginsdk.Schema {
 
 		// lintignore: S013
 		"policy_definition_reference": {
-			Type:     pluginsdk.TypeList,
-			Required: true,
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ExactlyOneOf: []string{"policy_definition_reference", "definition_json"},
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"policy_definition_id": {
@@ -259,6 +263,14 @@ func resourceArmPolicySetDefinitionCreate(d *pluginsdk.ResourceData, meta interf
 		props.PolicyDefinitionGroups = expandAzureRMPolicySetDefinitionPolicyGroups(v.(*pluginsdk.Set).List())
 	}
 
+	if v := d.Get("definition_json").(string); v != "" {
+		definitionProps, err := expandPolicySetDefinitionJSON(v, d.Get("policy_type").(string))
+		if err != nil {
+			return fmt.Errorf("expanding `definition_json`: %+v", err)
+		}
+		parameters.Properties = definitionProps
+	}
+
 	if _, err = client.CreateOrUpdate(ctx, id, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -347,6 +359,14 @@ func createForManagementGroup(ctx context.Context, client *policysetdefinitions.
 		props.PolicyDefinitionGroups = expandAzureRMPolicySetDefinitionPolicyGroups(v.(*pluginsdk.Set).List())
 	}
 
+	if v := d.Get("definition_json").(string); v != "" {
+		definitionProps, err := expandPolicySetDefinitionJSON(v, d.Get("policy_type").(string))
+		if err != nil {
+			return fmt.Errorf("expanding `definition_json`: %+v", err)
+		}
+		parameters.Properties = definitionProps
+	}
+
 	if _, err = client.CreateOrUpdateAtManagementGroup(ctx, id, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -468,6 +488,14 @@ func resourceArmPolicySetDefinitionUpdate(d *pluginsdk.ResourceData, meta interf
 		props.PolicyDefinitions = definitions
 	}
 
+	if v := d.Get("definition_json").(string); v != "" && d.HasChanges("definition_json", "policy_type") {
+		definitionProps, err := expandPolicySetDefinitionJSON(v, d.Get("policy_type").(string))
+		if err != nil {
+			return fmt.Errorf("expanding `definition_json`: %+v", err)
+		}
+		model.Properties = definitionProps
+	}
+
 	if _, err = client.CreateOrUpdate(ctx, id, *model); err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
@@ -555,6 +583,14 @@ func updateForManagementGroup(ctx context.Context, client *policysetdefinitions.
 		props.PolicyDefinitions = definitions
 	}
 
+	if v := d.Get("definition_json").(string); v != "" && d.HasChanges("definition_json", "policy_type") {
+		definitionProps, err := expandPolicySetDefinitionJSON(v, d.Get("policy_type").(string))
+		if err != nil {
+			return fmt.Errorf("expanding `definition_json`: %+v", err)
+		}
+		model.Properties = definitionProps
+	}
+
 	if _, err = client.CreateOrUpdateAtManagementGroup(ctx, id, *model); err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
@@ -602,6 +638,17 @@ func resourceArmPolicySetDefinitionRead(d *pluginsdk.ResourceData, meta interfac
 
 		if props := model.Properties; props != nil {
 			d.Set("policy_type", string(pointer.From(props.PolicyType)))
+
+			// when `definition_json` is used the individual arguments aren't set, since they conflict with it
+			if d.Get("definition_json").(string) != "" {
+				definitionJson, err := flattenPolicySetDefinitionJSON(props)
+				if err != nil {
+					return fmt.Errorf("flattening `definition_json`: %+v", err)
+				}
+				d.Set("definition_json", definitionJson)
+				return nil
+			}
+
 			d.Set("display_name", props.DisplayName)
 			d.Set("description", props.Description)
 
@@ -671,6 +718,17 @@ func readForManagementGroup(ctx context.Context, client *policysetdefinitions.Po
 
 		if props := model.Properties; props != nil {
 			d.Set("policy_type", string(pointer.From(props.PolicyType)))
+
+			// when `definition_json` is used the individual arguments aren't set, since they conflict with it
+			if d.Get("definition_json").(string) != "" {
+				definitionJson, err := flattenPolicySetDefinitionJSON(props)
+				if err != nil {
+					return fmt.Errorf("flattening `definition_json`: %+v", err)
+				}
+				d.Set("definition_json", definitionJson)
+				return nil
+			}
+
 			d.Set("display_name", props.DisplayName)
 			d.Set("description", props.Description)
 
@@ -866,6 +924,7 @@ type PolicySetDefinitionResourceModel struct {
 	Parameters                string                           `tfschema:"parameters"`
 	PolicyDefinitionReference []PolicyDefinitionReferenceModel `tfschema:"policy_definition_reference"`
 	PolicyDefinitionGroup     []PolicyDefinitionGroupModel     `tfschema:"policy_definition_group"`
+	DefinitionJSON            string                           `tfschema:"definition_json"`
 }
 
 var (
@@ -883,6 +942,11 @@ func (r PolicySetDefinitionResource) StateUpgraders() sdk.StateUpgradeData {
 }
 
 func (r PolicySetDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	policyDefinitionReference := policyDefinitionReferenceSchema()
+	policyDefinitionReference.Required = false
+	policyDefinitionReference.Optional = true
+	policyDefinitionReference.ExactlyOneOf = []string{"policy_definition_reference", "definition_json"}
+
 	return map[string]*pluginsdk.Schema{
 		"name": {
 			Type:         pluginsdk.TypeString,
@@ -900,10 +964,13 @@ func (r PolicySetDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"display_name": {
 			Type:         pluginsdk.TypeString,
-			Required:     true,
+			Optional:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"display_name", "definition_json"},
 		},
 
+		"definition_json": policySetDefinitionJSONSchema(),
+
 		"description": {
 			Type:     pluginsdk.TypeString,
 			Optional: true,
@@ -924,7 +991,7 @@ func (r PolicySetDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
 			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 		},
 
-		"policy_definition_reference": policyDefinitionReferenceSchema(),
+		"policy_definition_reference": policyDefinitionReference,
 
 		"policy_definition_group": policyDefinitionGroupSchema(),
 	}
@@ -1006,6 +1073,14 @@ func (r PolicySetDefinitionResource) Create() sdk.ResourceFunc {
 				props.PolicyDefinitionGroups = expandPolicyDefinitionGroup(model.PolicyDefinitionGroup)
 			}
 
+			if model.DefinitionJSON != "" {
+				definitionProps, err := expandPolicySetDefinitionJSON(model.DefinitionJSON, model.PolicyType)
+				if err != nil {
+					return fmt.Errorf("expanding `definition_json`: %+v", err)
+				}
+				parameters.Properties = definitionProps
+			}
+
 			if _, err = client.CreateOrUpdate(ctx, id, parameters); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
@@ -1042,9 +1117,20 @@ func (r PolicySetDefinitionResource) Read() sdk.ResourceFunc {
 
 			if model != nil {
 				if props := model.Properties; props != nil {
+					state.PolicyType = string(pointer.From(props.PolicyType))
+
+					// when `definition_json` is used the individual arguments aren't set, since they conflict with it
+					if metadata.ResourceData.Get("definition_json").(string) != "" {
+						definitionJson, err := flattenPolicySetDefinitionJSON(props)
+						if err != nil {
+							return fmt.Errorf("flattening `definition_json`: %+v", err)
+						}
+						state.DefinitionJSON = definitionJson
+						return metadata.Encode(&state)
+					}
+
 					state.Description = pointer.From(props.Description)
 					state.DisplayName = pointer.From(props.DisplayName)
-					state.PolicyType = string(pointer.From(props.PolicyType))
 
 					if v, ok := pointer.From(props.Metadata).(map[string]interface{}); ok {
 						flattenedMetadata, err := pluginsdk.FlattenJsonToString(v)
@@ -1151,6 +1237,14 @@ func (r PolicySetDefinitionResource) Update() sdk.ResourceFunc {
 				props.PolicyDefinitionGroups = expandPolicyDefinitionGroup(config.PolicyDefinitionGroup)
 			}
 
+			if config.DefinitionJSON != "" && metadata.ResourceData.HasChanges("definition_json", "policy_type") {
+				definitionProps, err := expandPolicySetDefinitionJSON(config.DefinitionJSON, config.PolicyType)
+				if err != nil {
+					return fmt.Errorf("expanding `definition_json`: %+v", err)
+				}
+				model.Properties = definitionProps
+			}
+
 			if _, err := client.CreateOrUpdate(ctx, *id, *model); err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
@@ -294,6 +294,43 @@ func TestAccAzureRMPolicySetDefinition_policyDefinitionVersion(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPolicySetDefinition_definitionJSON(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_set_definition", "test")
+	r := PolicySetDefinitionResourceTest{}
+
+	// `definition_json` isn't set during import, so there's no import step
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.definitionJSON(data, "acctestPolSet-display"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("policy_type").HasValue("Custom"),
+			),
+		},
+		{
+			Config: r.definitionJSON(data, "acctestPolSet-updated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccAzureRMPolicySetDefinition_definitionJSONFromDataSource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_set_definition", "test")
+	r := PolicySetDefinitionResourceTest{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.definitionJSONFromDataSource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("policy_type").HasValue("Custom"),
+			),
+		},
+	})
+}
+
 func (r PolicySetDefinitionResourceTest) builtIn(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -896,6 +933,73 @@ VALUES
 `, r.template(data), data.RandomInteger, version)
 }
 
+func (r PolicySetDefinitionResourceTest) definitionJSON(data acceptance.TestData, displayName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_policy_set_definition" "test" {
+  name        = "acctestPolSet-%[2]d"
+  policy_type = "Custom"
+
+  definition_json = jsonencode({
+    properties = {
+      displayName = "%[3]s-%[2]d"
+      description = "Imported from an initiative definition"
+      metadata = {
+        category = "Custom"
+      }
+      parameters = {
+        allowedLocations = {
+          type = "Array"
+          metadata = {
+            description = "The list of allowed locations for resources."
+            displayName = "Allowed locations"
+            strongType  = "location"
+          }
+        }
+      }
+      policyDefinitionGroups = [
+        {
+          name        = "group-1"
+          displayName = "Group 1"
+        }
+      ]
+      policyDefinitions = [
+        {
+          policyDefinitionId          = azurerm_policy_definition.test.id
+          policyDefinitionReferenceId = "allowed-locations"
+          groupNames                  = ["group-1"]
+          parameters = {
+            allowedLocations = {
+              value = "[parameters('allowedLocations')]"
+            }
+          }
+        }
+      ]
+    }
+  })
+}
+`, r.template(data), data.RandomInteger, displayName)
+}
+
+func (r PolicySetDefinitionResourceTest) definitionJSONFromDataSource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_policy_set_definition" "test" {
+  name = "095e4ed9-c835-4ab6-9439-b5644362a06c"
+}
+
+resource "azurerm_policy_set_definition" "test" {
+  name            = "acctestPolSet-%d"
+  policy_type     = "Custom"
+  definition_json = data.azurerm_policy_set_definition.test.definition_json
+}
+`, data.RandomInteger)
+}
+
 func (r PolicySetDefinitionResourceTest) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -23,11 +23,13 @@ type Registration struct{}
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		AssignmentDataSource{},
+		PolicyExemptionsDataSource{},
 	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
 	resources := []sdk.Resource{
+		MachineConfigurationPackageResource{},
 		ManagementGroupAssignmentResource{},
 		ManagementGroupPolicySetDefinitionResource{},
 		ResourceAssignmentResource{},
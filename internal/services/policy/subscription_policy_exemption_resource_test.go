@@ -6,6 +6,7 @@ package policy_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -81,6 +82,31 @@ func TestAccAzureRMSubscriptionPolicyExemption_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSubscriptionPolicyExemption_expiresOnInThePast(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_exemption", "test")
+	r := SubscriptionPolicyExemptionResource{}
+	endDate := time.Now().UTC().Add(time.Hour * -24).Format(time.RFC3339)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.complete(data, endDate),
+			ExpectError: regexp.MustCompile("`expires_on` must be in the future"),
+		},
+	})
+}
+
+func TestAccAzureRMSubscriptionPolicyExemption_duplicateReferenceIds(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_exemption", "test")
+	r := SubscriptionPolicyExemptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.duplicateReferenceIds(data),
+			ExpectError: regexp.MustCompile("is specified more than once within `policy_definition_reference_ids`"),
+		},
+	})
+}
+
 func (r SubscriptionPolicyExemptionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SubscriptionPolicyExemptionID(state.ID)
 	if err != nil {
@@ -111,6 +137,21 @@ resource "azurerm_subscription_policy_exemption" "test" {
 `, SubscriptionAssignmentTestResource{}.withBuiltInPolicySetBasic(data), data.RandomInteger)
 }
 
+func (r SubscriptionPolicyExemptionResource) duplicateReferenceIds(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subscription_policy_exemption" "test" {
+  name                 = "acctest-exemption-%d"
+  subscription_id      = data.azurerm_subscription.test.id
+  policy_assignment_id = azurerm_subscription_policy_assignment.test.id
+  exemption_category   = "Mitigated"
+
+  policy_definition_reference_ids = ["reference-1", "Reference-1"]
+}
+`, SubscriptionAssignmentTestResource{}.withBuiltInPolicySetBasic(data), data.RandomInteger)
+}
+
 func (r SubscriptionPolicyExemptionResource) complete(data acceptance.TestData, endDate string) string {
 	return fmt.Sprintf(`
 %s
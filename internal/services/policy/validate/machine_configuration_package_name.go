@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func MachineConfigurationPackageName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// The name is used as the Guest Configuration name on the machine and as the file name of the package, so it
+	// is limited to the characters accepted by the Guest Configuration agent.
+	if !regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,63}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and 64 characters, start with a letter or number and can only contain letters, numbers, underscores and hyphens", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateMachineConfigurationPackageName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected bool
+	}{
+		{
+			Name:     "empty",
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Name:     "basic example",
+			Input:    "AzureWebServer",
+			Expected: true,
+		},
+		{
+			Name:     "underscores and hyphens",
+			Input:    "audit_web-server",
+			Expected: true,
+		},
+		{
+			Name:     "start with an underscore",
+			Input:    "_hello",
+			Expected: false,
+		},
+		{
+			Name:     "contains a period",
+			Input:    "hello.world",
+			Expected: false,
+		},
+		{
+			Name:     "contains a slash",
+			Input:    "hello/world",
+			Expected: false,
+		},
+		{
+			Name:     "64 characters",
+			Input:    strings.Repeat("a", 64),
+			Expected: true,
+		},
+		{
+			Name:     "65 characters",
+			Input:    strings.Repeat("a", 65),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		_, errors := MachineConfigurationPackageName(v.Input, "name")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
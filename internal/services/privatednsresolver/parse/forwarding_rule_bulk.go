@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ForwardingRuleBulkId struct {
+	SubscriptionId           string
+	ResourceGroup            string
+	DnsForwardingRulesetName string
+	Name                     string
+}
+
+func NewForwardingRuleBulkID(subscriptionId, resourceGroup, dnsForwardingRulesetName, name string) ForwardingRuleBulkId {
+	return ForwardingRuleBulkId{
+		SubscriptionId:           subscriptionId,
+		ResourceGroup:            resourceGroup,
+		DnsForwardingRulesetName: dnsForwardingRulesetName,
+		Name:                     name,
+	}
+}
+
+func (id ForwardingRuleBulkId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Dns Forwarding Ruleset Name %q", id.DnsForwardingRulesetName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Forwarding Rule Bulk", segmentsStr)
+}
+
+func (id ForwardingRuleBulkId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsForwardingRulesets/%s/forwardingRuleBulks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, id.Name)
+}
+
+// ForwardingRuleBulkID parses a ForwardingRuleBulk ID into an ForwardingRuleBulkId struct
+func ForwardingRuleBulkID(input string) (*ForwardingRuleBulkId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ForwardingRuleBulk ID: %+v", input, err)
+	}
+
+	resourceId := ForwardingRuleBulkId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnsForwardingRulesetName, err = id.PopSegment("dnsForwardingRulesets"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("forwardingRuleBulks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,570 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatednsresolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDNSResolverForwardingRuleBulkModel struct {
+	Name                   string                            `tfschema:"name"`
+	DnsForwardingRulesetId string                            `tfschema:"dns_forwarding_ruleset_id"`
+	Rules                  []PrivateDNSResolverBulkRuleModel `tfschema:"rule"`
+	MaxParallelOperations  int64                             `tfschema:"max_parallel_operations"`
+	ForwardingRuleIds      map[string]string                 `tfschema:"forwarding_rule_ids"`
+}
+
+type PrivateDNSResolverBulkRuleModel struct {
+	Name             string                 `tfschema:"name"`
+	DomainName       string                 `tfschema:"domain_name"`
+	Enabled          bool                   `tfschema:"enabled"`
+	Metadata         map[string]string      `tfschema:"metadata"`
+	TargetDnsServers []TargetDnsServerModel `tfschema:"target_dns_servers"`
+}
+
+type PrivateDNSResolverForwardingRuleBulkResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate         = PrivateDNSResolverForwardingRuleBulkResource{}
+	_ sdk.ResourceWithCustomizeDiff  = PrivateDNSResolverForwardingRuleBulkResource{}
+	_ sdk.ResourceWithCustomImporter = PrivateDNSResolverForwardingRuleBulkResource{}
+)
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_forwarding_rule_bulk"
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) ModelObject() interface{} {
+	return &PrivateDNSResolverForwardingRuleBulkModel{}
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ForwardingRuleBulkID
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dns_forwarding_ruleset_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: dnsforwardingrulesets.ValidateDnsForwardingRulesetID,
+		},
+
+		"rule": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"domain_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"target_dns_servers": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"ip_address": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsIPAddress,
+								},
+
+								"port": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      53,
+									ValidateFunc: validation.IsPortNumber,
+								},
+							},
+						},
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"metadata": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"max_parallel_operations": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      5,
+			ValidateFunc: validation.IntBetween(1, 25),
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"forwarding_rule_ids": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config PrivateDNSResolverForwardingRuleBulkModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return validateForwardingRuleBulkRules(config.Rules)
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+			rulesetsClient := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+
+			var model PrivateDNSResolverForwardingRuleBulkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			rulesetId, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(model.DnsForwardingRulesetId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewForwardingRuleBulkID(rulesetId.SubscriptionId, rulesetId.ResourceGroupName, rulesetId.DnsForwardingRulesetName, model.Name)
+
+			if resp, err := rulesetsClient.Get(ctx, *rulesetId); err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *rulesetId, err)
+			} else if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *rulesetId)
+			}
+
+			if err := checkForwardingRuleBulkRulesDoNotExist(ctx, client, id, int(model.MaxParallelOperations), model.Rules); err != nil {
+				return err
+			}
+
+			model.ForwardingRuleIds = make(map[string]string)
+			var mutex sync.Mutex
+			err = utils.RunInParallel(int(model.MaxParallelOperations), model.Rules, func(rule PrivateDNSResolverBulkRuleModel) error {
+				ruleId := forwardingrules.NewForwardingRuleID(rulesetId.SubscriptionId, rulesetId.ResourceGroupName, rulesetId.DnsForwardingRulesetName, rule.Name)
+				if _, err := client.CreateOrUpdate(ctx, ruleId, expandForwardingRuleBulkRule(rule), forwardingrules.CreateOrUpdateOperationOptions{}); err != nil {
+					return fmt.Errorf("creating %s: %+v", ruleId, err)
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				model.ForwardingRuleIds[rule.Name] = ruleId.ID()
+				return nil
+			})
+			if err != nil {
+				// returning an error once the ID has been set would taint the resource, replacing every rule on the next
+				// apply - as such the rules which were provisioned are removed again instead
+				provisioned := make([]PrivateDNSResolverBulkRuleModel, 0, len(model.ForwardingRuleIds))
+				for name := range model.ForwardingRuleIds {
+					provisioned = append(provisioned, PrivateDNSResolverBulkRuleModel{Name: name})
+				}
+				if deleteErr := utils.RunInParallel(int(model.MaxParallelOperations), provisioned, func(rule PrivateDNSResolverBulkRuleModel) error {
+					ruleId := forwardingrules.NewForwardingRuleID(rulesetId.SubscriptionId, rulesetId.ResourceGroupName, rulesetId.DnsForwardingRulesetName, rule.Name)
+					if _, err := client.Delete(ctx, ruleId, forwardingrules.DeleteOperationOptions{}); err != nil {
+						return fmt.Errorf("deleting %s: %+v", ruleId, err)
+					}
+					return nil
+				}); deleteErr != nil {
+					return errors.Join(err, fmt.Errorf("removing the Forwarding Rules which were provisioned: %+v", deleteErr))
+				}
+
+				return err
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := parse.ForwardingRuleBulkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state PrivateDNSResolverForwardingRuleBulkModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.Name = id.Name
+			state.DnsForwardingRulesetId = dnsforwardingrulesets.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName).ID()
+			if state.MaxParallelOperations == 0 {
+				state.MaxParallelOperations = 5
+			}
+
+			tracked := make([]PrivateDNSResolverBulkRuleModel, 0)
+			for name := range state.ForwardingRuleIds {
+				tracked = append(tracked, PrivateDNSResolverBulkRuleModel{Name: name})
+			}
+
+			var mutex sync.Mutex
+			existingRuleIds := make(map[string]string)
+			rules := make([]PrivateDNSResolverBulkRuleModel, 0)
+			err = utils.RunInParallel(int(state.MaxParallelOperations), tracked, func(rule PrivateDNSResolverBulkRuleModel) error {
+				ruleId, err := forwardingrules.ParseForwardingRuleIDInsensitively(state.ForwardingRuleIds[rule.Name])
+				if err != nil {
+					return err
+				}
+
+				resp, err := client.Get(ctx, *ruleId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return nil
+					}
+					return fmt.Errorf("retrieving %s: %+v", *ruleId, err)
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				existingRuleIds[rule.Name] = ruleId.ID()
+				if model := resp.Model; model != nil {
+					rules = append(rules, flattenForwardingRuleBulkRule(rule.Name, model.Properties))
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(state.ForwardingRuleIds) > 0 && len(existingRuleIds) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+			state.ForwardingRuleIds = existingRuleIds
+			state.Rules = rules
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := parse.ForwardingRuleBulkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDNSResolverForwardingRuleBulkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the previous rules are only used to skip unchanged rules, so they're compared in their normalised form
+			oldRules, _ := metadata.ResourceData.GetChange("rule")
+			previous := make(map[string]PrivateDNSResolverBulkRuleModel)
+			for _, rule := range forwardingRuleBulkRulesFromRaw(oldRules.(*pluginsdk.Set).List()) {
+				previous[rule.Name] = rule
+			}
+
+			oldRuleIds, _ := metadata.ResourceData.GetChange("forwarding_rule_ids")
+			trackedRuleIds := make(map[string]string)
+			for name, ruleId := range oldRuleIds.(map[string]interface{}) {
+				trackedRuleIds[name] = ruleId.(string)
+			}
+
+			desired := make(map[string]struct{})
+			rulesToUpsert := make([]PrivateDNSResolverBulkRuleModel, 0)
+			for _, rule := range model.Rules {
+				desired[rule.Name] = struct{}{}
+
+				_, isTracked := trackedRuleIds[rule.Name]
+				if previousRule, ok := previous[rule.Name]; isTracked && ok && reflect.DeepEqual(previousRule, normaliseForwardingRuleBulkRule(rule)) {
+					continue
+				}
+				rulesToUpsert = append(rulesToUpsert, rule)
+			}
+
+			rulesToDelete := make([]PrivateDNSResolverBulkRuleModel, 0)
+			for name := range trackedRuleIds {
+				if _, ok := desired[name]; !ok {
+					rulesToDelete = append(rulesToDelete, PrivateDNSResolverBulkRuleModel{Name: name})
+				}
+			}
+
+			rulesToAdd := make([]PrivateDNSResolverBulkRuleModel, 0)
+			for _, rule := range rulesToUpsert {
+				if _, ok := trackedRuleIds[rule.Name]; !ok {
+					rulesToAdd = append(rulesToAdd, rule)
+				}
+			}
+			if err := checkForwardingRuleBulkRulesDoNotExist(ctx, client, *id, int(model.MaxParallelOperations), rulesToAdd); err != nil {
+				return err
+			}
+
+			var mutex sync.Mutex
+			deleteErr := utils.RunInParallel(int(model.MaxParallelOperations), rulesToDelete, func(rule PrivateDNSResolverBulkRuleModel) error {
+				ruleId, err := forwardingrules.ParseForwardingRuleIDInsensitively(trackedRuleIds[rule.Name])
+				if err != nil {
+					return err
+				}
+
+				if _, err := client.Delete(ctx, *ruleId, forwardingrules.DeleteOperationOptions{}); err != nil {
+					return fmt.Errorf("deleting %s: %+v", *ruleId, err)
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				delete(trackedRuleIds, rule.Name)
+				return nil
+			})
+
+			upsertErr := utils.RunInParallel(int(model.MaxParallelOperations), rulesToUpsert, func(rule PrivateDNSResolverBulkRuleModel) error {
+				ruleId := forwardingrules.NewForwardingRuleID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, rule.Name)
+				if _, err := client.CreateOrUpdate(ctx, ruleId, expandForwardingRuleBulkRule(rule), forwardingrules.CreateOrUpdateOperationOptions{}); err != nil {
+					return fmt.Errorf("creating/updating %s: %+v", ruleId, err)
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				trackedRuleIds[rule.Name] = ruleId.ID()
+				return nil
+			})
+
+			model.ForwardingRuleIds = trackedRuleIds
+			err = errors.Join(deleteErr, upsertErr)
+			if encodeErr := metadata.Encode(&model); encodeErr != nil {
+				return errors.Join(err, fmt.Errorf("encoding: %+v", encodeErr))
+			}
+
+			return err
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := parse.ForwardingRuleBulkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDNSResolverForwardingRuleBulkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			rules := make([]PrivateDNSResolverBulkRuleModel, 0)
+			for name := range model.ForwardingRuleIds {
+				rules = append(rules, PrivateDNSResolverBulkRuleModel{Name: name})
+			}
+
+			if err := utils.RunInParallel(int(model.MaxParallelOperations), rules, func(rule PrivateDNSResolverBulkRuleModel) error {
+				ruleId, err := forwardingrules.ParseForwardingRuleIDInsensitively(model.ForwardingRuleIds[rule.Name])
+				if err != nil {
+					return err
+				}
+
+				if _, err := client.Delete(ctx, *ruleId, forwardingrules.DeleteOperationOptions{}); err != nil {
+					return fmt.Errorf("deleting %s: %+v", *ruleId, err)
+				}
+				return nil
+			}); err != nil {
+				return fmt.Errorf("deleting the Forwarding Rules of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// validateForwardingRuleBulkRules checks the rules for the conflicts the API would otherwise only reject part way
+// through provisioning, since each rule is created individually
+func validateForwardingRuleBulkRules(rules []PrivateDNSResolverBulkRuleModel) error {
+	names := make(map[string]struct{})
+	domainNames := make(map[string]string)
+	for _, rule := range rules {
+		// the values may not be known until apply when they're sourced from another resource
+		if rule.Name == "" || rule.DomainName == "" {
+			continue
+		}
+
+		name := strings.ToLower(rule.Name)
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("the `name` %q is used by more than one `rule`", rule.Name)
+		}
+		names[name] = struct{}{}
+
+		if !strings.HasSuffix(rule.DomainName, ".") {
+			return fmt.Errorf("the `domain_name` %q of the `rule` %q must be a fully qualified domain name ending with a `.`", rule.DomainName, rule.Name)
+		}
+
+		domainName := strings.ToLower(rule.DomainName)
+		if existing, ok := domainNames[domainName]; ok {
+			return fmt.Errorf("the `domain_name` %q is used by both the `rule` %q and the `rule` %q", rule.DomainName, existing, rule.Name)
+		}
+		domainNames[domainName] = rule.Name
+
+		if len(rule.TargetDnsServers) == 0 {
+			return fmt.Errorf("the `rule` %q must specify at least one `target_dns_servers` block", rule.Name)
+		}
+	}
+
+	return nil
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`azurerm_private_dns_resolver_forwarding_rule_bulk` can't be imported since the Forwarding Rules it manages are only tracked in the state")
+	}
+}
+
+// checkForwardingRuleBulkRulesDoNotExist checks the rules which are about to be created up front, so that an existing
+// rule doesn't leave a partially provisioned set of rules
+func checkForwardingRuleBulkRulesDoNotExist(ctx context.Context, client *forwardingrules.ForwardingRulesClient, id parse.ForwardingRuleBulkId, maxParallel int, rules []PrivateDNSResolverBulkRuleModel) error {
+	return utils.RunInParallel(maxParallel, rules, func(rule PrivateDNSResolverBulkRuleModel) error {
+		ruleId := forwardingrules.NewForwardingRuleID(id.SubscriptionId, id.ResourceGroup, id.DnsForwardingRulesetName, rule.Name)
+		existing, err := client.Get(ctx, ruleId)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", ruleId, err)
+			}
+			return nil
+		}
+		return fmt.Errorf("%s already exists and cannot be managed by %s - either delete it or remove it from the `rule` blocks", ruleId, id)
+	})
+}
+
+func expandForwardingRuleBulkRule(rule PrivateDNSResolverBulkRuleModel) forwardingrules.ForwardingRule {
+	forwardingRuleState := forwardingrules.ForwardingRuleStateEnabled
+	if !rule.Enabled {
+		forwardingRuleState = forwardingrules.ForwardingRuleStateDisabled
+	}
+
+	return forwardingrules.ForwardingRule{
+		Properties: forwardingrules.ForwardingRuleProperties{
+			DomainName:          rule.DomainName,
+			ForwardingRuleState: pointer.To(forwardingRuleState),
+			Metadata:            pointer.To(rule.Metadata),
+			TargetDnsServers:    pointer.From(expandTargetDnsServerModel(rule.TargetDnsServers)),
+		},
+	}
+}
+
+func flattenForwardingRuleBulkRule(name string, input forwardingrules.ForwardingRuleProperties) PrivateDNSResolverBulkRuleModel {
+	return PrivateDNSResolverBulkRuleModel{
+		Name:             name,
+		DomainName:       input.DomainName,
+		Enabled:          pointer.From(input.ForwardingRuleState) == forwardingrules.ForwardingRuleStateEnabled,
+		Metadata:         pointer.From(input.Metadata),
+		TargetDnsServers: flattenTargetDnsServerModel(&input.TargetDnsServers),
+	}
+}
+
+// forwardingRuleBulkRulesFromRaw converts the raw `rule` blocks from the state into their normalised models
+func forwardingRuleBulkRulesFromRaw(input []interface{}) []PrivateDNSResolverBulkRuleModel {
+	output := make([]PrivateDNSResolverBulkRuleModel, 0, len(input))
+	for _, item := range input {
+		raw, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rule := PrivateDNSResolverBulkRuleModel{
+			Name:             raw["name"].(string),
+			DomainName:       raw["domain_name"].(string),
+			Enabled:          raw["enabled"].(bool),
+			Metadata:         make(map[string]string),
+			TargetDnsServers: make([]TargetDnsServerModel, 0),
+		}
+
+		for k, v := range raw["metadata"].(map[string]interface{}) {
+			rule.Metadata[k] = v.(string)
+		}
+
+		for _, server := range raw["target_dns_servers"].([]interface{}) {
+			if s, ok := server.(map[string]interface{}); ok {
+				rule.TargetDnsServers = append(rule.TargetDnsServers, TargetDnsServerModel{
+					IPAddress: s["ip_address"].(string),
+					Port:      int64(s["port"].(int)),
+				})
+			}
+		}
+
+		output = append(output, normaliseForwardingRuleBulkRule(rule))
+	}
+
+	return output
+}
+
+func normaliseForwardingRuleBulkRule(input PrivateDNSResolverBulkRuleModel) PrivateDNSResolverBulkRuleModel {
+	if input.Metadata == nil {
+		input.Metadata = make(map[string]string)
+	}
+	if input.TargetDnsServers == nil {
+		input.TargetDnsServers = make([]TargetDnsServerModel, 0)
+	}
+	return input
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatednsresolver_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PrivateDNSResolverForwardingRuleBulkResource struct{}
+
+func TestAccPrivateDNSResolverForwardingRuleBulk_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_forwarding_rule_bulk", "test")
+	r := PrivateDNSResolverForwardingRuleBulkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("forwarding_rule_ids.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccPrivateDNSResolverForwardingRuleBulk_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_forwarding_rule_bulk", "test")
+	r := PrivateDNSResolverForwardingRuleBulkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("forwarding_rule_ids.%").HasValue("2"),
+			),
+		},
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("forwarding_rule_ids.%").HasValue("3"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("forwarding_rule_ids.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccPrivateDNSResolverForwardingRuleBulk_duplicateDomainName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_forwarding_rule_bulk", "test")
+	r := PrivateDNSResolverForwardingRuleBulkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.duplicateDomainName(data),
+			ExpectError: regexp.MustCompile("is used by both the `rule`"),
+		},
+	})
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	count := 0
+	for key, v := range state.Attributes {
+		if !strings.HasPrefix(key, "forwarding_rule_ids.") || key == "forwarding_rule_ids.%" {
+			continue
+		}
+		id, err := forwardingrules.ParseForwardingRuleID(v)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := clients.PrivateDnsResolver.ForwardingRulesClient.Get(ctx, *id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		count++
+	}
+
+	return pointer.To(count > 0), nil
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_forwarding_rule_bulk" "test" {
+  name                      = "acctest-drfrb-%[2]d"
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.id
+
+  rule {
+    name        = "onprem"
+    domain_name = "onprem.local."
+
+    target_dns_servers {
+      ip_address = "10.10.0.1"
+    }
+  }
+
+  rule {
+    name        = "branch"
+    domain_name = "branch.local."
+
+    target_dns_servers {
+      ip_address = "10.20.0.1"
+    }
+
+    target_dns_servers {
+      ip_address = "10.20.0.2"
+      port       = 5353
+    }
+  }
+}
+`, PrivateDNSResolverForwardingRuleResource{}.template(data), data.RandomInteger)
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_forwarding_rule_bulk" "test" {
+  name                      = "acctest-drfrb-%[2]d"
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.id
+  max_parallel_operations   = 2
+
+  rule {
+    name        = "onprem"
+    domain_name = "onprem.local."
+    enabled     = false
+
+    target_dns_servers {
+      ip_address = "10.10.0.2"
+    }
+
+    metadata = {
+      key = "value"
+    }
+  }
+
+  rule {
+    name        = "branch"
+    domain_name = "branch.local."
+
+    target_dns_servers {
+      ip_address = "10.20.0.1"
+    }
+
+    target_dns_servers {
+      ip_address = "10.20.0.2"
+      port       = 5353
+    }
+  }
+
+  rule {
+    name        = "partner"
+    domain_name = "partner.example.com."
+
+    target_dns_servers {
+      ip_address = "10.30.0.1"
+    }
+  }
+}
+`, PrivateDNSResolverForwardingRuleResource{}.template(data), data.RandomInteger)
+}
+
+func (r PrivateDNSResolverForwardingRuleBulkResource) duplicateDomainName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_forwarding_rule_bulk" "test" {
+  name                      = "acctest-drfrb-%[2]d"
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.id
+
+  rule {
+    name        = "onprem"
+    domain_name = "onprem.local."
+
+    target_dns_servers {
+      ip_address = "10.10.0.1"
+    }
+  }
+
+  rule {
+    name        = "onprem2"
+    domain_name = "OnPrem.local."
+
+    target_dns_servers {
+      ip_address = "10.10.0.2"
+    }
+  }
+}
+`, PrivateDNSResolverForwardingRuleResource{}.template(data), data.RandomInteger)
+}
@@ -59,6 +59,7 @@ func (r Registration) Resources() []sdk.Resource {
 		PrivateDNSResolverDnsForwardingRulesetResource{},
 		PrivateDNSResolverDnsResolverResource{},
 		PrivateDNSResolverForwardingRuleResource{},
+		PrivateDNSResolverForwardingRuleBulkResource{},
 		PrivateDNSResolverInboundEndpointResource{},
 		PrivateDNSResolverOutboundEndpointResource{},
 		PrivateDNSResolverVirtualNetworkLinkResource{},
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatednsresolver
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ForwardingRuleBulk -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsForwardingRulesets/ruleset1/forwardingRuleBulks/bulk1
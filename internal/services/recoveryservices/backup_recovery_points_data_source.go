@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2025-02-01/recoverypoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type BackupRecoveryPointsDataSource struct{}
+
+type BackupRecoveryPointsDataSourceModel struct {
+	ProtectedItemId string                     `tfschema:"protected_item_id"`
+	RecoveryPoints  []BackupRecoveryPointModel `tfschema:"recovery_points"`
+}
+
+type BackupRecoveryPointModel struct {
+	Id   string `tfschema:"id"`
+	Name string `tfschema:"name"`
+	Time string `tfschema:"time"`
+	Type string `tfschema:"type"`
+}
+
+var _ sdk.DataSource = BackupRecoveryPointsDataSource{}
+
+func (r BackupRecoveryPointsDataSource) ResourceType() string {
+	return "azurerm_backup_recovery_points"
+}
+
+func (r BackupRecoveryPointsDataSource) ModelObject() interface{} {
+	return &BackupRecoveryPointsDataSourceModel{}
+}
+
+func (r BackupRecoveryPointsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"protected_item_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: recoverypoints.ValidateProtectedItemID,
+		},
+	}
+}
+
+func (r BackupRecoveryPointsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"recovery_points": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r BackupRecoveryPointsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.RecoveryPointsClient
+
+			var model BackupRecoveryPointsDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := recoverypoints.ParseProtectedItemID(model.ProtectedItemId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListComplete(ctx, *id, recoverypoints.DefaultListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing Recovery Points for %s: %+v", *id, err)
+			}
+
+			state := BackupRecoveryPointsDataSourceModel{
+				ProtectedItemId: id.ID(),
+				RecoveryPoints:  make([]BackupRecoveryPointModel, 0),
+			}
+
+			for _, item := range resp.Items {
+				recoveryPoint := BackupRecoveryPointModel{
+					Id:   pointer.From(item.Id),
+					Name: pointer.From(item.Name),
+				}
+
+				// the time and type of a Recovery Point are exposed differently depending on the type of the Protected Item
+				switch props := item.Properties.(type) {
+				case recoverypoints.IaasVMRecoveryPoint:
+					recoveryPoint.Time = pointer.From(props.RecoveryPointTime)
+					recoveryPoint.Type = pointer.From(props.RecoveryPointType)
+				case recoverypoints.AzureFileShareRecoveryPoint:
+					recoveryPoint.Time = pointer.From(props.RecoveryPointTime)
+					recoveryPoint.Type = pointer.From(props.RecoveryPointType)
+				case recoverypoints.GenericRecoveryPoint:
+					recoveryPoint.Time = pointer.From(props.RecoveryPointTime)
+					recoveryPoint.Type = pointer.From(props.RecoveryPointType)
+				case recoverypoints.AzureWorkloadRecoveryPoint:
+					recoveryPoint.Time = pointer.From(props.RecoveryPointTimeInUTC)
+					recoveryPoint.Type = string(pointer.From(props.Type))
+				}
+
+				state.RecoveryPoints = append(state.RecoveryPoints, recoveryPoint)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
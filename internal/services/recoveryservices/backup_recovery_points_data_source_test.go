@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type BackupRecoveryPointsDataSource struct{}
+
+func TestAccDataSourceBackupRecoveryPoints_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_backup_recovery_points", "test")
+	r := BackupRecoveryPointsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("protected_item_id").Exists(),
+				check.That(data.ResourceName).Key("recovery_points.#").Exists(),
+			),
+		},
+	})
+}
+
+func (BackupRecoveryPointsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_backup_recovery_points" "test" {
+  protected_item_id = azurerm_backup_protected_vm.test.id
+}
+`, BackupProtectedVmResource{}.basic(data))
+}
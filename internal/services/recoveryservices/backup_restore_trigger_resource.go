@@ -0,0 +1,448 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2024-01-01/vaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protecteditems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2025-02-01/restores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	backupRestoreFileSpecTypeDirectory = "Directory"
+	backupRestoreFileSpecTypeFile      = "File"
+)
+
+type BackupRestoreTriggerModel struct {
+	RecoveryPointId string                             `tfschema:"recovery_point_id"`
+	VirtualMachine  []BackupRestoreVirtualMachineModel `tfschema:"virtual_machine"`
+	FileShare       []BackupRestoreFileShareModel      `tfschema:"file_share"`
+}
+
+type BackupRestoreVirtualMachineModel struct {
+	RestoreType              string  `tfschema:"restore_type"`
+	StagingStorageAccountId  string  `tfschema:"staging_storage_account_id"`
+	TargetResourceGroupId    string  `tfschema:"target_resource_group_id"`
+	TargetVirtualMachineName string  `tfschema:"target_virtual_machine_name"`
+	TargetSubnetId           string  `tfschema:"target_subnet_id"`
+	RestoreDiskLuns          []int64 `tfschema:"restore_disk_luns"`
+}
+
+type BackupRestoreFileShareModel struct {
+	RestoreType            string                            `tfschema:"restore_type"`
+	ConflictResolution     string                            `tfschema:"conflict_resolution"`
+	TargetStorageAccountId string                            `tfschema:"target_storage_account_id"`
+	TargetFileShareName    string                            `tfschema:"target_file_share_name"`
+	TargetFolderPath       string                            `tfschema:"target_folder_path"`
+	Item                   []BackupRestoreFileShareItemModel `tfschema:"item"`
+}
+
+type BackupRestoreFileShareItemModel struct {
+	Path string `tfschema:"path"`
+	Type string `tfschema:"type"`
+}
+
+type BackupRestoreTriggerResource struct{}
+
+var (
+	_ sdk.ResourceWithCustomImporter = BackupRestoreTriggerResource{}
+	_ sdk.ResourceWithCustomizeDiff  = BackupRestoreTriggerResource{}
+)
+
+func (r BackupRestoreTriggerResource) ModelObject() interface{} {
+	return &BackupRestoreTriggerModel{}
+}
+
+func (r BackupRestoreTriggerResource) ResourceType() string {
+	return "azurerm_backup_restore_trigger"
+}
+
+func (r BackupRestoreTriggerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return restores.ValidateRecoveryPointID
+}
+
+func (r BackupRestoreTriggerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"recovery_point_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: restores.ValidateRecoveryPointID,
+		},
+
+		"virtual_machine": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"virtual_machine", "file_share"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"restore_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(restores.RecoveryTypeOriginalLocation),
+							string(restores.RecoveryTypeAlternateLocation),
+							string(restores.RecoveryTypeRestoreDisks),
+						}, false),
+					},
+
+					"staging_storage_account_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: commonids.ValidateStorageAccountID,
+					},
+
+					"target_resource_group_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: commonids.ValidateResourceGroupID,
+					},
+
+					"target_virtual_machine_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"target_subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: commonids.ValidateSubnetID,
+					},
+
+					"restore_disk_luns": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeInt,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+		},
+
+		"file_share": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"virtual_machine", "file_share"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"restore_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(restores.RecoveryTypeOriginalLocation),
+							string(restores.RecoveryTypeAlternateLocation),
+						}, false),
+					},
+
+					"conflict_resolution": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+						Default:  string(restores.CopyOptionsOverwrite),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(restores.CopyOptionsOverwrite),
+							string(restores.CopyOptionsSkip),
+						}, false),
+					},
+
+					"target_storage_account_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: commonids.ValidateStorageAccountID,
+					},
+
+					"target_file_share_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"target_folder_path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"item": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"path": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"type": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ForceNew: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										backupRestoreFileSpecTypeDirectory,
+										backupRestoreFileSpecTypeFile,
+									}, false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r BackupRestoreTriggerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r BackupRestoreTriggerResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model BackupRestoreTriggerModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if len(model.VirtualMachine) > 0 {
+				vm := model.VirtualMachine[0]
+				switch vm.RestoreType {
+				case string(restores.RecoveryTypeAlternateLocation):
+					if vm.TargetResourceGroupId == "" || vm.TargetVirtualMachineName == "" || vm.TargetSubnetId == "" {
+						return fmt.Errorf("`target_resource_group_id`, `target_virtual_machine_name` and `target_subnet_id` must be specified when `restore_type` is `%s`", restores.RecoveryTypeAlternateLocation)
+					}
+				case string(restores.RecoveryTypeRestoreDisks):
+					if vm.TargetResourceGroupId == "" {
+						return fmt.Errorf("`target_resource_group_id` must be specified when `restore_type` is `%s`", restores.RecoveryTypeRestoreDisks)
+					}
+				}
+			}
+
+			if len(model.FileShare) > 0 {
+				fileShare := model.FileShare[0]
+				if fileShare.RestoreType == string(restores.RecoveryTypeAlternateLocation) && (fileShare.TargetStorageAccountId == "" || fileShare.TargetFileShareName == "") {
+					return fmt.Errorf("`target_storage_account_id` and `target_file_share_name` must be specified when `restore_type` is `%s`", restores.RecoveryTypeAlternateLocation)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r BackupRestoreTriggerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.RestoresClient
+			protectedItemsClient := metadata.Client.RecoveryServices.ProtectedItemsClient
+
+			var model BackupRestoreTriggerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := restores.ParseRecoveryPointID(model.RecoveryPointId)
+			if err != nil {
+				return err
+			}
+
+			protectedItemId := protecteditems.NewProtectedItemID(id.SubscriptionId, id.ResourceGroupName, id.VaultName, id.BackupFabricName, id.ProtectionContainerName, id.ProtectedItemName)
+			protectedItem, err := protectedItemsClient.Get(ctx, protectedItemId, protecteditems.GetOperationOptions{})
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", protectedItemId, err)
+			}
+			if protectedItem.Model == nil || protectedItem.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", protectedItemId)
+			}
+
+			var request restores.RestoreRequest
+			switch props := protectedItem.Model.Properties.(type) {
+			case protecteditems.AzureIaaSComputeVMProtectedItem:
+				if len(model.VirtualMachine) == 0 {
+					return fmt.Errorf("a `virtual_machine` block must be specified to restore %s since it's a Virtual Machine", protectedItemId)
+				}
+
+				// Recovery Points can only be restored into the region of the Vault, restoring into the paired region
+				// requires the Cross Region Restore API
+				vaultId := vaults.NewVaultID(id.SubscriptionId, id.ResourceGroupName, id.VaultName)
+				vault, err := metadata.Client.RecoveryServices.VaultsClient.Get(ctx, vaultId)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", vaultId, err)
+				}
+				if vault.Model == nil {
+					return fmt.Errorf("retrieving %s: `model` was nil", vaultId)
+				}
+
+				request = expandBackupRestoreVirtualMachine(model.VirtualMachine[0], *id, pointer.From(props.SourceResourceId), location.Normalize(vault.Model.Location))
+			case protecteditems.AzureFileshareProtectedItem:
+				if len(model.FileShare) == 0 {
+					return fmt.Errorf("a `file_share` block must be specified to restore %s since it's a File Share", protectedItemId)
+				}
+
+				request = expandBackupRestoreFileShare(model.FileShare[0], pointer.From(props.SourceResourceId))
+			default:
+				return fmt.Errorf("restoring %s isn't supported, only Virtual Machines and File Shares can be restored", protectedItemId)
+			}
+
+			input := restores.RestoreRequestResource{
+				Properties: request,
+			}
+			if err := client.TriggerThenPoll(ctx, *id, input, restores.DefaultTriggerOperationOptions()); err != nil {
+				return fmt.Errorf("triggering a restore of %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r BackupRestoreTriggerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := restores.ParseRecoveryPointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state BackupRestoreTriggerModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// a restore can't be looked up once it has been triggered, and the Recovery Point it was triggered from
+			// expires with the retention of the Backup Policy - so the configuration is kept as-is rather than removing
+			// the resource from the state, which would trigger another restore
+			state.RecoveryPointId = id.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r BackupRestoreTriggerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the restored Virtual Machines, Disks and Files aren't managed by this resource, so they're intentionally left as-is
+			return nil
+		},
+	}
+}
+
+func (r BackupRestoreTriggerResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`azurerm_backup_restore_trigger` can't be imported since the settings of a restore can't be retrieved once it has been triggered")
+	}
+}
+
+func expandBackupRestoreVirtualMachine(input BackupRestoreVirtualMachineModel, id restores.RecoveryPointId, sourceVirtualMachineId string, region string) restores.IaasVMRestoreRequest {
+	recoveryType := restores.RecoveryType(input.RestoreType)
+	output := restores.IaasVMRestoreRequest{
+		CreateNewCloudService:        pointer.To(false),
+		OriginalStorageAccountOption: pointer.To(false),
+		RecoveryPointId:              pointer.To(id.RecoveryPointId),
+		RecoveryType:                 &recoveryType,
+		Region:                       pointer.To(region),
+		SourceResourceId:             pointer.To(sourceVirtualMachineId),
+		StorageAccountId:             pointer.To(input.StagingStorageAccountId),
+	}
+
+	if input.TargetResourceGroupId != "" {
+		output.TargetResourceGroupId = pointer.To(input.TargetResourceGroupId)
+	}
+
+	if recoveryType == restores.RecoveryTypeAlternateLocation {
+		// the Subnet ID has been validated by the schema
+		subnetId, _ := commonids.ParseSubnetID(input.TargetSubnetId)
+		resourceGroupId, _ := commonids.ParseResourceGroupID(input.TargetResourceGroupId)
+
+		output.TargetVirtualMachineId = pointer.To(commonids.NewVirtualMachineID(resourceGroupId.SubscriptionId, resourceGroupId.ResourceGroupName, input.TargetVirtualMachineName).ID())
+		output.VirtualNetworkId = pointer.To(commonids.NewVirtualNetworkID(subnetId.SubscriptionId, subnetId.ResourceGroupName, subnetId.VirtualNetworkName).ID())
+		output.SubnetId = pointer.To(subnetId.ID())
+	}
+
+	if len(input.RestoreDiskLuns) > 0 {
+		output.RestoreDiskLunList = pointer.To(input.RestoreDiskLuns)
+	}
+
+	return output
+}
+
+func expandBackupRestoreFileShare(input BackupRestoreFileShareModel, sourceStorageAccountId string) restores.AzureFileShareRestoreRequest {
+	recoveryType := restores.RecoveryType(input.RestoreType)
+	copyOptions := restores.CopyOptions(input.ConflictResolution)
+	output := restores.AzureFileShareRestoreRequest{
+		CopyOptions:        &copyOptions,
+		RecoveryType:       &recoveryType,
+		RestoreRequestType: pointer.To(restores.RestoreRequestTypeFullShareRestore),
+		SourceResourceId:   pointer.To(sourceStorageAccountId),
+	}
+
+	if recoveryType == restores.RecoveryTypeAlternateLocation {
+		output.TargetDetails = &restores.TargetAFSRestoreInfo{
+			Name:             pointer.To(input.TargetFileShareName),
+			TargetResourceId: pointer.To(input.TargetStorageAccountId),
+		}
+	}
+
+	fileSpecs := make([]restores.RestoreFileSpecs, 0)
+	for _, item := range input.Item {
+		fileSpec := restores.RestoreFileSpecs{
+			FileSpecType: pointer.To(item.Type),
+			Path:         pointer.To(item.Path),
+		}
+		if input.TargetFolderPath != "" {
+			fileSpec.TargetFolderPath = pointer.To(input.TargetFolderPath)
+		}
+		fileSpecs = append(fileSpecs, fileSpec)
+	}
+
+	if len(fileSpecs) > 0 {
+		output.RestoreRequestType = pointer.To(restores.RestoreRequestTypeItemLevelRestore)
+	} else if input.TargetFolderPath != "" {
+		fileSpecs = append(fileSpecs, restores.RestoreFileSpecs{
+			TargetFolderPath: pointer.To(input.TargetFolderPath),
+		})
+	}
+
+	if len(fileSpecs) > 0 {
+		output.RestoreFileSpecs = &fileSpecs
+	}
+
+	return output
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2025-02-01/recoverypoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type BackupRestoreTriggerResource struct{}
+
+// a Recovery Point only exists once a backup has completed, which can't be orchestrated within a single
+// test config - as such these tests require an existing Recovery Point for a Protected VM
+func TestAccBackupRestoreTrigger_virtualMachineRestoreDisks(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_restore_trigger", "test")
+	r := BackupRestoreTriggerResource{}
+
+	recoveryPointId := os.Getenv("ARM_TEST_BACKUP_VM_RECOVERY_POINT_ID")
+	if recoveryPointId == "" {
+		t.Skip("Skipping as ARM_TEST_BACKUP_VM_RECOVERY_POINT_ID not set")
+		return
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.virtualMachineRestoreDisks(data, recoveryPointId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (BackupRestoreTriggerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := recoverypoints.ParseRecoveryPointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.RecoveryServices.RecoveryPointsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (BackupRestoreTriggerResource) virtualMachineRestoreDisks(data acceptance.TestData, recoveryPointId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-backup-restore-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_backup_restore_trigger" "test" {
+  recovery_point_id = "%[4]s"
+
+  virtual_machine {
+    restore_type               = "RestoreDisks"
+    staging_storage_account_id = azurerm_storage_account.test.id
+    target_resource_group_id   = azurerm_resource_group.test.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, recoveryPointId)
+}
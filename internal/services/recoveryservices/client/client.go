@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectioncontainers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/resourceguardproxy"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2024-10-01/protectionpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2025-02-01/recoverypoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2025-02-01/restores"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationfabrics"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationnetworkmappings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationnetworks"
@@ -39,6 +41,8 @@ type Client struct {
 	ProtectedItemsGroupClient                 *backupprotecteditems.BackupProtectedItemsClient
 	ProtectionPoliciesClient                  *protectionpolicies.ProtectionPoliciesClient
 	ProtectionContainerOperationResultsClient *backup.ProtectionContainerOperationResultsClient
+	RecoveryPointsClient                      *recoverypoints.RecoveryPointsClient
+	RestoresClient                            *restores.RestoresClient
 	BackupProtectionContainersClient          *protectioncontainers.ProtectionContainersClient
 	BackupOperationStatusesClient             *backup.OperationStatusesClient
 	BackupOperationResultsClient              *backup.OperationResultsClient
@@ -106,6 +110,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	backupProtectionContainerOperationResultsClient := backup.NewProtectionContainerOperationResultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&backupProtectionContainerOperationResultsClient.Client, o.ResourceManagerAuthorizer)
 
+	recoveryPointsClient := recoverypoints.NewRecoveryPointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&recoveryPointsClient.Client, o.ResourceManagerAuthorizer)
+
+	restoresClient := restores.NewRestoresClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&restoresClient.Client, o.ResourceManagerAuthorizer)
+
 	fabricClient, err := replicationfabrics.NewReplicationFabricsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building ReplicationFabrics client: %+v", err)
@@ -175,6 +185,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ProtectedItemsGroupClient:                 &protectedItemsGroupClient,
 		ProtectionPoliciesClient:                  &protectionPoliciesClient,
 		ProtectionContainerOperationResultsClient: &backupProtectionContainerOperationResultsClient,
+		RecoveryPointsClient:                      &recoveryPointsClient,
+		RestoresClient:                            &restoresClient,
 		BackupProtectionContainersClient:          &backupProtectionContainersClient,
 		ProtectedItemOperationResultsClient:       &protectedItemOperationResultClient,
 		BackupOperationStatusesClient:             &backupOperationStatusesClient,
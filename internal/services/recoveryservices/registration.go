@@ -23,6 +23,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		SiteRecoveryRecoveryVaultDataSource{},
 		SiteRecoveryReplicationRecoveryPlanDataSource{},
+		BackupRecoveryPointsDataSource{},
 	}
 }
 
@@ -38,6 +39,8 @@ func (r Registration) Resources() []sdk.Resource {
 		VMWareReplicationPolicyAssociationResource{},
 		VaultGuardProxyResource{},
 		VMWareReplicatedVmResource{},
+		SiteRecoveryTestFailoverResource{},
+		BackupRestoreTriggerResource{},
 	}
 }
 
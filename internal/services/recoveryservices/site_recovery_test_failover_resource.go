@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationprotecteditems"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	siteRecoveryTestFailoverDirectionPrimaryToRecovery = "PrimaryToRecovery"
+	siteRecoveryTestFailoverDirectionRecoveryToPrimary = "RecoveryToPrimary"
+
+	// the Test Failover State of a Replicated VM which has no Test Failover in progress (or which has been cleaned up)
+	siteRecoveryTestFailoverStateNone = "None"
+)
+
+type SiteRecoveryTestFailoverModel struct {
+	ReplicatedVmId                 string `tfschema:"replicated_vm_id"`
+	NetworkId                      string `tfschema:"network_id"`
+	FailoverDirection              string `tfschema:"failover_direction"`
+	RecoveryPointId                string `tfschema:"recovery_point_id"`
+	CleanupComments                string `tfschema:"cleanup_comments"`
+	TestFailoverState              string `tfschema:"test_failover_state"`
+	TestFailoverStateDescription   string `tfschema:"test_failover_state_description"`
+	LastSuccessfulTestFailoverTime string `tfschema:"last_successful_test_failover_time"`
+}
+
+type SiteRecoveryTestFailoverResource struct{}
+
+var _ sdk.ResourceWithUpdate = SiteRecoveryTestFailoverResource{}
+
+func (r SiteRecoveryTestFailoverResource) ModelObject() interface{} {
+	return &SiteRecoveryTestFailoverModel{}
+}
+
+func (r SiteRecoveryTestFailoverResource) ResourceType() string {
+	return "azurerm_site_recovery_test_failover"
+}
+
+func (r SiteRecoveryTestFailoverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return replicationprotecteditems.ValidateReplicationProtectedItemID
+}
+
+func (r SiteRecoveryTestFailoverResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"replicated_vm_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: replicationprotecteditems.ValidateReplicationProtectedItemID,
+		},
+
+		"network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateVirtualNetworkID,
+		},
+
+		"failover_direction": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  siteRecoveryTestFailoverDirectionPrimaryToRecovery,
+			ValidateFunc: validation.StringInSlice([]string{
+				siteRecoveryTestFailoverDirectionPrimaryToRecovery,
+				siteRecoveryTestFailoverDirectionRecoveryToPrimary,
+			}, false),
+		},
+
+		"recovery_point_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cleanup_comments": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1024),
+		},
+	}
+}
+
+func (r SiteRecoveryTestFailoverResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"test_failover_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"test_failover_state_description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_successful_test_failover_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SiteRecoveryTestFailoverResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 120 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ReplicationProtectedItemsClient
+
+			var model SiteRecoveryTestFailoverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := replicationprotecteditems.ParseReplicationProtectedItemID(model.ReplicatedVmId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model != nil && existing.Model.Properties != nil {
+				if state := pointer.From(existing.Model.Properties.TestFailoverState); state != "" && !strings.EqualFold(state, siteRecoveryTestFailoverStateNone) {
+					return fmt.Errorf("a Test Failover is already in progress for %s (state %q) - this must be cleaned up before a new Test Failover can be started", *id, state)
+				}
+			}
+
+			providerSpecificInput := replicationprotecteditems.A2ATestFailoverInput{}
+			if model.RecoveryPointId != "" {
+				providerSpecificInput.RecoveryPointId = pointer.To(model.RecoveryPointId)
+			}
+
+			input := replicationprotecteditems.TestFailoverInput{
+				Properties: replicationprotecteditems.TestFailoverInputProperties{
+					FailoverDirection:       pointer.To(model.FailoverDirection),
+					NetworkId:               pointer.To(model.NetworkId),
+					NetworkType:             pointer.To("VmNetworkAsInput"),
+					ProviderSpecificDetails: providerSpecificInput,
+				},
+			}
+
+			if err := client.TestFailoverThenPoll(ctx, *id, input); err != nil {
+				return fmt.Errorf("performing Test Failover for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r SiteRecoveryTestFailoverResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ReplicationProtectedItemsClient
+
+			id, err := replicationprotecteditems.ParseReplicationProtectedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state SiteRecoveryTestFailoverModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state.ReplicatedVmId = id.ID()
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					testFailoverState := pointer.From(props.TestFailoverState)
+
+					// the Test Failover has been cleaned up outside of Terraform
+					if testFailoverState == "" || strings.EqualFold(testFailoverState, siteRecoveryTestFailoverStateNone) {
+						return metadata.MarkAsGone(id)
+					}
+
+					state.TestFailoverState = testFailoverState
+					state.TestFailoverStateDescription = pointer.From(props.TestFailoverStateDescription)
+					state.LastSuccessfulTestFailoverTime = pointer.From(props.LastSuccessfulTestFailoverTime)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SiteRecoveryTestFailoverResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// `cleanup_comments` is only used when the Test Failover is cleaned up, so there's nothing to update in Azure
+			return nil
+		},
+	}
+}
+
+func (r SiteRecoveryTestFailoverResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 120 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ReplicationProtectedItemsClient
+
+			id, err := replicationprotecteditems.ParseReplicationProtectedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SiteRecoveryTestFailoverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			input := replicationprotecteditems.TestFailoverCleanupInput{
+				Properties: replicationprotecteditems.TestFailoverCleanupInputProperties{},
+			}
+			if model.CleanupComments != "" {
+				input.Properties.Comments = pointer.To(model.CleanupComments)
+			}
+
+			if err := client.TestFailoverCleanupThenPoll(ctx, *id, input); err != nil {
+				return fmt.Errorf("cleaning up the Test Failover for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
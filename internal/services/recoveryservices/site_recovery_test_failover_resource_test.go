@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationprotecteditems"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SiteRecoveryTestFailoverResource struct{}
+
+func TestAccSiteRecoveryTestFailover_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_test_failover", "test")
+	r := SiteRecoveryTestFailoverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("test_failover_state").Exists(),
+			),
+		},
+		data.ImportStep("network_id", "failover_direction", "cleanup_comments"),
+	})
+}
+
+func (SiteRecoveryTestFailoverResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := replicationprotecteditems.ParseReplicationProtectedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.RecoveryServices.ReplicationProtectedItemsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	exists := false
+	if model := resp.Model; model != nil && model.Properties != nil {
+		testFailoverState := pointer.From(model.Properties.TestFailoverState)
+		exists = testFailoverState != "" && !strings.EqualFold(testFailoverState, "None")
+	}
+
+	return pointer.To(exists), nil
+}
+
+func (SiteRecoveryTestFailoverResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_site_recovery_test_failover" "test" {
+  replicated_vm_id = azurerm_site_recovery_replicated_vm.test.id
+  network_id       = azurerm_virtual_network.tfo.id
+  cleanup_comments = "acctest drill %d"
+}
+`, SiteRecoveryReplicatedVmResource{}.withTFOSettings(data), data.RandomInteger)
+}
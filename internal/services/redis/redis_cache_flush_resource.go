@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-11-01/redis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type RedisCacheFlushResource struct{}
+
+var _ sdk.ResourceWithCustomImporter = RedisCacheFlushResource{}
+
+type RedisCacheFlushResourceModel struct {
+	RedisCacheID string            `tfschema:"redis_cache_id"`
+	Triggers     map[string]string `tfschema:"triggers"`
+}
+
+func (r RedisCacheFlushResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"redis_cache_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: redis.ValidateRediID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r RedisCacheFlushResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r RedisCacheFlushResource) ModelObject() interface{} {
+	return &RedisCacheFlushResourceModel{}
+}
+
+func (r RedisCacheFlushResource) ResourceType() string {
+	return "azurerm_redis_cache_flush"
+}
+
+func (r RedisCacheFlushResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return redis.ValidateRediID
+}
+
+func (r RedisCacheFlushResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Redis.Redis
+
+			var model RedisCacheFlushResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := redis.ParseRediID(model.RedisCacheID)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			if err := client.FlushCacheThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("flushing %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r RedisCacheFlushResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Redis.Redis
+
+			id, err := redis.ParseRediID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var state RedisCacheFlushResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.RedisCacheID = id.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r RedisCacheFlushResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// flushing the cache is a one-off action, so there's nothing to remove
+			return nil
+		},
+	}
+}
+
+func (r RedisCacheFlushResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`azurerm_redis_cache_flush` can't be imported since it represents a one-off action against the Redis Cache")
+	}
+}
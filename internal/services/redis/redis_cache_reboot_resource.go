@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-11-01/redis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type RedisCacheRebootResource struct{}
+
+var _ sdk.ResourceWithCustomImporter = RedisCacheRebootResource{}
+
+type RedisCacheRebootResourceModel struct {
+	RedisCacheID string            `tfschema:"redis_cache_id"`
+	RebootType   string            `tfschema:"reboot_type"`
+	ShardId      int64             `tfschema:"shard_id"`
+	Ports        []int64           `tfschema:"ports"`
+	Triggers     map[string]string `tfschema:"triggers"`
+}
+
+func (r RedisCacheRebootResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"redis_cache_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: redis.ValidateRediID,
+		},
+
+		"reboot_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(redis.PossibleValuesForRebootType(), false),
+		},
+
+		"shard_id": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"ports": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeInt,
+				ValidateFunc: validation.IsPortNumber,
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r RedisCacheRebootResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r RedisCacheRebootResource) ModelObject() interface{} {
+	return &RedisCacheRebootResourceModel{}
+}
+
+func (r RedisCacheRebootResource) ResourceType() string {
+	return "azurerm_redis_cache_reboot"
+}
+
+func (r RedisCacheRebootResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return redis.ValidateRediID
+}
+
+func (r RedisCacheRebootResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Redis.Redis
+
+			var model RedisCacheRebootResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := redis.ParseRediID(model.RedisCacheID)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			parameters := redis.RedisRebootParameters{
+				RebootType: pointer.To(redis.RebootType(model.RebootType)),
+			}
+
+			if _, ok := metadata.ResourceData.GetOk("shard_id"); ok {
+				parameters.ShardId = pointer.To(model.ShardId)
+			}
+
+			if len(model.Ports) > 0 {
+				parameters.Ports = pointer.To(model.Ports)
+			}
+
+			if _, err := client.ForceReboot(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("rebooting %s: %+v", *id, err)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:                   []string{string(redis.ProvisioningStateUpdating), string(redis.ProvisioningStateProvisioning)},
+				Target:                    []string{string(redis.ProvisioningStateSucceeded)},
+				Refresh:                   redisStateRefreshFunc(ctx, client, *id),
+				MinTimeout:                15 * time.Second,
+				ContinuousTargetOccurence: 2,
+				Timeout:                   time.Until(deadline),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to finish rebooting: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r RedisCacheRebootResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Redis.Redis
+
+			id, err := redis.ParseRediID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var state RedisCacheRebootResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.RedisCacheID = id.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r RedisCacheRebootResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// rebooting the cache is a one-off action, so there's nothing to remove
+			return nil
+		},
+	}
+}
+
+func (r RedisCacheRebootResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`azurerm_redis_cache_reboot` can't be imported since it represents a one-off action against the Redis Cache")
+	}
+}
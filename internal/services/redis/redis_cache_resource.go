@@ -394,6 +394,27 @@ func resourceRedisCache() *pluginsdk.Resource {
 
 				return nil
 			}),
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				// persisting data using a Managed Identity requires the cache to have an identity and the storage
+				// connection strings to only contain the blob endpoint, rather than an access key or SAS token
+				if diff.Get("redis_configuration.0.data_persistence_authentication_method").(string) != "ManagedIdentity" {
+					return nil
+				}
+
+				persistenceEnabled := diff.Get("redis_configuration.0.rdb_backup_enabled").(bool) || diff.Get("redis_configuration.0.aof_backup_enabled").(bool)
+				if persistenceEnabled && len(diff.Get("identity").([]interface{})) == 0 {
+					return fmt.Errorf("an `identity` block must be specified when data persistence is enabled and `data_persistence_authentication_method` is set to `ManagedIdentity`")
+				}
+
+				for _, key := range []string{"rdb_storage_connection_string", "aof_storage_connection_string_0", "aof_storage_connection_string_1"} {
+					connectionString := strings.ToLower(diff.Get(fmt.Sprintf("redis_configuration.0.%s", key)).(string))
+					if strings.Contains(connectionString, "accountkey=") || strings.Contains(connectionString, "sharedaccesssignature=") {
+						return fmt.Errorf("`%s` must not contain an `AccountKey` or `SharedAccessSignature` when `data_persistence_authentication_method` is set to `ManagedIdentity`", key)
+					}
+				}
+
+				return nil
+			}),
 			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
 				// Replicates validation rules from Azure CLI
 				// https://github.com/Azure/azure-cli/blob/131634d374fe704920862a2e5b0745e61af9bc89/src/azure-cli/azure/cli/command_modules/redis/custom.py#L13
@@ -55,5 +55,7 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		RedisCacheAccessPolicyAssignmentResource{},
 		RedisCacheAccessPolicyResource{},
+		RedisCacheFlushResource{},
+		RedisCacheRebootResource{},
 	}
 }
@@ -42,6 +42,24 @@ func dataSourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 			Computed: true,
 		},
 
+		"linked_database": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
 		"primary_access_key": {
 			Type:      pluginsdk.TypeString,
 			Computed:  true,
@@ -105,6 +123,9 @@ func dataSourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta inter
 			if props.GeoReplication.LinkedDatabases != nil {
 				d.Set("linked_database_id", flattenArmGeoLinkedDatabase(props.GeoReplication.LinkedDatabases))
 			}
+			if err := d.Set("linked_database", flattenArmGeoLinkedDatabaseState(props.GeoReplication.LinkedDatabases)); err != nil {
+				return fmt.Errorf("setting `linked_database`: %+v", err)
+			}
 		}
 	}
 
@@ -42,6 +42,7 @@ func TestAccRedisEnterpriseDatabaseDataSource_geoDatabase(t *testing.T) {
 				check.That(data.ResourceName).Key("cluster_id").Exists(),
 				check.That(data.ResourceName).Key("linked_database_id.#").Exists(),
 				check.That(data.ResourceName).Key("linked_database_group_nickname").Exists(),
+				check.That(data.ResourceName).Key("linked_database.0.state").Exists(),
 				check.That(data.ResourceName).Key("primary_access_key").Exists(),
 				check.That(data.ResourceName).Key("secondary_access_key").Exists(),
 			),
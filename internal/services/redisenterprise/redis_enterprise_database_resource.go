@@ -44,6 +44,26 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 		// Since update is not currently supported all attribute have to be marked as FORCE NEW
 		// until support for Update comes online in the near future
 		Schema: redisEnterpriseDatabaseSchema(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			if diff.Id() == "" || !diff.HasChange("linked_database_id") || !diff.NewValueKnown("linked_database_id") {
+				return nil
+			}
+
+			// other databases leave the geo-replication group by being removed from `linked_database_id`, this
+			// database itself has to remain a member of the group
+			linkedDatabaseIds := diff.Get("linked_database_id").(*pluginsdk.Set).List()
+			if len(linkedDatabaseIds) == 0 {
+				return nil
+			}
+			for _, v := range linkedDatabaseIds {
+				if strings.EqualFold(v.(string), diff.Id()) {
+					return nil
+				}
+			}
+
+			return fmt.Errorf("`linked_database_id` must include the ID of this database (%s)", diff.Id())
+		}),
 	}
 }
 
@@ -155,6 +175,13 @@ func redisEnterpriseDatabaseSchema() map[string]*pluginsdk.Schema {
 			RequiredWith: []string{"linked_database_id"},
 		},
 
+		"linked_database_force_link_enabled": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			Default:      false,
+			RequiredWith: []string{"linked_database_id"},
+		},
+
 		// This attribute is currently in preview and is not returned by the RP
 		// "persistence": {
 		// 	Type:     pluginsdk.TypeList,
@@ -337,6 +364,7 @@ func resourceRedisEnterpriseDatabaseRead(d *pluginsdk.ResourceData, meta interfa
 	d.Set("name", id.DatabaseName)
 	clusterId := redisenterprise.NewRedisEnterpriseID(id.SubscriptionId, id.ResourceGroupName, id.RedisEnterpriseName)
 	d.Set("cluster_id", clusterId.ID())
+	d.Set("linked_database_force_link_enabled", d.Get("linked_database_force_link_enabled").(bool))
 
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
@@ -413,6 +441,32 @@ func resourceRedisEnterpriseDatabaseUpdate(d *pluginsdk.ResourceData, meta inter
 		return fmt.Errorf("Setting geo database for database %s error: %+v", id.ID(), err)
 	}
 
+	// a database which has been force unlinked from its geo-replication group (e.g. after a regional outage) can only
+	// rejoin the group by being force linked, which discards the data held in this database
+	if d.HasChange("linked_database_id") && d.Get("linked_database_force_link_enabled").(bool) && linkedDatabase != nil {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if !isLinkedToOtherDatabases(existing.Model, id) {
+			parameters := databases.ForceLinkParameters{
+				GeoReplication: databases.ForceLinkParametersGeoReplication{
+					GroupNickname:   linkedDatabase.GroupNickname,
+					LinkedDatabases: linkedDatabase.LinkedDatabases,
+				},
+			}
+
+			log.Printf("[DEBUG] Force linking %s to the geo-replication group %q..", id, d.Get("linked_database_group_nickname").(string))
+			if err := client.ForceLinkToReplicationGroupThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("force linking %s to the geo-replication group: %+v", id, err)
+			}
+
+			d.SetId(id.ID())
+			return resourceRedisEnterpriseDatabaseRead(d, meta)
+		}
+	}
+
 	isGeoEnabled := false
 	if linkedDatabase != nil {
 		isGeoEnabled = true
@@ -600,6 +654,52 @@ func flattenArmGeoLinkedDatabase(inputDB *[]databases.LinkedDatabase) []string {
 	return results
 }
 
+// isLinkedToOtherDatabases returns whether the database is currently linked to (or being linked to) any other database
+// within its geo-replication group
+func isLinkedToOtherDatabases(input *databases.Database, id databases.DatabaseId) bool {
+	if input == nil || input.Properties == nil || input.Properties.GeoReplication == nil || input.Properties.GeoReplication.LinkedDatabases == nil {
+		return false
+	}
+
+	for _, item := range *input.Properties.GeoReplication.LinkedDatabases {
+		if item.Id == nil || strings.EqualFold(*item.Id, id.ID()) {
+			continue
+		}
+
+		if item.State != nil && (*item.State == databases.LinkStateLinked || *item.State == databases.LinkStateLinking) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flattenArmGeoLinkedDatabaseState(inputDB *[]databases.LinkedDatabase) []interface{} {
+	results := make([]interface{}, 0)
+
+	if inputDB == nil {
+		return results
+	}
+
+	for _, item := range *inputDB {
+		id := ""
+		if item.Id != nil {
+			id = *item.Id
+		}
+
+		state := ""
+		if item.State != nil {
+			state = string(*item.State)
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":    id,
+			"state": state,
+		})
+	}
+	return results
+}
+
 func forceUnlinkItems(oldItemList []interface{}, newItemList []interface{}) (bool, *[]string) {
 	newItems := make(map[string]bool)
 	forceUnlinkList := make([]string, 0)
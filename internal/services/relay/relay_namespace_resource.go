@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/networkacl"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -60,6 +62,8 @@ func resourceRelayNamespace() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"network_acl": networkacl.Schema(),
+
 			"metric_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -132,6 +136,27 @@ func resourceRelayNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	}
 
 	d.SetId(id.ID())
+
+	if d.HasChange("network_acl") {
+		if v, ok := d.GetOk("network_acl"); ok {
+			// the Network Rule Set also contains the public network and trusted service access settings, which aren't
+			// managed by Terraform, so the existing Network Rule Set is updated rather than replaced
+			existing, err := client.GetNetworkRuleSet(ctx, id)
+			if err != nil {
+				return fmt.Errorf("retrieving the Network Rule Set for %s: %+v", id, err)
+			}
+
+			ruleSet, err := expandRelayNamespaceNetworkRuleSet(networkacl.Expand(v.([]interface{})), existing.Model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdateNetworkRuleSet(ctx, id, *ruleSet); err != nil {
+				return fmt.Errorf("updating the Network Rule Set for %s: %+v", id, err)
+			}
+		}
+	}
+
 	return resourceRelayNamespaceRead(d, meta)
 }
 
@@ -161,9 +186,18 @@ func resourceRelayNamespaceRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("listing keys for %s: %+v", *id, err)
 	}
 
+	ruleSetResp, err := client.GetNetworkRuleSet(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving the Network Rule Set for %s: %+v", *id, err)
+	}
+
 	d.Set("name", id.NamespaceName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
+	if err := d.Set("network_acl", networkacl.Flatten(flattenRelayNamespaceNetworkRuleSet(ruleSetResp.Model))); err != nil {
+		return fmt.Errorf("setting `network_acl`: %+v", err)
+	}
+
 	if model := resp.Model; model != nil {
 		d.Set("location", location.Normalize(model.Location))
 
@@ -221,6 +255,56 @@ func resourceRelayNamespaceDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	return nil
 }
 
+func expandRelayNamespaceNetworkRuleSet(input *networkacl.NetworkACL, existing *namespaces.NetworkRuleSet) (*namespaces.NetworkRuleSet, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	// Relay only supports IP rules which allow access, so they can only be used to open up a `Deny` default action
+	if input.DefaultAction == networkacl.ActionAllow && len(input.IPRules) > 0 {
+		return nil, fmt.Errorf("`ip_rules` can only be specified when `default_action` is `%s`", networkacl.ActionDeny)
+	}
+
+	ipRules := make([]namespaces.NWRuleSetIPRules, 0)
+	for _, rule := range input.IPRules {
+		ipRules = append(ipRules, namespaces.NWRuleSetIPRules{
+			Action: pointer.To(namespaces.NetworkRuleIPAction(rule.Action)),
+			IPMask: pointer.To(rule.Address),
+		})
+	}
+
+	output := namespaces.NetworkRuleSet{}
+	if existing != nil {
+		output = *existing
+	}
+	if output.Properties == nil {
+		output.Properties = &namespaces.NetworkRuleSetProperties{}
+	}
+	output.Properties.DefaultAction = pointer.To(namespaces.DefaultAction(input.DefaultAction))
+	output.Properties.IPRules = &ipRules
+
+	return &output, nil
+}
+
+func flattenRelayNamespaceNetworkRuleSet(input *namespaces.NetworkRuleSet) *networkacl.NetworkACL {
+	if input == nil || input.Properties == nil {
+		return nil
+	}
+
+	output := networkacl.NetworkACL{
+		DefaultAction: string(pointer.From(input.Properties.DefaultAction)),
+		IPRules:       make([]networkacl.IPRule, 0),
+	}
+	for _, rule := range pointer.From(input.Properties.IPRules) {
+		output.IPRules = append(output.IPRules, networkacl.IPRule{
+			Address: pointer.From(rule.IPMask),
+			Action:  string(pointer.From(rule.Action)),
+		})
+	}
+
+	return &output
+}
+
 func relayNamespaceDeleteRefreshFunc(ctx context.Context, client *namespaces.NamespacesClient, id namespaces.NamespaceId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.Get(ctx, id)
@@ -80,6 +80,31 @@ func TestAccRelayNamespace_complete(t *testing.T) {
 	})
 }
 
+func TestAccRelayNamespace_networkAcl(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_namespace", "test")
+	r := RelayNamespaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.networkAcl(data, `["10.0.0.1/32", "192.168.0.0/24"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_acl.0.default_action").HasValue("Deny"),
+				check.That(data.ResourceName).Key("network_acl.0.ip_rules.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.networkAcl(data, `["10.0.0.0/16"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_acl.0.ip_rules.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t RelayNamespaceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := namespaces.ParseNamespaceID(state.ID)
 	if err != nil {
@@ -157,3 +182,29 @@ resource "azurerm_relay_namespace" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (RelayNamespaceResource) networkAcl(data acceptance.TestData, ipRules string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku_name = "Standard"
+
+  network_acl {
+    default_action = "Deny"
+    ip_rules       = %s
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, ipRules)
+}
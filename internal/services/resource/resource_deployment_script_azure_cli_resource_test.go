@@ -9,7 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-10-01/deploymentscripts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-08-01/deploymentscripts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -82,6 +82,20 @@ func TestAccResourceDeploymentScriptAzureCLI_update(t *testing.T) {
 	})
 }
 
+func TestAccResourceDeploymentScriptAzureCLI_subnet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_deployment_script_azure_cli", "test")
+	r := ResourceDeploymentScriptAzureCLIResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.subnet(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("storage_account.0.key"),
+	})
+}
+
 func (r ResourceDeploymentScriptAzureCLIResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := deploymentscripts.ParseDeploymentScriptID(state.ID)
 	if err != nil {
@@ -289,3 +303,88 @@ resource "azurerm_resource_deployment_script_azure_cli" "test" {
 }
 `, template, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
+
+func (r ResourceDeploymentScriptAzureCLIResource) subnet(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestUAI-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[2]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+  service_endpoints    = ["Microsoft.Storage"]
+
+  delegation {
+    name = "aci"
+
+    service_delegation {
+      name    = "Microsoft.ContainerInstance/containerGroups"
+      actions = ["Microsoft.Network/virtualNetworks/subnets/action"]
+    }
+  }
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  network_rules {
+    default_action             = "Deny"
+    virtual_network_subnet_ids = [azurerm_subnet.test.id]
+  }
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage File Data Privileged Contributor"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_resource_deployment_script_azure_cli" "test" {
+  name                = "acctest-rdsac-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  version             = "2.40.0"
+  retention_interval  = "P1D"
+  script_content      = <<EOF
+            echo '{"name":{"displayName":"firstname lastname"}}' > $AZ_SCRIPTS_OUTPUT_PATH
+  EOF
+
+  container {
+    subnet_ids = [azurerm_subnet.test.id]
+  }
+
+  identity {
+    type = "UserAssigned"
+    identity_ids = [
+      azurerm_user_assigned_identity.test.id
+    ]
+  }
+
+  storage_account {
+    name = azurerm_storage_account.test.name
+    key  = azurerm_storage_account.test.primary_access_key
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, template, data.RandomInteger, data.RandomString)
+}
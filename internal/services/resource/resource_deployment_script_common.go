@@ -11,8 +11,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-10-01/deploymentscripts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-08-01/deploymentscripts"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	resourceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
@@ -48,7 +49,8 @@ type ResourceDeploymentScriptModel struct {
 }
 
 type ContainerConfigurationModel struct {
-	ContainerGroupName string `tfschema:"container_group_name"`
+	ContainerGroupName string   `tfschema:"container_group_name"`
+	SubnetIds          []string `tfschema:"subnet_ids"`
 }
 
 type EnvironmentVariableModel struct {
@@ -120,6 +122,15 @@ func getDeploymentScriptArguments(kind DeploymentScriptKind) map[string]*plugins
 						Optional:     true,
 						ValidateFunc: validation.StringIsNotEmpty,
 					},
+
+					"subnet_ids": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: commonids.ValidateSubnetID,
+						},
+					},
 				},
 			},
 		},
@@ -317,6 +328,16 @@ func expandContainerConfigurationModel(inputList []ContainerConfigurationModel)
 		output.ContainerGroupName = &input.ContainerGroupName
 	}
 
+	if len(input.SubnetIds) != 0 {
+		subnetIds := make([]deploymentscripts.ContainerGroupSubnetId, 0, len(input.SubnetIds))
+		for _, v := range input.SubnetIds {
+			subnetIds = append(subnetIds, deploymentscripts.ContainerGroupSubnetId{
+				Id: v,
+			})
+		}
+		output.SubnetIds = &subnetIds
+	}
+
 	return &output
 }
 
@@ -367,14 +388,23 @@ func flattenContainerConfigurationModel(input *deploymentscripts.ContainerConfig
 		return outputList
 	}
 
+	output := ContainerConfigurationModel{}
+
 	if input.ContainerGroupName != nil {
-		output := ContainerConfigurationModel{
-			ContainerGroupName: *input.ContainerGroupName,
+		output.ContainerGroupName = *input.ContainerGroupName
+	}
+
+	if input.SubnetIds != nil {
+		for _, v := range *input.SubnetIds {
+			output.SubnetIds = append(output.SubnetIds, v.Id)
 		}
-		outputList = append(outputList, output)
 	}
 
-	return outputList
+	if output.ContainerGroupName == "" && len(output.SubnetIds) == 0 {
+		return outputList
+	}
+
+	return append(outputList, output)
 }
 
 func flattenEnvironmentVariableModelArray(inputList *[]deploymentscripts.EnvironmentVariable, originalList []EnvironmentVariableModel) []EnvironmentVariableModel {
@@ -4,6 +4,7 @@
 package resource
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -16,9 +17,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -26,6 +30,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+const resourceGroupProtectionDefaultLockName = "terraform-resource-group-protection"
+
 func resourceResourceGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceResourceGroupCreateUpdate,
@@ -56,6 +62,28 @@ func resourceResourceGroup() *pluginsdk.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			"protection": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"lock_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      resourceGroupProtectionDefaultLockName,
+							ValidateFunc: validate.ManagementLockName,
+						},
+
+						"notes": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 512),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -154,6 +182,12 @@ func resourceResourceGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface
 
 	d.SetId(id.ID())
 
+	if d.IsNewResource() || d.HasChange("protection") {
+		if err := updateResourceGroupProtection(ctx, d, meta, *id); err != nil {
+			return err
+		}
+	}
+
 	return resourceResourceGroupRead(d, meta)
 }
 
@@ -181,6 +215,26 @@ func resourceResourceGroupRead(d *pluginsdk.ResourceData, meta interface{}) erro
 	d.Set("name", resp.Name)
 	d.Set("location", location.NormalizeNilable(resp.Location))
 	d.Set("managed_by", pointer.From(resp.ManagedBy))
+
+	// only the lock managed by this resource is checked, since other locks may be managed separately - this is looked
+	// up even when `protection` isn't in the state so that imports and locks added outside of Terraform are detected
+	lockName := resourceGroupProtectionLockName(d)
+	lock, err := resourceGroupProtectionLock(ctx, meta, *id, lockName)
+	if err != nil {
+		return err
+	}
+
+	output := make([]interface{}, 0)
+	if lock != nil {
+		output = append(output, map[string]interface{}{
+			"lock_name": lockName,
+			"notes":     pointer.From(lock.Properties.Notes),
+		})
+	}
+	if err := d.Set("protection", output); err != nil {
+		return fmt.Errorf("setting `protection`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -194,8 +248,18 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
+	// the lock may have been removed outside of Terraform, so check that it still exists rather than relying on the state
+	lock, err := resourceGroupProtectionLock(ctx, meta, *id, resourceGroupProtectionLockName(d))
+	if err != nil {
+		return err
+	}
+	if lock != nil {
+		return fmt.Errorf("deleting %s: deletion protection is enabled - the `protection` block must be removed (and applied) before the Resource Group can be deleted", *id)
+	}
+
 	// conditionally check for nested resources and error if they exist
-	if meta.(*clients.Client).Features.ResourceGroup.PreventDeletionIfContainsResources {
+	resourceGroupFeatures := meta.(*clients.Client).Features.ResourceGroup
+	if resourceGroupFeatures.PreventDeletionIfContainsResources || len(resourceGroupFeatures.PreventDeletionIfContainsResourceTypes) > 0 {
 		resourceClient := meta.(*clients.Client).Resource.LegacyResourcesClient
 		// Resource groups sometimes hold on to resource information after the resources have been deleted. We'll retry this check to account for that eventual consistency.
 		err = pluginsdk.Retry(10*time.Minute, func() *pluginsdk.RetryError {
@@ -209,7 +273,7 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 			nestedResourceIds := make([]string, 0)
 			for _, value := range results.Values() {
 				val := value
-				if val.ID != nil {
+				if val.ID != nil && resourceGroupPreventsDeletionOfResourceType(resourceGroupFeatures, pointer.From(val.Type)) {
 					nestedResourceIds = append(nestedResourceIds, *val.ID)
 				}
 
@@ -245,6 +309,113 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 	return nil
 }
 
+// resourceGroupPreventsDeletionOfResourceType returns whether a nested Resource of the type resourceType prevents the
+// deletion of the Resource Group, when resource types are specified only those types prevent the deletion
+func resourceGroupPreventsDeletionOfResourceType(input features.ResourceGroupFeatures, resourceType string) bool {
+	if len(input.PreventDeletionIfContainsResourceTypes) == 0 {
+		return true
+	}
+
+	for _, v := range input.PreventDeletionIfContainsResourceTypes {
+		if strings.EqualFold(v, resourceType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func updateResourceGroupProtection(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id parse.ResourceGroupId) error {
+	client := meta.(*clients.Client).Resource.LocksClient
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context was missing a deadline")
+	}
+
+	oldRaw, newRaw := d.GetChange("protection")
+	oldProtection := oldRaw.([]interface{})
+	newProtection := newRaw.([]interface{})
+
+	newLockName := ""
+	if len(newProtection) > 0 && newProtection[0] != nil {
+		newLockName = newProtection[0].(map[string]interface{})["lock_name"].(string)
+	}
+
+	// the previous lock is removed when protection is disabled, or when the lock is renamed
+	if len(oldProtection) > 0 && oldProtection[0] != nil {
+		oldLockName := oldProtection[0].(map[string]interface{})["lock_name"].(string)
+		if oldLockName != newLockName {
+			lockId := managementlocks.NewScopedLockID(id.ID(), oldLockName)
+			if resp, err := client.DeleteByScope(ctx, lockId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("deleting %s: %+v", lockId, err)
+			}
+
+			stateConf := &pluginsdk.StateChangeConf{
+				Target:                    []string{"NotFound"},
+				Refresh:                   managementLockStateRefreshFunc(ctx, client, lockId),
+				MinTimeout:                10 * time.Second,
+				ContinuousTargetOccurence: 12,
+				Timeout:                   time.Until(deadline),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to finish delete replication", lockId)
+			}
+		}
+	}
+
+	if newLockName == "" {
+		return nil
+	}
+
+	lockId := managementlocks.NewScopedLockID(id.ID(), newLockName)
+	payload := managementlocks.ManagementLockObject{
+		Properties: managementlocks.ManagementLockProperties{
+			Level: managementlocks.LockLevelCanNotDelete,
+			Notes: pointer.To(newProtection[0].(map[string]interface{})["notes"].(string)),
+		},
+	}
+	if _, err := client.CreateOrUpdateByScope(ctx, lockId, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", lockId, err)
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Target:                    []string{"OK"},
+		Refresh:                   managementLockStateRefreshFunc(ctx, client, lockId),
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 12,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to finish create replication", lockId)
+	}
+
+	return nil
+}
+
+func resourceGroupProtectionLockName(d *pluginsdk.ResourceData) string {
+	if protection := d.Get("protection").([]interface{}); len(protection) > 0 && protection[0] != nil {
+		if lockName := protection[0].(map[string]interface{})["lock_name"].(string); lockName != "" {
+			return lockName
+		}
+	}
+	return resourceGroupProtectionDefaultLockName
+}
+
+// resourceGroupProtectionLock returns the protection Management Lock on the Resource Group, or nil if it doesn't exist
+func resourceGroupProtectionLock(ctx context.Context, meta interface{}, id parse.ResourceGroupId, lockName string) (*managementlocks.ManagementLockObject, error) {
+	lockId := managementlocks.NewScopedLockID(id.ID(), lockName)
+	lock, err := meta.(*clients.Client).Resource.LocksClient.GetByScope(ctx, lockId)
+	if err != nil {
+		if response.WasNotFound(lock.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", lockId, err)
+	}
+
+	return lock.Model, nil
+}
+
 func resourceGroupContainsItemsError(name string, nestedResourceIds []string) error {
 	formattedResourceUris := make([]string, 0)
 	for _, id := range nestedResourceIds {
@@ -123,6 +123,67 @@ func TestAccResourceGroup_withNestedItemsAndFeatureFlag(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroup_withNestedItemsAndResourceTypesFeatureFlag(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+	r := ResourceGroupResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withResourceTypesFeatureFlag(data, "Microsoft.Network/virtualNetworks"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.createNetworkOutsideTerraform(fmt.Sprintf("acctestvnet-%d", data.RandomInteger))),
+			),
+		},
+		{
+			// attempting to delete this with the vnet should error, since Virtual Networks are a protected type
+			Config:      r.withResourceTypesFeatureFlag(data, "Microsoft.Network/virtualNetworks"),
+			Destroy:     true,
+			ExpectError: regexp.MustCompile("This feature is intended to avoid the unintentional destruction"),
+		},
+		{
+			// Virtual Networks aren't a protected type, so we should delete the RG and the Network
+			Config:  r.withResourceTypesFeatureFlag(data, "Microsoft.Storage/storageAccounts"),
+			Destroy: true,
+		},
+	})
+}
+
+func TestAccResourceGroup_protection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+	r := ResourceGroupResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.protection(data, "Protected by Terraform"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protection.0.lock_name").HasValue("terraform-resource-group-protection"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.protection(data, "Protected by Terraform"),
+			Destroy:     true,
+			ExpectError: regexp.MustCompile("deletion protection is enabled"),
+		},
+		{
+			Config: r.protection(data, "Updated by Terraform"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protection.0.notes").HasValue("Updated by Terraform"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basicConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protection.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t ResourceGroupResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	// NOTE: Due to the Resource Group resource still using the old Azure SDK and sourcing the Resource Group ID
 	// from the Azure API, we need to support both `resourceGroups` and the legacy `resourcegroups` value here
@@ -239,6 +300,41 @@ resource "azurerm_resource_group" "test" {
 `, featureFlagEnabled, data.RandomInteger, data.Locations.Primary)
 }
 
+func (t ResourceGroupResource) withResourceTypesFeatureFlag(data acceptance.TestData, resourceType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    resource_group {
+      prevent_deletion_if_contains_resources      = false
+      prevent_deletion_if_contains_resource_types = ["%s"]
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, resourceType, data.RandomInteger, data.Locations.Primary)
+}
+
+func (t ResourceGroupResource) protection(data acceptance.TestData, notes string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  protection {
+    notes = "%s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, notes)
+}
+
 func (t ResourceGroupResource) withTagsConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/deployments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = ResourceGroupTemplateDeploymentWhatIfDataSource{}
+
+type ResourceGroupTemplateDeploymentWhatIfDataSource struct{}
+
+type ResourceGroupTemplateDeploymentWhatIfDataSourceModel struct {
+	Name                  string                                        `tfschema:"name"`
+	ResourceGroupName     string                                        `tfschema:"resource_group_name"`
+	DeploymentMode        string                                        `tfschema:"deployment_mode"`
+	TemplateContent       string                                        `tfschema:"template_content"`
+	TemplateSpecVersionId string                                        `tfschema:"template_spec_version_id"`
+	ParametersContent     string                                        `tfschema:"parameters_content"`
+	ResultFormat          string                                        `tfschema:"result_format"`
+	Changes               []ResourceGroupTemplateDeploymentWhatIfChange `tfschema:"changes"`
+}
+
+type ResourceGroupTemplateDeploymentWhatIfChange struct {
+	ResourceId        string `tfschema:"resource_id"`
+	ChangeType        string `tfschema:"change_type"`
+	UnsupportedReason string `tfschema:"unsupported_reason"`
+	BeforeContent     string `tfschema:"before_content"`
+	AfterContent      string `tfschema:"after_content"`
+	DeltaContent      string `tfschema:"delta_content"`
+}
+
+func (ResourceGroupTemplateDeploymentWhatIfDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.TemplateDeploymentName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+		"deployment_mode": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(deployments.DeploymentModeComplete),
+				string(deployments.DeploymentModeIncremental),
+			}, false),
+		},
+
+		"template_content": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ExactlyOneOf: []string{
+				"template_content",
+				"template_spec_version_id",
+			},
+			ValidateFunc: validation.StringIsJSON,
+		},
+
+		"template_spec_version_id": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ExactlyOneOf: []string{
+				"template_content",
+				"template_spec_version_id",
+			},
+			ValidateFunc: validate.TemplateSpecVersionID,
+		},
+
+		"parameters_content": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+
+		"result_format": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(deployments.WhatIfResultFormatFullResourcePayloads),
+			ValidateFunc: validation.StringInSlice(deployments.PossibleValuesForWhatIfResultFormat(), false),
+		},
+	}
+}
+
+func (ResourceGroupTemplateDeploymentWhatIfDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"changes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"change_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"unsupported_reason": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					// NOTE: the resource payloads and property changes are arbitrary (and nested) objects, so these are
+					// exposed as JSON which can be parsed using `jsondecode`, in the same way as `output_content`
+					"before_content": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"after_content": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"delta_content": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ResourceGroupTemplateDeploymentWhatIfDataSource) ModelObject() interface{} {
+	return &ResourceGroupTemplateDeploymentWhatIfDataSourceModel{}
+}
+
+func (ResourceGroupTemplateDeploymentWhatIfDataSource) ResourceType() string {
+	return "azurerm_resource_group_template_deployment_whatif"
+}
+
+func (ResourceGroupTemplateDeploymentWhatIfDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state ResourceGroupTemplateDeploymentWhatIfDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := deployments.NewResourceGroupProviderDeploymentID(subscriptionId, state.ResourceGroupName, state.Name)
+
+			payload := deployments.DeploymentWhatIf{
+				Properties: deployments.DeploymentWhatIfProperties{
+					Mode: deployments.DeploymentMode(state.DeploymentMode),
+					WhatIfSettings: &deployments.DeploymentWhatIfSettings{
+						ResultFormat: pointer.To(deployments.WhatIfResultFormat(state.ResultFormat)),
+					},
+				},
+			}
+
+			if state.TemplateContent != "" {
+				var template interface{}
+				if err := json.Unmarshal([]byte(state.TemplateContent), &template); err != nil {
+					return fmt.Errorf("expanding `template_content`: %+v", err)
+				}
+				payload.Properties.Template = &template
+			}
+
+			if state.TemplateSpecVersionId != "" {
+				payload.Properties.TemplateLink = &deployments.TemplateLink{
+					Id: pointer.To(state.TemplateSpecVersionId),
+				}
+			}
+
+			if state.ParametersContent != "" {
+				parameters, err := expandTemplateDeploymentWhatIfParameters(state.ParametersContent)
+				if err != nil {
+					return fmt.Errorf("expanding `parameters_content`: %+v", err)
+				}
+				payload.Properties.Parameters = parameters
+			}
+
+			resp, err := client.WhatIf(ctx, id, payload)
+			if err != nil {
+				return fmt.Errorf("performing What-If for %s: %+v", id, err)
+			}
+			if err := resp.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("polling after What-If for %s: %+v", id, err)
+			}
+
+			var result deployments.WhatIfOperationResult
+			if err := resp.Poller.FinalResult(&result); err != nil {
+				return fmt.Errorf("retrieving the result of the What-If for %s: %+v", id, err)
+			}
+
+			if result.Error != nil {
+				return fmt.Errorf("performing What-If for %s: %s: %s", id, pointer.From(result.Error.Code), pointer.From(result.Error.Message))
+			}
+
+			changes := make([]ResourceGroupTemplateDeploymentWhatIfChange, 0)
+			if props := result.Properties; props != nil {
+				changes, err = flattenTemplateDeploymentWhatIfChanges(props.Changes)
+				if err != nil {
+					return fmt.Errorf("flattening `changes`: %+v", err)
+				}
+			}
+			state.Changes = changes
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func expandTemplateDeploymentWhatIfParameters(input string) (*map[string]deployments.DeploymentParameter, error) {
+	var output map[string]deployments.DeploymentParameter
+
+	if err := json.Unmarshal([]byte(input), &output); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func flattenTemplateDeploymentWhatIfChanges(input *[]deployments.WhatIfChange) ([]ResourceGroupTemplateDeploymentWhatIfChange, error) {
+	output := make([]ResourceGroupTemplateDeploymentWhatIfChange, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, item := range *input {
+		change := ResourceGroupTemplateDeploymentWhatIfChange{
+			ResourceId:        item.ResourceId,
+			ChangeType:        string(item.ChangeType),
+			UnsupportedReason: pointer.From(item.UnsupportedReason),
+		}
+
+		if item.Before != nil {
+			before, err := flattenTemplateDeploymentBody(*item.Before)
+			if err != nil {
+				return nil, fmt.Errorf("flattening `before_content` for %q: %+v", item.ResourceId, err)
+			}
+			change.BeforeContent = pointer.From(before)
+		}
+
+		if item.After != nil {
+			after, err := flattenTemplateDeploymentBody(*item.After)
+			if err != nil {
+				return nil, fmt.Errorf("flattening `after_content` for %q: %+v", item.ResourceId, err)
+			}
+			change.AfterContent = pointer.From(after)
+		}
+
+		if item.Delta != nil {
+			delta, err := flattenTemplateDeploymentBody(*item.Delta)
+			if err != nil {
+				return nil, fmt.Errorf("flattening `delta_content` for %q: %+v", item.ResourceId, err)
+			}
+			change.DeltaContent = pointer.From(delta)
+		}
+
+		output = append(output, change)
+	}
+
+	return output, nil
+}
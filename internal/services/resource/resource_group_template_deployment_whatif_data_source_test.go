@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ResourceGroupTemplateDeploymentWhatIfDataSource struct{}
+
+func TestAccDataSourceResourceGroupTemplateDeploymentWhatIf_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_group_template_deployment_whatif", "test")
+	r := ResourceGroupTemplateDeploymentWhatIfDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("changes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("changes.0.change_type").HasValue("Create"),
+				check.That(data.ResourceName).Key("changes.0.after_content").Exists(),
+			),
+		},
+	})
+}
+
+func (ResourceGroupTemplateDeploymentWhatIfDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+data "azurerm_resource_group_template_deployment_whatif" "test" {
+  name                = "acctest"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "vnetName": {
+      "type": "String"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Network/virtualNetworks",
+      "apiVersion": "2023-09-01",
+      "name": "[parameters('vnetName')]",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "addressSpace": {
+          "addressPrefixes": [
+            "10.0.0.0/16"
+          ]
+        }
+      }
+    }
+  ]
+}
+TEMPLATE
+
+  parameters_content = jsonencode({
+    "vnetName" = {
+      value = "acctestvnet-%d"
+    }
+  })
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
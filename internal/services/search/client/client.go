@@ -4,13 +4,17 @@
 package client
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/adminkeys"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/querykeys"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/sharedprivatelinkresources"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
 )
 
 type Client struct {
@@ -18,6 +22,32 @@ type Client struct {
 	QueryKeysClient                       *querykeys.QueryKeysClient
 	ServicesClient                        *services.ServicesClient
 	SearchSharedPrivateLinkResourceClient *sharedprivatelinkresources.SharedPrivateLinkResourcesClient
+
+	environment         environments.Environment
+	configureClientFunc func(c client.BaseClient)
+}
+
+// DataPlaneClient returns a client for the Data Plane of the Search Service, which authenticates using its Primary Admin Key.
+func (c *Client) DataPlaneClient(ctx context.Context, searchServiceId services.SearchServiceId) (*dataplane.Client, error) {
+	endpoint, err := dataplane.EndpointForSearchService(c.environment, searchServiceId.SearchServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	adminKeysId := adminkeys.NewSearchServiceID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName)
+	keys, err := c.AdminKeysClient.Get(ctx, adminKeysId, adminkeys.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Admin Keys for %s: %+v", searchServiceId, err)
+	}
+	if keys.Model == nil || keys.Model.PrimaryKey == nil {
+		return nil, fmt.Errorf("retrieving the Admin Keys for %s: `primaryKey` was nil", searchServiceId)
+	}
+
+	dataPlaneClient := dataplane.NewClient(*endpoint)
+	c.configureClientFunc(dataPlaneClient.Client)
+	dataPlaneClient.AuthorizeWithAdminKey(*keys.Model.PrimaryKey)
+
+	return dataPlaneClient, nil
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -50,5 +80,11 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		QueryKeysClient:                       queryKeysClient,
 		ServicesClient:                        servicesClient,
 		SearchSharedPrivateLinkResourceClient: searchSharedPrivateLinkResourceClient,
+
+		environment: o.Environment,
+		configureClientFunc: func(c client.BaseClient) {
+			// the Admin Key is used to authenticate requests rather than an Authorizer
+			o.Configure(c, nil)
+		},
 	}, nil
 }
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// there's no Data Plane SDK for Azure AI Search available in `hashicorp/go-azure-sdk` yet, so this is a minimal
+// client for the Indexes, Indexers and Data Sources APIs which can be removed once one becomes available
+const apiVersion = "2024-07-01"
+
+// Collection is the path segment of a type of resource within the Search Service Data Plane API.
+type Collection string
+
+const (
+	CollectionDataSources Collection = "datasources"
+	CollectionIndexers    Collection = "indexers"
+	CollectionIndexes     Collection = "indexes"
+)
+
+type Client struct {
+	*dataplane.Client
+}
+
+func NewClient(endpoint string) *Client {
+	return &Client{
+		Client: dataplane.NewDataPlaneClient(endpoint, "search", apiVersion),
+	}
+}
+
+// AuthorizeWithAdminKey authenticates requests using an Admin Key of the Search Service. The key is added by a request
+// middleware, so this must be called after any logging middleware has been appended to avoid the key being logged.
+func (c *Client) AuthorizeWithAdminKey(adminKey string) {
+	c.AppendRequestMiddleware(func(req *http.Request) (*http.Request, error) {
+		req.Header.Set("api-key", adminKey)
+		return req, nil
+	})
+}
+
+// EndpointForSearchService returns the Data Plane endpoint of a Search Service within the given environment.
+func EndpointForSearchService(environment environments.Environment, searchServiceName string) (*string, error) {
+	domainSuffix := ""
+	switch environment.Name {
+	case environments.AzurePublicCloud:
+		domainSuffix = "search.windows.net"
+	case environments.AzureUSGovernmentCloud:
+		domainSuffix = "search.azure.us"
+	case environments.AzureChinaCloud:
+		domainSuffix = "search.azure.cn"
+	default:
+		return nil, fmt.Errorf("the Search Service Data Plane isn't supported in the %q environment", environment.Name)
+	}
+
+	endpoint := fmt.Sprintf("https://%s.%s", searchServiceName, domainSuffix)
+	return &endpoint, nil
+}
+
+type GetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        map[string]interface{}
+}
+
+// Get retrieves the definition of the named item within the collection.
+func (c Client) Get(ctx context.Context, collection Collection, name string) (result GetOperationResponse, err error) {
+	req, err := c.newRequest(ctx, client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       c.path(collection, name),
+	})
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type CreateOrUpdateOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+// CreateOrUpdate creates or replaces the definition of the named item within the collection.
+func (c Client) CreateOrUpdate(ctx context.Context, collection Collection, name string, input interface{}) (result CreateOrUpdateOperationResponse, err error) {
+	req, err := c.newRequest(ctx, client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       c.path(collection, name),
+	})
+	if err != nil {
+		return
+	}
+
+	// the definition is returned unless this header is specified, which we don't need since it's retrieved in the Read
+	req.Header.Set("Prefer", "return=minimal")
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+type DeleteOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+// Delete deletes the named item within the collection, which is considered successful if it doesn't exist.
+func (c Client) Delete(ctx context.Context, collection Collection, name string) (result DeleteOperationResponse, err error) {
+	req, err := c.newRequest(ctx, client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusNotFound,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       c.path(collection, name),
+	})
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (c Client) newRequest(ctx context.Context, input client.RequestOptions) (*client.Request, error) {
+	if err := input.Validate(); err != nil {
+		return nil, fmt.Errorf("internal-error: pre-validating request payload: %+v", err)
+	}
+
+	req, err := c.Client.NewRequest(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("building %s request: %+v", input.HttpMethod, err)
+	}
+
+	query := url.Values{}
+	query.Set("api-version", c.ApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	return req, nil
+}
+
+func (c Client) path(collection Collection, name string) string {
+	return fmt.Sprintf("/%s/%s", collection, url.PathEscape(name))
+}
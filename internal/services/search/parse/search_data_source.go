@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SearchDataSourceId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	SearchServiceName string
+	DatasourceName    string
+}
+
+func NewSearchDataSourceID(subscriptionId, resourceGroup, searchServiceName, datasourceName string) SearchDataSourceId {
+	return SearchDataSourceId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		SearchServiceName: searchServiceName,
+		DatasourceName:    datasourceName,
+	}
+}
+
+func (id SearchDataSourceId) String() string {
+	segments := []string{
+		fmt.Sprintf("Datasource Name %q", id.DatasourceName),
+		fmt.Sprintf("Search Service Name %q", id.SearchServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Search Data Source", segmentsStr)
+}
+
+func (id SearchDataSourceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s/datasources/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SearchServiceName, id.DatasourceName)
+}
+
+// SearchDataSourceID parses a SearchDataSource ID into an SearchDataSourceId struct
+func SearchDataSourceID(input string) (*SearchDataSourceId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an SearchDataSource ID: %+v", input, err)
+	}
+
+	resourceId := SearchDataSourceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SearchServiceName, err = id.PopSegment("searchServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatasourceName, err = id.PopSegment("datasources"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SearchIndexId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	SearchServiceName string
+	IndexName         string
+}
+
+func NewSearchIndexID(subscriptionId, resourceGroup, searchServiceName, indexName string) SearchIndexId {
+	return SearchIndexId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		SearchServiceName: searchServiceName,
+		IndexName:         indexName,
+	}
+}
+
+func (id SearchIndexId) String() string {
+	segments := []string{
+		fmt.Sprintf("Index Name %q", id.IndexName),
+		fmt.Sprintf("Search Service Name %q", id.SearchServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Search Index", segmentsStr)
+}
+
+func (id SearchIndexId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s/indexes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SearchServiceName, id.IndexName)
+}
+
+// SearchIndexID parses a SearchIndex ID into an SearchIndexId struct
+func SearchIndexID(input string) (*SearchIndexId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an SearchIndex ID: %+v", input, err)
+	}
+
+	resourceId := SearchIndexId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SearchServiceName, err = id.PopSegment("searchServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.IndexName, err = id.PopSegment("indexes"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -33,6 +33,9 @@ func (r Registration) WebsiteCategories() []string {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		SearchDataSourceResource{},
+		SearchIndexResource{},
+		SearchIndexerResource{},
 		SharedPrivateLinkServiceResource{},
 	}
 }
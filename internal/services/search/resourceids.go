@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+// the data plane resources within a Search Service aren't ARM resources, so these IDs are composed of the Search Service ID and the name of the data plane resource
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SearchIndex -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Search/searchServices/service1/indexes/index1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SearchIndexer -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Search/searchServices/service1/indexers/indexer1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SearchDataSource -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Search/searchServices/service1/datasources/datasource1
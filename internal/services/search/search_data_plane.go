@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// searchDataPlaneClientForRead returns a Data Plane client for the Search Service, or nil if the Search Service no
+// longer exists, in which case everything within it has gone too.
+func searchDataPlaneClientForRead(ctx context.Context, metadata sdk.ResourceMetaData, searchServiceId services.SearchServiceId) (*dataplane.Client, error) {
+	resp, err := metadata.Client.Search.ServicesClient.Get(ctx, searchServiceId, services.GetOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", searchServiceId, err)
+	}
+
+	return metadata.Client.Search.DataPlaneClient(ctx, searchServiceId)
+}
+
+// searchDataPlaneDefinitionJsonSchema returns the schema for specifying the definition of a Data Plane resource as
+// JSON, as an alternative to the structured blocks.
+func searchDataPlaneDefinitionJsonSchema(conflictsWith []string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:             pluginsdk.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ConflictsWith:    conflictsWith,
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: suppressSearchDataPlaneDefinitionJsonDiff,
+	}
+}
+
+func expandSearchDataPlaneDefinitionJson(input string, name string) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(input), &output); err != nil {
+		return nil, fmt.Errorf("unmarshaling `definition_json`: %+v", err)
+	}
+
+	// the name is taken from the `name` field, so that it always matches the Resource ID
+	output["name"] = name
+
+	return output, nil
+}
+
+func flattenSearchDataPlaneDefinitionJson(input map[string]interface{}) (string, error) {
+	output := make(map[string]interface{})
+	for k, v := range input {
+		if strings.HasPrefix(k, "@odata.") {
+			continue
+		}
+		output[k] = v
+	}
+
+	result, err := json.Marshal(output)
+	if err != nil {
+		return "", fmt.Errorf("marshaling `definition_json`: %+v", err)
+	}
+
+	return string(result), nil
+}
+
+// suppressSearchDataPlaneDefinitionJsonDiff suppresses the diff when the configured definition is a subset of the
+// definition returned by the API, since the API returns the default values for any properties which aren't specified.
+func suppressSearchDataPlaneDefinitionJsonDiff(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	var actual, expected interface{}
+	if err := json.Unmarshal([]byte(old), &actual); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &expected); err != nil {
+		return false
+	}
+
+	// the name is taken from the `name` field, so any value specified within the JSON is ignored
+	if v, ok := expected.(map[string]interface{}); ok {
+		delete(v, "name")
+	}
+
+	return searchDataPlaneJsonIsSubset(expected, actual)
+}
+
+func searchDataPlaneJsonIsSubset(expected, actual interface{}) bool {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range e {
+			if !searchDataPlaneJsonIsSubset(v, a[k]) {
+				return false
+			}
+		}
+		return true
+
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(e) {
+			return false
+		}
+		for i := range e {
+			if !searchDataPlaneJsonIsSubset(e[i], a[i]) {
+				return false
+			}
+		}
+		return true
+
+	default:
+		return reflect.DeepEqual(expected, actual)
+	}
+}
@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SearchDataSourceResource struct{}
+
+var (
+	_ sdk.Resource           = SearchDataSourceResource{}
+	_ sdk.ResourceWithUpdate = SearchDataSourceResource{}
+)
+
+type SearchDataSourceModel struct {
+	Name             string                      `tfschema:"name"`
+	SearchServiceId  string                      `tfschema:"search_service_id"`
+	Type             string                      `tfschema:"type"`
+	ConnectionString string                      `tfschema:"connection_string"`
+	Container        []SearchDataSourceContainer `tfschema:"container"`
+	Description      string                      `tfschema:"description"`
+	DefinitionJson   string                      `tfschema:"definition_json"`
+}
+
+type SearchDataSourceContainer struct {
+	Name  string `tfschema:"name"`
+	Query string `tfschema:"query"`
+}
+
+func (r SearchDataSourceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"type", "definition_json"},
+			RequiredWith: []string{"connection_string", "container"},
+			ValidateFunc: validation.StringInSlice([]string{
+				"adlsgen2",
+				"azureblob",
+				"azuresql",
+				"azuretable",
+				"cosmosdb",
+				"mysql",
+				"onelake",
+			}, false),
+		},
+
+		"connection_string": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ConflictsWith: []string{"definition_json"},
+			ValidateFunc:  validation.StringIsNotEmpty,
+		},
+
+		"container": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			Computed:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"definition_json"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"query": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"definition_json"},
+			ValidateFunc:  validation.StringIsNotEmpty,
+		},
+
+		"definition_json": func() *pluginsdk.Schema {
+			s := searchDataPlaneDefinitionJsonSchema([]string{"type", "connection_string", "container", "description"})
+			// the connection string is part of the definition
+			s.Sensitive = true
+			return s
+		}(),
+	}
+}
+
+func (r SearchDataSourceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SearchDataSourceResource) ModelObject() interface{} {
+	return &SearchDataSourceModel{}
+}
+
+func (r SearchDataSourceResource) ResourceType() string {
+	return "azurerm_search_data_source"
+}
+
+func (r SearchDataSourceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SearchDataSourceID
+}
+
+func (r SearchDataSourceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SearchDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSearchDataSourceID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, *searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, dataplane.CollectionDataSources, id.DatasourceName)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandSearchDataSource(metadata, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, dataplane.CollectionDataSources, id.DatasourceName, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SearchDataSourceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := searchDataPlaneClientForRead(ctx, metadata, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.Get(ctx, dataplane.CollectionDataSources, id.DatasourceName)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			var existing SearchDataSourceModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := SearchDataSourceModel{
+				Name:            id.DatasourceName,
+				SearchServiceId: searchServiceId.ID(),
+				// the connection string isn't returned by the API, so it's taken from the state
+				ConnectionString: existing.ConnectionString,
+			}
+
+			model := resp.Model
+			state.Type, _ = model["type"].(string)
+			state.Description, _ = model["description"].(string)
+
+			if container, ok := model["container"].(map[string]interface{}); ok {
+				item := SearchDataSourceContainer{}
+				item.Name, _ = container["name"].(string)
+				item.Query, _ = container["query"].(string)
+				state.Container = []SearchDataSourceContainer{item}
+			}
+
+			if credentials, ok := model["credentials"].(map[string]interface{}); ok && credentials["connectionString"] == nil {
+				credentials["connectionString"] = searchDataSourceConnectionStringFromState(existing)
+			}
+
+			definitionJson, err := flattenSearchDataPlaneDefinitionJson(model)
+			if err != nil {
+				return err
+			}
+			state.DefinitionJson = definitionJson
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SearchDataSourceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SearchDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName))
+			if err != nil {
+				return err
+			}
+
+			payload, err := expandSearchDataSource(metadata, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, dataplane.CollectionDataSources, id.DatasourceName, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SearchDataSourceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchDataSourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName))
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, dataplane.CollectionDataSources, id.DatasourceName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSearchDataSource(metadata sdk.ResourceMetaData, model SearchDataSourceModel) (map[string]interface{}, error) {
+	if !metadata.ResourceData.GetRawConfig().AsValueMap()["definition_json"].IsNull() {
+		return expandSearchDataPlaneDefinitionJson(model.DefinitionJson, model.Name)
+	}
+
+	output := map[string]interface{}{
+		"name": model.Name,
+		"type": model.Type,
+		"credentials": map[string]interface{}{
+			"connectionString": model.ConnectionString,
+		},
+	}
+
+	if len(model.Container) > 0 {
+		container := map[string]interface{}{
+			"name": model.Container[0].Name,
+		}
+		if model.Container[0].Query != "" {
+			container["query"] = model.Container[0].Query
+		}
+		output["container"] = container
+	}
+
+	if model.Description != "" {
+		output["description"] = model.Description
+	}
+
+	return output, nil
+}
+
+// searchDataSourceConnectionStringFromState returns the connection string which was specified, either directly or
+// within the definition JSON, since it's redacted by the API.
+func searchDataSourceConnectionStringFromState(input SearchDataSourceModel) interface{} {
+	if input.ConnectionString != "" {
+		return input.ConnectionString
+	}
+
+	if input.DefinitionJson != "" {
+		var definition map[string]interface{}
+		if err := json.Unmarshal([]byte(input.DefinitionJson), &definition); err == nil {
+			if credentials, ok := definition["credentials"].(map[string]interface{}); ok {
+				return credentials["connectionString"]
+			}
+		}
+	}
+
+	return nil
+}
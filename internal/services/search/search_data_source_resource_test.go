@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SearchDataSourceResource struct{}
+
+func TestAccSearchDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string", "definition_json"),
+	})
+}
+
+func TestAccSearchDataSource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchDataSource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string", "definition_json"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("container.0.query").HasValue("documents"),
+			),
+		},
+		data.ImportStep("connection_string", "definition_json"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string", "definition_json"),
+	})
+}
+
+func TestAccSearchDataSource_definitionJson(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_data_source", "test")
+	r := SearchDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.definitionJson(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("type").HasValue("azureblob"),
+			),
+		},
+		data.ImportStep("definition_json"),
+	})
+}
+
+func (SearchDataSourceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SearchDataSourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, dataplane.CollectionDataSources, id.DatasourceName)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (SearchDataSourceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-search-%d"
+  location = "%s"
+}
+
+resource "azurerm_search_service" "test" {
+  name                = "acctestsearchservice%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "basic"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "documents"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomString)
+}
+
+func (r SearchDataSourceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "test" {
+  name              = "acctestdatasource-%d"
+  search_service_id = azurerm_search_service.test.id
+  type              = "azureblob"
+  connection_string = azurerm_storage_account.test.primary_connection_string
+
+  container {
+    name = azurerm_storage_container.test.name
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchDataSourceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "import" {
+  name              = azurerm_search_data_source.test.name
+  search_service_id = azurerm_search_data_source.test.search_service_id
+  type              = azurerm_search_data_source.test.type
+  connection_string = azurerm_storage_account.test.primary_connection_string
+
+  container {
+    name = azurerm_storage_container.test.name
+  }
+}
+`, r.basic(data))
+}
+
+func (r SearchDataSourceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "test" {
+  name              = "acctestdatasource-%d"
+  search_service_id = azurerm_search_service.test.id
+  type              = "azureblob"
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  description       = "Documents uploaded to the storage container"
+
+  container {
+    name  = azurerm_storage_container.test.name
+    query = "documents"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchDataSourceResource) definitionJson(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_data_source" "test" {
+  name              = "acctestdatasource-%d"
+  search_service_id = azurerm_search_service.test.id
+
+  definition_json = jsonencode({
+    type = "azureblob"
+    credentials = {
+      connectionString = azurerm_storage_account.test.primary_connection_string
+    }
+    container = {
+      name = azurerm_storage_container.test.name
+    }
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SearchIndexResource struct{}
+
+var (
+	_ sdk.Resource           = SearchIndexResource{}
+	_ sdk.ResourceWithUpdate = SearchIndexResource{}
+)
+
+type SearchIndexModel struct {
+	Name            string             `tfschema:"name"`
+	SearchServiceId string             `tfschema:"search_service_id"`
+	Field           []SearchIndexField `tfschema:"field"`
+	DefinitionJson  string             `tfschema:"definition_json"`
+}
+
+type SearchIndexField struct {
+	Name         string `tfschema:"name"`
+	Type         string `tfschema:"type"`
+	Key          bool   `tfschema:"key"`
+	Searchable   bool   `tfschema:"searchable"`
+	Filterable   bool   `tfschema:"filterable"`
+	Sortable     bool   `tfschema:"sortable"`
+	Facetable    bool   `tfschema:"facetable"`
+	Retrievable  bool   `tfschema:"retrievable"`
+	AnalyzerName string `tfschema:"analyzer_name"`
+}
+
+func (r SearchIndexResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"field": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"field", "definition_json"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"Edm.String",
+							"Edm.Int32",
+							"Edm.Int64",
+							"Edm.Double",
+							"Edm.Boolean",
+							"Edm.DateTimeOffset",
+							"Edm.GeographyPoint",
+							"Collection(Edm.String)",
+							"Collection(Edm.Int32)",
+							"Collection(Edm.Int64)",
+							"Collection(Edm.Double)",
+							"Collection(Edm.Boolean)",
+							"Collection(Edm.DateTimeOffset)",
+							"Collection(Edm.GeographyPoint)",
+						}, false),
+					},
+
+					"key": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"searchable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"filterable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"sortable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"facetable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"retrievable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"analyzer_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"definition_json": searchDataPlaneDefinitionJsonSchema([]string{"field"}),
+	}
+}
+
+func (r SearchIndexResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SearchIndexResource) ModelObject() interface{} {
+	return &SearchIndexModel{}
+}
+
+func (r SearchIndexResource) ResourceType() string {
+	return "azurerm_search_index"
+}
+
+func (r SearchIndexResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SearchIndexID
+}
+
+func (r SearchIndexResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SearchIndexModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSearchIndexID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, *searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, dataplane.CollectionIndexes, id.IndexName)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandSearchIndex(metadata, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, dataplane.CollectionIndexes, id.IndexName, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SearchIndexResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := searchDataPlaneClientForRead(ctx, metadata, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.Get(ctx, dataplane.CollectionIndexes, id.IndexName)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := SearchIndexModel{
+				Name:            id.IndexName,
+				SearchServiceId: searchServiceId.ID(),
+				Field:           flattenSearchIndexFields(resp.Model["fields"]),
+			}
+
+			definitionJson, err := flattenSearchDataPlaneDefinitionJson(resp.Model)
+			if err != nil {
+				return err
+			}
+			state.DefinitionJson = definitionJson
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SearchIndexResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SearchIndexModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName))
+			if err != nil {
+				return err
+			}
+
+			payload, err := expandSearchIndex(metadata, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, dataplane.CollectionIndexes, id.IndexName, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SearchIndexResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName))
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, dataplane.CollectionIndexes, id.IndexName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSearchIndex(metadata sdk.ResourceMetaData, model SearchIndexModel) (map[string]interface{}, error) {
+	if !metadata.ResourceData.GetRawConfig().AsValueMap()["definition_json"].IsNull() {
+		return expandSearchDataPlaneDefinitionJson(model.DefinitionJson, model.Name)
+	}
+
+	fields := make([]interface{}, 0)
+	for _, field := range model.Field {
+		item := map[string]interface{}{
+			"name":        field.Name,
+			"type":        field.Type,
+			"key":         field.Key,
+			"searchable":  field.Searchable,
+			"filterable":  field.Filterable,
+			"sortable":    field.Sortable,
+			"facetable":   field.Facetable,
+			"retrievable": field.Retrievable,
+		}
+		if field.AnalyzerName != "" {
+			item["analyzer"] = field.AnalyzerName
+		}
+		fields = append(fields, item)
+	}
+
+	return map[string]interface{}{
+		"name":   model.Name,
+		"fields": fields,
+	}, nil
+}
+
+func flattenSearchIndexFields(input interface{}) []SearchIndexField {
+	output := make([]SearchIndexField, 0)
+
+	fields, ok := input.([]interface{})
+	if !ok {
+		return output
+	}
+
+	for _, v := range fields {
+		field, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		item := SearchIndexField{}
+		item.Name, _ = field["name"].(string)
+		item.Type, _ = field["type"].(string)
+		item.Key, _ = field["key"].(bool)
+		item.Searchable, _ = field["searchable"].(bool)
+		item.Filterable, _ = field["filterable"].(bool)
+		item.Sortable, _ = field["sortable"].(bool)
+		item.Facetable, _ = field["facetable"].(bool)
+		item.Retrievable, _ = field["retrievable"].(bool)
+		item.AnalyzerName, _ = field["analyzer"].(string)
+		output = append(output, item)
+	}
+
+	return output
+}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SearchIndexResource struct{}
+
+func TestAccSearchIndex_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("field.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchIndex_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchIndex_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("field.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchIndex_definitionJson(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_index", "test")
+	r := SearchIndexResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.definitionJson(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("field.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("definition_json"),
+	})
+}
+
+func (SearchIndexResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SearchIndexID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, dataplane.CollectionIndexes, id.IndexName)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (SearchIndexResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-search-%d"
+  location = "%s"
+}
+
+resource "azurerm_search_service" "test" {
+  name                = "acctestsearchservice%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r SearchIndexResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "test" {
+  name              = "acctestindex-%d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name = "id"
+    type = "Edm.String"
+    key  = true
+  }
+
+  field {
+    name       = "description"
+    type       = "Edm.String"
+    searchable = true
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchIndexResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "import" {
+  name              = azurerm_search_index.test.name
+  search_service_id = azurerm_search_index.test.search_service_id
+
+  field {
+    name = "id"
+    type = "Edm.String"
+    key  = true
+  }
+}
+`, r.basic(data))
+}
+
+func (r SearchIndexResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "test" {
+  name              = "acctestindex-%d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name = "id"
+    type = "Edm.String"
+    key  = true
+  }
+
+  field {
+    name       = "description"
+    type       = "Edm.String"
+    searchable = true
+  }
+
+  field {
+    name          = "title"
+    type          = "Edm.String"
+    searchable    = true
+    analyzer_name = "en.microsoft"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchIndexResource) definitionJson(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "test" {
+  name              = "acctestindex-%d"
+  search_service_id = azurerm_search_service.test.id
+
+  definition_json = jsonencode({
+    fields = [
+      {
+        name = "id"
+        type = "Edm.String"
+        key  = true
+      },
+      {
+        name       = "description"
+        type       = "Edm.String"
+        searchable = true
+      },
+    ]
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
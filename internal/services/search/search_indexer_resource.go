@@ -0,0 +1,396 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SearchIndexerResource struct{}
+
+var (
+	_ sdk.Resource           = SearchIndexerResource{}
+	_ sdk.ResourceWithUpdate = SearchIndexerResource{}
+)
+
+type SearchIndexerModel struct {
+	Name            string                      `tfschema:"name"`
+	SearchServiceId string                      `tfschema:"search_service_id"`
+	DataSourceName  string                      `tfschema:"data_source_name"`
+	TargetIndexName string                      `tfschema:"target_index_name"`
+	Description     string                      `tfschema:"description"`
+	SkillsetName    string                      `tfschema:"skillset_name"`
+	Schedule        []SearchIndexerSchedule     `tfschema:"schedule"`
+	FieldMapping    []SearchIndexerFieldMapping `tfschema:"field_mapping"`
+	Enabled         bool                        `tfschema:"enabled"`
+	DefinitionJson  string                      `tfschema:"definition_json"`
+}
+
+type SearchIndexerSchedule struct {
+	Interval  string `tfschema:"interval"`
+	StartTime string `tfschema:"start_time"`
+}
+
+type SearchIndexerFieldMapping struct {
+	SourceFieldName string `tfschema:"source_field_name"`
+	TargetFieldName string `tfschema:"target_field_name"`
+}
+
+func (r SearchIndexerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneName,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: services.ValidateSearchServiceID,
+		},
+
+		"data_source_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"data_source_name", "definition_json"},
+			RequiredWith: []string{"target_index_name"},
+			ValidateFunc: validate.DataPlaneName,
+		},
+
+		"target_index_name": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"definition_json"},
+			RequiredWith:  []string{"data_source_name"},
+			ValidateFunc:  validate.DataPlaneName,
+		},
+
+		"description": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"definition_json"},
+			ValidateFunc:  validation.StringIsNotEmpty,
+		},
+
+		"skillset_name": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"definition_json"},
+			ValidateFunc:  validate.DataPlaneName,
+		},
+
+		"schedule": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"definition_json"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"interval": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"start_time": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+				},
+			},
+		},
+
+		"field_mapping": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"definition_json"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"source_field_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"target_field_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"enabled": {
+			Type:          pluginsdk.TypeBool,
+			Optional:      true,
+			Default:       true,
+			ConflictsWith: []string{"definition_json"},
+		},
+
+		"definition_json": searchDataPlaneDefinitionJsonSchema([]string{"data_source_name", "target_index_name", "description", "skillset_name", "schedule", "field_mapping", "enabled"}),
+	}
+}
+
+func (r SearchIndexerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SearchIndexerResource) ModelObject() interface{} {
+	return &SearchIndexerModel{}
+}
+
+func (r SearchIndexerResource) ResourceType() string {
+	return "azurerm_search_indexer"
+}
+
+func (r SearchIndexerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SearchIndexerID
+}
+
+func (r SearchIndexerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SearchIndexerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := services.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSearchIndexerID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, *searchServiceId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, dataplane.CollectionIndexers, id.IndexerName)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandSearchIndexer(metadata, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, dataplane.CollectionIndexers, id.IndexerName, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SearchIndexerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName)
+			client, err := searchDataPlaneClientForRead(ctx, metadata, searchServiceId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.Get(ctx, dataplane.CollectionIndexers, id.IndexerName)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := SearchIndexerModel{
+				Name:            id.IndexerName,
+				SearchServiceId: searchServiceId.ID(),
+				FieldMapping:    flattenSearchIndexerFieldMappings(resp.Model["fieldMappings"]),
+			}
+
+			model := resp.Model
+			state.DataSourceName, _ = model["dataSourceName"].(string)
+			state.TargetIndexName, _ = model["targetIndexName"].(string)
+			state.Description, _ = model["description"].(string)
+			state.SkillsetName, _ = model["skillsetName"].(string)
+
+			disabled, _ := model["disabled"].(bool)
+			state.Enabled = !disabled
+
+			if schedule, ok := model["schedule"].(map[string]interface{}); ok {
+				item := SearchIndexerSchedule{}
+				item.Interval, _ = schedule["interval"].(string)
+				item.StartTime, _ = schedule["startTime"].(string)
+				state.Schedule = []SearchIndexerSchedule{item}
+			}
+
+			definitionJson, err := flattenSearchDataPlaneDefinitionJson(model)
+			if err != nil {
+				return err
+			}
+			state.DefinitionJson = definitionJson
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SearchIndexerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SearchIndexerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName))
+			if err != nil {
+				return err
+			}
+
+			payload, err := expandSearchIndexer(metadata, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, dataplane.CollectionIndexers, id.IndexerName, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SearchIndexerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SearchIndexerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName))
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, dataplane.CollectionIndexers, id.IndexerName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSearchIndexer(metadata sdk.ResourceMetaData, model SearchIndexerModel) (map[string]interface{}, error) {
+	if !metadata.ResourceData.GetRawConfig().AsValueMap()["definition_json"].IsNull() {
+		return expandSearchDataPlaneDefinitionJson(model.DefinitionJson, model.Name)
+	}
+
+	fieldMappings := make([]interface{}, 0)
+	for _, mapping := range model.FieldMapping {
+		item := map[string]interface{}{
+			"sourceFieldName": mapping.SourceFieldName,
+		}
+		if mapping.TargetFieldName != "" {
+			item["targetFieldName"] = mapping.TargetFieldName
+		}
+		fieldMappings = append(fieldMappings, item)
+	}
+
+	output := map[string]interface{}{
+		"name":            model.Name,
+		"dataSourceName":  model.DataSourceName,
+		"targetIndexName": model.TargetIndexName,
+		"fieldMappings":   fieldMappings,
+		"disabled":        !model.Enabled,
+	}
+
+	if model.Description != "" {
+		output["description"] = model.Description
+	}
+
+	if model.SkillsetName != "" {
+		output["skillsetName"] = model.SkillsetName
+	}
+
+	if len(model.Schedule) > 0 {
+		schedule := map[string]interface{}{
+			"interval": model.Schedule[0].Interval,
+		}
+		if model.Schedule[0].StartTime != "" {
+			schedule["startTime"] = model.Schedule[0].StartTime
+		}
+		output["schedule"] = schedule
+	}
+
+	return output, nil
+}
+
+func flattenSearchIndexerFieldMappings(input interface{}) []SearchIndexerFieldMapping {
+	output := make([]SearchIndexerFieldMapping, 0)
+
+	mappings, ok := input.([]interface{})
+	if !ok {
+		return output
+	}
+
+	for _, v := range mappings {
+		mapping, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		item := SearchIndexerFieldMapping{}
+		item.SourceFieldName, _ = mapping["sourceFieldName"].(string)
+		item.TargetFieldName, _ = mapping["targetFieldName"].(string)
+		output = append(output, item)
+	}
+
+	return output
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SearchIndexerResource struct{}
+
+func TestAccSearchIndexer_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_indexer", "test")
+	r := SearchIndexerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchIndexer_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_indexer", "test")
+	r := SearchIndexerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchIndexer_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_indexer", "test")
+	r := SearchIndexerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("schedule.0.interval").HasValue("PT2H"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchIndexer_definitionJson(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_indexer", "test")
+	r := SearchIndexerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.definitionJson(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_index_name").Exists(),
+			),
+		},
+		data.ImportStep("definition_json"),
+	})
+}
+
+func (SearchIndexerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SearchIndexerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.Search.DataPlaneClient(ctx, services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.SearchServiceName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, dataplane.CollectionIndexers, id.IndexerName)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (SearchIndexerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_index" "test" {
+  name              = "acctestindex-%d"
+  search_service_id = azurerm_search_service.test.id
+
+  field {
+    name = "id"
+    type = "Edm.String"
+    key  = true
+  }
+
+  field {
+    name       = "content"
+    type       = "Edm.String"
+    searchable = true
+  }
+}
+`, SearchDataSourceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r SearchIndexerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_indexer" "test" {
+  name              = "acctestindexer-%d"
+  search_service_id = azurerm_search_service.test.id
+  data_source_name  = azurerm_search_data_source.test.name
+  target_index_name = azurerm_search_index.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchIndexerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_indexer" "import" {
+  name              = azurerm_search_indexer.test.name
+  search_service_id = azurerm_search_indexer.test.search_service_id
+  data_source_name  = azurerm_search_indexer.test.data_source_name
+  target_index_name = azurerm_search_indexer.test.target_index_name
+}
+`, r.basic(data))
+}
+
+func (r SearchIndexerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_indexer" "test" {
+  name              = "acctestindexer-%d"
+  search_service_id = azurerm_search_service.test.id
+  data_source_name  = azurerm_search_data_source.test.name
+  target_index_name = azurerm_search_index.test.name
+  description       = "Indexes the documents within the storage container"
+  enabled           = false
+
+  schedule {
+    interval = "PT2H"
+  }
+
+  field_mapping {
+    source_field_name = "metadata_storage_path"
+    target_field_name = "id"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchIndexerResource) definitionJson(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_indexer" "test" {
+  name              = "acctestindexer-%d"
+  search_service_id = azurerm_search_service.test.id
+
+  definition_json = jsonencode({
+    dataSourceName  = azurerm_search_data_source.test.name
+    targetIndexName = azurerm_search_index.test.name
+    schedule = {
+      interval = "PT1H"
+    }
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
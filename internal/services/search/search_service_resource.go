@@ -111,7 +111,6 @@ func resourceSearchService() *pluginsdk.Resource {
 			"hosting_mode": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Default:  string(services.HostingModeDefault),
 				ValidateFunc: validation.StringInSlice([]string{
 					string(services.HostingModeDefault),
@@ -512,7 +511,7 @@ func resourceSearchServiceUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 
 		// NOTE: Semantic Search SKU cannot be set if the SKU is 'free'
-		if pointer.From(model.Sku.Name) == services.SkuNameFree && semanticSearchSku != services.SearchSemanticSearchDisabled {
+		if model.Sku != nil && pointer.From(model.Sku.Name) == services.SkuNameFree && semanticSearchSku != services.SearchSemanticSearchDisabled {
 			return fmt.Errorf("`semantic_search_sku` can only be specified when `sku` is not set to %q", string(services.SkuNameFree))
 		}
 
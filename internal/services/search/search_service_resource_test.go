@@ -357,7 +357,7 @@ func TestAccSearchService_hostingMode(t *testing.T) {
 
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
-			Config: r.hostingMode(data, "standard3"),
+			Config: r.hostingMode(data, "standard3", "highDensity"),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
@@ -366,13 +366,36 @@ func TestAccSearchService_hostingMode(t *testing.T) {
 	})
 }
 
+func TestAccSearchService_hostingModeUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_service", "test")
+	r := SearchServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hostingMode(data, "standard3", "default"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.hostingMode(data, "standard3", "highDensity"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hosting_mode").HasValue("highDensity"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccSearchService_hostingModeInvalidSKU(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_search_service", "test")
 	r := SearchServiceResource{}
 
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
-			Config:      r.hostingMode(data, "standard2"),
+			Config:      r.hostingMode(data, "standard2", "highDensity"),
 			Check:       acceptance.ComposeTestCheckFunc(),
 			ExpectError: regexp.MustCompile("can only be defined if"),
 		},
@@ -857,7 +880,7 @@ resource "azurerm_search_service" "test" {
 `, template, data.RandomInteger)
 }
 
-func (r SearchServiceResource) hostingMode(data acceptance.TestData, sku string) string {
+func (r SearchServiceResource) hostingMode(data acceptance.TestData, sku string, hostingMode string) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -871,9 +894,9 @@ resource "azurerm_search_service" "test" {
   resource_group_name = azurerm_resource_group.test.name
   location            = azurerm_resource_group.test.location
   sku                 = "%s"
-  hosting_mode        = "highDensity"
+  hosting_mode        = "%s"
 }
-`, template, data.RandomInteger, sku)
+`, template, data.RandomInteger, sku, hostingMode)
 }
 
 func (r SearchServiceResource) partitionCount(data acceptance.TestData, sku string, count int) string {
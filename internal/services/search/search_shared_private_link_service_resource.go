@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/sharedprivatelinkresources"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -33,6 +34,7 @@ type SharedPrivateLinkServiceModel struct {
 	SubResourceName  string `tfschema:"subresource_name"`
 	TargetResourceId string `tfschema:"target_resource_id"`
 	RequestMessage   string `tfschema:"request_message"`
+	ResourceRegion   string `tfschema:"resource_region"`
 	Status           string `tfschema:"status"`
 }
 
@@ -71,6 +73,15 @@ func (r SharedPrivateLinkServiceResource) Arguments() map[string]*pluginsdk.Sche
 			Optional:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
+
+		"resource_region": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     location.EnhancedValidate,
+			StateFunc:        location.StateFunc,
+			DiffSuppressFunc: location.DiffSuppressFunc,
+		},
 	}
 }
 
@@ -135,10 +146,18 @@ func (r SharedPrivateLinkServiceResource) Create() sdk.ResourceFunc {
 				parameters.Properties.RequestMessage = pointer.To(model.RequestMessage)
 			}
 
+			if model.ResourceRegion != "" {
+				parameters.Properties.ResourceRegion = pointer.To(location.Normalize(model.ResourceRegion))
+			}
+
 			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters, sharedprivatelinkresources.CreateOrUpdateOperationOptions{}); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
+			if err := waitForSearchSharedPrivateLinkResourceToBeProvisioned(ctx, client, id); err != nil {
+				return err
+			}
+
 			metadata.SetID(id)
 			return nil
 		},
@@ -184,6 +203,10 @@ func (r SharedPrivateLinkServiceResource) Read() sdk.ResourceFunc {
 						state.RequestMessage = *props.RequestMessage
 					}
 
+					if props.ResourceRegion != nil {
+						state.ResourceRegion = location.Normalize(*props.ResourceRegion)
+					}
+
 					if props.Status != nil {
 						state.Status = string(*props.Status)
 					}
@@ -216,14 +239,30 @@ func (r SharedPrivateLinkServiceResource) Update() sdk.ResourceFunc {
 			client := metadata.Client.Search.SearchSharedPrivateLinkResourceClient
 
 			if metadata.ResourceData.HasChange("request_message") {
+				existing, err := client.Get(ctx, *id, sharedprivatelinkresources.GetOperationOptions{})
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				if existing.Model == nil || existing.Model.Properties == nil {
+					return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+				}
+
+				// the group ID and target resource are required by the API, so the existing properties are sent along with the new message
 				props := sharedprivatelinkresources.SharedPrivateLinkResource{
 					Properties: &sharedprivatelinkresources.SharedPrivateLinkResourceProperties{
-						RequestMessage: pointer.To(state.RequestMessage),
+						GroupId:               existing.Model.Properties.GroupId,
+						PrivateLinkResourceId: existing.Model.Properties.PrivateLinkResourceId,
+						RequestMessage:        pointer.To(state.RequestMessage),
+						ResourceRegion:        existing.Model.Properties.ResourceRegion,
 					},
 				}
 				if err := client.CreateOrUpdateThenPoll(ctx, *id, props, sharedprivatelinkresources.CreateOrUpdateOperationOptions{}); err != nil {
 					return fmt.Errorf("updating %s: %+v", *id, err)
 				}
+
+				if err := waitForSearchSharedPrivateLinkResourceToBeProvisioned(ctx, client, *id); err != nil {
+					return err
+				}
 			}
 			return nil
 		},
@@ -252,3 +291,51 @@ func (r SharedPrivateLinkServiceResource) Delete() sdk.ResourceFunc {
 		Timeout: 60 * time.Minute,
 	}
 }
+
+// waitForSearchSharedPrivateLinkResourceToBeProvisioned waits for the provisioning state to settle, since the
+// long-running operation can complete whilst the private endpoint to the target resource is still being set up.
+func waitForSearchSharedPrivateLinkResourceToBeProvisioned(ctx context.Context, client *sharedprivatelinkresources.SharedPrivateLinkResourcesClient, id sharedprivatelinkresources.SharedPrivateLinkResourceId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(sharedprivatelinkresources.SharedPrivateLinkResourceProvisioningStateIncomplete),
+			string(sharedprivatelinkresources.SharedPrivateLinkResourceProvisioningStateUpdating),
+		},
+		Target: []string{
+			string(sharedprivatelinkresources.SharedPrivateLinkResourceProvisioningStateSucceeded),
+		},
+		Refresh:    searchSharedPrivateLinkResourceProvisioningStateRefreshFunc(ctx, client, id),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be provisioned: %+v", id, err)
+	}
+
+	return nil
+}
+
+func searchSharedPrivateLinkResourceProvisioningStateRefreshFunc(ctx context.Context, client *sharedprivatelinkresources.SharedPrivateLinkResourcesClient, id sharedprivatelinkresources.SharedPrivateLinkResourceId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id, sharedprivatelinkresources.GetOperationOptions{})
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		state := ""
+		if model := resp.Model; model != nil && model.Properties != nil {
+			state = string(pointer.From(model.Properties.ProvisioningState))
+		}
+
+		if state == string(sharedprivatelinkresources.SharedPrivateLinkResourceProvisioningStateFailed) {
+			return resp, state, fmt.Errorf("%s failed to provision", id)
+		}
+
+		return resp, state, nil
+	}
+}
@@ -47,6 +47,42 @@ func TestAccSearchSharedPrivateLinkServiceResource_requiresImport(t *testing.T)
 	})
 }
 
+func TestAccSearchSharedPrivateLinkServiceResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_shared_private_link_service", "test")
+	r := SearchSharedPrivateLinkServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r)),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("request_message").HasValue("please approve again")),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchSharedPrivateLinkServiceResource_openAI(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_shared_private_link_service", "test")
+	r := SearchSharedPrivateLinkServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.openAI(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Pending")),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r SearchSharedPrivateLinkServiceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := sharedprivatelinkresources.ParseSharedPrivateLinkResourceID(state.ID)
 	if err != nil {
@@ -84,6 +120,45 @@ resource "azurerm_search_shared_private_link_service" "test2" {
 `, template, data.RandomInteger)
 }
 
+func (r SearchSharedPrivateLinkServiceResource) updated(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_shared_private_link_service" "test" {
+  name               = "acctest%[2]d"
+  search_service_id  = azurerm_search_service.test.id
+  subresource_name   = "blob"
+  target_resource_id = azurerm_storage_account.test.id
+  request_message    = "please approve again"
+}
+`, template, data.RandomInteger)
+}
+
+func (r SearchSharedPrivateLinkServiceResource) openAI(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account" "test" {
+  name                  = "acctestcogacc-%[2]d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  kind                  = "OpenAI"
+  sku_name              = "S0"
+  custom_subdomain_name = "acctestcogacc-%[2]d"
+}
+
+resource "azurerm_search_shared_private_link_service" "test" {
+  name               = "acctest%[2]d"
+  search_service_id  = azurerm_search_service.test.id
+  subresource_name   = "openai_account"
+  target_resource_id = azurerm_cognitive_account.test.id
+  request_message    = "please approve"
+}
+`, template, data.RandomInteger)
+}
+
 func (r SearchSharedPrivateLinkServiceResource) requiresImport(data acceptance.TestData) string {
 	template := SearchSharedPrivateLinkServiceResource{}.basic(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DataPlaneName validates the name of an Index, Indexer or Data Source within a Search Service, which must only
+// contain lowercase letters, numbers and dashes, start and end with a letter or number and be at most 128 characters.
+func DataPlaneName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,126}[a-z0-9])?$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must only contain lowercase letters, numbers and dashes, start and end with a letter or number and be between 1 and 128 characters", k))
+	}
+
+	return
+}
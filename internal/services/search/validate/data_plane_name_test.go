@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDataPlaneName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "a",
+			Valid: true,
+		},
+		{
+			Input: "hotels-index-1",
+			Valid: true,
+		},
+		{
+			Input: "-hotels",
+			Valid: false,
+		},
+		{
+			Input: "hotels-",
+			Valid: false,
+		},
+		{
+			Input: "Hotels",
+			Valid: false,
+		},
+		{
+			Input: "hotels_index",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 128),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 129),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := DataPlaneName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
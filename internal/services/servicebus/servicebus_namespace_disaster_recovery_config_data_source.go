@@ -8,6 +8,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/disasterrecoveryconfigs"
@@ -51,6 +52,16 @@ func dataSourceServiceBusNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"role": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"pending_replication_operations_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
 			"primary_connection_string_alias": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -147,6 +158,8 @@ func dataSourceServiceBusNamespaceDisasterRecoveryConfigRead(d *pluginsdk.Resour
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("partner_namespace_id", props.PartnerNamespace)
+			d.Set("pending_replication_operations_count", pointer.From(props.PendingReplicationOperationsCount))
+			d.Set("role", pointer.FromEnum(props.Role))
 		}
 	}
 
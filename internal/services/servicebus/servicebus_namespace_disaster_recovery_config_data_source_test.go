@@ -24,6 +24,8 @@ func TestAccDataSourceServiceBusNamespaceDisasterRecoveryConfig_basic(t *testing
 				check.That(data.ResourceName).Key("name").Exists(),
 				check.That(data.ResourceName).Key("namespace_id").Exists(),
 				check.That(data.ResourceName).Key("partner_namespace_id").Exists(),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+				check.That(data.ResourceName).Key("pending_replication_operations_count").Exists(),
 				check.That(data.ResourceName).Key("primary_connection_string_alias").Exists(),
 				check.That(data.ResourceName).Key("secondary_connection_string_alias").Exists(),
 				check.That(data.ResourceName).Key("default_primary_key").Exists(),
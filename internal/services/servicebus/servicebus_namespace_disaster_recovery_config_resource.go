@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/disasterrecoveryconfigs"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -68,6 +70,34 @@ func resourceServiceBusNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"failover_trigger": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"safe_failover_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"break_pairing_trigger": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"role": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"pending_replication_operations_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
 			"primary_connection_string_alias": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -116,7 +146,9 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigCreate(d *pluginsdk.Resour
 			}
 		}
 
-		if !response.WasNotFound(existing.HttpResponse) {
+		// following a failover the alias remains on the new primary namespace without a partner, which is re-paired
+		// by swapping `primary_namespace_id` and `partner_namespace_id`
+		if !response.WasNotFound(existing.HttpResponse) && !isUnpairedServiceBusDisasterRecoveryConfig(existing.Model) {
 			return tf.ImportAsExistsError("azurerm_servicebus_namespace_disaster_recovery_config", id.ID())
 		}
 	}
@@ -152,6 +184,52 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigUpdate(d *pluginsdk.Resour
 	locks.ByName(id.NamespaceName, serviceBusNamespaceResourceName)
 	defer locks.UnlockByName(id.NamespaceName, serviceBusNamespaceResourceName)
 
+	if serviceBusNamespaceDisasterRecoveryConfigIsFailedOver(d, *id) {
+		return fmt.Errorf("%s has been failed over from %q, swap `primary_namespace_id` and `partner_namespace_id` to pair the namespaces again", *id, d.Get("primary_namespace_id").(string))
+	}
+
+	// breaking the pairing and failing over both leave the namespaces unpaired, so these are performed in place of any
+	// other changes - a broken pairing is then re-established on a subsequent apply if `partner_namespace_id` is still set
+	if d.HasChange("break_pairing_trigger") && d.Get("break_pairing_trigger").(string) != "" {
+		if _, err := client.BreakPairing(ctx, *id); err != nil {
+			return fmt.Errorf("breaking the pairing for %s: %+v", *id, err)
+		}
+		if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id); err != nil {
+			return fmt.Errorf("waiting for the pairing to break for %s: %+v", *id, err)
+		}
+
+		return resourceServiceBusNamespaceDisasterRecoveryConfigRead(d, meta)
+	}
+
+	if d.HasChange("failover_trigger") && d.Get("failover_trigger").(string) != "" {
+		// a failover has to be initiated against the alias on the secondary namespace
+		oldPartnerNamespaceId, _ := d.GetChange("partner_namespace_id")
+		partnerNamespaceId, err := disasterrecoveryconfigs.ParseNamespaceID(oldPartnerNamespaceId.(string))
+		if err != nil {
+			return fmt.Errorf("parsing `partner_namespace_id`: %+v", err)
+		}
+		secondaryId := disasterrecoveryconfigs.NewDisasterRecoveryConfigID(partnerNamespaceId.SubscriptionId, partnerNamespaceId.ResourceGroupName, partnerNamespaceId.NamespaceName, id.DisasterRecoveryConfigName)
+
+		locks.ByName(secondaryId.NamespaceName, serviceBusNamespaceResourceName)
+		defer locks.UnlockByName(secondaryId.NamespaceName, serviceBusNamespaceResourceName)
+
+		parameters := disasterrecoveryconfigs.FailoverProperties{
+			Properties: &disasterrecoveryconfigs.FailoverPropertiesProperties{
+				IsSafeFailover: pointer.To(d.Get("safe_failover_enabled").(bool)),
+			},
+		}
+		if _, err := client.FailOver(ctx, secondaryId, parameters); err != nil {
+			return fmt.Errorf("failing over %s: %+v", secondaryId, err)
+		}
+		if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, secondaryId); err != nil {
+			return fmt.Errorf("waiting for the failover of %s to complete: %+v", secondaryId, err)
+		}
+
+		// the alias no longer exists on the original primary namespace, so track the alias on the new primary
+		d.SetId(secondaryId.ID())
+		return resourceServiceBusNamespaceDisasterRecoveryConfigRead(d, meta)
+	}
+
 	if d.HasChange("partner_namespace_id") {
 		if _, err := client.BreakPairing(ctx, *id); err != nil {
 			return fmt.Errorf("breaking the pairing for %s: %+v", *id, err)
@@ -189,21 +267,47 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigRead(d *pluginsdk.Resource
 
 	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if response.WasNotFound(resp.HttpResponse) {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		// the alias may have been failed over to the partner namespace outside of Terraform
+		failedOverId, err := serviceBusNamespaceDisasterRecoveryConfigFailedOverId(ctx, client, *id, d.Get("partner_namespace_id").(string))
+		if err != nil {
+			return err
+		}
+		if failedOverId == nil {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("retrieving %s: %+v", id, err)
-	}
 
-	primaryId := disasterrecoveryconfigs.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
+		log.Printf("[WARN] %s has been failed over to %s", *id, *failedOverId)
+		id = failedOverId
+		d.SetId(id.ID())
+
+		resp, err = client.Get(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+	}
 
 	d.Set("name", id.DisasterRecoveryConfigName)
-	d.Set("primary_namespace_id", primaryId.ID())
+
+	// following a failover the pairing is retained in the state, so that it can be re-established by swapping
+	// `primary_namespace_id` and `partner_namespace_id`
+	failedOver := serviceBusNamespaceDisasterRecoveryConfigIsFailedOver(d, *id)
+	if !failedOver {
+		primaryId := disasterrecoveryconfigs.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
+		d.Set("primary_namespace_id", primaryId.ID())
+	}
 
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
-			d.Set("partner_namespace_id", props.PartnerNamespace)
+			if !failedOver {
+				d.Set("partner_namespace_id", props.PartnerNamespace)
+			}
+			d.Set("pending_replication_operations_count", pointer.From(props.PendingReplicationOperationsCount))
+			d.Set("role", pointer.FromEnum(props.Role))
 		}
 	}
 
@@ -243,6 +347,20 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigDelete(d *pluginsdk.Resour
 		return err
 	}
 
+	// following a failover the alias on the new primary namespace must be left intact, so that it can be re-paired
+	if serviceBusNamespaceDisasterRecoveryConfigIsFailedOver(d, *id) {
+		log.Printf("[DEBUG] %s has been failed over from %q - removing from state without deleting the alias", *id, d.Get("primary_namespace_id").(string))
+		return nil
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
 	// @tombuildsstuff: whilst we previously checked the 200 response, since that's the only valid status
 	// code defined in the Swagger, anything else would raise an error thus the check is superfluous
 	if _, err := client.BreakPairing(ctx, *id); err != nil {
@@ -326,6 +444,55 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigDelete(d *pluginsdk.Resour
 	return nil
 }
 
+// serviceBusNamespaceDisasterRecoveryConfigFailedOverId returns the ID of the alias on the partner namespace when the
+// alias has been failed over to it, or nil when the alias doesn't exist on the partner namespace as an unpaired primary
+func serviceBusNamespaceDisasterRecoveryConfigFailedOverId(ctx context.Context, client *disasterrecoveryconfigs.DisasterRecoveryConfigsClient, id disasterrecoveryconfigs.DisasterRecoveryConfigId, partnerNamespace string) (*disasterrecoveryconfigs.DisasterRecoveryConfigId, error) {
+	if partnerNamespace == "" {
+		return nil, nil
+	}
+
+	partnerNamespaceId, err := disasterrecoveryconfigs.ParseNamespaceIDInsensitively(partnerNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `partner_namespace_id`: %+v", err)
+	}
+
+	partnerId := disasterrecoveryconfigs.NewDisasterRecoveryConfigID(partnerNamespaceId.SubscriptionId, partnerNamespaceId.ResourceGroupName, partnerNamespaceId.NamespaceName, id.DisasterRecoveryConfigName)
+	resp, err := client.Get(ctx, partnerId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", partnerId, err)
+	}
+
+	if !isUnpairedServiceBusDisasterRecoveryConfig(resp.Model) {
+		return nil, nil
+	}
+
+	return &partnerId, nil
+}
+
+// serviceBusNamespaceDisasterRecoveryConfigIsFailedOver returns whether the alias being tracked lives on a namespace
+// other than the configured `primary_namespace_id`, which is the case following a failover
+func serviceBusNamespaceDisasterRecoveryConfigIsFailedOver(d *pluginsdk.ResourceData, id disasterrecoveryconfigs.DisasterRecoveryConfigId) bool {
+	primaryNamespaceId, err := disasterrecoveryconfigs.ParseNamespaceIDInsensitively(d.Get("primary_namespace_id").(string))
+	if err != nil {
+		return false
+	}
+
+	return !strings.EqualFold(primaryNamespaceId.ID(), disasterrecoveryconfigs.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID())
+}
+
+// isUnpairedServiceBusDisasterRecoveryConfig returns whether the alias is a primary without a partner namespace, which
+// is the case for the alias on the new primary namespace following a failover
+func isUnpairedServiceBusDisasterRecoveryConfig(input *disasterrecoveryconfigs.ArmDisasterRecovery) bool {
+	if input == nil || input.Properties == nil {
+		return false
+	}
+
+	return pointer.From(input.Properties.Role) == disasterrecoveryconfigs.RoleDisasterRecoveryPrimaryNotReplicating && pointer.From(input.Properties.PartnerNamespace) == ""
+}
+
 func resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx context.Context, client *disasterrecoveryconfigs.DisasterRecoveryConfigsClient, id disasterrecoveryconfigs.DisasterRecoveryConfigId) error {
 	deadline, ok := ctx.Deadline()
 	if !ok {
@@ -50,6 +50,58 @@ func TestAccAzureRMServiceBusNamespacePairing_resourceGroupName(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMServiceBusNamespacePairing_breakPairing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_config", "pairing_test")
+	r := ServiceBusNamespaceDisasterRecoveryConfigResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.breakPairing(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("PrimaryNotReplicating"),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+	})
+}
+
+func TestAccAzureRMServiceBusNamespacePairing_failover(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_config", "pairing_test")
+	r := ServiceBusNamespaceDisasterRecoveryConfigResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.failover(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("PrimaryNotReplicating"),
+			),
+		},
+		{
+			Config: r.repaired(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t ServiceBusNamespaceDisasterRecoveryConfigResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigID(state.ID)
 	if err != nil {
@@ -147,3 +199,78 @@ resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test"
 }
 `, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
 }
+
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) breakPairing(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
+  name                  = "acctest-alias-%d"
+  primary_namespace_id  = azurerm_servicebus_namespace.primary_namespace_test.id
+  partner_namespace_id  = azurerm_servicebus_namespace.secondary_namespace_test.id
+  break_pairing_trigger = "1"
+}
+`, r.namespaces(data), data.RandomInteger)
+}
+
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) failover(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
+  name                  = "acctest-alias-%d"
+  primary_namespace_id  = azurerm_servicebus_namespace.primary_namespace_test.id
+  partner_namespace_id  = azurerm_servicebus_namespace.secondary_namespace_test.id
+  failover_trigger      = "1"
+  safe_failover_enabled = true
+}
+`, r.namespaces(data), data.RandomInteger)
+}
+
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) repaired(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
+  name                 = "acctest-alias-%d"
+  primary_namespace_id = azurerm_servicebus_namespace.secondary_namespace_test.id
+  partner_namespace_id = azurerm_servicebus_namespace.primary_namespace_test.id
+}
+`, r.namespaces(data), data.RandomInteger)
+}
+
+func (ServiceBusNamespaceDisasterRecoveryConfigResource) namespaces(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "primary" {
+  name     = "acctest1RG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_resource_group" "secondary" {
+  name     = "acctest2RG-%[1]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_servicebus_namespace" "primary_namespace_test" {
+  name                         = "acctest1-%[1]d"
+  location                     = azurerm_resource_group.primary.location
+  resource_group_name          = azurerm_resource_group.primary.name
+  sku                          = "Premium"
+  capacity                     = "1"
+  premium_messaging_partitions = 1
+}
+
+resource "azurerm_servicebus_namespace" "secondary_namespace_test" {
+  name                         = "acctest2-%[1]d"
+  location                     = azurerm_resource_group.secondary.location
+  resource_group_name          = azurerm_resource_group.secondary.name
+  sku                          = "Premium"
+  capacity                     = "1"
+  premium_messaging_partitions = 1
+}
+`, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
+}
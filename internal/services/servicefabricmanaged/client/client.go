@@ -6,17 +6,43 @@ package client
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/application"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/applicationtype"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/applicationtypeversion"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/managedcluster"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/nodetype"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/service"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	ManagedClusterClient *managedcluster.ManagedClusterClient
-	NodeTypeClient       *nodetype.NodeTypeClient
+	ApplicationClient            *application.ApplicationClient
+	ApplicationTypeClient        *applicationtype.ApplicationTypeClient
+	ApplicationTypeVersionClient *applicationtypeversion.ApplicationTypeVersionClient
+	ManagedClusterClient         *managedcluster.ManagedClusterClient
+	NodeTypeClient               *nodetype.NodeTypeClient
+	ServiceClient                *service.ServiceClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	applicationClient, err := application.NewApplicationClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Application client: %+v", err)
+	}
+	o.Configure(applicationClient.Client, o.Authorizers.ResourceManager)
+
+	applicationTypeClient, err := applicationtype.NewApplicationTypeClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ApplicationType client: %+v", err)
+	}
+	o.Configure(applicationTypeClient.Client, o.Authorizers.ResourceManager)
+
+	applicationTypeVersionClient, err := applicationtypeversion.NewApplicationTypeVersionClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ApplicationTypeVersion client: %+v", err)
+	}
+	o.Configure(applicationTypeVersionClient.Client, o.Authorizers.ResourceManager)
+
 	managedCluster, err := managedcluster.NewManagedClusterClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building ManagedCluster client: %+v", err)
@@ -29,8 +55,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(nodeType.Client, o.Authorizers.ResourceManager)
 
+	serviceClient, err := service.NewServiceClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Service client: %+v", err)
+	}
+	o.Configure(serviceClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
-		ManagedClusterClient: managedCluster,
-		NodeTypeClient:       nodeType,
+		ApplicationClient:            applicationClient,
+		ApplicationTypeClient:        applicationTypeClient,
+		ApplicationTypeVersionClient: applicationTypeVersionClient,
+		ManagedClusterClient:         managedCluster,
+		NodeTypeClient:               nodeType,
+		ServiceClient:                serviceClient,
 	}, nil
 }
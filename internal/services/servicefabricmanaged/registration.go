@@ -24,7 +24,11 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ApplicationResource{},
+		ApplicationTypeResource{},
+		ApplicationTypeVersionResource{},
 		ClusterResource{},
+		ServiceResource{},
 	}
 }
 
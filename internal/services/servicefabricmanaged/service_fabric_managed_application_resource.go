@@ -0,0 +1,582 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicefabricmanaged
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/application"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/applicationtypeversion"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/managedcluster"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	serviceFabricValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabric/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = ApplicationResource{}
+	_ sdk.ResourceWithCustomizeDiff = ApplicationResource{}
+)
+
+type ApplicationResourceModel struct {
+	Name                     string                     `tfschema:"name"`
+	ManagedClusterId         string                     `tfschema:"managed_cluster_id"`
+	ApplicationTypeVersionId string                     `tfschema:"application_type_version_id"`
+	Parameters               map[string]string          `tfschema:"parameters"`
+	UpgradePolicy            []ApplicationUpgradePolicy `tfschema:"upgrade_policy"`
+	Tags                     map[string]interface{}     `tfschema:"tags"`
+}
+
+type ApplicationUpgradePolicy struct {
+	ForceRestartEnabled                    bool                                  `tfschema:"force_restart_enabled"`
+	RecreateApplicationEnabled             bool                                  `tfschema:"recreate_application_enabled"`
+	InstanceCloseDelayDurationInSeconds    int64                                 `tfschema:"instance_close_delay_duration_in_seconds"`
+	UpgradeMode                            string                                `tfschema:"upgrade_mode"`
+	UpgradeReplicaSetCheckTimeoutInSeconds int64                                 `tfschema:"upgrade_replica_set_check_timeout_in_seconds"`
+	HealthPolicy                           []ApplicationHealthPolicy             `tfschema:"health_policy"`
+	RollingUpgradeMonitoringPolicy         []ApplicationRollingUpgradeMonitoring `tfschema:"rolling_upgrade_monitoring_policy"`
+}
+
+type ApplicationHealthPolicy struct {
+	ConsiderWarningAsErrorEnabled           bool                                `tfschema:"consider_warning_as_error_enabled"`
+	MaxUnhealthyDeployedApplicationsPercent int64                               `tfschema:"max_unhealthy_deployed_applications_percent"`
+	DefaultServiceTypeHealthPolicy          []ApplicationServiceTypeHealth      `tfschema:"default_service_type_health_policy"`
+	ServiceTypeHealthPolicy                 []ApplicationNamedServiceTypeHealth `tfschema:"service_type_health_policy"`
+}
+
+type ApplicationServiceTypeHealth struct {
+	MaxUnhealthyServicesPercent             int64 `tfschema:"max_unhealthy_services_percent"`
+	MaxUnhealthyPartitionsPerServicePercent int64 `tfschema:"max_unhealthy_partitions_per_service_percent"`
+	MaxUnhealthyReplicasPerPartitionPercent int64 `tfschema:"max_unhealthy_replicas_per_partition_percent"`
+}
+
+type ApplicationNamedServiceTypeHealth struct {
+	ServiceTypeName                         string `tfschema:"service_type_name"`
+	MaxUnhealthyServicesPercent             int64  `tfschema:"max_unhealthy_services_percent"`
+	MaxUnhealthyPartitionsPerServicePercent int64  `tfschema:"max_unhealthy_partitions_per_service_percent"`
+	MaxUnhealthyReplicasPerPartitionPercent int64  `tfschema:"max_unhealthy_replicas_per_partition_percent"`
+}
+
+type ApplicationRollingUpgradeMonitoring struct {
+	FailureAction             string `tfschema:"failure_action"`
+	HealthCheckRetryTimeout   string `tfschema:"health_check_retry_timeout"`
+	HealthCheckStableDuration string `tfschema:"health_check_stable_duration"`
+	HealthCheckWaitDuration   string `tfschema:"health_check_wait_duration"`
+	UpgradeDomainTimeout      string `tfschema:"upgrade_domain_timeout"`
+	UpgradeTimeout            string `tfschema:"upgrade_timeout"`
+}
+
+func (r ApplicationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		"managed_cluster_id": commonschema.ResourceIDReferenceRequiredForceNew(&managedcluster.ManagedClusterId{}),
+
+		"application_type_version_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: applicationtypeversion.ValidateVersionID,
+		},
+
+		"parameters": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"upgrade_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"force_restart_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"recreate_application_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"instance_close_delay_duration_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"upgrade_mode": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(application.RollingUpgradeModeMonitored),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(application.RollingUpgradeModeMonitored),
+							string(application.RollingUpgradeModeUnmonitoredAuto),
+						}, false),
+					},
+
+					"upgrade_replica_set_check_timeout_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"health_policy": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"consider_warning_as_error_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+
+								"max_unhealthy_deployed_applications_percent": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      0,
+									ValidateFunc: validation.IntBetween(0, 100),
+								},
+
+								"default_service_type_health_policy": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: applicationServiceTypeHealthPolicySchema(false),
+									},
+								},
+
+								"service_type_health_policy": {
+									Type:     pluginsdk.TypeSet,
+									Optional: true,
+									Elem: &pluginsdk.Resource{
+										Schema: applicationServiceTypeHealthPolicySchema(true),
+									},
+								},
+							},
+						},
+					},
+
+					"rolling_upgrade_monitoring_policy": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"failure_action": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(application.FailureActionManual),
+										string(application.FailureActionRollback),
+									}, false),
+								},
+
+								"health_check_retry_timeout": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: serviceFabricValidate.UpgradeTimeout,
+								},
+
+								"health_check_stable_duration": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: serviceFabricValidate.UpgradeTimeout,
+								},
+
+								"health_check_wait_duration": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: serviceFabricValidate.UpgradeTimeout,
+								},
+
+								"upgrade_domain_timeout": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: serviceFabricValidate.UpgradeTimeout,
+								},
+
+								"upgrade_timeout": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: serviceFabricValidate.UpgradeTimeout,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func applicationServiceTypeHealthPolicySchema(named bool) map[string]*pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		"max_unhealthy_services_percent": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+
+		"max_unhealthy_partitions_per_service_percent": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+
+		"max_unhealthy_replicas_per_partition_percent": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+	}
+
+	if named {
+		s["service_type_name"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		}
+	}
+
+	return s
+}
+
+func (r ApplicationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApplicationResource) ModelObject() interface{} {
+	return &ApplicationResourceModel{}
+}
+
+func (r ApplicationResource) ResourceType() string {
+	return "azurerm_service_fabric_managed_application"
+}
+
+func (r ApplicationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return application.ValidateApplicationID
+}
+
+func (r ApplicationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationClient
+
+			var model ApplicationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := managedcluster.ParseManagedClusterID(model.ManagedClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := application.NewApplicationID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.ManagedClusterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandApplication(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationClient
+
+			id, err := application.ParseApplicationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApplicationResourceModel{
+				Name:             id.ApplicationName,
+				ManagedClusterId: managedcluster.NewManagedClusterID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName).ID(),
+			}
+
+			if model := existing.Model; model != nil {
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if v := pointer.From(props.Version); v != "" {
+						versionId, err := applicationtypeversion.ParseVersionIDInsensitively(v)
+						if err != nil {
+							return err
+						}
+						state.ApplicationTypeVersionId = versionId.ID()
+					}
+
+					state.Parameters = pointer.From(props.Parameters)
+					state.UpgradePolicy = flattenApplicationUpgradePolicy(props.UpgradePolicy)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationClient
+
+			id, err := application.ParseApplicationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// changing the version or parameters of the application triggers an upgrade using the upgrade policy
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandApplication(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationClient
+
+			id, err := application.ParseApplicationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			// an application can only be upgraded to another version of the same application type
+			if rd.HasChange("application_type_version_id") {
+				o, n := rd.GetChange("application_type_version_id")
+				oldVersionId, err := applicationtypeversion.ParseVersionID(o.(string))
+				if err != nil {
+					return nil
+				}
+				newVersionId, err := applicationtypeversion.ParseVersionID(n.(string))
+				if err != nil {
+					return nil
+				}
+				if !strings.EqualFold(oldVersionId.ApplicationTypeName, newVersionId.ApplicationTypeName) {
+					if err := rd.ForceNew("application_type_version_id"); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplication(model ApplicationResourceModel) application.ApplicationResource {
+	return application.ApplicationResource{
+		Properties: &application.ApplicationResourceProperties{
+			Parameters:    pointer.To(model.Parameters),
+			UpgradePolicy: expandApplicationUpgradePolicy(model.UpgradePolicy),
+			Version:       pointer.To(model.ApplicationTypeVersionId),
+		},
+		Tags: tags.Expand(model.Tags),
+	}
+}
+
+func expandApplicationUpgradePolicy(input []ApplicationUpgradePolicy) *application.ApplicationUpgradePolicy {
+	if len(input) == 0 {
+		return nil
+	}
+	policy := input[0]
+
+	output := &application.ApplicationUpgradePolicy{
+		ForceRestart:        pointer.To(policy.ForceRestartEnabled),
+		RecreateApplication: pointer.To(policy.RecreateApplicationEnabled),
+		UpgradeMode:         pointer.To(application.RollingUpgradeMode(policy.UpgradeMode)),
+	}
+
+	if policy.InstanceCloseDelayDurationInSeconds > 0 {
+		output.InstanceCloseDelayDuration = pointer.To(policy.InstanceCloseDelayDurationInSeconds)
+	}
+
+	if policy.UpgradeReplicaSetCheckTimeoutInSeconds > 0 {
+		output.UpgradeReplicaSetCheckTimeout = pointer.To(policy.UpgradeReplicaSetCheckTimeoutInSeconds)
+	}
+
+	if len(policy.HealthPolicy) > 0 {
+		healthPolicy := policy.HealthPolicy[0]
+		output.ApplicationHealthPolicy = &application.ApplicationHealthPolicy{
+			ConsiderWarningAsError:                  healthPolicy.ConsiderWarningAsErrorEnabled,
+			MaxPercentUnhealthyDeployedApplications: healthPolicy.MaxUnhealthyDeployedApplicationsPercent,
+		}
+
+		if len(healthPolicy.DefaultServiceTypeHealthPolicy) > 0 {
+			output.ApplicationHealthPolicy.DefaultServiceTypeHealthPolicy = pointer.To(expandApplicationServiceTypeHealthPolicy(healthPolicy.DefaultServiceTypeHealthPolicy[0]))
+		}
+
+		if len(healthPolicy.ServiceTypeHealthPolicy) > 0 {
+			serviceTypeHealthPolicies := make(map[string]application.ServiceTypeHealthPolicy)
+			for _, v := range healthPolicy.ServiceTypeHealthPolicy {
+				serviceTypeHealthPolicies[v.ServiceTypeName] = application.ServiceTypeHealthPolicy{
+					MaxPercentUnhealthyPartitionsPerService: v.MaxUnhealthyPartitionsPerServicePercent,
+					MaxPercentUnhealthyReplicasPerPartition: v.MaxUnhealthyReplicasPerPartitionPercent,
+					MaxPercentUnhealthyServices:             v.MaxUnhealthyServicesPercent,
+				}
+			}
+			output.ApplicationHealthPolicy.ServiceTypeHealthPolicyMap = &serviceTypeHealthPolicies
+		}
+	}
+
+	if len(policy.RollingUpgradeMonitoringPolicy) > 0 {
+		monitoringPolicy := policy.RollingUpgradeMonitoringPolicy[0]
+		output.RollingUpgradeMonitoringPolicy = &application.RollingUpgradeMonitoringPolicy{
+			FailureAction:             application.FailureAction(monitoringPolicy.FailureAction),
+			HealthCheckRetryTimeout:   monitoringPolicy.HealthCheckRetryTimeout,
+			HealthCheckStableDuration: monitoringPolicy.HealthCheckStableDuration,
+			HealthCheckWaitDuration:   monitoringPolicy.HealthCheckWaitDuration,
+			UpgradeDomainTimeout:      monitoringPolicy.UpgradeDomainTimeout,
+			UpgradeTimeout:            monitoringPolicy.UpgradeTimeout,
+		}
+	}
+
+	return output
+}
+
+func expandApplicationServiceTypeHealthPolicy(input ApplicationServiceTypeHealth) application.ServiceTypeHealthPolicy {
+	return application.ServiceTypeHealthPolicy{
+		MaxPercentUnhealthyPartitionsPerService: input.MaxUnhealthyPartitionsPerServicePercent,
+		MaxPercentUnhealthyReplicasPerPartition: input.MaxUnhealthyReplicasPerPartitionPercent,
+		MaxPercentUnhealthyServices:             input.MaxUnhealthyServicesPercent,
+	}
+}
+
+func flattenApplicationUpgradePolicy(input *application.ApplicationUpgradePolicy) []ApplicationUpgradePolicy {
+	if input == nil {
+		return []ApplicationUpgradePolicy{}
+	}
+
+	policy := ApplicationUpgradePolicy{
+		ForceRestartEnabled:                    pointer.From(input.ForceRestart),
+		RecreateApplicationEnabled:             pointer.From(input.RecreateApplication),
+		InstanceCloseDelayDurationInSeconds:    pointer.From(input.InstanceCloseDelayDuration),
+		UpgradeMode:                            string(pointer.From(input.UpgradeMode)),
+		UpgradeReplicaSetCheckTimeoutInSeconds: pointer.From(input.UpgradeReplicaSetCheckTimeout),
+	}
+
+	if healthPolicy := input.ApplicationHealthPolicy; healthPolicy != nil {
+		flattened := ApplicationHealthPolicy{
+			ConsiderWarningAsErrorEnabled:           healthPolicy.ConsiderWarningAsError,
+			MaxUnhealthyDeployedApplicationsPercent: healthPolicy.MaxPercentUnhealthyDeployedApplications,
+		}
+
+		if v := healthPolicy.DefaultServiceTypeHealthPolicy; v != nil {
+			flattened.DefaultServiceTypeHealthPolicy = []ApplicationServiceTypeHealth{
+				{
+					MaxUnhealthyServicesPercent:             v.MaxPercentUnhealthyServices,
+					MaxUnhealthyPartitionsPerServicePercent: v.MaxPercentUnhealthyPartitionsPerService,
+					MaxUnhealthyReplicasPerPartitionPercent: v.MaxPercentUnhealthyReplicasPerPartition,
+				},
+			}
+		}
+
+		if v := healthPolicy.ServiceTypeHealthPolicyMap; v != nil {
+			for name, serviceTypeHealthPolicy := range *v {
+				flattened.ServiceTypeHealthPolicy = append(flattened.ServiceTypeHealthPolicy, ApplicationNamedServiceTypeHealth{
+					ServiceTypeName:                         name,
+					MaxUnhealthyServicesPercent:             serviceTypeHealthPolicy.MaxPercentUnhealthyServices,
+					MaxUnhealthyPartitionsPerServicePercent: serviceTypeHealthPolicy.MaxPercentUnhealthyPartitionsPerService,
+					MaxUnhealthyReplicasPerPartitionPercent: serviceTypeHealthPolicy.MaxPercentUnhealthyReplicasPerPartition,
+				})
+			}
+		}
+
+		policy.HealthPolicy = []ApplicationHealthPolicy{flattened}
+	}
+
+	if monitoringPolicy := input.RollingUpgradeMonitoringPolicy; monitoringPolicy != nil {
+		policy.RollingUpgradeMonitoringPolicy = []ApplicationRollingUpgradeMonitoring{
+			{
+				FailureAction:             string(monitoringPolicy.FailureAction),
+				HealthCheckRetryTimeout:   monitoringPolicy.HealthCheckRetryTimeout,
+				HealthCheckStableDuration: monitoringPolicy.HealthCheckStableDuration,
+				HealthCheckWaitDuration:   monitoringPolicy.HealthCheckWaitDuration,
+				UpgradeDomainTimeout:      monitoringPolicy.UpgradeDomainTimeout,
+				UpgradeTimeout:            monitoringPolicy.UpgradeTimeout,
+			},
+		}
+	}
+
+	return []ApplicationUpgradePolicy{policy}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicefabricmanaged_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/application"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApplicationResource struct{}
+
+func TestAccServiceFabricManagedApplication_basic(t *testing.T) {
+	if os.Getenv(applicationPackageUrlEnvVar) == "" {
+		t.Skipf("Skipping as %q is not set", applicationPackageUrlEnvVar)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceFabricManagedApplication_requiresImport(t *testing.T) {
+	if os.Getenv(applicationPackageUrlEnvVar) == "" {
+		t.Skipf("Skipping as %q is not set", applicationPackageUrlEnvVar)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccServiceFabricManagedApplication_complete(t *testing.T) {
+	if os.Getenv(applicationPackageUrlEnvVar) == "" {
+		t.Skipf("Skipping as %q is not set", applicationPackageUrlEnvVar)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceFabricManagedApplication_update(t *testing.T) {
+	if os.Getenv(applicationPackageUrlEnvVar) == "" {
+		t.Skipf("Skipping as %q is not set", applicationPackageUrlEnvVar)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := application.ParseApplicationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceFabricManaged.ApplicationClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApplicationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_application" "test" {
+  name                        = "acctest-app-%s"
+  managed_cluster_id          = azurerm_service_fabric_managed_cluster.test.id
+  application_type_version_id = azurerm_service_fabric_managed_application_type_version.test.id
+}
+`, ApplicationTypeVersionResource{}.basic(data), data.RandomString)
+}
+
+func (r ApplicationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_application" "import" {
+  name                        = azurerm_service_fabric_managed_application.test.name
+  managed_cluster_id          = azurerm_service_fabric_managed_application.test.managed_cluster_id
+  application_type_version_id = azurerm_service_fabric_managed_application.test.application_type_version_id
+}
+`, r.basic(data))
+}
+
+func (r ApplicationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_application" "test" {
+  name                        = "acctest-app-%s"
+  managed_cluster_id          = azurerm_service_fabric_managed_cluster.test.id
+  application_type_version_id = azurerm_service_fabric_managed_application_type_version.test.id
+
+  upgrade_policy {
+    force_restart_enabled                        = true
+    instance_close_delay_duration_in_seconds     = 30
+    upgrade_mode                                 = "Monitored"
+    upgrade_replica_set_check_timeout_in_seconds = 600
+
+    health_policy {
+      consider_warning_as_error_enabled           = true
+      max_unhealthy_deployed_applications_percent = 10
+
+      default_service_type_health_policy {
+        max_unhealthy_services_percent               = 10
+        max_unhealthy_partitions_per_service_percent = 10
+        max_unhealthy_replicas_per_partition_percent = 10
+      }
+
+      service_type_health_policy {
+        service_type_name                            = "VotingWebType"
+        max_unhealthy_services_percent               = 20
+        max_unhealthy_partitions_per_service_percent = 20
+        max_unhealthy_replicas_per_partition_percent = 20
+      }
+    }
+
+    rolling_upgrade_monitoring_policy {
+      failure_action               = "Rollback"
+      health_check_retry_timeout   = "00:10:00"
+      health_check_stable_duration = "00:02:00"
+      health_check_wait_duration   = "00:00:30"
+      upgrade_domain_timeout       = "01:00:00"
+      upgrade_timeout              = "02:00:00"
+    }
+  }
+
+  tags = {
+    Environment = "Test"
+  }
+}
+`, ApplicationTypeVersionResource{}.basic(data), data.RandomString)
+}
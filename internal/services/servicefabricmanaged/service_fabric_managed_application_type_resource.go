@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicefabricmanaged
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/applicationtype"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/managedcluster"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationTypeResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationTypeResource{}
+
+type ApplicationTypeResourceModel struct {
+	Name             string                 `tfschema:"name"`
+	ManagedClusterId string                 `tfschema:"managed_cluster_id"`
+	Tags             map[string]interface{} `tfschema:"tags"`
+}
+
+func (r ApplicationTypeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		"managed_cluster_id": commonschema.ResourceIDReferenceRequiredForceNew(&managedcluster.ManagedClusterId{}),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ApplicationTypeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApplicationTypeResource) ModelObject() interface{} {
+	return &ApplicationTypeResourceModel{}
+}
+
+func (r ApplicationTypeResource) ResourceType() string {
+	return "azurerm_service_fabric_managed_application_type"
+}
+
+func (r ApplicationTypeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return applicationtype.ValidateApplicationTypeID
+}
+
+func (r ApplicationTypeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeClient
+
+			var model ApplicationTypeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := managedcluster.ParseManagedClusterID(model.ManagedClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := applicationtype.NewApplicationTypeID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.ManagedClusterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := applicationtype.ApplicationTypeResource{
+				Tags: tags.Expand(model.Tags),
+			}
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationTypeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeClient
+
+			id, err := applicationtype.ParseApplicationTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApplicationTypeResourceModel{
+				Name:             id.ApplicationTypeName,
+				ManagedClusterId: managedcluster.NewManagedClusterID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName).ID(),
+			}
+
+			if model := existing.Model; model != nil {
+				state.Tags = tags.Flatten(model.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationTypeResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeClient
+
+			id, err := applicationtype.ParseApplicationTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationTypeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := applicationtype.ApplicationTypeUpdateParameters{
+					Tags: tags.Expand(model.Tags),
+				}
+				if _, err := client.Update(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationTypeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeClient
+
+			id, err := applicationtype.ParseApplicationTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
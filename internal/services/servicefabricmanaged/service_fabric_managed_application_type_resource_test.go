@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicefabricmanaged_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/applicationtype"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApplicationTypeResource struct{}
+
+func TestAccServiceFabricManagedApplicationType_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_application_type", "test")
+	r := ApplicationTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceFabricManagedApplicationType_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_application_type", "test")
+	r := ApplicationTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccServiceFabricManagedApplicationType_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_application_type", "test")
+	r := ApplicationTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationTypeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := applicationtype.ParseApplicationTypeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceFabricManaged.ApplicationTypeClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApplicationTypeResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_application_type" "test" {
+  name               = "VotingType"
+  managed_cluster_id = azurerm_service_fabric_managed_cluster.test.id
+}
+`, r.template(data))
+}
+
+func (r ApplicationTypeResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_application_type" "import" {
+  name               = azurerm_service_fabric_managed_application_type.test.name
+  managed_cluster_id = azurerm_service_fabric_managed_application_type.test.managed_cluster_id
+}
+`, r.basic(data))
+}
+
+func (r ApplicationTypeResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_application_type" "test" {
+  name               = "VotingType"
+  managed_cluster_id = azurerm_service_fabric_managed_cluster.test.id
+
+  tags = {
+    Environment = "Test"
+  }
+}
+`, r.template(data))
+}
+
+func (r ApplicationTypeResource) template(data acceptance.TestData) string {
+	cluster := ClusterResource{}
+	return cluster.basic(data, cluster.nodeType("test1", true, 130))
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicefabricmanaged
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/applicationtype"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/applicationtypeversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationTypeVersionResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationTypeVersionResource{}
+
+type ApplicationTypeVersionResourceModel struct {
+	Name              string                 `tfschema:"name"`
+	ApplicationTypeId string                 `tfschema:"application_type_id"`
+	PackageUrl        string                 `tfschema:"package_url"`
+	Tags              map[string]interface{} `tfschema:"tags"`
+}
+
+func (r ApplicationTypeVersionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		"application_type_id": commonschema.ResourceIDReferenceRequiredForceNew(&applicationtype.ApplicationTypeId{}),
+
+		"package_url": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ApplicationTypeVersionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApplicationTypeVersionResource) ModelObject() interface{} {
+	return &ApplicationTypeVersionResourceModel{}
+}
+
+func (r ApplicationTypeVersionResource) ResourceType() string {
+	return "azurerm_service_fabric_managed_application_type_version"
+}
+
+func (r ApplicationTypeVersionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return applicationtypeversion.ValidateVersionID
+}
+
+func (r ApplicationTypeVersionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeVersionClient
+
+			var model ApplicationTypeVersionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			applicationTypeId, err := applicationtype.ParseApplicationTypeID(model.ApplicationTypeId)
+			if err != nil {
+				return err
+			}
+
+			id := applicationtypeversion.NewVersionID(applicationTypeId.SubscriptionId, applicationTypeId.ResourceGroupName, applicationTypeId.ManagedClusterName, applicationTypeId.ApplicationTypeName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := applicationtypeversion.ApplicationTypeVersionResource{
+				Properties: &applicationtypeversion.ApplicationTypeVersionResourceProperties{
+					AppPackageURL: model.PackageUrl,
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationTypeVersionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeVersionClient
+
+			id, err := applicationtypeversion.ParseVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApplicationTypeVersionResourceModel{
+				Name:              id.VersionName,
+				ApplicationTypeId: applicationtype.NewApplicationTypeID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, id.ApplicationTypeName).ID(),
+			}
+
+			if model := existing.Model; model != nil {
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.PackageUrl = props.AppPackageURL
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationTypeVersionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeVersionClient
+
+			id, err := applicationtypeversion.ParseVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationTypeVersionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := applicationtypeversion.ApplicationTypeVersionUpdateParameters{
+					Tags: tags.Expand(model.Tags),
+				}
+				if _, err := client.Update(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationTypeVersionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeVersionClient
+
+			id, err := applicationtypeversion.ParseVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicefabricmanaged_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/applicationtypeversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// the application package must contain the `VotingType` application type at version `1.0.0`, which
+// contains the stateless `VotingWebType` service type, e.g. the Service Fabric Voting sample application.
+const applicationPackageUrlEnvVar = "ARM_TEST_SERVICE_FABRIC_MANAGED_APPLICATION_PACKAGE_URL"
+
+type ApplicationTypeVersionResource struct{}
+
+func TestAccServiceFabricManagedApplicationTypeVersion_basic(t *testing.T) {
+	if os.Getenv(applicationPackageUrlEnvVar) == "" {
+		t.Skipf("Skipping as %q is not set", applicationPackageUrlEnvVar)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_application_type_version", "test")
+	r := ApplicationTypeVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceFabricManagedApplicationTypeVersion_requiresImport(t *testing.T) {
+	if os.Getenv(applicationPackageUrlEnvVar) == "" {
+		t.Skipf("Skipping as %q is not set", applicationPackageUrlEnvVar)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_application_type_version", "test")
+	r := ApplicationTypeVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccServiceFabricManagedApplicationTypeVersion_update(t *testing.T) {
+	if os.Getenv(applicationPackageUrlEnvVar) == "" {
+		t.Skipf("Skipping as %q is not set", applicationPackageUrlEnvVar)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_application_type_version", "test")
+	r := ApplicationTypeVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationTypeVersionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := applicationtypeversion.ParseVersionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceFabricManaged.ApplicationTypeVersionClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApplicationTypeVersionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_application_type_version" "test" {
+  name                = "1.0.0"
+  application_type_id = azurerm_service_fabric_managed_application_type.test.id
+  package_url         = "%s"
+}
+`, ApplicationTypeResource{}.basic(data), os.Getenv(applicationPackageUrlEnvVar))
+}
+
+func (r ApplicationTypeVersionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_application_type_version" "import" {
+  name                = azurerm_service_fabric_managed_application_type_version.test.name
+  application_type_id = azurerm_service_fabric_managed_application_type_version.test.application_type_id
+  package_url         = azurerm_service_fabric_managed_application_type_version.test.package_url
+}
+`, r.basic(data))
+}
+
+func (r ApplicationTypeVersionResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_application_type_version" "test" {
+  name                = "1.0.0"
+  application_type_id = azurerm_service_fabric_managed_application_type.test.id
+  package_url         = "%s"
+
+  tags = {
+    Environment = "Test"
+  }
+}
+`, ApplicationTypeResource{}.basic(data), os.Getenv(applicationPackageUrlEnvVar))
+}
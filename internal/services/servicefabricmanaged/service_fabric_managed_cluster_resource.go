@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/managedcluster"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/nodetype"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -91,6 +92,7 @@ type NodeType struct {
 	EphemeralPorts      string            `tfschema:"ephemeral_port_range"`
 	PlacementProperties map[string]string `tfschema:"placement_properties"`
 	VmSecrets           []VmSecrets       `tfschema:"vm_secrets"`
+	Zones               []string          `tfschema:"zones"`
 }
 
 type CertType string
@@ -124,6 +126,8 @@ type ClusterResourceModel struct {
 	SubnetId             string                               `tfschema:"subnet_id"`
 	Tags                 map[string]interface{}               `tfschema:"tags"`
 	UpgradeWave          managedcluster.ClusterUpgradeCadence `tfschema:"upgrade_wave"`
+	ZonalResiliency      bool                                 `tfschema:"zonal_resiliency_enabled"`
+	ZonalUpdateMode      managedcluster.ZonalUpdateMode       `tfschema:"zonal_update_mode"`
 }
 
 func (k ClusterResource) Arguments() map[string]*pluginsdk.Schema {
@@ -227,6 +231,21 @@ func (k ClusterResource) Arguments() map[string]*pluginsdk.Schema {
 				string(managedcluster.ClusterUpgradeCadenceWaveTwo),
 			}, false),
 		},
+		"zonal_resiliency_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+		},
+		"zonal_update_mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			// the API only returns a value when the cluster spans availability zones
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(managedcluster.ZonalUpdateModeFast),
+				string(managedcluster.ZonalUpdateModeStandard),
+			}, false),
+		},
 	}
 }
 
@@ -501,12 +520,17 @@ func (k ClusterResource) CustomizeDiff() sdk.ResourceFunc {
 			for _, nti := range rd.Get("node_type").([]interface{}) {
 				nt := nti.(map[string]interface{})
 				vmCount := nt["vm_instance_count"].(int)
-				if sku == string(managedcluster.SkuNameBasic) && vmCount < 3 {
+				isStateless := nt["stateless"].(bool)
+				// stateless node types only host stateless services, so can be scaled below the minimum required for the cluster
+				if !isStateless && sku == string(managedcluster.SkuNameBasic) && vmCount < 3 {
 					return fmt.Errorf("basic SKU requires at least 3 instances in a node type")
-				} else if sku == string(managedcluster.SkuNameStandard) && vmCount < 5 {
+				} else if !isStateless && sku == string(managedcluster.SkuNameStandard) && vmCount < 5 {
 					return fmt.Errorf("standard SKU requires at least 5 instances in a node type")
 				}
 				isPrimary := nt["primary"].(bool)
+				if isPrimary && isStateless {
+					return fmt.Errorf("the primary node type cannot be stateless")
+				}
 				if isPrimary && !primary {
 					primary = true
 				} else if isPrimary {
@@ -651,6 +675,9 @@ func flattenClusterProperties(cluster *managedcluster.ManagedCluster) *ClusterRe
 		model.UpgradeWave = *upgradeWave
 	}
 
+	model.ZonalResiliency = pointer.From(properties.ZonalResiliency)
+	model.ZonalUpdateMode = pointer.From(properties.ZonalUpdateMode)
+
 	if t := cluster.Tags; t != nil {
 		modelTags := make(map[string]interface{})
 		for tag, value := range *t {
@@ -738,6 +765,8 @@ func flattenNodetypeProperties(nt nodetype.NodeType) NodeType {
 		}
 		out.VmSecrets = secs
 	}
+
+	out.Zones = zones.Flatten(props.Zones)
 	return out
 }
 
@@ -790,6 +819,10 @@ func expandClusterProperties(model *ClusterResourceModel) *managedcluster.Manage
 
 	out.ClientConnectionPort = &model.ClientConnectionPort
 	out.ClusterUpgradeCadence = &model.UpgradeWave
+	out.ZonalResiliency = pointer.To(model.ZonalResiliency)
+	if model.ZonalUpdateMode != "" {
+		out.ZonalUpdateMode = pointer.To(model.ZonalUpdateMode)
+	}
 
 	if customSettings := model.CustomFabricSettings; len(customSettings) > 0 {
 		// First we build a map of all settings per section
@@ -904,6 +937,11 @@ func expandNodeTypeProperties(nt *NodeType) (*nodetype.NodeTypeProperties, error
 		VMSize:                  &nt.VmSize,
 	}
 
+	if len(nt.Zones) > 0 {
+		nodeTypeZones := zones.Expand(nt.Zones)
+		nodeTypeProperties.Zones = &nodeTypeZones
+	}
+
 	return nodeTypeProperties, nil
 }
 
@@ -976,7 +1014,7 @@ func nodeTypeSchema() *pluginsdk.Schema {
 				"vm_instance_count": {
 					Type:         pluginsdk.TypeInt,
 					Required:     true,
-					ValidateFunc: validation.IntBetween(3, 100),
+					ValidateFunc: validation.IntBetween(1, 100),
 				},
 				"vm_size": {
 					Type:     pluginsdk.TypeString,
@@ -1051,6 +1089,7 @@ func nodeTypeSchema() *pluginsdk.Schema {
 						},
 					},
 				},
+				"zones": commonschema.ZonesMultipleOptionalForceNew(),
 			},
 		},
 	}
@@ -128,6 +128,23 @@ func TestAccServiceFabricManagedCluster_authentication(t *testing.T) {
 	})
 }
 
+func TestAccServiceFabricManagedCluster_zonal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster", "test")
+	r := ClusterResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.zonal(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zonal_resiliency_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("node_type.1.stateless").HasValue("true"),
+				check.That(data.ResourceName).Key("node_type.1.zones.#").HasValue("3"),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
 func (r ClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := managedcluster.ParseManagedClusterID(state.ID)
 	if err != nil {
@@ -290,6 +307,60 @@ resource "azurerm_service_fabric_managed_cluster" "test1" {
 `, r.basic(data, nt), data.RandomString, nt)
 }
 
+func (r ClusterResource) zonal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sfmc-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_fabric_managed_cluster" "test" {
+  name                     = "testacc-sfmc-%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  sku                      = "Standard"
+  username                 = "testUser"
+  password                 = "NotV3ryS3cur3P@$$w0rd"
+  zonal_resiliency_enabled = true
+  zonal_update_mode        = "Fast"
+
+  client_connection_port = 12345
+  http_gateway_port      = 23456
+
+  lb_rule {
+    backend_port       = 8000
+    frontend_port      = 443
+    probe_protocol     = "http"
+    protocol           = "tcp"
+    probe_request_path = "/"
+  }
+
+  %[4]s
+
+  node_type {
+    data_disk_size_gb      = 130
+    name                   = "test2"
+    primary                = false
+    stateless              = true
+    application_port_range = "7000-9000"
+    ephemeral_port_range   = "10000-20000"
+
+    vm_size            = "Standard_DS2_v2"
+    vm_image_publisher = "MicrosoftWindowsServer"
+    vm_image_sku       = "2016-Datacenter"
+    vm_image_offer     = "WindowsServer"
+    vm_image_version   = "latest"
+    vm_instance_count  = 1
+    zones              = ["1", "2", "3"]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, r.nodeType("test1", true, 130))
+}
+
 func (r ClusterResource) nodeType(name string, primary bool, diskSize int) string {
 	return fmt.Sprintf(`
 node_type {
@@ -0,0 +1,581 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicefabricmanaged
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/service"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	serviceFabricValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabric/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ServiceResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = ServiceResource{}
+	_ sdk.ResourceWithCustomizeDiff = ServiceResource{}
+)
+
+type ServiceResourceModel struct {
+	Name                         string                   `tfschema:"name"`
+	ApplicationId                string                   `tfschema:"application_id"`
+	ServiceTypeName              string                   `tfschema:"service_type_name"`
+	Stateless                    []ServiceStatelessConfig `tfschema:"stateless"`
+	Stateful                     []ServiceStatefulConfig  `tfschema:"stateful"`
+	Partition                    []ServicePartition       `tfschema:"partition"`
+	PlacementConstraints         string                   `tfschema:"placement_constraints"`
+	DefaultMoveCost              string                   `tfschema:"default_move_cost"`
+	ServiceDnsName               string                   `tfschema:"service_dns_name"`
+	ServicePackageActivationMode string                   `tfschema:"service_package_activation_mode"`
+	Tags                         map[string]interface{}   `tfschema:"tags"`
+}
+
+type ServiceStatelessConfig struct {
+	InstanceCount         int64 `tfschema:"instance_count"`
+	MinInstanceCount      int64 `tfschema:"min_instance_count"`
+	MinInstancePercentage int64 `tfschema:"min_instance_percentage"`
+}
+
+type ServiceStatefulConfig struct {
+	TargetReplicaSetSize       int64  `tfschema:"target_replica_set_size"`
+	MinReplicaSetSize          int64  `tfschema:"min_replica_set_size"`
+	PersistedStateEnabled      bool   `tfschema:"persisted_state_enabled"`
+	QuorumLossWaitDuration     string `tfschema:"quorum_loss_wait_duration"`
+	ReplicaRestartWaitDuration string `tfschema:"replica_restart_wait_duration"`
+	StandByReplicaKeepDuration string `tfschema:"stand_by_replica_keep_duration"`
+	ServicePlacementTimeLimit  string `tfschema:"service_placement_time_limit"`
+}
+
+type ServicePartition struct {
+	Scheme  string   `tfschema:"scheme"`
+	Names   []string `tfschema:"names"`
+	Count   int64    `tfschema:"count"`
+	LowKey  int64    `tfschema:"low_key"`
+	HighKey int64    `tfschema:"high_key"`
+}
+
+func (r ServiceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		"application_id": commonschema.ResourceIDReferenceRequiredForceNew(&service.ApplicationId{}),
+
+		"service_type_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		"stateless": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"stateless", "stateful"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"instance_count": {
+						Type:     pluginsdk.TypeInt,
+						Required: true,
+						// -1 places an instance on every node which the service can be placed on
+						ValidateFunc: validation.Any(
+							validation.IntInSlice([]int{-1}),
+							validation.IntAtLeast(1),
+						),
+					},
+
+					"min_instance_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"min_instance_percentage": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 100),
+					},
+				},
+			},
+		},
+
+		"stateful": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"stateless", "stateful"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"target_replica_set_size": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"min_replica_set_size": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"persisted_state_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"quorum_loss_wait_duration": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: serviceFabricValidate.UpgradeTimeout,
+					},
+
+					"replica_restart_wait_duration": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: serviceFabricValidate.UpgradeTimeout,
+					},
+
+					"stand_by_replica_keep_duration": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: serviceFabricValidate.UpgradeTimeout,
+					},
+
+					"service_placement_time_limit": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: serviceFabricValidate.UpgradeTimeout,
+					},
+				},
+			},
+		},
+
+		"partition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"scheme": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(service.PartitionSchemeNamed),
+							string(service.PartitionSchemeUniformIntSixFourRange),
+						}, false),
+					},
+
+					"names": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"low_key": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+						ForceNew: true,
+					},
+
+					"high_key": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+						ForceNew: true,
+					},
+				},
+			},
+		},
+
+		"placement_constraints": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"default_move_cost": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(service.MoveCostZero),
+				string(service.MoveCostLow),
+				string(service.MoveCostMedium),
+				string(service.MoveCostHigh),
+			}, false),
+		},
+
+		"service_dns_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"service_package_activation_mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(service.ServicePackageActivationModeSharedProcess),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(service.ServicePackageActivationModeExclusiveProcess),
+				string(service.ServicePackageActivationModeSharedProcess),
+			}, false),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ServiceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ServiceResource) ModelObject() interface{} {
+	return &ServiceResourceModel{}
+}
+
+func (r ServiceResource) ResourceType() string {
+	return "azurerm_service_fabric_managed_service"
+}
+
+func (r ServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return service.ValidateServiceID
+}
+
+func (r ServiceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ServiceClient
+
+			var model ServiceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			applicationId, err := service.ParseApplicationID(model.ApplicationId)
+			if err != nil {
+				return err
+			}
+
+			id := service.NewServiceID(applicationId.SubscriptionId, applicationId.ResourceGroupName, applicationId.ManagedClusterName, applicationId.ApplicationName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandService(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ServiceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ServiceClient
+
+			id, err := service.ParseServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ServiceResourceModel{
+				Name:          id.ServiceName,
+				ApplicationId: service.NewApplicationID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, id.ApplicationName).ID(),
+			}
+
+			if model := existing.Model; model != nil {
+				state.Tags = tags.Flatten(model.Tags)
+
+				if model.Properties != nil {
+					props := model.Properties.ServiceResourceProperties()
+					state.ServiceTypeName = props.ServiceTypeName
+					state.PlacementConstraints = pointer.From(props.PlacementConstraints)
+					state.DefaultMoveCost = string(pointer.From(props.DefaultMoveCost))
+					state.ServiceDnsName = pointer.From(props.ServiceDnsName)
+					state.ServicePackageActivationMode = string(pointer.From(props.ServicePackageActivationMode))
+					state.Partition = flattenServicePartition(props.PartitionDescription)
+
+					switch v := model.Properties.(type) {
+					case service.StatelessServiceProperties:
+						state.Stateless = []ServiceStatelessConfig{
+							{
+								InstanceCount:         v.InstanceCount,
+								MinInstanceCount:      pointer.From(v.MinInstanceCount),
+								MinInstancePercentage: pointer.From(v.MinInstancePercentage),
+							},
+						}
+					case service.StatefulServiceProperties:
+						state.Stateful = []ServiceStatefulConfig{
+							{
+								TargetReplicaSetSize:       pointer.From(v.TargetReplicaSetSize),
+								MinReplicaSetSize:          pointer.From(v.MinReplicaSetSize),
+								PersistedStateEnabled:      pointer.From(v.HasPersistedState),
+								QuorumLossWaitDuration:     pointer.From(v.QuorumLossWaitDuration),
+								ReplicaRestartWaitDuration: pointer.From(v.ReplicaRestartWaitDuration),
+								StandByReplicaKeepDuration: pointer.From(v.StandByReplicaKeepDuration),
+								ServicePlacementTimeLimit:  pointer.From(v.ServicePlacementTimeLimit),
+							},
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ServiceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ServiceClient
+
+			id, err := service.ParseServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ServiceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandService(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ServiceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ServiceClient
+
+			id, err := service.ParseServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ServiceResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			var model ServiceResourceModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the kind of a service can't be changed once it's been created
+			if rd.Id() != "" && rd.HasChange("stateless") {
+				o, n := rd.GetChange("stateless")
+				if len(o.([]interface{})) != len(n.([]interface{})) {
+					if err := rd.ForceNew("stateless"); err != nil {
+						return err
+					}
+				}
+			}
+
+			if len(model.Partition) > 0 {
+				partition := model.Partition[0]
+				switch service.PartitionScheme(partition.Scheme) {
+				case service.PartitionSchemeNamed:
+					if len(partition.Names) == 0 {
+						return fmt.Errorf("`partition.0.names` must be specified when `partition.0.scheme` is `%s`", service.PartitionSchemeNamed)
+					}
+				case service.PartitionSchemeUniformIntSixFourRange:
+					if partition.Count == 0 {
+						return fmt.Errorf("`partition.0.count` must be specified when `partition.0.scheme` is `%s`", service.PartitionSchemeUniformIntSixFourRange)
+					}
+					if partition.LowKey > partition.HighKey {
+						return fmt.Errorf("`partition.0.low_key` must not be greater than `partition.0.high_key`")
+					}
+				}
+			}
+
+			if len(model.Stateful) > 0 && model.Stateful[0].MinReplicaSetSize > model.Stateful[0].TargetReplicaSetSize {
+				return fmt.Errorf("`stateful.0.min_replica_set_size` must not be greater than `stateful.0.target_replica_set_size`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandService(model ServiceResourceModel) service.ServiceResource {
+	partition := expandServicePartition(model.Partition)
+
+	var moveCost *service.MoveCost
+	if model.DefaultMoveCost != "" {
+		moveCost = pointer.To(service.MoveCost(model.DefaultMoveCost))
+	}
+
+	var placementConstraints *string
+	if model.PlacementConstraints != "" {
+		placementConstraints = pointer.To(model.PlacementConstraints)
+	}
+
+	var serviceDnsName *string
+	if model.ServiceDnsName != "" {
+		serviceDnsName = pointer.To(model.ServiceDnsName)
+	}
+
+	activationMode := pointer.To(service.ServicePackageActivationMode(model.ServicePackageActivationMode))
+
+	payload := service.ServiceResource{
+		Tags: tags.Expand(model.Tags),
+	}
+
+	if len(model.Stateless) > 0 {
+		stateless := model.Stateless[0]
+		properties := service.StatelessServiceProperties{
+			InstanceCount:                stateless.InstanceCount,
+			DefaultMoveCost:              moveCost,
+			PartitionDescription:         partition,
+			PlacementConstraints:         placementConstraints,
+			ServiceDnsName:               serviceDnsName,
+			ServicePackageActivationMode: activationMode,
+			ServiceTypeName:              model.ServiceTypeName,
+		}
+		if stateless.MinInstanceCount > 0 {
+			properties.MinInstanceCount = pointer.To(stateless.MinInstanceCount)
+		}
+		if stateless.MinInstancePercentage > 0 {
+			properties.MinInstancePercentage = pointer.To(stateless.MinInstancePercentage)
+		}
+		payload.Properties = properties
+		return payload
+	}
+
+	stateful := model.Stateful[0]
+	properties := service.StatefulServiceProperties{
+		HasPersistedState:            pointer.To(stateful.PersistedStateEnabled),
+		MinReplicaSetSize:            pointer.To(stateful.MinReplicaSetSize),
+		TargetReplicaSetSize:         pointer.To(stateful.TargetReplicaSetSize),
+		DefaultMoveCost:              moveCost,
+		PartitionDescription:         partition,
+		PlacementConstraints:         placementConstraints,
+		ServiceDnsName:               serviceDnsName,
+		ServicePackageActivationMode: activationMode,
+		ServiceTypeName:              model.ServiceTypeName,
+	}
+	if stateful.QuorumLossWaitDuration != "" {
+		properties.QuorumLossWaitDuration = pointer.To(stateful.QuorumLossWaitDuration)
+	}
+	if stateful.ReplicaRestartWaitDuration != "" {
+		properties.ReplicaRestartWaitDuration = pointer.To(stateful.ReplicaRestartWaitDuration)
+	}
+	if stateful.StandByReplicaKeepDuration != "" {
+		properties.StandByReplicaKeepDuration = pointer.To(stateful.StandByReplicaKeepDuration)
+	}
+	if stateful.ServicePlacementTimeLimit != "" {
+		properties.ServicePlacementTimeLimit = pointer.To(stateful.ServicePlacementTimeLimit)
+	}
+	payload.Properties = properties
+
+	return payload
+}
+
+func expandServicePartition(input []ServicePartition) service.Partition {
+	if len(input) == 0 {
+		return service.SingletonPartitionScheme{}
+	}
+	partition := input[0]
+
+	if service.PartitionScheme(partition.Scheme) == service.PartitionSchemeNamed {
+		return service.NamedPartitionScheme{
+			Names: partition.Names,
+		}
+	}
+
+	return service.UniformInt64RangePartitionScheme{
+		Count:   partition.Count,
+		HighKey: partition.HighKey,
+		LowKey:  partition.LowKey,
+	}
+}
+
+func flattenServicePartition(input service.Partition) []ServicePartition {
+	switch v := input.(type) {
+	case service.NamedPartitionScheme:
+		return []ServicePartition{
+			{
+				Scheme: string(service.PartitionSchemeNamed),
+				Names:  v.Names,
+			},
+		}
+	case service.UniformInt64RangePartitionScheme:
+		return []ServicePartition{
+			{
+				Scheme:  string(service.PartitionSchemeUniformIntSixFourRange),
+				Count:   v.Count,
+				HighKey: v.HighKey,
+				LowKey:  v.LowKey,
+			},
+		}
+	}
+
+	// a Singleton partition is used when no `partition` block is specified
+	return []ServicePartition{}
+}
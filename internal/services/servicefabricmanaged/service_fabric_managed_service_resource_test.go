@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicefabricmanaged_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2024-04-01/service"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ServiceResource struct{}
+
+func TestAccServiceFabricManagedService_stateless(t *testing.T) {
+	if os.Getenv(applicationPackageUrlEnvVar) == "" {
+		t.Skipf("Skipping as %q is not set", applicationPackageUrlEnvVar)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_service", "test")
+	r := ServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.stateless(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceFabricManagedService_requiresImport(t *testing.T) {
+	if os.Getenv(applicationPackageUrlEnvVar) == "" {
+		t.Skipf("Skipping as %q is not set", applicationPackageUrlEnvVar)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_service", "test")
+	r := ServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.stateless(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccServiceFabricManagedService_update(t *testing.T) {
+	if os.Getenv(applicationPackageUrlEnvVar) == "" {
+		t.Skipf("Skipping as %q is not set", applicationPackageUrlEnvVar)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_service", "test")
+	r := ServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.stateless(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.stateless(data, -1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("stateless.0.instance_count").HasValue("-1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceFabricManagedService_namedPartitions(t *testing.T) {
+	if os.Getenv(applicationPackageUrlEnvVar) == "" {
+		t.Skipf("Skipping as %q is not set", applicationPackageUrlEnvVar)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_service", "test")
+	r := ServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.namedPartitions(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ServiceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := service.ParseServiceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceFabricManaged.ServiceClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ServiceResource) stateless(data acceptance.TestData, instanceCount int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_service" "test" {
+  name              = "acctest-svc-%s"
+  application_id    = azurerm_service_fabric_managed_application.test.id
+  service_type_name = "VotingWebType"
+
+  stateless {
+    instance_count = %d
+  }
+}
+`, ApplicationResource{}.basic(data), data.RandomString, instanceCount)
+}
+
+func (r ServiceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_service" "import" {
+  name              = azurerm_service_fabric_managed_service.test.name
+  application_id    = azurerm_service_fabric_managed_service.test.application_id
+  service_type_name = azurerm_service_fabric_managed_service.test.service_type_name
+
+  stateless {
+    instance_count = 1
+  }
+}
+`, r.stateless(data, 1))
+}
+
+func (r ServiceResource) namedPartitions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_service" "test" {
+  name              = "acctest-svc-%s"
+  application_id    = azurerm_service_fabric_managed_application.test.id
+  service_type_name = "VotingWebType"
+  default_move_cost = "Low"
+
+  stateless {
+    instance_count     = 2
+    min_instance_count = 1
+  }
+
+  partition {
+    scheme = "Named"
+    names  = ["first", "second"]
+  }
+
+  tags = {
+    Environment = "Test"
+  }
+}
+`, ApplicationResource{}.basic(data), data.RandomString)
+}
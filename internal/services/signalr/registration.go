@@ -26,6 +26,8 @@ func (r Registration) Resources() []sdk.Resource {
 		CustomCertWebPubsubResource{},
 		CustomCertSignalrServiceResource{},
 		WebPubSubSocketIOResource{},
+		SignalRServiceReplicaResource{},
+		WebPubSubReplicaResource{},
 	}
 }
 
@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/privateendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2024-03-01/signalr"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/networkacl"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -51,14 +53,7 @@ func resourceArmSignalRServiceNetworkACL() *pluginsdk.Resource {
 				ValidateFunc: signalr.ValidateSignalRID,
 			},
 
-			"default_action": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					string(signalr.ACLActionAllow),
-					string(signalr.ACLActionDeny),
-				}, false),
-			},
+			"default_action": networkacl.DefaultActionSchema(),
 
 			"public_network": {
 				Type:     pluginsdk.TypeList,
@@ -99,6 +94,8 @@ func resourceArmSignalRServiceNetworkACL() *pluginsdk.Resource {
 				},
 			},
 
+			"ip_rules": networkacl.IPRulesSchema(),
+
 			"private_endpoint": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
@@ -173,28 +170,19 @@ func resourceSignalRServiceNetworkACLCreateUpdate(d *pluginsdk.ResourceData, met
 			PublicNetwork: expandSignalRServicePublicNetwork(d.Get("public_network").([]interface{})),
 		}
 
-		if v, ok := d.GetOk("private_endpoint"); ok {
-			networkACL.PrivateEndpoints = expandSignalRServicePrivateEndpoint(v.(*pluginsdk.Set).List(), props.PrivateEndpointConnections)
+		// the IP rules are only managed when specified, since the service applies default rules which allow all addresses
+		if !d.GetRawConfig().AsValueMap()["ip_rules"].IsNull() {
+			networkACL.IPRules = expandSignalRServiceIPRules(networkacl.ExpandIPRules(d.Get("ip_rules").(*pluginsdk.Set).List(), string(defaultAction)))
+		} else if props.NetworkACLs != nil {
+			networkACL.IPRules = props.NetworkACLs.IPRules
 		}
 
-		if defaultAction == signalr.ACLActionAllow && len(*networkACL.PublicNetwork.Allow) != 0 {
-			return fmt.Errorf("when `default_action` is `Allow` for `public_network`, `allowed_request_types` cannot be specified")
-		} else if defaultAction == signalr.ACLActionDeny && len(*networkACL.PublicNetwork.Deny) != 0 {
-			return fmt.Errorf("when `default_action` is `Deny` for `public_network`, `denied_request_types` cannot be specified")
+		if v, ok := d.GetOk("private_endpoint"); ok {
+			networkACL.PrivateEndpoints = expandSignalRServicePrivateEndpoint(v.(*pluginsdk.Set).List(), props.PrivateEndpointConnections)
 		}
 
-		if networkACL.PrivateEndpoints != nil {
-			for _, privateEndpoint := range *networkACL.PrivateEndpoints {
-				if len(*privateEndpoint.Allow) != 0 && len(*privateEndpoint.Deny) != 0 {
-					return fmt.Errorf("`allowed_request_types` and `denied_request_types` cannot be set together for `private_endpoint`")
-				}
-
-				if defaultAction == signalr.ACLActionAllow && len(*privateEndpoint.Allow) != 0 {
-					return fmt.Errorf("when `default_action` is `Allow` for `private_endpoint`, `allowed_request_types` cannot be specified")
-				} else if defaultAction == signalr.ACLActionDeny && len(*privateEndpoint.Deny) != 0 {
-					return fmt.Errorf("when `default_action` is `Deny` for `private_endpoint`, `denied_request_types` cannot be specified")
-				}
-			}
+		if err := validateNetworkACLRequestTypes(d); err != nil {
+			return err
 		}
 
 		model.Properties.NetworkACLs = &networkACL
@@ -237,6 +225,10 @@ func resourceSignalRServiceNetworkACLRead(d *pluginsdk.ResourceData, meta interf
 			}
 			d.Set("default_action", defaultAction)
 
+			if err := d.Set("ip_rules", networkacl.FlattenIPRules(flattenSignalRServiceIPRules(props.NetworkACLs.IPRules), defaultAction)); err != nil {
+				return fmt.Errorf("setting `ip_rules`: %+v", err)
+			}
+
 			if err := d.Set("public_network", flattenSignalRServicePublicNetwork(props.NetworkACLs.PublicNetwork)); err != nil {
 				return fmt.Errorf("setting `public_network`: %+v", err)
 			}
@@ -282,6 +274,7 @@ func resourceSignalRServiceNetworkACLDelete(d *pluginsdk.ResourceData, meta inte
 	}
 	networkACL := &signalr.SignalRNetworkACLs{
 		DefaultAction: &defaultAction,
+		IPRules:       expandSignalRServiceIPRules(networkacl.DefaultIPRules()),
 		PublicNetwork: &signalr.NetworkACL{
 			Allow: &defaultRequestTypes,
 		},
@@ -309,6 +302,58 @@ func resourceSignalRServiceNetworkACLDelete(d *pluginsdk.ResourceData, meta inte
 	return nil
 }
 
+// validateNetworkACLRequestTypes validates the request types of the `azurerm_signalr_service_network_acl` and
+// `azurerm_web_pubsub_network_acl` resources, which share the same schema
+func validateNetworkACLRequestTypes(d *pluginsdk.ResourceData) error {
+	defaultAction := d.Get("default_action").(string)
+
+	for _, item := range d.Get("public_network").([]interface{}) {
+		if v, ok := item.(map[string]interface{}); ok {
+			if err := networkacl.ValidateRequestTypes(defaultAction, "public_network", v); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, item := range d.Get("private_endpoint").(*pluginsdk.Set).List() {
+		if v, ok := item.(map[string]interface{}); ok {
+			if err := networkacl.ValidateRequestTypes(defaultAction, "private_endpoint", v); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandSignalRServiceIPRules(input []networkacl.IPRule) *[]signalr.IPRule {
+	result := make([]signalr.IPRule, 0)
+	for _, item := range input {
+		result = append(result, signalr.IPRule{
+			Action: pointer.To(signalr.ACLAction(item.Action)),
+			Value:  pointer.To(item.Address),
+		})
+	}
+
+	return &result
+}
+
+func flattenSignalRServiceIPRules(input *[]signalr.IPRule) []networkacl.IPRule {
+	result := make([]networkacl.IPRule, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, item := range *input {
+		result = append(result, networkacl.IPRule{
+			Action:  string(pointer.From(item.Action)),
+			Address: pointer.From(item.Value),
+		})
+	}
+
+	return result
+}
+
 func expandSignalRServicePublicNetwork(input []interface{}) *signalr.NetworkACL {
 	allowedRTs := make([]signalr.SignalRRequestType, 0)
 	deniedRTs := make([]signalr.SignalRRequestType, 0)
@@ -106,6 +106,29 @@ func TestAccSignalRServiceNetworkACL_updateMultiplePrivateEndpoints(t *testing.T
 	})
 }
 
+func TestAccSignalRServiceNetworkACL_ipRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_signalr_service_network_acl", "test")
+	r := SignalRServiceNetworkACLResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ipRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_rules.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r SignalRServiceNetworkACLResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := signalr.ParseSignalRID(state.ID)
 	if err != nil {
@@ -154,6 +177,22 @@ resource "azurerm_signalr_service_network_acl" "test" {
 `, r.template(data))
 }
 
+func (r SignalRServiceNetworkACLResource) ipRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_signalr_service_network_acl" "test" {
+  signalr_service_id = azurerm_signalr_service.test.id
+  default_action     = "Deny"
+  ip_rules           = ["10.0.0.1/32", "192.168.0.0/24"]
+
+  public_network {
+    allowed_request_types = ["ClientConnection"]
+  }
+}
+`, r.template(data))
+}
+
 func (r SignalRServiceNetworkACLResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2024-03-01/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SignalRServiceReplicaResource struct{}
+
+type SignalRServiceReplicaResourceModel struct {
+	Name                    string                          `tfschema:"name"`
+	SignalRServiceId        string                          `tfschema:"signalr_service_id"`
+	Location                string                          `tfschema:"location"`
+	Sku                     []SignalRServiceReplicaSkuModel `tfschema:"sku"`
+	RegionalEndpointEnabled bool                            `tfschema:"regional_endpoint_enabled"`
+	ResourceStopped         bool                            `tfschema:"resource_stopped"`
+	Tags                    map[string]string               `tfschema:"tags"`
+	HostName                string                          `tfschema:"hostname"`
+}
+
+type SignalRServiceReplicaSkuModel struct {
+	Name     string `tfschema:"name"`
+	Capacity int64  `tfschema:"capacity"`
+}
+
+var _ sdk.ResourceWithUpdate = SignalRServiceReplicaResource{}
+
+func (r SignalRServiceReplicaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ReplicaName(),
+		},
+
+		"signalr_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: signalr.ValidateSignalRID,
+		},
+
+		"location": commonschema.Location(),
+
+		"sku": replicaSkuSchema(),
+
+		"regional_endpoint_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"resource_stopped": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r SignalRServiceReplicaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SignalRServiceReplicaResource) ModelObject() interface{} {
+	return &SignalRServiceReplicaResourceModel{}
+}
+
+func (r SignalRServiceReplicaResource) ResourceType() string {
+	return "azurerm_signalr_service_replica"
+}
+
+func (r SignalRServiceReplicaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return signalr.ValidateReplicaID
+}
+
+func (r SignalRServiceReplicaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.SignalRClient
+
+			var config SignalRServiceReplicaResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			signalRId, err := signalr.ParseSignalRID(config.SignalRServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := signalr.NewReplicaID(signalRId.SubscriptionId, signalRId.ResourceGroupName, signalRId.SignalRName, config.Name)
+
+			locks.ByID(signalRId.ID())
+			defer locks.UnlockByID(signalRId.ID())
+
+			existing, err := client.ReplicasGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := signalr.Replica{
+				Location: location.Normalize(config.Location),
+				Properties: &signalr.ReplicaProperties{
+					RegionEndpointEnabled: pointer.To(replicaFeatureState(config.RegionalEndpointEnabled)),
+					ResourceStopped:       pointer.To(strings.ToLower(fmt.Sprint(config.ResourceStopped))),
+				},
+				Sku:  expandSignalRServiceReplicaSkuFromModel(config.Sku),
+				Tags: pointer.To(config.Tags),
+			}
+
+			if err := client.ReplicasCreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SignalRServiceReplicaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.SignalRClient
+
+			id, err := signalr.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ReplicasGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			signalRId := signalr.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.SignalRName)
+			signalRResp, err := client.Get(ctx, signalRId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", signalRId, err)
+			}
+
+			state := SignalRServiceReplicaResourceModel{
+				Name:             id.ReplicaName,
+				SignalRServiceId: signalRId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+				state.Sku = flattenSignalRServiceReplicaSkuToModel(model.Sku)
+
+				if props := model.Properties; props != nil {
+					state.RegionalEndpointEnabled = strings.EqualFold(pointer.From(props.RegionEndpointEnabled), replicaFeatureStateEnabled)
+					state.ResourceStopped = strings.EqualFold(pointer.From(props.ResourceStopped), "true")
+				}
+			}
+
+			if model := signalRResp.Model; model != nil && model.Properties != nil {
+				state.HostName = replicaHostName(pointer.From(model.Properties.HostName), id.ReplicaName)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SignalRServiceReplicaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.SignalRClient
+
+			id, err := signalr.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config SignalRServiceReplicaResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			signalRId := signalr.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.SignalRName)
+			locks.ByID(signalRId.ID())
+			defer locks.UnlockByID(signalRId.ID())
+
+			existing, err := client.ReplicasGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if payload.Properties == nil {
+				payload.Properties = &signalr.ReplicaProperties{}
+			}
+
+			if metadata.ResourceData.HasChange("sku") {
+				payload.Sku = expandSignalRServiceReplicaSkuFromModel(config.Sku)
+			}
+
+			if metadata.ResourceData.HasChange("regional_endpoint_enabled") {
+				payload.Properties.RegionEndpointEnabled = pointer.To(replicaFeatureState(config.RegionalEndpointEnabled))
+			}
+
+			if metadata.ResourceData.HasChange("resource_stopped") {
+				payload.Properties.ResourceStopped = pointer.To(strings.ToLower(fmt.Sprint(config.ResourceStopped)))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(config.Tags)
+			}
+
+			if err := client.ReplicasUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SignalRServiceReplicaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.SignalRClient
+
+			id, err := signalr.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			signalRId := signalr.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.SignalRName)
+			locks.ByID(signalRId.ID())
+			defer locks.UnlockByID(signalRId.ID())
+
+			if _, err := client.ReplicasDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+const (
+	replicaFeatureStateEnabled  = "Enabled"
+	replicaFeatureStateDisabled = "Disabled"
+)
+
+func replicaSkuSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				// replicas are only available for the Premium tier
+				"name": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						helpers.SkuNamePremiumP1,
+						helpers.SkuNamePremiumP2,
+					}, false),
+				},
+
+				"capacity": {
+					Type:     pluginsdk.TypeInt,
+					Optional: true,
+					Default:  1,
+					ValidateFunc: validation.IntInSlice([]int{
+						1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100, 200,
+						300, 400, 500, 600, 700, 800, 900, 1000,
+					}),
+				},
+			},
+		},
+	}
+}
+
+func replicaFeatureState(enabled bool) string {
+	if enabled {
+		return replicaFeatureStateEnabled
+	}
+	return replicaFeatureStateDisabled
+}
+
+// replicaHostName builds the regional host name of a replica, which shares the domain of the
+// parent service but uses the replica name as the first label, e.g. `example-replica.service.signalr.net`
+func replicaHostName(serviceHostName, replicaName string) string {
+	_, domain, found := strings.Cut(serviceHostName, ".")
+	if !found {
+		return ""
+	}
+	return fmt.Sprintf("%s.%s", replicaName, domain)
+}
+
+func expandSignalRServiceReplicaSkuFromModel(input []SignalRServiceReplicaSkuModel) *signalr.ResourceSku {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &signalr.ResourceSku{
+		Name:     input[0].Name,
+		Capacity: pointer.To(input[0].Capacity),
+	}
+}
+
+func flattenSignalRServiceReplicaSkuToModel(input *signalr.ResourceSku) []SignalRServiceReplicaSkuModel {
+	result := make([]SignalRServiceReplicaSkuModel, 0)
+	if input == nil {
+		return result
+	}
+
+	return append(result, SignalRServiceReplicaSkuModel{
+		Name:     input.Name,
+		Capacity: pointer.From(input.Capacity),
+	})
+}
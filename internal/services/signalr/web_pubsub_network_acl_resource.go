@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/privateendpoints"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/networkacl"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -51,15 +53,13 @@ func resourceWebpubsubNetworkACL() *pluginsdk.Resource {
 		Schema: map[string]*pluginsdk.Schema{
 			"web_pubsub_id": commonschema.ResourceIDReferenceRequiredForceNew(&webpubsub.WebPubSubId{}),
 
-			"default_action": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				Default:  webpubsub.ACLActionDeny,
-				ValidateFunc: validation.StringInSlice([]string{
-					string(webpubsub.ACLActionAllow),
-					string(webpubsub.ACLActionDeny),
-				}, false),
-			},
+			"default_action": func() *pluginsdk.Schema {
+				s := networkacl.DefaultActionSchema()
+				s.Required = false
+				s.Optional = true
+				s.Default = networkacl.ActionDeny
+				return s
+			}(),
 
 			"public_network": {
 				Type:     pluginsdk.TypeList,
@@ -90,6 +90,8 @@ func resourceWebpubsubNetworkACL() *pluginsdk.Resource {
 				},
 			},
 
+			"ip_rules": networkacl.IPRulesSchema(),
+
 			"private_endpoint": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
@@ -163,25 +165,17 @@ func resourceWebPubsubNetworkACLCreateUpdate(d *pluginsdk.ResourceData, meta int
 		PrivateEndpoints: expandWebpubsubPrivateEndpoint(d.Get("private_endpoint").(*pluginsdk.Set).List(), payload.Properties.PrivateEndpointConnections),
 	}
 
-	if defaultAction == webpubsub.ACLActionAllow && networkACL.PublicNetwork.Allow != nil && len(*networkACL.PublicNetwork.Allow) != 0 {
-		return fmt.Errorf("when `default_action` is `Allow` for `public_network`, `allowed_request_types` cannot be specified")
-	} else if defaultAction == webpubsub.ACLActionDeny && networkACL.PublicNetwork.Deny != nil && len(*networkACL.PublicNetwork.Deny) != 0 {
-		return fmt.Errorf("when `default_action` is `Deny` for `public_network`, `denied_request_types` cannot be specified")
+	// the IP rules are only managed when specified, since the service applies default rules which allow all addresses
+	if !d.GetRawConfig().AsValueMap()["ip_rules"].IsNull() {
+		networkACL.IPRules = expandWebpubsubIPRules(networkacl.ExpandIPRules(d.Get("ip_rules").(*pluginsdk.Set).List(), string(defaultAction)))
+	} else if payload.Properties.NetworkACLs != nil {
+		networkACL.IPRules = payload.Properties.NetworkACLs.IPRules
 	}
 
-	if networkACL.PrivateEndpoints != nil {
-		for _, privateEndpoint := range *networkACL.PrivateEndpoints {
-			if privateEndpoint.Allow != nil && privateEndpoint.Deny != nil && len(*privateEndpoint.Allow) != 0 && len(*privateEndpoint.Deny) != 0 {
-				return fmt.Errorf("`allowed_request_types` and `denied_request_types` cannot be set together for `private_endpoint`")
-			}
-
-			if defaultAction == webpubsub.ACLActionAllow && privateEndpoint.Allow != nil && len(*privateEndpoint.Allow) != 0 {
-				return fmt.Errorf("when `default_action` is `Allow` for `private_endpoint`, `allowed_request_types` cannot be specified")
-			} else if defaultAction == webpubsub.ACLActionDeny && privateEndpoint.Deny != nil && len(*privateEndpoint.Deny) != 0 {
-				return fmt.Errorf("when `default_action` is `Deny` for `private_endpoint`, `denied_request_types` cannot be specified")
-			}
-		}
+	if err := validateNetworkACLRequestTypes(d); err != nil {
+		return err
 	}
+
 	payload.Properties.NetworkACLs = &networkACL
 
 	if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
@@ -223,6 +217,10 @@ func resourceWebPubsubNetworkACLRead(d *pluginsdk.ResourceData, meta interface{}
 				}
 				d.Set("default_action", defaultAction)
 
+				if err := d.Set("ip_rules", networkacl.FlattenIPRules(flattenWebpubsubIPRules(props.NetworkACLs.IPRules), defaultAction)); err != nil {
+					return fmt.Errorf("setting `ip_rules`: %+v", err)
+				}
+
 				if err := d.Set("public_network", flattenWebpubsubPublicNetwork(props.NetworkACLs.PublicNetwork)); err != nil {
 					return fmt.Errorf("setting `public_network`: %+v", err)
 				}
@@ -256,6 +254,7 @@ func resourceWebpubsubNetworkACLDelete(d *pluginsdk.ResourceData, meta interface
 	var denyRequestTypes []webpubsub.WebPubSubRequestType
 	networkACL := &webpubsub.WebPubSubNetworkACLs{
 		DefaultAction: &defaultAction,
+		IPRules:       expandWebpubsubIPRules(networkacl.DefaultIPRules()),
 		PublicNetwork: &webpubsub.NetworkACL{
 			Allow: &defaultRequestTypes,
 			Deny:  &denyRequestTypes,
@@ -290,6 +289,34 @@ func resourceWebpubsubNetworkACLDelete(d *pluginsdk.ResourceData, meta interface
 	return nil
 }
 
+func expandWebpubsubIPRules(input []networkacl.IPRule) *[]webpubsub.IPRule {
+	result := make([]webpubsub.IPRule, 0)
+	for _, item := range input {
+		result = append(result, webpubsub.IPRule{
+			Action: pointer.To(webpubsub.ACLAction(item.Action)),
+			Value:  pointer.To(item.Address),
+		})
+	}
+
+	return &result
+}
+
+func flattenWebpubsubIPRules(input *[]webpubsub.IPRule) []networkacl.IPRule {
+	result := make([]networkacl.IPRule, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, item := range *input {
+		result = append(result, networkacl.IPRule{
+			Action:  string(pointer.From(item.Action)),
+			Address: pointer.From(item.Value),
+		})
+	}
+
+	return result
+}
+
 func expandWebpubsubPublicNetwork(input []interface{}) *webpubsub.NetworkACL {
 	allowRTs := make([]webpubsub.WebPubSubRequestType, 0)
 	deniedRTs := make([]webpubsub.WebPubSubRequestType, 0)
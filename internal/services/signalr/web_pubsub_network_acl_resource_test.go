@@ -116,6 +116,29 @@ func TestAccWebPubsubNetworkACL_updateMultiplePrivateEndpoints(t *testing.T) {
 	})
 }
 
+func TestAccWebPubsubNetworkACL_ipRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_pubsub_network_acl", "test")
+	r := WebPubsubNetworkACLResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ipRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_rules.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r WebPubsubNetworkACLResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := webpubsub.ParseWebPubSubID(state.ID)
 	if err != nil {
@@ -164,6 +187,22 @@ resource "azurerm_web_pubsub_network_acl" "test" {
 `, r.template(data))
 }
 
+func (r WebPubsubNetworkACLResource) ipRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub_network_acl" "test" {
+  web_pubsub_id  = azurerm_web_pubsub.test.id
+  default_action = "Deny"
+  ip_rules       = ["10.0.0.1/32", "192.168.0.0/24"]
+
+  public_network {
+    allowed_request_types = ["ClientConnection"]
+  }
+}
+`, r.template(data))
+}
+
 func (r WebPubsubNetworkACLResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
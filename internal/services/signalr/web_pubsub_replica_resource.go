@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2024-03-01/webpubsub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type WebPubSubReplicaResource struct{}
+
+type WebPubSubReplicaResourceModel struct {
+	Name                    string                     `tfschema:"name"`
+	WebPubSubId             string                     `tfschema:"web_pubsub_id"`
+	Location                string                     `tfschema:"location"`
+	Sku                     []WebPubSubReplicaSkuModel `tfschema:"sku"`
+	RegionalEndpointEnabled bool                       `tfschema:"regional_endpoint_enabled"`
+	ResourceStopped         bool                       `tfschema:"resource_stopped"`
+	Tags                    map[string]string          `tfschema:"tags"`
+	HostName                string                     `tfschema:"hostname"`
+}
+
+type WebPubSubReplicaSkuModel struct {
+	Name     string `tfschema:"name"`
+	Capacity int64  `tfschema:"capacity"`
+}
+
+var _ sdk.ResourceWithUpdate = WebPubSubReplicaResource{}
+
+func (r WebPubSubReplicaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ReplicaName(),
+		},
+
+		"web_pubsub_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: webpubsub.ValidateWebPubSubID,
+		},
+
+		"location": commonschema.Location(),
+
+		"sku": replicaSkuSchema(),
+
+		"regional_endpoint_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"resource_stopped": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r WebPubSubReplicaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r WebPubSubReplicaResource) ModelObject() interface{} {
+	return &WebPubSubReplicaResourceModel{}
+}
+
+func (r WebPubSubReplicaResource) ResourceType() string {
+	return "azurerm_web_pubsub_replica"
+}
+
+func (r WebPubSubReplicaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return webpubsub.ValidateReplicaID
+}
+
+func (r WebPubSubReplicaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.WebPubSubClient.WebPubSub
+
+			var config WebPubSubReplicaResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			webPubSubId, err := webpubsub.ParseWebPubSubID(config.WebPubSubId)
+			if err != nil {
+				return err
+			}
+
+			id := webpubsub.NewReplicaID(webPubSubId.SubscriptionId, webPubSubId.ResourceGroupName, webPubSubId.WebPubSubName, config.Name)
+
+			locks.ByID(webPubSubId.ID())
+			defer locks.UnlockByID(webPubSubId.ID())
+
+			existing, err := client.ReplicasGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := webpubsub.Replica{
+				Location: location.Normalize(config.Location),
+				Properties: &webpubsub.ReplicaProperties{
+					RegionEndpointEnabled: pointer.To(replicaFeatureState(config.RegionalEndpointEnabled)),
+					ResourceStopped:       pointer.To(strings.ToLower(fmt.Sprint(config.ResourceStopped))),
+				},
+				Sku:  expandWebPubSubReplicaSkuFromModel(config.Sku),
+				Tags: pointer.To(config.Tags),
+			}
+
+			if err := client.ReplicasCreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WebPubSubReplicaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.WebPubSubClient.WebPubSub
+
+			id, err := webpubsub.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ReplicasGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			webPubSubId := webpubsub.NewWebPubSubID(id.SubscriptionId, id.ResourceGroupName, id.WebPubSubName)
+			webPubSubResp, err := client.Get(ctx, webPubSubId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", webPubSubId, err)
+			}
+
+			state := WebPubSubReplicaResourceModel{
+				Name:        id.ReplicaName,
+				WebPubSubId: webPubSubId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+				state.Sku = flattenWebPubSubReplicaSkuToModel(model.Sku)
+
+				if props := model.Properties; props != nil {
+					state.RegionalEndpointEnabled = strings.EqualFold(pointer.From(props.RegionEndpointEnabled), replicaFeatureStateEnabled)
+					state.ResourceStopped = strings.EqualFold(pointer.From(props.ResourceStopped), "true")
+				}
+			}
+
+			if model := webPubSubResp.Model; model != nil && model.Properties != nil {
+				state.HostName = replicaHostName(pointer.From(model.Properties.HostName), id.ReplicaName)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WebPubSubReplicaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.WebPubSubClient.WebPubSub
+
+			id, err := webpubsub.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config WebPubSubReplicaResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			webPubSubId := webpubsub.NewWebPubSubID(id.SubscriptionId, id.ResourceGroupName, id.WebPubSubName)
+			locks.ByID(webPubSubId.ID())
+			defer locks.UnlockByID(webPubSubId.ID())
+
+			existing, err := client.ReplicasGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if payload.Properties == nil {
+				payload.Properties = &webpubsub.ReplicaProperties{}
+			}
+
+			if metadata.ResourceData.HasChange("sku") {
+				payload.Sku = expandWebPubSubReplicaSkuFromModel(config.Sku)
+			}
+
+			if metadata.ResourceData.HasChange("regional_endpoint_enabled") {
+				payload.Properties.RegionEndpointEnabled = pointer.To(replicaFeatureState(config.RegionalEndpointEnabled))
+			}
+
+			if metadata.ResourceData.HasChange("resource_stopped") {
+				payload.Properties.ResourceStopped = pointer.To(strings.ToLower(fmt.Sprint(config.ResourceStopped)))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(config.Tags)
+			}
+
+			if err := client.ReplicasUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WebPubSubReplicaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.WebPubSubClient.WebPubSub
+
+			id, err := webpubsub.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			webPubSubId := webpubsub.NewWebPubSubID(id.SubscriptionId, id.ResourceGroupName, id.WebPubSubName)
+			locks.ByID(webPubSubId.ID())
+			defer locks.UnlockByID(webPubSubId.ID())
+
+			if _, err := client.ReplicasDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandWebPubSubReplicaSkuFromModel(input []WebPubSubReplicaSkuModel) *webpubsub.ResourceSku {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &webpubsub.ResourceSku{
+		Name:     input[0].Name,
+		Capacity: pointer.To(input[0].Capacity),
+	}
+}
+
+func flattenWebPubSubReplicaSkuToModel(input *webpubsub.ResourceSku) []WebPubSubReplicaSkuModel {
+	result := make([]WebPubSubReplicaSkuModel, 0)
+	if input == nil {
+		return result
+	}
+
+	return append(result, WebPubSubReplicaSkuModel{
+		Name:     input.Name,
+		Capacity: pointer.From(input.Capacity),
+	})
+}
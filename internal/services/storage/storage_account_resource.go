@@ -49,6 +49,12 @@ import (
 
 //go:generate go run ../../tools/generator-tests resourceidentity -resource-name storage_account -service-package-name storage -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
 
+const (
+	// the phases of migrating an existing Storage Account to use a Hierarchical Namespace
+	storageAccountHnsMigrationRequestTypeValidation = "HnsOnValidationRequest"
+	storageAccountHnsMigrationRequestTypeHydration  = "HnsOnHydrationRequest"
+)
+
 var (
 	storageAccountResourceName  = "azurerm_storage_account"
 	storageKindsSupportsSkuTier = map[storageaccounts.Kind]struct{}{
@@ -331,7 +337,6 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
 				Default:  false,
-				ForceNew: true,
 			},
 
 			"nfsv3_enabled": {
@@ -1149,6 +1154,10 @@ func resourceStorageAccount() *pluginsdk.Resource {
 					}
 				}
 
+				if err := validateStorageAccountProtocolSupport(d); err != nil {
+					return err
+				}
+
 				if d.HasChange("immutability_policy.0.state") {
 					old, new := d.GetChange("immutability_policy.0.state")
 
@@ -1175,6 +1184,10 @@ func resourceStorageAccount() *pluginsdk.Resource {
 
 				return nil
 			}),
+			// the Hierarchical Namespace can be enabled on an existing account (by migrating it), but can't be disabled
+			pluginsdk.ForceNewIfChange("is_hns_enabled", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
 			pluginsdk.ForceNewIfChange("account_replication_type", func(ctx context.Context, old, new, meta interface{}) bool {
 				newAccRep := strings.ToUpper(new.(string))
 
@@ -1444,25 +1457,6 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		payload.Properties.AccessTier = pointer.To(storageaccounts.AccessTier(accessTier.(string)))
 	}
 
-	if _, supportsHns := storageKindsSupportHns[accountKind]; !supportsHns && isHnsEnabled {
-		keys := sortedKeysFromSlice(storageKindsSupportHns)
-		return fmt.Errorf("`is_hns_enabled` can only be used for accounts with `kind` set to one of: %+v", strings.Join(keys, " / "))
-	}
-
-	// NFSv3 is supported for standard general-purpose v2 storage accounts and for premium block blob storage accounts.
-	// (https://docs.microsoft.com/en-us/azure/storage/blobs/network-file-system-protocol-support-how-to#step-5-create-and-configure-a-storage-account)
-	if nfsV3Enabled {
-		if !isHnsEnabled {
-			return fmt.Errorf("`nfsv3_enabled` can only be used when `is_hns_enabled` is `true`")
-		}
-
-		isPremiumTierAndBlockBlobStorageKind := accountTier == storageaccounts.SkuTierPremium && accountKind == storageaccounts.KindBlockBlobStorage
-		isStandardTierAndStorageV2Kind := accountTier == storageaccounts.SkuTierStandard && accountKind == storageaccounts.KindStorageVTwo
-		if !isPremiumTierAndBlockBlobStorageKind && !isStandardTierAndStorageV2Kind {
-			return fmt.Errorf("`nfsv3_enabled` can only be used with account tier `Standard` and account kind `StorageV2`, or account tier `Premium` and account kind `BlockBlobStorage`")
-		}
-	}
-
 	// nolint staticcheck
 	if v, ok := d.GetOkExists("large_file_share_enabled"); ok {
 		// @tombuildsstuff: we can't set this to `false` because the API returns:
@@ -1601,11 +1595,7 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 			return err
 		}
 
-		// See: https://learn.microsoft.com/en-us/azure/storage/blobs/versioning-overview#:~:text=Storage%20accounts%20with%20a%20hierarchical%20namespace%20enabled%20for%20use%20with%20Azure%20Data%20Lake%20Storage%20Gen2%20are%20not%20currently%20supported.
 		isVersioningEnabled := pointer.From(blobProperties.Properties.IsVersioningEnabled)
-		if isVersioningEnabled && isHnsEnabled {
-			return fmt.Errorf("`versioning_enabled` can't be true when `is_hns_enabled` is true")
-		}
 
 		if !isVersioningEnabled {
 			if blobProperties.Properties.RestorePolicy != nil && blobProperties.Properties.RestorePolicy.Enabled {
@@ -1700,6 +1690,27 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("is_hns_enabled") && d.Get("is_hns_enabled").(bool) {
+		// enabling the Hierarchical Namespace on an existing account is done by migrating it in two phases: first validating
+		// that the account can be migrated, then migrating (hydrating) it - this happens prior to the other changes, since
+		// some of these (e.g. `sftp_enabled`) require the Hierarchical Namespace
+		log.Printf("[DEBUG] Validating the Hierarchical Namespace migration for %s..", id)
+		validationOptions := storageaccounts.HierarchicalNamespaceMigrationOperationOptions{
+			RequestType: pointer.To(storageAccountHnsMigrationRequestTypeValidation),
+		}
+		if err := client.HierarchicalNamespaceMigrationThenPoll(ctx, *id, validationOptions); err != nil {
+			return fmt.Errorf("validating the Hierarchical Namespace migration for %s: %+v", id, err)
+		}
+
+		log.Printf("[DEBUG] Migrating %s to use a Hierarchical Namespace..", id)
+		hydrationOptions := storageaccounts.HierarchicalNamespaceMigrationOperationOptions{
+			RequestType: pointer.To(storageAccountHnsMigrationRequestTypeHydration),
+		}
+		if err := client.HierarchicalNamespaceMigrationThenPoll(ctx, *id, hydrationOptions); err != nil {
+			return fmt.Errorf("migrating %s to use a Hierarchical Namespace: %+v", id, err)
+		}
+	}
+
 	existing, err := client.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
@@ -1931,10 +1942,6 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 			return err
 		}
 
-		if blobProperties.Properties.IsVersioningEnabled != nil && *blobProperties.Properties.IsVersioningEnabled && d.Get("is_hns_enabled").(bool) {
-			return fmt.Errorf("`versioning_enabled` can't be true when `is_hns_enabled` is true")
-		}
-
 		// Disable restore_policy first. Disabling restore_policy and while setting delete_retention_policy.allow_permanent_delete to true cause error.
 		// Issue : https://github.com/Azure/azure-rest-api-specs/issues/11237
 		if v := d.Get("blob_properties.0.restore_policy"); d.HasChange("blob_properties.0.restore_policy") && len(v.([]interface{})) == 0 {
@@ -3574,3 +3581,46 @@ func flattenEdgeZone(input *edgezones.Model) string {
 	}
 	return output
 }
+
+// validateStorageAccountProtocolSupport validates the combinations of the Hierarchical Namespace and the protocols which depend on it
+func validateStorageAccountProtocolSupport(d *pluginsdk.ResourceDiff) error {
+	// these are only known at apply time when they reference other resources, in which case the API validates them
+	if !d.NewValueKnown("account_kind") || !d.NewValueKnown("account_tier") || !d.NewValueKnown("is_hns_enabled") {
+		return nil
+	}
+
+	accountKind := storageaccounts.Kind(d.Get("account_kind").(string))
+	accountTier := storageaccounts.SkuTier(d.Get("account_tier").(string))
+	isHnsEnabled := d.Get("is_hns_enabled").(bool)
+
+	if _, supportsHns := storageKindsSupportHns[accountKind]; !supportsHns && isHnsEnabled {
+		keys := sortedKeysFromSlice(storageKindsSupportHns)
+		return fmt.Errorf("`is_hns_enabled` can only be used for accounts with `kind` set to one of: %+v", strings.Join(keys, " / "))
+	}
+
+	// NFSv3 is supported for standard general-purpose v2 storage accounts and for premium block blob storage accounts.
+	// (https://docs.microsoft.com/en-us/azure/storage/blobs/network-file-system-protocol-support-how-to#step-5-create-and-configure-a-storage-account)
+	if d.Get("nfsv3_enabled").(bool) {
+		if !isHnsEnabled {
+			return fmt.Errorf("`nfsv3_enabled` can only be used when `is_hns_enabled` is `true`")
+		}
+
+		isPremiumTierAndBlockBlobStorageKind := accountTier == storageaccounts.SkuTierPremium && accountKind == storageaccounts.KindBlockBlobStorage
+		isStandardTierAndStorageV2Kind := accountTier == storageaccounts.SkuTierStandard && accountKind == storageaccounts.KindStorageVTwo
+		if !isPremiumTierAndBlockBlobStorageKind && !isStandardTierAndStorageV2Kind {
+			return fmt.Errorf("`nfsv3_enabled` can only be used with account tier `Standard` and account kind `StorageV2`, or account tier `Premium` and account kind `BlockBlobStorage`")
+		}
+	}
+
+	// See: https://learn.microsoft.com/en-us/azure/storage/blobs/secure-file-transfer-protocol-support
+	if d.Get("sftp_enabled").(bool) && !isHnsEnabled {
+		return fmt.Errorf("`sftp_enabled` can only be used when `is_hns_enabled` is `true`")
+	}
+
+	// See: https://learn.microsoft.com/en-us/azure/storage/blobs/versioning-overview
+	if isHnsEnabled && d.Get("blob_properties.0.versioning_enabled").(bool) {
+		return fmt.Errorf("`versioning_enabled` can't be true when `is_hns_enabled` is true")
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ManagedPrivateEndpointBulkId struct {
+	SubscriptionId            string
+	ResourceGroup             string
+	WorkspaceName             string
+	ManagedVirtualNetworkName string
+	Name                      string
+}
+
+func NewManagedPrivateEndpointBulkID(subscriptionId, resourceGroup, workspaceName, managedVirtualNetworkName, name string) ManagedPrivateEndpointBulkId {
+	return ManagedPrivateEndpointBulkId{
+		SubscriptionId:            subscriptionId,
+		ResourceGroup:             resourceGroup,
+		WorkspaceName:             workspaceName,
+		ManagedVirtualNetworkName: managedVirtualNetworkName,
+		Name:                      name,
+	}
+}
+
+func (id ManagedPrivateEndpointBulkId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Managed Virtual Network Name %q", id.ManagedVirtualNetworkName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Managed Private Endpoint Bulk", segmentsStr)
+}
+
+func (id ManagedPrivateEndpointBulkId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Synapse/workspaces/%s/managedVirtualNetworks/%s/managedPrivateEndpointBulks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.ManagedVirtualNetworkName, id.Name)
+}
+
+// ManagedPrivateEndpointBulkID parses a ManagedPrivateEndpointBulk ID into an ManagedPrivateEndpointBulkId struct
+func ManagedPrivateEndpointBulkID(input string) (*ManagedPrivateEndpointBulkId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ManagedPrivateEndpointBulk ID: %+v", input, err)
+	}
+
+	resourceId := ManagedPrivateEndpointBulkId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.ManagedVirtualNetworkName, err = id.PopSegment("managedVirtualNetworks"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("managedPrivateEndpointBulks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
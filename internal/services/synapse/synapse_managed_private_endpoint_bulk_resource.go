@@ -0,0 +1,578 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	managedvirtualnetwork "github.com/jackofallops/kermit/sdk/synapse/2019-06-01-preview/synapse"
+)
+
+type SynapseManagedPrivateEndpointBulkModel struct {
+	Name                      string                                  `tfschema:"name"`
+	SynapseWorkspaceId        string                                  `tfschema:"synapse_workspace_id"`
+	ManagedPrivateEndpoints   []SynapseManagedPrivateEndpointBulkItem `tfschema:"managed_private_endpoint"`
+	MaxParallelOperations     int64                                   `tfschema:"max_parallel_operations"`
+	ManagedPrivateEndpointIds map[string]string                       `tfschema:"managed_private_endpoint_ids"`
+}
+
+type SynapseManagedPrivateEndpointBulkItem struct {
+	Name             string `tfschema:"name"`
+	TargetResourceId string `tfschema:"target_resource_id"`
+	SubresourceName  string `tfschema:"subresource_name"`
+}
+
+type SynapseManagedPrivateEndpointBulkResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate         = SynapseManagedPrivateEndpointBulkResource{}
+	_ sdk.ResourceWithCustomizeDiff  = SynapseManagedPrivateEndpointBulkResource{}
+	_ sdk.ResourceWithCustomImporter = SynapseManagedPrivateEndpointBulkResource{}
+)
+
+func (r SynapseManagedPrivateEndpointBulkResource) ResourceType() string {
+	return "azurerm_synapse_managed_private_endpoint_bulk"
+}
+
+func (r SynapseManagedPrivateEndpointBulkResource) ModelObject() interface{} {
+	return &SynapseManagedPrivateEndpointBulkModel{}
+}
+
+func (r SynapseManagedPrivateEndpointBulkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedPrivateEndpointBulkID
+}
+
+func (r SynapseManagedPrivateEndpointBulkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"synapse_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WorkspaceID,
+		},
+
+		"managed_private_endpoint": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"target_resource_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+
+					"subresource_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: networkValidate.PrivateLinkSubResourceName,
+					},
+				},
+			},
+		},
+
+		"max_parallel_operations": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      5,
+			ValidateFunc: validation.IntBetween(1, 25),
+		},
+	}
+}
+
+func (r SynapseManagedPrivateEndpointBulkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_private_endpoint_ids": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r SynapseManagedPrivateEndpointBulkResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config SynapseManagedPrivateEndpointBulkModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			names := make(map[string]struct{})
+			for _, endpoint := range config.ManagedPrivateEndpoints {
+				// the name may not be known until apply when it's sourced from another resource
+				if endpoint.Name == "" {
+					continue
+				}
+
+				name := strings.ToLower(endpoint.Name)
+				if _, ok := names[name]; ok {
+					return fmt.Errorf("the `name` %q is used by more than one `managed_private_endpoint`", endpoint.Name)
+				}
+				names[name] = struct{}{}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SynapseManagedPrivateEndpointBulkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			workspaceClient := metadata.Client.Synapse.WorkspaceClient
+
+			var model SynapseManagedPrivateEndpointBulkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := parse.WorkspaceID(model.SynapseWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			workspace, err := workspaceClient.Get(ctx, workspaceId.ResourceGroup, workspaceId.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *workspaceId, err)
+			}
+			if workspace.WorkspaceProperties == nil || workspace.WorkspaceProperties.ManagedVirtualNetwork == nil {
+				return fmt.Errorf("retrieving %s: `ManagedVirtualNetwork` was nil", *workspaceId)
+			}
+
+			id := parse.NewManagedPrivateEndpointBulkID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, *workspace.WorkspaceProperties.ManagedVirtualNetwork, model.Name)
+
+			client, err := synapseManagedPrivateEndpointsClient(metadata, id.WorkspaceName)
+			if err != nil {
+				return fmt.Errorf("building Client for %s: %+v", id, err)
+			}
+
+			if err := checkSynapseManagedPrivateEndpointsDoNotExist(ctx, client, id, int(model.MaxParallelOperations), model.ManagedPrivateEndpoints); err != nil {
+				return err
+			}
+
+			model.ManagedPrivateEndpointIds = make(map[string]string)
+			var mutex sync.Mutex
+			err = utils.RunInParallel(int(model.MaxParallelOperations), model.ManagedPrivateEndpoints, func(endpoint SynapseManagedPrivateEndpointBulkItem) error {
+				endpointId := parse.NewManagedPrivateEndpointID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.ManagedVirtualNetworkName, endpoint.Name)
+				if _, err := client.Create(ctx, endpointId.ManagedVirtualNetworkName, endpointId.Name, expandSynapseManagedPrivateEndpointBulkItem(endpoint)); err != nil {
+					return fmt.Errorf("creating %s: %+v", endpointId, err)
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				model.ManagedPrivateEndpointIds[endpoint.Name] = endpointId.ID()
+				return nil
+			})
+			if err == nil {
+				err = waitForSynapseManagedPrivateEndpointsToProvision(ctx, client, id, model.ManagedPrivateEndpointIds)
+			}
+			if err != nil {
+				// returning an error once the ID has been set would taint the resource, replacing every endpoint on the
+				// next apply - as such the endpoints which were provisioned are removed again instead
+				provisioned := make([]SynapseManagedPrivateEndpointBulkItem, 0, len(model.ManagedPrivateEndpointIds))
+				for name := range model.ManagedPrivateEndpointIds {
+					provisioned = append(provisioned, SynapseManagedPrivateEndpointBulkItem{Name: name})
+				}
+				if deleteErr := utils.RunInParallel(int(model.MaxParallelOperations), provisioned, func(endpoint SynapseManagedPrivateEndpointBulkItem) error {
+					endpointId := parse.NewManagedPrivateEndpointID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.ManagedVirtualNetworkName, endpoint.Name)
+					if _, err := client.Delete(ctx, endpointId.ManagedVirtualNetworkName, endpointId.Name); err != nil {
+						return fmt.Errorf("deleting %s: %+v", endpointId, err)
+					}
+					return nil
+				}); deleteErr != nil {
+					return errors.Join(err, fmt.Errorf("removing the Managed Private Endpoints which were provisioned: %+v", deleteErr))
+				}
+
+				return err
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r SynapseManagedPrivateEndpointBulkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedPrivateEndpointBulkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state SynapseManagedPrivateEndpointBulkModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.Name = id.Name
+			state.SynapseWorkspaceId = parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID()
+			if state.MaxParallelOperations == 0 {
+				state.MaxParallelOperations = 5
+			}
+
+			client, err := synapseManagedPrivateEndpointsClient(metadata, id.WorkspaceName)
+			if err != nil {
+				return fmt.Errorf("building Client for %s: %+v", *id, err)
+			}
+
+			// a single List is used rather than retrieving each endpoint, since a workspace may contain a large number
+			existing, err := listSynapseManagedPrivateEndpoints(ctx, client, id.ManagedVirtualNetworkName)
+			if err != nil {
+				return fmt.Errorf("listing the Managed Private Endpoints for %s: %+v", *id, err)
+			}
+
+			existingEndpointIds := make(map[string]string)
+			endpoints := make([]SynapseManagedPrivateEndpointBulkItem, 0)
+			for name, endpointId := range state.ManagedPrivateEndpointIds {
+				endpoint, ok := existing[strings.ToLower(name)]
+				if !ok {
+					continue
+				}
+
+				existingEndpointIds[name] = endpointId
+				item := SynapseManagedPrivateEndpointBulkItem{
+					Name: name,
+				}
+				if props := endpoint.Properties; props != nil {
+					item.TargetResourceId = pointer.From(props.PrivateLinkResourceID)
+					item.SubresourceName = pointer.From(props.GroupID)
+				}
+				endpoints = append(endpoints, item)
+			}
+
+			if len(state.ManagedPrivateEndpointIds) > 0 && len(existingEndpointIds) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+			state.ManagedPrivateEndpointIds = existingEndpointIds
+			state.ManagedPrivateEndpoints = endpoints
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SynapseManagedPrivateEndpointBulkResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedPrivateEndpointBulkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SynapseManagedPrivateEndpointBulkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := synapseManagedPrivateEndpointsClient(metadata, id.WorkspaceName)
+			if err != nil {
+				return fmt.Errorf("building Client for %s: %+v", *id, err)
+			}
+
+			oldEndpoints, _ := metadata.ResourceData.GetChange("managed_private_endpoint")
+			previous := make(map[string]SynapseManagedPrivateEndpointBulkItem)
+			for _, raw := range oldEndpoints.(*pluginsdk.Set).List() {
+				if v, ok := raw.(map[string]interface{}); ok {
+					endpoint := SynapseManagedPrivateEndpointBulkItem{
+						Name:             v["name"].(string),
+						TargetResourceId: v["target_resource_id"].(string),
+						SubresourceName:  v["subresource_name"].(string),
+					}
+					previous[endpoint.Name] = endpoint
+				}
+			}
+
+			oldEndpointIds, _ := metadata.ResourceData.GetChange("managed_private_endpoint_ids")
+			trackedEndpointIds := make(map[string]string)
+			for name, endpointId := range oldEndpointIds.(map[string]interface{}) {
+				trackedEndpointIds[name] = endpointId.(string)
+			}
+
+			// Managed Private Endpoints can't be updated, so any which have changed are deleted and then recreated
+			desired := make(map[string]struct{})
+			endpointsToCreate := make([]SynapseManagedPrivateEndpointBulkItem, 0)
+			endpointsToDelete := make([]SynapseManagedPrivateEndpointBulkItem, 0)
+			for _, endpoint := range model.ManagedPrivateEndpoints {
+				desired[endpoint.Name] = struct{}{}
+
+				_, isTracked := trackedEndpointIds[endpoint.Name]
+				previousEndpoint, ok := previous[endpoint.Name]
+				if isTracked && ok && strings.EqualFold(previousEndpoint.TargetResourceId, endpoint.TargetResourceId) && strings.EqualFold(previousEndpoint.SubresourceName, endpoint.SubresourceName) {
+					continue
+				}
+				if isTracked {
+					endpointsToDelete = append(endpointsToDelete, endpoint)
+				}
+				endpointsToCreate = append(endpointsToCreate, endpoint)
+			}
+			for name := range trackedEndpointIds {
+				if _, ok := desired[name]; !ok {
+					endpointsToDelete = append(endpointsToDelete, SynapseManagedPrivateEndpointBulkItem{Name: name})
+				}
+			}
+
+			endpointsToAdd := make([]SynapseManagedPrivateEndpointBulkItem, 0)
+			for _, endpoint := range endpointsToCreate {
+				if _, ok := trackedEndpointIds[endpoint.Name]; !ok {
+					endpointsToAdd = append(endpointsToAdd, endpoint)
+				}
+			}
+			if err := checkSynapseManagedPrivateEndpointsDoNotExist(ctx, client, *id, int(model.MaxParallelOperations), endpointsToAdd); err != nil {
+				return err
+			}
+
+			var mutex sync.Mutex
+			err = utils.RunInParallel(int(model.MaxParallelOperations), endpointsToDelete, func(endpoint SynapseManagedPrivateEndpointBulkItem) error {
+				endpointId, err := parse.ManagedPrivateEndpointID(trackedEndpointIds[endpoint.Name])
+				if err != nil {
+					return err
+				}
+
+				if _, err := client.Delete(ctx, endpointId.ManagedVirtualNetworkName, endpointId.Name); err != nil {
+					return fmt.Errorf("deleting %s: %+v", *endpointId, err)
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				delete(trackedEndpointIds, endpoint.Name)
+				return nil
+			})
+
+			// endpoints are only recreated once any previous endpoint with the same name has been deleted
+			if err == nil {
+				err = utils.RunInParallel(int(model.MaxParallelOperations), endpointsToCreate, func(endpoint SynapseManagedPrivateEndpointBulkItem) error {
+					endpointId := parse.NewManagedPrivateEndpointID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.ManagedVirtualNetworkName, endpoint.Name)
+					if _, err := client.Create(ctx, endpointId.ManagedVirtualNetworkName, endpointId.Name, expandSynapseManagedPrivateEndpointBulkItem(endpoint)); err != nil {
+						return fmt.Errorf("creating %s: %+v", endpointId, err)
+					}
+
+					mutex.Lock()
+					defer mutex.Unlock()
+					trackedEndpointIds[endpoint.Name] = endpointId.ID()
+					return nil
+				})
+			}
+			if err == nil {
+				err = waitForSynapseManagedPrivateEndpointsToProvision(ctx, client, *id, trackedEndpointIds)
+			}
+
+			model.ManagedPrivateEndpointIds = trackedEndpointIds
+			if encodeErr := metadata.Encode(&model); encodeErr != nil {
+				return errors.Join(err, fmt.Errorf("encoding: %+v", encodeErr))
+			}
+
+			return err
+		},
+	}
+}
+
+func (r SynapseManagedPrivateEndpointBulkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedPrivateEndpointBulkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SynapseManagedPrivateEndpointBulkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := synapseManagedPrivateEndpointsClient(metadata, id.WorkspaceName)
+			if err != nil {
+				return fmt.Errorf("building Client for %s: %+v", *id, err)
+			}
+
+			endpoints := make([]SynapseManagedPrivateEndpointBulkItem, 0)
+			for name := range model.ManagedPrivateEndpointIds {
+				endpoints = append(endpoints, SynapseManagedPrivateEndpointBulkItem{Name: name})
+			}
+
+			if err := utils.RunInParallel(int(model.MaxParallelOperations), endpoints, func(endpoint SynapseManagedPrivateEndpointBulkItem) error {
+				endpointId, err := parse.ManagedPrivateEndpointID(model.ManagedPrivateEndpointIds[endpoint.Name])
+				if err != nil {
+					return err
+				}
+
+				if _, err := client.Delete(ctx, endpointId.ManagedVirtualNetworkName, endpointId.Name); err != nil {
+					return fmt.Errorf("deleting %s: %+v", *endpointId, err)
+				}
+				return nil
+			}); err != nil {
+				return fmt.Errorf("deleting the Managed Private Endpoints of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SynapseManagedPrivateEndpointBulkResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`azurerm_synapse_managed_private_endpoint_bulk` can't be imported since the Managed Private Endpoints it manages are only tracked in the state")
+	}
+}
+
+func synapseManagedPrivateEndpointsClient(metadata sdk.ResourceMetaData, workspaceName string) (*managedvirtualnetwork.ManagedPrivateEndpointsClient, error) {
+	environment := metadata.Client.Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return nil, fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	return metadata.Client.Synapse.ManagedPrivateEndpointsClient(workspaceName, *synapseDomainSuffix)
+}
+
+// listSynapseManagedPrivateEndpoints returns the Managed Private Endpoints within the Managed Virtual Network, keyed
+// by their name in lower case
+func listSynapseManagedPrivateEndpoints(ctx context.Context, client *managedvirtualnetwork.ManagedPrivateEndpointsClient, managedVirtualNetworkName string) (map[string]managedvirtualnetwork.ManagedPrivateEndpoint, error) {
+	output := make(map[string]managedvirtualnetwork.ManagedPrivateEndpoint)
+
+	iterator, err := client.ListComplete(ctx, managedVirtualNetworkName)
+	if err != nil {
+		return nil, err
+	}
+	for iterator.NotDone() {
+		endpoint := iterator.Value()
+		if endpoint.Name != nil {
+			output[strings.ToLower(*endpoint.Name)] = endpoint
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+// waitForSynapseManagedPrivateEndpointsToProvision polls for the provisioning state of all of the endpoints using a
+// single List, rather than polling each endpoint individually, to keep the number of requests down for large batches
+func waitForSynapseManagedPrivateEndpointsToProvision(ctx context.Context, client *managedvirtualnetwork.ManagedPrivateEndpointsClient, id parse.ManagedPrivateEndpointBulkId, endpointIds map[string]string) error {
+	if len(endpointIds) == 0 {
+		return nil
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:      []string{"Provisioning"},
+		Target:       []string{"Succeeded"},
+		Refresh:      synapseManagedPrivateEndpointsProvisioningStateRefreshFunc(ctx, client, id, endpointIds),
+		MinTimeout:   10 * time.Second,
+		PollInterval: 10 * time.Second,
+		Timeout:      time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the Managed Private Endpoints of %s to be provisioned: %+v", id, err)
+	}
+
+	return nil
+}
+
+func synapseManagedPrivateEndpointsProvisioningStateRefreshFunc(ctx context.Context, client *managedvirtualnetwork.ManagedPrivateEndpointsClient, id parse.ManagedPrivateEndpointBulkId, endpointIds map[string]string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		existing, err := listSynapseManagedPrivateEndpoints(ctx, client, id.ManagedVirtualNetworkName)
+		if err != nil {
+			return nil, "", fmt.Errorf("listing the Managed Private Endpoints for %s: %+v", id, err)
+		}
+
+		pending := make([]string, 0)
+		failed := make([]string, 0)
+		for name := range endpointIds {
+			endpoint, ok := existing[strings.ToLower(name)]
+			if !ok || endpoint.Properties == nil {
+				pending = append(pending, name)
+				continue
+			}
+
+			switch state := pointer.From(endpoint.Properties.ProvisioningState); {
+			case strings.EqualFold(state, "Succeeded"):
+				continue
+			case strings.EqualFold(state, "Failed"):
+				failed = append(failed, name)
+			default:
+				pending = append(pending, name)
+			}
+		}
+
+		if len(failed) > 0 {
+			sort.Strings(failed)
+			return existing, "Failed", fmt.Errorf("the Managed Private Endpoints %q failed to provision", strings.Join(failed, ", "))
+		}
+		if len(pending) > 0 {
+			return existing, "Provisioning", nil
+		}
+
+		return existing, "Succeeded", nil
+	}
+}
+
+// checkSynapseManagedPrivateEndpointsDoNotExist checks the endpoints which are about to be created up front, so that an
+// existing endpoint doesn't leave a partially provisioned set
+func checkSynapseManagedPrivateEndpointsDoNotExist(ctx context.Context, client *managedvirtualnetwork.ManagedPrivateEndpointsClient, id parse.ManagedPrivateEndpointBulkId, maxParallel int, endpoints []SynapseManagedPrivateEndpointBulkItem) error {
+	return utils.RunInParallel(maxParallel, endpoints, func(endpoint SynapseManagedPrivateEndpointBulkItem) error {
+		endpointId := parse.NewManagedPrivateEndpointID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.ManagedVirtualNetworkName, endpoint.Name)
+		existing, err := client.Get(ctx, endpointId.ManagedVirtualNetworkName, endpointId.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", endpointId, err)
+			}
+			return nil
+		}
+		return fmt.Errorf("%s already exists and cannot be managed by %s - either delete it or remove it from the `managed_private_endpoint` blocks", endpointId, id)
+	})
+}
+
+func expandSynapseManagedPrivateEndpointBulkItem(input SynapseManagedPrivateEndpointBulkItem) managedvirtualnetwork.ManagedPrivateEndpoint {
+	return managedvirtualnetwork.ManagedPrivateEndpoint{
+		Properties: &managedvirtualnetwork.ManagedPrivateEndpointProperties{
+			PrivateLinkResourceID: pointer.To(input.TargetResourceId),
+			GroupID:               pointer.To(input.SubresourceName),
+		},
+	}
+}
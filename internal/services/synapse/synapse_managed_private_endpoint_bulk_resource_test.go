@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SynapseManagedPrivateEndpointBulkResource struct{}
+
+func TestAccSynapseManagedPrivateEndpointBulk_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_managed_private_endpoint_bulk", "test")
+	r := SynapseManagedPrivateEndpointBulkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_private_endpoint_ids.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccSynapseManagedPrivateEndpointBulk_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_managed_private_endpoint_bulk", "test")
+	r := SynapseManagedPrivateEndpointBulkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_private_endpoint_ids.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (r SynapseManagedPrivateEndpointBulkResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedPrivateEndpointBulkID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix, ok := client.Account.Environment.Synapse.DomainSuffix()
+	if !ok {
+		return nil, fmt.Errorf("could not determine Synapse domain suffix for environment %q", client.Account.Environment.Name)
+	}
+
+	managedPrivateEndpointsClient, err := client.Synapse.ManagedPrivateEndpointsClient(id.WorkspaceName, *suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	count := 0
+	for key, v := range state.Attributes {
+		if !strings.HasPrefix(key, "managed_private_endpoint_ids.") || key == "managed_private_endpoint_ids.%" {
+			continue
+		}
+		endpoint, err := parse.ManagedPrivateEndpointID(v)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := managedPrivateEndpointsClient.Get(ctx, endpoint.ManagedVirtualNetworkName, endpoint.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", *endpoint, err)
+		}
+		count++
+	}
+
+	return utils.Bool(count > 0), nil
+}
+
+func (r SynapseManagedPrivateEndpointBulkResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_managed_private_endpoint_bulk" "test" {
+  name                 = "acctestbulk%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+
+  managed_private_endpoint {
+    name               = "acctestEndpointBlob%d"
+    target_resource_id = azurerm_storage_account.test_endpoint.id
+    subresource_name   = "blob"
+  }
+
+  managed_private_endpoint {
+    name               = "acctestEndpointData%d"
+    target_resource_id = azurerm_storage_account.test.id
+    subresource_name   = "blob"
+  }
+
+  depends_on = [azurerm_synapse_firewall_rule.test]
+}
+`, SynapseManagedPrivateEndpointResource{}.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r SynapseManagedPrivateEndpointBulkResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_managed_private_endpoint_bulk" "test" {
+  name                    = "acctestbulk%d"
+  synapse_workspace_id    = azurerm_synapse_workspace.test.id
+  max_parallel_operations = 2
+
+  managed_private_endpoint {
+    name               = "acctestEndpointBlob%d"
+    target_resource_id = azurerm_storage_account.test_endpoint.id
+    subresource_name   = "blob"
+  }
+
+  managed_private_endpoint {
+    name               = "acctestEndpointOther%d"
+    target_resource_id = azurerm_storage_account.test.id
+    subresource_name   = "blob"
+  }
+
+  depends_on = [azurerm_synapse_firewall_rule.test]
+}
+`, SynapseManagedPrivateEndpointResource{}.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -480,7 +480,7 @@ func resourceSynapseWorkspaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	if d.HasChanges("tags", "sql_administrator_login_password", "github_repo", "azure_devops_repo", "customer_managed_key", "public_network_access_enabled") {
+	if d.HasChanges("tags", "sql_administrator_login_password", "customer_managed_key", "public_network_access_enabled") {
 		publicNetworkAccess := synapse.WorkspacePublicNetworkAccessEnabled
 		if !d.Get("public_network_access_enabled").(bool) {
 			publicNetworkAccess = synapse.WorkspacePublicNetworkAccessDisabled
@@ -488,10 +488,9 @@ func resourceSynapseWorkspaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		workspacePatchInfo := synapse.WorkspacePatchInfo{
 			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 			WorkspacePatchProperties: &synapse.WorkspacePatchProperties{
-				SQLAdministratorLoginPassword:    utils.String(d.Get("sql_administrator_login_password").(string)),
-				WorkspaceRepositoryConfiguration: expandWorkspaceRepositoryConfiguration(d),
-				Encryption:                       expandEncryptionDetails(d),
-				PublicNetworkAccess:              publicNetworkAccess,
+				SQLAdministratorLoginPassword: utils.String(d.Get("sql_administrator_login_password").(string)),
+				Encryption:                    expandEncryptionDetails(d),
+				PublicNetworkAccess:           publicNetworkAccess,
 			},
 		}
 
@@ -526,6 +525,29 @@ func resourceSynapseWorkspaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
+	// the repository configuration is updated on its own, so that the other settings of the workspace (such as the
+	// SQL Administrator password and the Customer Managed Key) aren't sent when only the repository has changed
+	if d.HasChanges("github_repo", "azure_devops_repo") {
+		workspacePatchInfo := synapse.WorkspacePatchInfo{
+			WorkspacePatchProperties: &synapse.WorkspacePatchProperties{
+				WorkspaceRepositoryConfiguration: expandWorkspaceRepositoryConfiguration(d),
+			},
+		}
+
+		if err := waitSynapseWorkspaceProvisioningState(ctx, client, id); err != nil {
+			return fmt.Errorf("failed waiting for updating %s: %+v", id, err)
+		}
+
+		future, err := client.Update(ctx, id.ResourceGroup, id.Name, workspacePatchInfo)
+		if err != nil {
+			return fmt.Errorf("updating the repository configuration for %s: %+v", id, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the repository configuration for %s to be updated: %+v", id, err)
+		}
+	}
+
 	if d.HasChange("azuread_authentication_only") {
 		future, err := azureADOnlyAuthenticationsClient.Create(ctx, id.ResourceGroup, id.Name, synapse.AzureADOnlyAuthentication{
 			AzureADOnlyAuthenticationProperties: &synapse.AzureADOnlyAuthenticationProperties{
@@ -168,6 +168,36 @@ func TestAccSynapseWorkspace_github(t *testing.T) {
 	})
 }
 
+func TestAccSynapseWorkspace_repoConfigUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace", "test")
+	r := SynapseWorkspaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.github(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("sql_administrator_login_password"),
+		{
+			Config: r.githubUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("github_repo.0.branch_name").HasValue("main"),
+			),
+		},
+		data.ImportStep("sql_administrator_login_password"),
+		{
+			Config: r.azureDevOps(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("sql_administrator_login_password"),
+	})
+}
+
 func TestAccSynapseWorkspace_customerManagedKeyActivation(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace", "test")
 	r := SynapseWorkspaceResource{}
@@ -598,6 +628,35 @@ resource "azurerm_synapse_workspace" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r SynapseWorkspaceResource) githubUpdated(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_workspace" "test" {
+  name                                 = "acctestsw%d"
+  resource_group_name                  = azurerm_resource_group.test.name
+  location                             = azurerm_resource_group.test.location
+  storage_data_lake_gen2_filesystem_id = azurerm_storage_data_lake_gen2_filesystem.test.id
+  sql_administrator_login              = "sqladminuser"
+  sql_administrator_login_password     = "H@Sh1CoR3!"
+
+  github_repo {
+    account_name    = "myuser"
+    git_url         = "https://github.mydomain.com"
+    repository_name = "myrepo"
+    branch_name     = "main"
+    root_folder     = "/synapse"
+    last_commit_id  = "1592393b38543d51feb12714cbd39501d697610c"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r SynapseWorkspaceResource) customerManagedKey(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
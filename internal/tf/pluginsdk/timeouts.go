@@ -21,4 +21,6 @@ const (
 	TimeoutUpdate  = schema.TimeoutUpdate
 	TimeoutDelete  = schema.TimeoutDelete
 	TimeoutDefault = schema.TimeoutDefault
+
+	TimeoutsConfigKey = schema.TimeoutsConfigKey
 )
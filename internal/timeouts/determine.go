@@ -15,7 +15,7 @@ import (
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForCreate(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(pluginsdk.TimeoutCreate))
+	return buildWithTimeout(ctx, determine(ctx, d, pluginsdk.TimeoutCreate))
 }
 
 // ForCreateUpdate returns the context wrapped with the timeout for an combined Create/Update operation
@@ -35,7 +35,7 @@ func ForCreateUpdate(ctx context.Context, d *pluginsdk.ResourceData) (context.Co
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForDelete(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(pluginsdk.TimeoutDelete))
+	return buildWithTimeout(ctx, determine(ctx, d, pluginsdk.TimeoutDelete))
 }
 
 // ForRead returns the context wrapped with the timeout for an Read operation
@@ -51,7 +51,48 @@ func ForRead(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, c
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForUpdate(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(pluginsdk.TimeoutUpdate))
+	return buildWithTimeout(ctx, determine(ctx, d, pluginsdk.TimeoutUpdate))
+}
+
+type scaledDefaultsKey struct{}
+
+// WithScaledDefaults returns a copy of the context which scales the default Create, Update and Delete timeouts of a
+// resource by the specified multiplier, when these are determined by ForCreate, ForUpdate and ForDelete.
+//
+// Timeouts specified in the resource's `timeouts` block are left unchanged.
+func WithScaledDefaults(ctx context.Context, multiplier float64) context.Context {
+	return context.WithValue(ctx, scaledDefaultsKey{}, multiplier)
+}
+
+func determine(ctx context.Context, d *pluginsdk.ResourceData, key string) time.Duration {
+	timeout := d.Timeout(key)
+
+	multiplier, ok := ctx.Value(scaledDefaultsKey{}).(float64)
+	if !ok || multiplier <= 1 || isConfigured(d, key) {
+		return timeout
+	}
+
+	return time.Duration(float64(timeout) * multiplier)
+}
+
+// isConfigured returns whether the timeout is specified in the resource's `timeouts` block. The configuration isn't
+// available when a resource is being deleted, however the `timeouts` block is also stored in the state.
+func isConfigured(d *pluginsdk.ResourceData, key string) bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		raw = d.GetRawState()
+	}
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() {
+		return false
+	}
+
+	timeouts, ok := raw.AsValueMap()[pluginsdk.TimeoutsConfigKey]
+	if !ok || timeouts.IsNull() || !timeouts.IsKnown() {
+		return false
+	}
+
+	v, ok := timeouts.AsValueMap()[key]
+	return ok && !v.IsNull()
 }
 
 func buildWithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RunInParallel runs the operation for each of the items, with at most maxParallel operations running at once. The
+// errors of every operation which failed are returned together, sorted so that the output is stable regardless of
+// the order in which the operations completed.
+func RunInParallel[T any](maxParallel int, items []T, operation func(item T) error) error {
+	if maxParallel < 1 {
+		maxParallel = 1
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+		errs  []error
+	)
+	semaphore := make(chan struct{}, maxParallel)
+
+	for _, item := range items {
+		wg.Add(1)
+		go func(item T) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			if err := operation(item); err != nil {
+				mutex.Lock()
+				defer mutex.Unlock()
+				errs = append(errs, err)
+			}
+		}(item)
+	}
+
+	wg.Wait()
+
+	sort.Slice(errs, func(i, j int) bool {
+		return strings.Compare(errs[i].Error(), errs[j].Error()) < 0
+	})
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRunInParallel_LimitsConcurrency(t *testing.T) {
+	var running, maxRunning int32
+	var mutex sync.Mutex
+	processed := make(map[int]struct{})
+
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	err := RunInParallel(3, items, func(item int) error {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		if current > maxRunning {
+			maxRunning = current
+		}
+		processed[item] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	if maxRunning > 3 {
+		t.Fatalf("expected at most 3 operations to run at once but got %d", maxRunning)
+	}
+	if len(processed) != len(items) {
+		t.Fatalf("expected %d items to be processed but got %d", len(items), len(processed))
+	}
+}
+
+func TestRunInParallel_SortsErrors(t *testing.T) {
+	err := RunInParallel(5, []string{"c", "a", "b", "d"}, func(item string) error {
+		if item == "d" {
+			return nil
+		}
+		return fmt.Errorf("failed %s", item)
+	})
+
+	expected := "failed a\nfailed b\nfailed c"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected %q but got %v", expected, err)
+	}
+}
+
+func TestRunInParallel_NoItems(t *testing.T) {
+	if err := RunInParallel(0, []string{}, func(string) error { return fmt.Errorf("unexpected") }); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+}